@@ -51,6 +51,48 @@ const (
 
 	// Logging
 	defaultLoggingLevel = "debug"
+
+	// Storefront
+	defaultStorefrontResetHour = 0
+
+	// Gifts
+	defaultGiftsDailyLimit = 5
+
+	// Payments
+	defaultPaymentsProvider      = ""
+	defaultPaymentsFakeEnabled   = false
+	defaultPaymentsWebhookPath   = "/payments/webhook"
+	defaultPaymentsWebhookSecret = ""
+
+	// Rentals
+	defaultRentalsSweepInterval = 60
+
+	// Seasons
+	defaultSeasonsRolloverInterval = 300
+
+	// News
+	defaultNewsPublishInterval = 30
+
+	// Leaderboard
+	defaultLeaderboardCacheStaleness = 30
+
+	// Rewards
+	defaultRewardsKillCoins     = 5
+	defaultRewardsFirstWinCoins = 50
+
+	// Stats plausibility
+	defaultStatsMaxGames        = 10
+	defaultStatsMaxKills        = 200
+	defaultStatsMaxKillsPerGame = 40
+	defaultStatsFlagViolations  = true
+
+	// Images
+	defaultImagesDir     = "images"
+	defaultImagesPath    = "/images/"
+	defaultImagesMaxSize = 5 << 20
+
+	// Localization
+	defaultLocale = "en"
 )
 
 var (
@@ -94,4 +136,37 @@ var (
 	flagSessionPublicKeyPath  = pflag.String("session.key.public.path", defaultPublicKeyPath, "Path to the public key used to sign JWTs")
 
 	flagLoggingLevel = pflag.String("logging.level", defaultLoggingLevel, "log level of application")
+
+	flagStorefrontResetHour = pflag.Int("storefront.reset.hour", defaultStorefrontResetHour, "UTC hour (0-23) at which the featured storefront rotates")
+
+	flagGiftsDailyLimit = pflag.Int("gifts.daily.limit", defaultGiftsDailyLimit, "number of gifts a user can send per day, 0 disables the limit")
+
+	flagPaymentsProvider      = pflag.String("payments.provider", defaultPaymentsProvider, "payment provider used for gem purchases, empty disables payments")
+	flagPaymentsFakeEnabled   = pflag.Bool("payments.fake.enabled", defaultPaymentsFakeEnabled, "allow the fake payment provider, for local profiles only")
+	flagPaymentsWebhookPath   = pflag.String("payments.webhook.path", defaultPaymentsWebhookPath, "path of the payment provider webhook")
+	flagPaymentsWebhookSecret = pflag.String("payments.webhook.secret", defaultPaymentsWebhookSecret, "secret used to verify payment webhook signatures")
+
+	flagRentalsSweepInterval = pflag.Int("rentals.sweep.interval", defaultRentalsSweepInterval, "interval, in seconds, between removals of expired rentals")
+
+	flagSeasonsRolloverInterval = pflag.Int("seasons.rollover.interval", defaultSeasonsRolloverInterval, "interval, in seconds, between archivals of ended seasons")
+
+	flagNewsPublishInterval = pflag.Int("news.publish.interval", defaultNewsPublishInterval, "interval, in seconds, between publications of due scheduled news")
+
+	flagLeaderboardCacheStaleness = pflag.Int("leaderboard.cache.staleness", defaultLeaderboardCacheStaleness, "how old, in seconds, cached leaderboards can get before they are rebuilt, 0 disables the cache")
+
+	flagRewardsPlacementCoins = pflag.IntSlice("rewards.placement.coins", []int{100, 60, 40, 30, 20}, "coins paid for placement 1, 2 and so on of a recorded match")
+	flagRewardsKillCoins      = pflag.Int("rewards.kill.coins", defaultRewardsKillCoins, "coins paid per kill in a recorded match")
+	flagRewardsFirstWinCoins  = pflag.Int("rewards.first.win.coins", defaultRewardsFirstWinCoins, "bonus coins paid for the first win of a UTC day")
+
+	flagStatsMaxGames        = pflag.Int("stats.max.games", defaultStatsMaxGames, "most games a single stats update can add, 0 disables the rule")
+	flagStatsMaxKills        = pflag.Int("stats.max.kills", defaultStatsMaxKills, "most kills a single stats update can add, 0 disables the rule")
+	flagStatsMaxKillsPerGame = pflag.Int("stats.max.game.kills", defaultStatsMaxKillsPerGame, "most kills per game a stats update can add, 0 disables the rule")
+	flagStatsFlagViolations  = pflag.Bool("stats.flag.violations", defaultStatsFlagViolations, "accept implausible stats updates and flag them for review instead of rejecting them")
+
+	flagImagesDir     = pflag.String("images.dir", defaultImagesDir, "directory uploaded images are stored in")
+	flagImagesPath    = pflag.String("images.path", defaultImagesPath, "path images are uploaded to and served from")
+	flagImagesMaxSize = pflag.Int64("images.max.size", defaultImagesMaxSize, "maximum size of an uploaded image in bytes")
+
+	flagLocaleDefault   = pflag.String("locale.default", defaultLocale, "locale store items and news are written in")
+	flagLocaleSupported = pflag.StringSlice("locale.supported", []string{defaultLocale}, "locales store items and news can be translated to")
 )
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"time"
 
 	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/images"
 
+	"github.com/amikhailau/users-service/pkg/payments"
 	"github.com/amikhailau/users-service/pkg/pb"
 	"github.com/amikhailau/users-service/pkg/svc"
 	"github.com/dgrijalva/jwt-go"
@@ -14,6 +18,7 @@ import (
 	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	"github.com/infobloxopen/atlas-app-toolkit/gateway"
 	"github.com/infobloxopen/atlas-app-toolkit/requestid"
+	"github.com/infobloxopen/atlas-app-toolkit/server"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/sirupsen/logrus"
@@ -22,7 +27,8 @@ import (
 	"google.golang.org/grpc/keepalive"
 )
 
-func NewGRPCServer(logger *logrus.Logger, dbConnectionString string) (*grpc.Server, error) {
+// NewGRPCServer creates the gRPC server along with the plain HTTP handlers that have to share its services
+func NewGRPCServer(logger *logrus.Logger, dbConnectionString string) (*grpc.Server, []server.Option, error) {
 	grpcServer := grpc.NewServer(
 		grpc.KeepaliveParams(
 			keepalive.ServerParameters{
@@ -53,36 +59,49 @@ func NewGRPCServer(logger *logrus.Logger, dbConnectionString string) (*grpc.Serv
 	pubKeyBytes, err := ioutil.ReadFile(publicKeyPath)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to read public key file")
-		return nil, err
+		return nil, nil, err
 	}
 	sessionPublicKey, err := jwt.ParseRSAPublicKeyFromPEM(pubKeyBytes)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to parse public key")
-		return nil, err
+		return nil, nil, err
 	}
 
 	privateKeyPath := viper.GetString("session.key.private.path")
 	privKeyBytes, err := ioutil.ReadFile(privateKeyPath)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to read private key file")
-		return nil, err
+		return nil, nil, err
 	}
 	sessionPrivateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privKeyBytes)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to parse private key")
-		return nil, err
+		return nil, nil, err
+	}
+
+	sweepInterval, err := jobInterval("rentals.sweep.interval")
+	if err != nil {
+		return nil, nil, err
+	}
+	rolloverInterval, err := jobInterval("seasons.rollover.interval")
+	if err != nil {
+		return nil, nil, err
+	}
+	newsPublishInterval, err := jobInterval("news.publish.interval")
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// create new postgres database
 	db, err := gorm.Open("postgres", dbConnectionString)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// register service implementation with the grpcServer
 	s, err := svc.NewBasicServer(db)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pb.RegisterUsersServiceServer(grpcServer, s)
 
@@ -92,34 +111,128 @@ func NewGRPCServer(logger *logrus.Logger, dbConnectionString string) (*grpc.Serv
 		RSAPublicKey:  sessionPublicKey,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pb.RegisterUsersServer(grpcServer, usrS)
 
+	locales := svc.LocaleConfig{
+		Default:   viper.GetString("locale.default"),
+		Supported: viper.GetStringSlice("locale.supported"),
+	}
+
+	imageStore, err := images.NewLocalStore(viper.GetString("images.dir"))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	stiS, err := svc.NewStoreItemsServer(&svc.StoreItemsServerConfig{
-		Database: db,
+		Database:    db,
+		GiftsPerDay: viper.GetInt("gifts.daily.limit"),
+		Images:      imageStore,
+		Locales:     locales,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pb.RegisterStoreItemsServer(grpcServer, stiS)
+	go svc.RunExpiredItemsSweeper(context.Background(), stiS, sweepInterval, logger)
 
 	usrstsS, err := svc.NewUsersStatsServer(&svc.UsersStatsServerConfig{
 		Database:    db,
 		UsersServer: usrS,
+		Rewards: svc.RewardsConfig{
+			Placement:     viper.GetIntSlice("rewards.placement.coins"),
+			PerKill:       viper.GetInt("rewards.kill.coins"),
+			FirstWinOfDay: viper.GetInt("rewards.first.win.coins"),
+		},
+		Plausibility: svc.PlausibilityConfig{
+			MaxGames:        int32(viper.GetInt("stats.max.games")),
+			MaxKills:        int32(viper.GetInt("stats.max.kills")),
+			MaxKillsPerGame: int32(viper.GetInt("stats.max.game.kills")),
+			Flag:            viper.GetBool("stats.flag.violations"),
+		},
+		LeaderboardStaleness: time.Duration(viper.GetInt("leaderboard.cache.staleness")) * time.Second,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pb.RegisterUsersStatsServer(grpcServer, usrstsS)
+	go svc.RunSeasonRollover(context.Background(), usrstsS, rolloverInterval, logger)
+	go svc.RunLeaderboardRefresh(context.Background(), usrstsS, logger)
 
 	newsS, err := svc.NewNewsServer(&svc.NewsServerConfig{
 		Database: db,
+		Locales:  locales,
+		Images:   imageStore,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pb.RegisterNewsServiceServer(grpcServer, newsS)
+	go svc.RunNewsScheduler(context.Background(), newsS, newsPublishInterval, logger)
+
+	sfS, err := svc.NewStorefrontServer(&svc.StorefrontServerConfig{
+		Database:  db,
+		ResetHour: viper.GetInt("storefront.reset.hour"),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	pb.RegisterStorefrontServer(grpcServer, sfS)
+
+	handlers := []server.Option{
+		server.WithHandler(viper.GetString("images.path"), svc.NewImagesHandler(&svc.ImagesHandlerConfig{
+			Store:     imageStore,
+			MaxSize:   viper.GetInt64("images.max.size"),
+			Path:      viper.GetString("images.path"),
+			PublicKey: sessionPublicKey,
+		}, logger)),
+	}
+
+	if viper.GetString("payments.provider") == "" {
+		logger.Warn("No payment provider is configured, gem purchases are disabled")
+		return grpcServer, handlers, nil
+	}
+
+	provider, err := newPaymentProvider(viper.GetString("payments.provider"))
+	if err != nil {
+		return nil, nil, err
+	}
+	paymentsS, err := svc.NewPaymentsServer(&svc.PaymentsServerConfig{
+		Database: db,
+		Provider: provider,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	pb.RegisterPaymentsServer(grpcServer, paymentsS)
+	handlers = append(handlers, server.WithHandler(viper.GetString("payments.webhook.path"), svc.NewPaymentsWebhookHandler(paymentsS, logger)))
+
+	return grpcServer, handlers, nil
+}
 
-	return grpcServer, nil
+// jobInterval reads the interval, in seconds, a background job runs at; tickers can't run without a positive one
+func jobInterval(key string) (time.Duration, error) {
+	seconds := viper.GetInt(key)
+	if seconds <= 0 {
+		return 0, fmt.Errorf("%s must be positive", key)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func newPaymentProvider(name string) (payments.PaymentProvider, error) {
+	secret := viper.GetString("payments.webhook.secret")
+	if secret == "" {
+		return nil, fmt.Errorf("payments.webhook.secret must be set to a private value")
+	}
+
+	switch name {
+	case "fake":
+		if !viper.GetBool("payments.fake.enabled") {
+			return nil, fmt.Errorf("fake payment provider is only available with payments.fake.enabled, for local profiles")
+		}
+		return payments.NewFakeProvider(secret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
 }
@@ -95,28 +95,39 @@ func ServeExternal(logger *logrus.Logger) error {
 	if viper.GetString("database.dsn") == "" {
 		setDBConnection()
 	}
-	grpcServer, err := NewGRPCServer(logger, viper.GetString("database.dsn"))
+	grpcServer, handlers, err := NewGRPCServer(logger, viper.GetString("database.dsn"))
 	if err != nil {
 		logger.Fatalln(err)
 	}
 
-	s, err := server.NewServer(
+	endpoint := viper.GetString("gateway.endpoint")
+	registrations := []gateway.Option{
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterUsersServiceHandlerFromEndpoint),
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterUsersHandlerFromEndpoint),
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterUsersStatsHandlerFromEndpoint),
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterNewsServiceHandlerFromEndpoint),
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterStoreItemsHandlerFromEndpoint),
+		gateway.WithEndpointRegistration(endpoint, pb.RegisterStorefrontHandlerFromEndpoint),
+	}
+	// payments are only served when a provider is configured
+	if viper.GetString("payments.provider") != "" {
+		registrations = append(registrations, gateway.WithEndpointRegistration(endpoint, pb.RegisterPaymentsHandlerFromEndpoint))
+	}
+
+	opts := []server.Option{
 		server.WithGrpcServer(grpcServer),
-		server.WithGateway(
+		server.WithGateway(append([]gateway.Option{
 			gateway.WithGatewayOptions(
 				runtime.WithForwardResponseOption(forwardResponseOption),
 				runtime.WithIncomingHeaderMatcher(gateway.ExtendedDefaultHeaderMatcher(
 					requestid.DefaultRequestIDKey)),
 			),
 			gateway.WithServerAddress(fmt.Sprintf("%s:%s", viper.GetString("server.address"), viper.GetString("server.port"))),
-			gateway.WithEndpointRegistration(viper.GetString("gateway.endpoint"), pb.RegisterUsersServiceHandlerFromEndpoint),
-			gateway.WithEndpointRegistration(viper.GetString("gateway.endpoint"), pb.RegisterUsersHandlerFromEndpoint),
-			gateway.WithEndpointRegistration(viper.GetString("gateway.endpoint"), pb.RegisterUsersStatsHandlerFromEndpoint),
-			gateway.WithEndpointRegistration(viper.GetString("gateway.endpoint"), pb.RegisterNewsServiceHandlerFromEndpoint),
-			gateway.WithEndpointRegistration(viper.GetString("gateway.endpoint"), pb.RegisterStoreItemsHandlerFromEndpoint),
-		),
+		}, registrations...)...),
 		server.WithHandler("/swagger/", NewSwaggerHandler(viper.GetString("gateway.swaggerFile"))),
-	)
+	}
+
+	s, err := server.NewServer(append(opts, handlers...)...)
 	if err != nil {
 		logger.Fatalln(err)
 	}
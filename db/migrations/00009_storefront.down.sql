@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TRIGGER storefront_pins_updated_at on storefront_pins;
+
+DROP TABLE storefront_pins;
+
+DROP TRIGGER storefront_pool_entries_updated_at on storefront_pool_entries;
+
+DROP TABLE storefront_pool_entries;
+
+DROP TRIGGER storefront_slots_updated_at on storefront_slots;
+
+DROP TABLE storefront_slots;
+
+COMMIT;
@@ -0,0 +1,51 @@
+BEGIN;
+
+CREATE TABLE storefront_slots (
+  id serial primary key,
+  name varchar NOT NULL,
+  items_count int NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(name)
+);
+
+CREATE TRIGGER storefront_slots_updated_at
+  BEFORE UPDATE OR INSERT ON storefront_slots
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE storefront_pool_entries (
+  id serial primary key,
+  storefront_slot_id int,
+  item_id varchar,
+  weight int NOT NULL DEFAULT 1,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT storefront_pool_entries_slot_id FOREIGN KEY(storefront_slot_id) REFERENCES storefront_slots(id) ON DELETE CASCADE,
+  CONSTRAINT storefront_pool_entries_item_id FOREIGN KEY(item_id) REFERENCES store_items(id) ON DELETE CASCADE,
+  UNIQUE(storefront_slot_id, item_id)
+);
+
+CREATE TRIGGER storefront_pool_entries_updated_at
+  BEFORE UPDATE OR INSERT ON storefront_pool_entries
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE storefront_pins (
+  id serial primary key,
+  slot_id int,
+  day date NOT NULL,
+  store_item_id varchar,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT storefront_pins_slot_id FOREIGN KEY(slot_id) REFERENCES storefront_slots(id) ON DELETE CASCADE,
+  CONSTRAINT storefront_pins_store_item_id FOREIGN KEY(store_item_id) REFERENCES store_items(id) ON DELETE CASCADE,
+  UNIQUE(slot_id, day, store_item_id)
+);
+
+CREATE TRIGGER storefront_pins_updated_at
+  BEFORE UPDATE OR INSERT ON storefront_pins
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER gifts_updated_at on gifts;
+
+DROP TABLE gifts;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE gifts (
+  id varchar primary key,
+  sender_id varchar,
+  recipient_id varchar,
+  store_item_id varchar,
+  message text NOT NULL DEFAULT '',
+  coins_price int NOT NULL,
+  gems_price int NOT NULL,
+  status varchar NOT NULL DEFAULT 'pending',
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT gifts_sender_id FOREIGN KEY(sender_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT gifts_recipient_id FOREIGN KEY(recipient_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT gifts_store_item_id FOREIGN KEY(store_item_id) REFERENCES store_items(id) ON DELETE CASCADE
+);
+
+CREATE INDEX gifts_recipient_id_status ON gifts(recipient_id, status);
+CREATE INDEX gifts_sender_id_created_at ON gifts(sender_id, created_at);
+
+CREATE TRIGGER gifts_updated_at
+  BEFORE UPDATE OR INSERT ON gifts
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TRIGGER purchase_intents_updated_at on purchase_intents;
+
+DROP TABLE purchase_intents;
+
+DROP TRIGGER payment_transactions_updated_at on payment_transactions;
+
+DROP TABLE payment_transactions;
+
+DROP TRIGGER gem_packs_updated_at on gem_packs;
+
+DROP TABLE gem_packs;
+
+COMMIT;
@@ -0,0 +1,59 @@
+BEGIN;
+
+CREATE TABLE gem_packs (
+  id varchar primary key,
+  name varchar NOT NULL,
+  gems int NOT NULL,
+  price_cents bigint NOT NULL,
+  currency varchar NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(name)
+);
+
+CREATE TRIGGER gem_packs_updated_at
+  BEFORE UPDATE OR INSERT ON gem_packs
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE payment_transactions (
+  id serial primary key,
+  provider varchar NOT NULL,
+  provider_transaction_id varchar NOT NULL,
+  user_id varchar,
+  gem_pack_id varchar DEFAULT NULL,
+  gems int NOT NULL,
+  status varchar NOT NULL DEFAULT 'credited',
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT payment_transactions_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+  UNIQUE(provider, provider_transaction_id)
+);
+
+CREATE TRIGGER payment_transactions_updated_at
+  BEFORE UPDATE OR INSERT ON payment_transactions
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- purchase_intents snapshots the pack of a purchase when it's started, so the gems credited when
+-- it settles don't depend on the pack still existing or keeping its amount
+CREATE TABLE purchase_intents (
+  provider varchar NOT NULL,
+  intent_id varchar NOT NULL,
+  user_id varchar NOT NULL,
+  gem_pack_id varchar NOT NULL,
+  gems int NOT NULL,
+  price_cents bigint NOT NULL,
+  currency varchar NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  PRIMARY KEY (provider, intent_id),
+  CONSTRAINT purchase_intents_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER purchase_intents_updated_at
+  BEFORE UPDATE OR INSERT ON purchase_intents
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
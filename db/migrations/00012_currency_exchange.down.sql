@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER currency_exchanges_updated_at on currency_exchanges;
+
+DROP TABLE currency_exchanges;
+
+DROP TRIGGER exchange_rates_updated_at on exchange_rates;
+
+DROP TABLE exchange_rates;
+
+COMMIT;
@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE TABLE exchange_rates (
+  id serial primary key,
+  from_currency varchar NOT NULL,
+  to_currency varchar NOT NULL,
+  rate_gems int NOT NULL DEFAULT 1,
+  rate_coins int NOT NULL,
+  min_amount int NOT NULL DEFAULT 1,
+  max_amount int NOT NULL DEFAULT 0,
+  daily_cap int NOT NULL DEFAULT 0,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(from_currency, to_currency)
+);
+
+CREATE TRIGGER exchange_rates_updated_at
+  BEFORE UPDATE OR INSERT ON exchange_rates
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE currency_exchanges (
+  id serial primary key,
+  user_id varchar,
+  from_currency varchar NOT NULL,
+  to_currency varchar NOT NULL,
+  amount int NOT NULL,
+  received int NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT currency_exchanges_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX currency_exchanges_user_id_created_at ON currency_exchanges(user_id, created_at);
+
+CREATE TRIGGER currency_exchanges_updated_at
+  BEFORE UPDATE OR INSERT ON currency_exchanges
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
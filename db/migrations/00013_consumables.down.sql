@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE users_store_items DROP COLUMN quantity;
+
+ALTER TABLE store_items DROP COLUMN max_stack;
+ALTER TABLE store_items DROP COLUMN consumable;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE store_items ADD COLUMN consumable boolean DEFAULT FALSE;
+ALTER TABLE store_items ADD COLUMN max_stack int DEFAULT 0;
+
+ALTER TABLE users_store_items ADD COLUMN quantity int NOT NULL DEFAULT 1;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE store_items DROP CONSTRAINT store_items_type;
+
+DROP TRIGGER item_types_updated_at ON item_types;
+DROP TABLE item_types;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE item_types (
+  id int primary key,
+  name varchar NOT NULL,
+  display_name varchar NOT NULL DEFAULT '',
+  slot varchar NOT NULL DEFAULT '',
+  slot_capacity int NOT NULL DEFAULT 1,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(name)
+);
+
+CREATE TRIGGER item_types_updated_at
+  BEFORE UPDATE OR INSERT ON item_types
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- every existing type keeps its own single item slot, as EquipByUser used to assume
+INSERT INTO item_types (id, name, display_name, slot, slot_capacity)
+  SELECT DISTINCT type, 'type_' || type, 'Type ' || type, 'slot_' || type, 1 FROM store_items;
+
+ALTER TABLE store_items ADD CONSTRAINT store_items_type FOREIGN KEY(type) REFERENCES item_types(id);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER loadout_items_updated_at on loadout_items;
+
+DROP TABLE loadout_items;
+
+DROP TRIGGER loadouts_updated_at on loadouts;
+
+DROP TABLE loadouts;
+
+COMMIT;
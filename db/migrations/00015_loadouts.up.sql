@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE loadouts (
+  id serial primary key,
+  user_id varchar NOT NULL,
+  name varchar NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(user_id, name),
+  CONSTRAINT loadouts_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER loadouts_updated_at
+  BEFORE UPDATE OR INSERT ON loadouts
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE loadout_items (
+  id serial primary key,
+  loadout_id int NOT NULL,
+  store_item_id varchar NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(loadout_id, store_item_id),
+  CONSTRAINT loadout_items_loadout_id FOREIGN KEY(loadout_id) REFERENCES loadouts(id) ON DELETE CASCADE,
+  CONSTRAINT loadout_items_store_item_id FOREIGN KEY(store_item_id) REFERENCES store_items(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER loadout_items_updated_at
+  BEFORE UPDATE OR INSERT ON loadout_items
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
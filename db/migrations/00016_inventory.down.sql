@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP VIEW inventory_entries;
+
+DROP INDEX users_store_items_user_id;
+
+ALTER TABLE users_store_items DROP COLUMN source;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE users_store_items ADD COLUMN source varchar NOT NULL DEFAULT 'purchase';
+
+CREATE INDEX users_store_items_user_id ON users_store_items(user_id);
+
+CREATE VIEW inventory_entries AS
+  SELECT usi.user_id, si.id AS item_id, si.name, si.description, si.type, si.coins_price, si.gems_price,
+    si.image_id, si.on_sale, si.sale_coins_price, si.sale_gems_price, si.consumable, si.max_stack,
+    usi.equipped, usi.quantity, usi.created_at AS acquired_at, usi.source
+  FROM users_store_items usi
+  JOIN store_items si ON si.id = usi.store_item_id;
+
+COMMIT;
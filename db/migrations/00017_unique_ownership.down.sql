@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE users_store_items DROP CONSTRAINT users_store_items_user_id_store_item_id;
+
+DROP TRIGGER ownership_refunds_updated_at on ownership_refunds;
+
+DROP TABLE ownership_refunds;
+
+COMMIT;
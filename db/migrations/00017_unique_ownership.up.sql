@@ -0,0 +1,52 @@
+BEGIN;
+
+CREATE TABLE ownership_refunds (
+  id serial primary key,
+  user_id varchar NOT NULL,
+  store_item_id varchar NOT NULL,
+  duplicates int NOT NULL,
+  coins int NOT NULL,
+  gems int NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT ownership_refunds_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT ownership_refunds_store_item_id FOREIGN KEY(store_item_id) REFERENCES store_items(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER ownership_refunds_updated_at
+  BEFORE UPDATE OR INSERT ON ownership_refunds
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- every extra copy of a non-consumable item is refunded at its current list price
+INSERT INTO ownership_refunds (user_id, store_item_id, duplicates, coins, gems)
+  SELECT usi.user_id, usi.store_item_id, count(*) - 1, (count(*) - 1) * si.coins_price, (count(*) - 1) * si.gems_price
+  FROM users_store_items usi
+  JOIN store_items si ON si.id = usi.store_item_id
+  WHERE NOT si.consumable
+  GROUP BY usi.user_id, usi.store_item_id, si.coins_price, si.gems_price
+  HAVING count(*) > 1;
+
+UPDATE users u SET coins = u.coins + r.coins, gems = u.gems + r.gems
+  FROM (SELECT user_id, SUM(coins) AS coins, SUM(gems) AS gems FROM ownership_refunds GROUP BY user_id) r
+  WHERE u.id = r.user_id;
+
+-- duplicates collapse into the oldest row, consumable stacks keep their total quantity
+UPDATE users_store_items usi SET
+    quantity = CASE WHEN merged.consumable THEN merged.quantity ELSE usi.quantity END,
+    equipped = merged.equipped
+  FROM (
+    SELECT min(u.id) AS id, SUM(u.quantity) AS quantity, bool_or(u.equipped) AS equipped, si.consumable
+    FROM users_store_items u
+    JOIN store_items si ON si.id = u.store_item_id
+    GROUP BY u.user_id, u.store_item_id, si.consumable
+    HAVING count(*) > 1
+  ) merged
+  WHERE usi.id = merged.id;
+
+DELETE FROM users_store_items usi USING users_store_items kept
+  WHERE usi.user_id = kept.user_id AND usi.store_item_id = kept.store_item_id AND usi.id > kept.id;
+
+ALTER TABLE users_store_items ADD CONSTRAINT users_store_items_user_id_store_item_id UNIQUE(user_id, store_item_id);
+
+COMMIT;
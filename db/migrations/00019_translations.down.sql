@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER news_translations_updated_at on news_translations;
+
+DROP TABLE news_translations;
+
+DROP TRIGGER store_item_translations_updated_at on store_item_translations;
+
+DROP TABLE store_item_translations;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TABLE store_item_translations (
+  id serial primary key,
+  store_item_id varchar NOT NULL,
+  locale varchar NOT NULL,
+  name varchar NOT NULL,
+  description text NOT NULL DEFAULT '',
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(store_item_id, locale),
+  CONSTRAINT store_item_translations_store_item_id FOREIGN KEY(store_item_id) REFERENCES store_items(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER store_item_translations_updated_at
+  BEFORE UPDATE OR INSERT ON store_item_translations
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE news_translations (
+  id serial primary key,
+  news_id varchar NOT NULL,
+  locale varchar NOT NULL,
+  title varchar NOT NULL,
+  description text NOT NULL DEFAULT '',
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(news_id, locale),
+  CONSTRAINT news_translations_news_id FOREIGN KEY(news_id) REFERENCES news(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER news_translations_updated_at
+  BEFORE UPDATE OR INSERT ON news_translations
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
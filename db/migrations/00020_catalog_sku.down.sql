@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP VIEW inventory_entries;
+
+CREATE VIEW inventory_entries AS
+  SELECT usi.user_id, si.id AS item_id, si.name, si.description, si.type, si.coins_price, si.gems_price,
+    si.image_id, si.on_sale, si.sale_coins_price, si.sale_gems_price, si.consumable, si.max_stack,
+    usi.equipped, usi.quantity, usi.created_at AS acquired_at, usi.source, usi.expires_at,
+    si.rental_days, si.rental_coins_price, si.rental_gems_price
+  FROM users_store_items usi
+  JOIN store_items si ON si.id = usi.store_item_id
+  WHERE usi.expires_at IS NULL OR usi.expires_at > now();
+
+ALTER TABLE store_items DROP CONSTRAINT store_items_sku;
+ALTER TABLE store_items DROP COLUMN sku;
+
+COMMIT;
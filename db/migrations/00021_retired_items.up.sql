@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE store_items ADD COLUMN retired boolean NOT NULL DEFAULT FALSE;
+
+CREATE OR REPLACE VIEW inventory_entries AS
+  SELECT usi.user_id, si.id AS item_id, si.name, si.description, si.type, si.coins_price, si.gems_price,
+    si.image_id, si.on_sale, si.sale_coins_price, si.sale_gems_price, si.consumable, si.max_stack,
+    usi.equipped, usi.quantity, usi.created_at AS acquired_at, usi.source, usi.expires_at,
+    si.rental_days, si.rental_coins_price, si.rental_gems_price, si.sku, si.retired
+  FROM users_store_items usi
+  JOIN store_items si ON si.id = usi.store_item_id
+  WHERE usi.expires_at IS NULL OR usi.expires_at > now();
+
+COMMIT;
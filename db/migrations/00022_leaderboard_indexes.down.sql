@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX user_stats_top5;
+DROP INDEX user_stats_games;
+DROP INDEX user_stats_kills;
+DROP INDEX user_stats_wins;
+
+COMMIT;
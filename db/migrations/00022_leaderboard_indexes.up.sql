@@ -0,0 +1,8 @@
+BEGIN;
+
+CREATE INDEX user_stats_wins ON user_stats (wins, user_id COLLATE "C");
+CREATE INDEX user_stats_kills ON user_stats (kills, user_id COLLATE "C");
+CREATE INDEX user_stats_games ON user_stats (games, user_id COLLATE "C");
+CREATE INDEX user_stats_top5 ON user_stats (top5, user_id COLLATE "C");
+
+COMMIT;
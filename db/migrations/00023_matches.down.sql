@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TRIGGER stat_baselines_updated_at on stat_baselines;
+DROP TABLE stat_baselines;
+
+DROP TRIGGER stat_updates_updated_at on stat_updates;
+DROP TABLE stat_updates;
+
+DROP TRIGGER match_participants_updated_at on match_participants;
+DROP TABLE match_participants;
+
+DROP TRIGGER matches_updated_at on matches;
+DROP TABLE matches;
+
+COMMIT;
@@ -0,0 +1,76 @@
+BEGIN;
+
+CREATE TABLE matches (
+  id varchar primary key,
+  mode varchar NOT NULL,
+  duration_seconds int NOT NULL DEFAULT 0,
+  players int NOT NULL,
+  played_at timestamptz NOT NULL DEFAULT current_timestamp,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL
+);
+
+CREATE TRIGGER matches_updated_at
+  BEFORE UPDATE OR INSERT ON matches
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE match_participants (
+  match_id varchar NOT NULL,
+  user_id varchar NOT NULL,
+  placement int NOT NULL,
+  kills int NOT NULL DEFAULT 0,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  PRIMARY KEY (match_id, user_id),
+  CONSTRAINT match_participants_match_id FOREIGN KEY(match_id) REFERENCES matches(id) ON DELETE CASCADE,
+  CONSTRAINT match_participants_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX match_participants_user_id ON match_participants(user_id);
+
+CREATE TRIGGER match_participants_updated_at
+  BEFORE UPDATE OR INSERT ON match_participants
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- stat_updates logs every stats change, rebuilding stats adds it up on top of stat_baselines
+CREATE TABLE stat_updates (
+  id bigserial primary key,
+  user_id varchar NOT NULL,
+  games int NOT NULL,
+  wins int NOT NULL,
+  top5 int NOT NULL,
+  kills int NOT NULL,
+  played_at timestamptz NOT NULL DEFAULT current_timestamp,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT stat_updates_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER stat_updates_updated_at
+  BEFORE UPDATE OR INSERT ON stat_updates
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- stat_baselines holds the lifetime stats counted before stat_updates logged every change
+CREATE TABLE stat_baselines (
+  user_id varchar primary key,
+  games int NOT NULL,
+  wins int NOT NULL,
+  top5 int NOT NULL,
+  kills int NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT stat_baselines_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER stat_baselines_updated_at
+  BEFORE UPDATE OR INSERT ON stat_baselines
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+INSERT INTO stat_baselines (user_id, games, wins, top5, kills)
+SELECT user_id, games, wins, top5, kills FROM user_stats;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TRIGGER season_standings_updated_at on season_standings;
+DROP TABLE season_standings;
+
+DROP TRIGGER season_stats_updated_at on season_stats;
+DROP TABLE season_stats;
+
+DROP TRIGGER seasons_updated_at on seasons;
+DROP TABLE seasons;
+
+COMMIT;
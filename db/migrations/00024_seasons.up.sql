@@ -0,0 +1,60 @@
+BEGIN;
+
+CREATE TABLE seasons (
+  id serial primary key,
+  name varchar NOT NULL,
+  starts_at timestamptz NOT NULL,
+  ends_at timestamptz NOT NULL,
+  archived_at timestamptz DEFAULT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(name),
+  CONSTRAINT seasons_dates CHECK (ends_at > starts_at)
+);
+
+CREATE TRIGGER seasons_updated_at
+  BEFORE UPDATE OR INSERT ON seasons
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- season_stats holds the stats of seasons that are still running or waiting for rollover
+CREATE TABLE season_stats (
+  season_id int NOT NULL,
+  user_id varchar NOT NULL,
+  games int NOT NULL DEFAULT 0,
+  wins int NOT NULL DEFAULT 0,
+  top5 int NOT NULL DEFAULT 0,
+  kills int NOT NULL DEFAULT 0,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  PRIMARY KEY (season_id, user_id),
+  CONSTRAINT season_stats_season_id FOREIGN KEY(season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+  CONSTRAINT season_stats_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER season_stats_updated_at
+  BEFORE UPDATE OR INSERT ON season_stats
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+-- season_standings freezes the final stats of archived seasons
+CREATE TABLE season_standings (
+  season_id int NOT NULL,
+  user_id varchar NOT NULL,
+  games int NOT NULL,
+  wins int NOT NULL,
+  top5 int NOT NULL,
+  kills int NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  PRIMARY KEY (season_id, user_id),
+  CONSTRAINT season_standings_season_id FOREIGN KEY(season_id) REFERENCES seasons(id) ON DELETE CASCADE,
+  CONSTRAINT season_standings_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER season_standings_updated_at
+  BEFORE UPDATE OR INSERT ON season_standings
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER rating_history_updated_at on rating_history;
+DROP TABLE rating_history;
+
+DROP TRIGGER user_ratings_updated_at on user_ratings;
+DROP TABLE user_ratings;
+
+COMMIT;
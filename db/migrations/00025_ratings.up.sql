@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE TABLE user_ratings (
+  user_id varchar primary key,
+  rating double precision NOT NULL,
+  deviation double precision NOT NULL,
+  volatility double precision NOT NULL,
+  games int NOT NULL DEFAULT 0,
+  last_played_at timestamptz NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT user_ratings_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER user_ratings_updated_at
+  BEFORE UPDATE OR INSERT ON user_ratings
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE rating_history (
+  id serial primary key,
+  user_id varchar NOT NULL,
+  match_id varchar NOT NULL,
+  rating double precision NOT NULL,
+  deviation double precision NOT NULL,
+  volatility double precision NOT NULL,
+  played_at timestamptz NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT rating_history_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT rating_history_match_id FOREIGN KEY(match_id) REFERENCES matches(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER rating_history_updated_at
+  BEFORE UPDATE OR INSERT ON rating_history
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE INDEX rating_history_user_played_at ON rating_history(user_id, played_at);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER user_achievements_updated_at on user_achievements;
+DROP TABLE user_achievements;
+
+DROP TRIGGER achievements_updated_at on achievements;
+DROP TABLE achievements;
+
+COMMIT;
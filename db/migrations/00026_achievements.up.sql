@@ -0,0 +1,44 @@
+BEGIN;
+
+CREATE TABLE achievements (
+  id serial primary key,
+  name varchar NOT NULL,
+  description varchar NOT NULL DEFAULT '',
+  metric varchar NOT NULL,
+  scope varchar NOT NULL,
+  threshold int NOT NULL,
+  reward_coins int NOT NULL DEFAULT 0,
+  reward_gems int NOT NULL DEFAULT 0,
+  reward_item_id varchar DEFAULT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  UNIQUE(name),
+  CONSTRAINT achievements_metric CHECK (metric IN ('games', 'wins', 'top5', 'kills')),
+  CONSTRAINT achievements_scope CHECK (scope IN ('lifetime', 'match')),
+  CONSTRAINT achievements_threshold CHECK (threshold > 0),
+  CONSTRAINT achievements_reward_item_id FOREIGN KEY(reward_item_id) REFERENCES store_items(id) ON DELETE SET NULL
+);
+
+CREATE TRIGGER achievements_updated_at
+  BEFORE UPDATE OR INSERT ON achievements
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TABLE user_achievements (
+  user_id varchar NOT NULL,
+  achievement_id int NOT NULL,
+  progress int NOT NULL DEFAULT 0,
+  unlocked_at timestamptz DEFAULT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  PRIMARY KEY (user_id, achievement_id),
+  CONSTRAINT user_achievements_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT user_achievements_achievement_id FOREIGN KEY(achievement_id) REFERENCES achievements(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER user_achievements_updated_at
+  BEFORE UPDATE OR INSERT ON user_achievements
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX stat_updates_user_games_played_at;
+DROP INDEX stat_updates_user_played_at;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- the windowed stats are computed from the stats update log by play time
+CREATE INDEX stat_updates_user_played_at ON stat_updates(user_id, played_at);
+CREATE INDEX stat_updates_user_games_played_at ON stat_updates(user_id, played_at) WHERE games > 0;
+
+COMMIT;
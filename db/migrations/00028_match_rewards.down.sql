@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE match_participants DROP COLUMN reward_coins;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE match_participants ADD COLUMN reward_coins int NOT NULL DEFAULT 0;
+
+COMMIT;
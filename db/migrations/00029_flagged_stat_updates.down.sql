@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TRIGGER flagged_stat_updates_updated_at on flagged_stat_updates;
+DROP TABLE flagged_stat_updates;
+
+COMMIT;
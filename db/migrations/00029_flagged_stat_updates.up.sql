@@ -0,0 +1,29 @@
+BEGIN;
+
+-- flagged_stat_updates is the review queue of implausible stat updates that were accepted
+CREATE TABLE flagged_stat_updates (
+  id serial primary key,
+  user_id varchar NOT NULL,
+  stat_update_id bigint DEFAULT NULL,
+  games int NOT NULL,
+  wins int NOT NULL,
+  top5 int NOT NULL,
+  kills int NOT NULL,
+  violations varchar NOT NULL,
+  status varchar NOT NULL DEFAULT 'pending',
+  resolved_at timestamptz DEFAULT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  CONSTRAINT flagged_stat_updates_user_id FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT flagged_stat_updates_stat_update_id FOREIGN KEY(stat_update_id) REFERENCES stat_updates(id) ON DELETE SET NULL,
+  CONSTRAINT flagged_stat_updates_status CHECK (status IN ('pending', 'cleared', 'rolled_back'))
+);
+
+CREATE TRIGGER flagged_stat_updates_updated_at
+  BEFORE UPDATE OR INSERT ON flagged_stat_updates
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE INDEX flagged_stat_updates_status_created_at ON flagged_stat_updates(status, created_at);
+
+COMMIT;
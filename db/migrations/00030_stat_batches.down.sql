@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TRIGGER stat_batches_updated_at on stat_batches;
+DROP TABLE stat_batches;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- stat_batches makes batch stats submissions idempotent on the match id,
+-- results holds the serialized response replayed to retries
+CREATE TABLE stat_batches (
+  match_id varchar primary key,
+  results bytea NOT NULL,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL
+);
+
+CREATE TRIGGER stat_batches_updated_at
+  BEFORE UPDATE OR INSERT ON stat_batches
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX news_status_publish_at;
+ALTER TABLE news DROP COLUMN published_at;
+ALTER TABLE news DROP COLUMN publish_at;
+ALTER TABLE news DROP COLUMN status;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- status holds NewsStatus: 0 draft, 1 scheduled, 2 published, 3 archived.
+-- News created before the workflow were visible to players, so they stay published
+ALTER TABLE news ADD COLUMN status int NOT NULL DEFAULT 2;
+ALTER TABLE news ALTER COLUMN status SET DEFAULT 0;
+ALTER TABLE news ADD CONSTRAINT news_status CHECK (status BETWEEN 0 AND 3);
+ALTER TABLE news ADD COLUMN publish_at timestamptz DEFAULT NULL;
+ALTER TABLE news ADD COLUMN published_at timestamptz DEFAULT NULL;
+
+UPDATE news SET published_at = created_at;
+
+CREATE INDEX news_status_publish_at ON news(status, publish_at);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER season_stat_baselines_updated_at on season_stat_baselines;
+DROP TABLE season_stat_baselines;
+
+DROP TRIGGER stat_baselines_updated_at on stat_baselines;
+DROP TABLE stat_baselines;
+
+COMMIT;
@@ -15,6 +15,7 @@ require (
 	github.com/infobloxopen/protoc-gen-atlas-validate v0.4.1
 	github.com/infobloxopen/protoc-gen-gorm v0.20.0
 	github.com/jinzhu/gorm v1.9.16
+	github.com/lib/pq v1.1.1
 	github.com/satori/go.uuid v1.2.0
 	github.com/sirupsen/logrus v1.7.0
 	github.com/spf13/pflag v1.0.5
@@ -2,6 +2,10 @@ package auth
 
 import (
 	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -15,7 +19,17 @@ import (
 
 var (
 	svcEndpoints = []string{"Users/GrantCurrencies", "UsersService/GetVersion", "StoreItems/Create", "StoreItems/Update",
-		"StoreItems/ThrowAwayByUser", "StoreItems/Delete", "UsersStats/UpdateStats", "NewsService/Create", "NewsService/Update"}
+		"StoreItems/ThrowAwayByUser", "StoreItems/Delete", "UsersStats/UpdateStats", "NewsService/Create", "NewsService/Update",
+		"Storefront/PreviewStorefront", "Storefront/CreateStorefrontSlot", "Storefront/UpdateStorefrontSlot", "Storefront/DeleteStorefrontSlot",
+		"Storefront/ListStorefrontSlots", "Storefront/PinStorefrontItem", "Storefront/UnpinStorefrontItem",
+		"Payments/CreateGemPack", "Payments/DeleteGemPack", "Users/SetExchangeRate", "Users/DeleteExchangeRate",
+		"StoreItems/ConsumeItem", "StoreItems/SetItemType", "StoreItems/DeleteItemType",
+		"StoreItems/GrantItem", "StoreItems/SetItemTranslation", "StoreItems/DeleteItemTranslation",
+		"NewsService/SetNewsTranslation", "NewsService/DeleteNewsTranslation", "StoreItems/ImportCatalog", "StoreItems/ExportCatalog",
+		"StoreItems/PurgeItem", "UsersStats/RecordMatch", "UsersStats/RebuildStats", "UsersStats/CreateSeason",
+		"UsersStats/CreateAchievement", "UsersStats/DeleteAchievement",
+		"UsersStats/ListFlaggedStatUpdates", "UsersStats/ClearFlaggedStatUpdate", "UsersStats/RollbackFlaggedStatUpdate",
+		"UsersStats/BatchUpdateStats", "NewsService/Delete", "NewsService/Publish", "NewsService/Unpublish"}
 )
 
 func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -60,12 +74,47 @@ func GetAuthorizationData(ctx context.Context) (*GameClaims, error) {
 		logger.WithError(err).Error("Token not found")
 		return nil, err
 	}
-	claims := &GameClaims{}
-	parser := &jwt.Parser{}
-	_, _, err = parser.ParseUnverified(token, claims)
+	claims, err := parseClaims(token)
 	if err != nil {
 		logger.WithError(err).Error("Not able to parse token")
 		return nil, err
 	}
 	return claims, nil
 }
+
+// GetHTTPAuthorizationData reads the claims of a bearer token for handlers living outside of the gRPC server,
+// the token has to be signed by the session key
+func GetHTTPAuthorizationData(request *http.Request, key *rsa.PublicKey) (*GameClaims, error) {
+	header := request.Header.Get("Authorization")
+	if len(header) <= len("bearer ") || !strings.EqualFold(header[:len("bearer ")], "bearer ") {
+		return nil, errors.New("bearer token not found")
+	}
+	return verifyClaims(header[len("bearer "):], key)
+}
+
+// verifyClaims parses a token signed with RS512, the method login tokens are signed with
+func verifyClaims(token string, key *rsa.PublicKey) (*GameClaims, error) {
+	if key == nil {
+		return nil, errors.New("no key to verify the token with")
+	}
+	claims := &GameClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(parsed *jwt.Token) (interface{}, error) {
+		if parsed.Method != jwt.SigningMethodRS512 {
+			return nil, fmt.Errorf("unexpected signing method %v", parsed.Header["alg"])
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func parseClaims(token string) (*GameClaims, error) {
+	claims := &GameClaims{}
+	parser := &jwt.Parser{}
+	if _, _, err := parser.ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
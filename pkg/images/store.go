@@ -0,0 +1,21 @@
+package images
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// BlobStore keeps the uploaded image files, keys are slash separated paths
+type BlobStore interface {
+	// Put stores data under key, replacing whatever was there
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the data stored under key or ErrNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Exists reports whether anything is stored under key
+	Exists(ctx context.Context, key string) (bool, error)
+}
@@ -0,0 +1,135 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+)
+
+const (
+	// Original is the variant name of the uploaded file itself
+	Original = "original"
+	// MaxDimension bounds the width and height of uploads so decoding stays cheap
+	MaxDimension = 4096
+
+	jpegQuality = 90
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	ErrTooLarge        = errors.New("image dimensions are too large")
+)
+
+// Variant is a resized copy of an upload that fits into a Size x Size box
+type Variant struct {
+	Name string
+	Size int
+}
+
+var Variants = []Variant{
+	{Name: "thumb", Size: 128},
+	{Name: "medium", Size: 512},
+}
+
+// Key is the BlobStore key of an image variant
+func Key(id, variant string) string {
+	return id + "/" + variant
+}
+
+// IsVariant reports whether name is Original or one of Variants
+func IsVariant(name string) bool {
+	if name == Original {
+		return true
+	}
+	for _, v := range Variants {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Process validates an uploaded PNG or JPEG and encodes all of its Variants in the same format
+func Process(data []byte) (map[string][]byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "png" && format != "jpeg") {
+		return nil, ErrUnsupportedType
+	}
+	if cfg.Width > MaxDimension || cfg.Height > MaxDimension {
+		return nil, ErrTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+
+	variants := map[string][]byte{Original: data}
+	for _, v := range Variants {
+		var buf bytes.Buffer
+		resized := resize(src, v.Size)
+		if format == "png" {
+			err = png.Encode(&buf, resized)
+		} else {
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+		}
+		if err != nil {
+			return nil, err
+		}
+		variants[v.Name] = buf.Bytes()
+	}
+	return variants, nil
+}
+
+// resize scales src down with a box filter so it fits into a size x size box, smaller images are kept as they are
+func resize(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return src
+	}
+
+	dstWidth, dstHeight := size, size
+	if width > height {
+		dstHeight = height * size / width
+	} else {
+		dstWidth = width * size / height
+	}
+	if dstWidth == 0 {
+		dstWidth = 1
+	}
+	if dstHeight == 0 {
+		dstHeight = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		y0, y1 := bounds.Min.Y+y*height/dstHeight, bounds.Min.Y+(y+1)*height/dstHeight
+		for x := 0; x < dstWidth; x++ {
+			x0, x1 := bounds.Min.X+x*width/dstWidth, bounds.Min.X+(x+1)*width/dstWidth
+
+			// channels are alpha premultiplied so averaging them doesn't bleed transparent colors
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
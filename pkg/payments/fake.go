@@ -0,0 +1,152 @@
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	fakeProviderName    = "fake"
+	fakeSignatureHeader = "X-Fake-Signature"
+	fakeReceiptPrefix   = "fake-receipt:"
+)
+
+// FakeProvider is an in-memory provider for local development and tests.
+// Purchases are settled by calling CompletePurchase, which produces the
+// signed webhook a real provider would send.
+type FakeProvider struct {
+	secret []byte
+
+	mu           sync.Mutex
+	intents      map[string]*PurchaseIntent
+	transactions map[string]*Event
+}
+
+var _ PaymentProvider = &FakeProvider{}
+
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{
+		secret:       []byte(secret),
+		intents:      map[string]*PurchaseIntent{},
+		transactions: map[string]*Event{},
+	}
+}
+
+func (p *FakeProvider) Name() string {
+	return fakeProviderName
+}
+
+func (p *FakeProvider) SignatureHeader() string {
+	return fakeSignatureHeader
+}
+
+func (p *FakeProvider) CreatePurchaseIntent(ctx context.Context, intent *PurchaseIntent) (*PurchaseIntentResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intentID := uuid.NewV4().String()
+	p.intents[intentID] = intent
+
+	return &PurchaseIntentResult{IntentID: intentID, ClientSecret: p.Sign([]byte(intentID))}, nil
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, signature string) (*Event, error) {
+	expected, err := hex.DecodeString(p.Sign(payload))
+	if err != nil {
+		return nil, err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return nil, ErrInvalidSignature
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (p *FakeProvider) ValidateReceipt(ctx context.Context, receipt string) (*Event, error) {
+	if !strings.HasPrefix(receipt, fakeReceiptPrefix) {
+		return nil, ErrInvalidReceipt
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	event, ok := p.transactions[strings.TrimPrefix(receipt, fakeReceiptPrefix)]
+	if !ok {
+		return nil, ErrInvalidReceipt
+	}
+	return event, nil
+}
+
+// Sign returns the hex encoded signature the provider attaches to a payload
+func (p *FakeProvider) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CompletePurchase settles an intent and returns the signed webhook payload along with the client receipt
+func (p *FakeProvider) CompletePurchase(intentID string) (payload []byte, signature, receipt string, err error) {
+	p.mu.Lock()
+	intent, ok := p.intents[intentID]
+	if !ok {
+		p.mu.Unlock()
+		return nil, "", "", ErrUnknownIntent
+	}
+	delete(p.intents, intentID)
+
+	event := &Event{
+		Type:          EventPurchase,
+		TransactionID: uuid.NewV4().String(),
+		IntentID:      intentID,
+		UserID:        intent.UserID,
+		PackID:        intent.PackID,
+	}
+	p.transactions[event.TransactionID] = event
+	p.mu.Unlock()
+
+	payload, signature, err = p.signEvent(event)
+	return payload, signature, fakeReceiptPrefix + event.TransactionID, err
+}
+
+// Refund returns the signed webhook payload reversing a completed transaction
+func (p *FakeProvider) Refund(transactionID string) ([]byte, string, error) {
+	return p.reverse(transactionID, EventRefund)
+}
+
+// Chargeback returns the signed webhook payload of a disputed transaction
+func (p *FakeProvider) Chargeback(transactionID string) ([]byte, string, error) {
+	return p.reverse(transactionID, EventChargeback)
+}
+
+func (p *FakeProvider) reverse(transactionID string, eventType EventType) ([]byte, string, error) {
+	p.mu.Lock()
+	purchase, ok := p.transactions[transactionID]
+	p.mu.Unlock()
+	if !ok {
+		return nil, "", ErrInvalidReceipt
+	}
+
+	event := *purchase
+	event.Type = eventType
+	return p.signEvent(&event)
+}
+
+func (p *FakeProvider) signEvent(event *Event) ([]byte, string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, p.Sign(payload), nil
+}
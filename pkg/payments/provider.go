@@ -0,0 +1,55 @@
+package payments
+
+import (
+	"context"
+	"errors"
+)
+
+type EventType string
+
+const (
+	EventPurchase   EventType = "purchase"
+	EventRefund     EventType = "refund"
+	EventChargeback EventType = "chargeback"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidReceipt   = errors.New("invalid receipt")
+	ErrUnknownIntent    = errors.New("unknown purchase intent")
+)
+
+// PaymentProvider is the real-money backend gems are bought through
+type PaymentProvider interface {
+	// Name identifies the provider, transaction ids are only unique per provider
+	Name() string
+	// SignatureHeader is the HTTP header the provider puts webhook signatures into
+	SignatureHeader() string
+	// CreatePurchaseIntent registers an upcoming payment and returns what the client needs to complete it
+	CreatePurchaseIntent(ctx context.Context, intent *PurchaseIntent) (*PurchaseIntentResult, error)
+	// VerifyWebhook checks the payload signature and decodes the event it carries
+	VerifyWebhook(payload []byte, signature string) (*Event, error)
+	// ValidateReceipt checks a receipt handed over by the client and returns the settled purchase
+	ValidateReceipt(ctx context.Context, receipt string) (*Event, error)
+}
+
+type PurchaseIntent struct {
+	UserID     string
+	PackID     string
+	PriceCents int64
+	Currency   string
+}
+
+type PurchaseIntentResult struct {
+	IntentID     string
+	ClientSecret string
+}
+
+// Event is a settled change to a provider transaction
+type Event struct {
+	Type          EventType `json:"type"`
+	TransactionID string    `json:"transaction_id"`
+	IntentID      string    `json:"intent_id"`
+	UserID        string    `json:"user_id"`
+	PackID        string    `json:"pack_id"`
+}
@@ -32,6 +32,98 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// LeaderboardMetric is a user stat players can be ranked by
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_WINS  LeaderboardMetric = 0
+	LeaderboardMetric_KILLS LeaderboardMetric = 1
+	LeaderboardMetric_GAMES LeaderboardMetric = 2
+	LeaderboardMetric_TOP5  LeaderboardMetric = 3
+)
+
+var LeaderboardMetric_name = map[int32]string{
+	0: "WINS",
+	1: "KILLS",
+	2: "GAMES",
+	3: "TOP5",
+}
+
+var LeaderboardMetric_value = map[string]int32{
+	"WINS":  0,
+	"KILLS": 1,
+	"GAMES": 2,
+	"TOP5":  3,
+}
+
+func (x LeaderboardMetric) String() string {
+	return proto.EnumName(LeaderboardMetric_name, int32(x))
+}
+
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{0}
+}
+
+type AchievementScope int32
+
+const (
+	// LIFETIME achievements track the stats totals
+	AchievementScope_LIFETIME AchievementScope = 0
+	// MATCH achievements track the best single game, stats updates adding
+	// several games don't count toward them
+	AchievementScope_MATCH AchievementScope = 1
+)
+
+var AchievementScope_name = map[int32]string{
+	0: "LIFETIME",
+	1: "MATCH",
+}
+
+var AchievementScope_value = map[string]int32{
+	"LIFETIME": 0,
+	"MATCH":    1,
+}
+
+func (x AchievementScope) String() string {
+	return proto.EnumName(AchievementScope_name, int32(x))
+}
+
+func (AchievementScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{1}
+}
+
+// NewsStatus is the publishing state of a news, only published news are visible to players
+type NewsStatus int32
+
+const (
+	NewsStatus_DRAFT     NewsStatus = 0
+	NewsStatus_SCHEDULED NewsStatus = 1
+	NewsStatus_PUBLISHED NewsStatus = 2
+	NewsStatus_ARCHIVED  NewsStatus = 3
+)
+
+var NewsStatus_name = map[int32]string{
+	0: "DRAFT",
+	1: "SCHEDULED",
+	2: "PUBLISHED",
+	3: "ARCHIVED",
+}
+
+var NewsStatus_value = map[string]int32{
+	"DRAFT":     0,
+	"SCHEDULED": 1,
+	"PUBLISHED": 2,
+	"ARCHIVED":  3,
+}
+
+func (x NewsStatus) String() string {
+	return proto.EnumName(NewsStatus_name, int32(x))
+}
+
+func (NewsStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{2}
+}
+
 // TODO: Structure your own protobuf messages. Each protocol buffer message is a
 // small logical record of information, containing a series of name-value pairs.
 type VersionResponse struct {
@@ -888,2936 +980,13040 @@ func (m *GetUserCurrenciesResponse) GetGems() int32 {
 	return 0
 }
 
-type StoreItem struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type                 int32    `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
-	CoinsPrice           int32    `protobuf:"varint,5,opt,name=coins_price,json=coinsPrice,proto3" json:"coins_price,omitempty"`
-	GemsPrice            int32    `protobuf:"varint,6,opt,name=gems_price,json=gemsPrice,proto3" json:"gems_price,omitempty"`
-	ImageId              string   `protobuf:"bytes,7,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	OnSale               bool     `protobuf:"varint,8,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
-	SaleCoinsPrice       int32    `protobuf:"varint,9,opt,name=sale_coins_price,json=saleCoinsPrice,proto3" json:"sale_coins_price,omitempty"`
-	SaleGemsPrice        int32    `protobuf:"varint,10,opt,name=sale_gems_price,json=saleGemsPrice,proto3" json:"sale_gems_price,omitempty"`
+type ExchangeRate struct {
+	Id           int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromCurrency string `protobuf:"bytes,2,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency   string `protobuf:"bytes,3,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	// rate_gems gems are exchanged for rate_coins coins
+	RateGems             int32    `protobuf:"varint,4,opt,name=rate_gems,json=rateGems,proto3" json:"rate_gems,omitempty"`
+	MinAmount            int32    `protobuf:"varint,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount            int32    `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DailyCap             int32    `protobuf:"varint,7,opt,name=daily_cap,json=dailyCap,proto3" json:"daily_cap,omitempty"`
+	RateCoins            int32    `protobuf:"varint,8,opt,name=rate_coins,json=rateCoins,proto3" json:"rate_coins,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StoreItem) Reset()         { *m = StoreItem{} }
-func (m *StoreItem) String() string { return proto.CompactTextString(m) }
-func (*StoreItem) ProtoMessage()    {}
-func (*StoreItem) Descriptor() ([]byte, []int) {
+func (m *ExchangeRate) Reset()         { *m = ExchangeRate{} }
+func (m *ExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRate) ProtoMessage()    {}
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{18}
 }
 
-func (m *StoreItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StoreItem.Unmarshal(m, b)
+func (m *ExchangeRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeRate.Unmarshal(m, b)
 }
-func (m *StoreItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StoreItem.Marshal(b, m, deterministic)
+func (m *ExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeRate.Marshal(b, m, deterministic)
 }
-func (m *StoreItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StoreItem.Merge(m, src)
+func (m *ExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRate.Merge(m, src)
 }
-func (m *StoreItem) XXX_Size() int {
-	return xxx_messageInfo_StoreItem.Size(m)
+func (m *ExchangeRate) XXX_Size() int {
+	return xxx_messageInfo_ExchangeRate.Size(m)
 }
-func (m *StoreItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_StoreItem.DiscardUnknown(m)
+func (m *ExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StoreItem proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeRate proto.InternalMessageInfo
 
-func (m *StoreItem) GetId() string {
+func (m *ExchangeRate) GetId() int32 {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *StoreItem) GetName() string {
+func (m *ExchangeRate) GetFromCurrency() string {
 	if m != nil {
-		return m.Name
+		return m.FromCurrency
 	}
 	return ""
 }
 
-func (m *StoreItem) GetDescription() string {
+func (m *ExchangeRate) GetToCurrency() string {
 	if m != nil {
-		return m.Description
+		return m.ToCurrency
 	}
 	return ""
 }
 
-func (m *StoreItem) GetType() int32 {
+func (m *ExchangeRate) GetRateGems() int32 {
 	if m != nil {
-		return m.Type
+		return m.RateGems
 	}
 	return 0
 }
 
-func (m *StoreItem) GetCoinsPrice() int32 {
+func (m *ExchangeRate) GetMinAmount() int32 {
 	if m != nil {
-		return m.CoinsPrice
+		return m.MinAmount
 	}
 	return 0
 }
 
-func (m *StoreItem) GetGemsPrice() int32 {
+func (m *ExchangeRate) GetMaxAmount() int32 {
 	if m != nil {
-		return m.GemsPrice
+		return m.MaxAmount
 	}
 	return 0
 }
 
-func (m *StoreItem) GetImageId() string {
-	if m != nil {
-		return m.ImageId
-	}
-	return ""
-}
-
-func (m *StoreItem) GetOnSale() bool {
-	if m != nil {
-		return m.OnSale
-	}
-	return false
-}
-
-func (m *StoreItem) GetSaleCoinsPrice() int32 {
+func (m *ExchangeRate) GetDailyCap() int32 {
 	if m != nil {
-		return m.SaleCoinsPrice
+		return m.DailyCap
 	}
 	return 0
 }
 
-func (m *StoreItem) GetSaleGemsPrice() int32 {
+func (m *ExchangeRate) GetRateCoins() int32 {
 	if m != nil {
-		return m.SaleGemsPrice
+		return m.RateCoins
 	}
 	return 0
 }
 
-type CreateStoreItemRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Type                 int32    `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	CoinsPrice           int32    `protobuf:"varint,4,opt,name=coins_price,json=coinsPrice,proto3" json:"coins_price,omitempty"`
-	GemsPrice            int32    `protobuf:"varint,5,opt,name=gems_price,json=gemsPrice,proto3" json:"gems_price,omitempty"`
-	ImageId              string   `protobuf:"bytes,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	OnSale               bool     `protobuf:"varint,7,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
-	SaleCoinsPrice       int32    `protobuf:"varint,8,opt,name=sale_coins_price,json=saleCoinsPrice,proto3" json:"sale_coins_price,omitempty"`
-	SaleGemsPrice        int32    `protobuf:"varint,9,opt,name=sale_gems_price,json=saleGemsPrice,proto3" json:"sale_gems_price,omitempty"`
+type SetExchangeRateRequest struct {
+	FromCurrency string `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency   string `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	// rate_gems defaults to 1
+	RateGems             int32    `protobuf:"varint,3,opt,name=rate_gems,json=rateGems,proto3" json:"rate_gems,omitempty"`
+	MinAmount            int32    `protobuf:"varint,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount            int32    `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DailyCap             int32    `protobuf:"varint,6,opt,name=daily_cap,json=dailyCap,proto3" json:"daily_cap,omitempty"`
+	RateCoins            int32    `protobuf:"varint,7,opt,name=rate_coins,json=rateCoins,proto3" json:"rate_coins,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateStoreItemRequest) Reset()         { *m = CreateStoreItemRequest{} }
-func (m *CreateStoreItemRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateStoreItemRequest) ProtoMessage()    {}
-func (*CreateStoreItemRequest) Descriptor() ([]byte, []int) {
+func (m *SetExchangeRateRequest) Reset()         { *m = SetExchangeRateRequest{} }
+func (m *SetExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*SetExchangeRateRequest) ProtoMessage()    {}
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{19}
 }
 
-func (m *CreateStoreItemRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStoreItemRequest.Unmarshal(m, b)
+func (m *SetExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetExchangeRateRequest.Unmarshal(m, b)
 }
-func (m *CreateStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStoreItemRequest.Marshal(b, m, deterministic)
+func (m *SetExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetExchangeRateRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateStoreItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStoreItemRequest.Merge(m, src)
+func (m *SetExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetExchangeRateRequest.Merge(m, src)
 }
-func (m *CreateStoreItemRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateStoreItemRequest.Size(m)
+func (m *SetExchangeRateRequest) XXX_Size() int {
+	return xxx_messageInfo_SetExchangeRateRequest.Size(m)
 }
-func (m *CreateStoreItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStoreItemRequest.DiscardUnknown(m)
+func (m *SetExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetExchangeRateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStoreItemRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetExchangeRateRequest proto.InternalMessageInfo
 
-func (m *CreateStoreItemRequest) GetName() string {
+func (m *SetExchangeRateRequest) GetFromCurrency() string {
 	if m != nil {
-		return m.Name
+		return m.FromCurrency
 	}
 	return ""
 }
 
-func (m *CreateStoreItemRequest) GetDescription() string {
+func (m *SetExchangeRateRequest) GetToCurrency() string {
 	if m != nil {
-		return m.Description
+		return m.ToCurrency
 	}
 	return ""
 }
 
-func (m *CreateStoreItemRequest) GetType() int32 {
+func (m *SetExchangeRateRequest) GetRateGems() int32 {
 	if m != nil {
-		return m.Type
+		return m.RateGems
 	}
 	return 0
 }
 
-func (m *CreateStoreItemRequest) GetCoinsPrice() int32 {
+func (m *SetExchangeRateRequest) GetMinAmount() int32 {
 	if m != nil {
-		return m.CoinsPrice
+		return m.MinAmount
 	}
 	return 0
 }
 
-func (m *CreateStoreItemRequest) GetGemsPrice() int32 {
+func (m *SetExchangeRateRequest) GetMaxAmount() int32 {
 	if m != nil {
-		return m.GemsPrice
+		return m.MaxAmount
 	}
 	return 0
 }
 
-func (m *CreateStoreItemRequest) GetImageId() string {
-	if m != nil {
-		return m.ImageId
-	}
-	return ""
-}
-
-func (m *CreateStoreItemRequest) GetOnSale() bool {
-	if m != nil {
-		return m.OnSale
-	}
-	return false
-}
-
-func (m *CreateStoreItemRequest) GetSaleCoinsPrice() int32 {
+func (m *SetExchangeRateRequest) GetDailyCap() int32 {
 	if m != nil {
-		return m.SaleCoinsPrice
+		return m.DailyCap
 	}
 	return 0
 }
 
-func (m *CreateStoreItemRequest) GetSaleGemsPrice() int32 {
+func (m *SetExchangeRateRequest) GetRateCoins() int32 {
 	if m != nil {
-		return m.SaleGemsPrice
+		return m.RateCoins
 	}
 	return 0
 }
 
-type CreateStoreItemResponse struct {
-	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type SetExchangeRateResponse struct {
+	Result               *ExchangeRate `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CreateStoreItemResponse) Reset()         { *m = CreateStoreItemResponse{} }
-func (m *CreateStoreItemResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateStoreItemResponse) ProtoMessage()    {}
-func (*CreateStoreItemResponse) Descriptor() ([]byte, []int) {
+func (m *SetExchangeRateResponse) Reset()         { *m = SetExchangeRateResponse{} }
+func (m *SetExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*SetExchangeRateResponse) ProtoMessage()    {}
+func (*SetExchangeRateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{20}
 }
 
-func (m *CreateStoreItemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStoreItemResponse.Unmarshal(m, b)
+func (m *SetExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetExchangeRateResponse.Unmarshal(m, b)
 }
-func (m *CreateStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStoreItemResponse.Marshal(b, m, deterministic)
+func (m *SetExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetExchangeRateResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateStoreItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStoreItemResponse.Merge(m, src)
+func (m *SetExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetExchangeRateResponse.Merge(m, src)
 }
-func (m *CreateStoreItemResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateStoreItemResponse.Size(m)
+func (m *SetExchangeRateResponse) XXX_Size() int {
+	return xxx_messageInfo_SetExchangeRateResponse.Size(m)
 }
-func (m *CreateStoreItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStoreItemResponse.DiscardUnknown(m)
+func (m *SetExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetExchangeRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStoreItemResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetExchangeRateResponse proto.InternalMessageInfo
 
-func (m *CreateStoreItemResponse) GetResult() *StoreItem {
+func (m *SetExchangeRateResponse) GetResult() *ExchangeRate {
 	if m != nil {
 		return m.Result
 	}
 	return nil
 }
 
-type ReadStoreItemRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type DeleteExchangeRateRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadStoreItemRequest) Reset()         { *m = ReadStoreItemRequest{} }
-func (m *ReadStoreItemRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadStoreItemRequest) ProtoMessage()    {}
-func (*ReadStoreItemRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteExchangeRateRequest) Reset()         { *m = DeleteExchangeRateRequest{} }
+func (m *DeleteExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteExchangeRateRequest) ProtoMessage()    {}
+func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{21}
 }
 
-func (m *ReadStoreItemRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadStoreItemRequest.Unmarshal(m, b)
+func (m *DeleteExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteExchangeRateRequest.Unmarshal(m, b)
 }
-func (m *ReadStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadStoreItemRequest.Marshal(b, m, deterministic)
+func (m *DeleteExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteExchangeRateRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadStoreItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadStoreItemRequest.Merge(m, src)
+func (m *DeleteExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExchangeRateRequest.Merge(m, src)
 }
-func (m *ReadStoreItemRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadStoreItemRequest.Size(m)
+func (m *DeleteExchangeRateRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteExchangeRateRequest.Size(m)
 }
-func (m *ReadStoreItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadStoreItemRequest.DiscardUnknown(m)
+func (m *DeleteExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExchangeRateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadStoreItemRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteExchangeRateRequest proto.InternalMessageInfo
 
-func (m *ReadStoreItemRequest) GetId() string {
+func (m *DeleteExchangeRateRequest) GetId() int32 {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return 0
 }
 
-type ReadStoreItemResponse struct {
-	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type DeleteExchangeRateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadStoreItemResponse) Reset()         { *m = ReadStoreItemResponse{} }
-func (m *ReadStoreItemResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadStoreItemResponse) ProtoMessage()    {}
-func (*ReadStoreItemResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteExchangeRateResponse) Reset()         { *m = DeleteExchangeRateResponse{} }
+func (m *DeleteExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteExchangeRateResponse) ProtoMessage()    {}
+func (*DeleteExchangeRateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{22}
 }
 
-func (m *ReadStoreItemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadStoreItemResponse.Unmarshal(m, b)
+func (m *DeleteExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteExchangeRateResponse.Unmarshal(m, b)
 }
-func (m *ReadStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadStoreItemResponse.Marshal(b, m, deterministic)
+func (m *DeleteExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteExchangeRateResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadStoreItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadStoreItemResponse.Merge(m, src)
+func (m *DeleteExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExchangeRateResponse.Merge(m, src)
 }
-func (m *ReadStoreItemResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadStoreItemResponse.Size(m)
+func (m *DeleteExchangeRateResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteExchangeRateResponse.Size(m)
 }
-func (m *ReadStoreItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadStoreItemResponse.DiscardUnknown(m)
+func (m *DeleteExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExchangeRateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadStoreItemResponse proto.InternalMessageInfo
-
-func (m *ReadStoreItemResponse) GetResult() *StoreItem {
-	if m != nil {
-		return m.Result
-	}
-	return nil
-}
+var xxx_messageInfo_DeleteExchangeRateResponse proto.InternalMessageInfo
 
-type UpdateStoreItemRequest struct {
-	Payload              *StoreItem            `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Fields               *field_mask.FieldMask `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ExchangeQuote struct {
+	Rate                 *ExchangeRate `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Amount               int32         `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Receive              int32         `protobuf:"varint,3,opt,name=receive,proto3" json:"receive,omitempty"`
+	RemainingToday       int32         `protobuf:"varint,4,opt,name=remaining_today,json=remainingToday,proto3" json:"remaining_today,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *UpdateStoreItemRequest) Reset()         { *m = UpdateStoreItemRequest{} }
-func (m *UpdateStoreItemRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateStoreItemRequest) ProtoMessage()    {}
-func (*UpdateStoreItemRequest) Descriptor() ([]byte, []int) {
+func (m *ExchangeQuote) Reset()         { *m = ExchangeQuote{} }
+func (m *ExchangeQuote) String() string { return proto.CompactTextString(m) }
+func (*ExchangeQuote) ProtoMessage()    {}
+func (*ExchangeQuote) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b1742b9f6e5341e0, []int{23}
 }
 
-func (m *UpdateStoreItemRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateStoreItemRequest.Unmarshal(m, b)
+func (m *ExchangeQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeQuote.Unmarshal(m, b)
 }
-func (m *UpdateStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateStoreItemRequest.Marshal(b, m, deterministic)
+func (m *ExchangeQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeQuote.Marshal(b, m, deterministic)
 }
-func (m *UpdateStoreItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateStoreItemRequest.Merge(m, src)
+func (m *ExchangeQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeQuote.Merge(m, src)
 }
-func (m *UpdateStoreItemRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateStoreItemRequest.Size(m)
+func (m *ExchangeQuote) XXX_Size() int {
+	return xxx_messageInfo_ExchangeQuote.Size(m)
 }
-func (m *UpdateStoreItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateStoreItemRequest.DiscardUnknown(m)
+func (m *ExchangeQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeQuote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateStoreItemRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeQuote proto.InternalMessageInfo
 
-func (m *UpdateStoreItemRequest) GetPayload() *StoreItem {
+func (m *ExchangeQuote) GetRate() *ExchangeRate {
 	if m != nil {
-		return m.Payload
+		return m.Rate
 	}
 	return nil
 }
 
-func (m *UpdateStoreItemRequest) GetFields() *field_mask.FieldMask {
+func (m *ExchangeQuote) GetAmount() int32 {
 	if m != nil {
-		return m.Fields
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-type UpdateStoreItemResponse struct {
-	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ExchangeQuote) GetReceive() int32 {
+	if m != nil {
+		return m.Receive
+	}
+	return 0
 }
 
-func (m *UpdateStoreItemResponse) Reset()         { *m = UpdateStoreItemResponse{} }
-func (m *UpdateStoreItemResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateStoreItemResponse) ProtoMessage()    {}
-func (*UpdateStoreItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{24}
-}
-
-func (m *UpdateStoreItemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateStoreItemResponse.Unmarshal(m, b)
-}
-func (m *UpdateStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateStoreItemResponse.Marshal(b, m, deterministic)
-}
-func (m *UpdateStoreItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateStoreItemResponse.Merge(m, src)
-}
-func (m *UpdateStoreItemResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateStoreItemResponse.Size(m)
-}
-func (m *UpdateStoreItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateStoreItemResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateStoreItemResponse proto.InternalMessageInfo
-
-func (m *UpdateStoreItemResponse) GetResult() *StoreItem {
+func (m *ExchangeQuote) GetRemainingToday() int32 {
 	if m != nil {
-		return m.Result
+		return m.RemainingToday
 	}
-	return nil
+	return 0
 }
 
-type DeleteStoreItemRequest struct {
+type GetExchangeRatesRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               int32    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteStoreItemRequest) Reset()         { *m = DeleteStoreItemRequest{} }
-func (m *DeleteStoreItemRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteStoreItemRequest) ProtoMessage()    {}
-func (*DeleteStoreItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{25}
+func (m *GetExchangeRatesRequest) Reset()         { *m = GetExchangeRatesRequest{} }
+func (m *GetExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeRatesRequest) ProtoMessage()    {}
+func (*GetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{24}
 }
 
-func (m *DeleteStoreItemRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteStoreItemRequest.Unmarshal(m, b)
+func (m *GetExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeRatesRequest.Unmarshal(m, b)
 }
-func (m *DeleteStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteStoreItemRequest.Marshal(b, m, deterministic)
+func (m *GetExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeRatesRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteStoreItemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteStoreItemRequest.Merge(m, src)
+func (m *GetExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeRatesRequest.Merge(m, src)
 }
-func (m *DeleteStoreItemRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteStoreItemRequest.Size(m)
+func (m *GetExchangeRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeRatesRequest.Size(m)
 }
-func (m *DeleteStoreItemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteStoreItemRequest.DiscardUnknown(m)
+func (m *GetExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeRatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteStoreItemRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangeRatesRequest proto.InternalMessageInfo
 
-func (m *DeleteStoreItemRequest) GetId() string {
+func (m *GetExchangeRatesRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type DeleteStoreItemResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetExchangeRatesRequest) GetAmount() int32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *DeleteStoreItemResponse) Reset()         { *m = DeleteStoreItemResponse{} }
-func (m *DeleteStoreItemResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteStoreItemResponse) ProtoMessage()    {}
-func (*DeleteStoreItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{26}
+type GetExchangeRatesResponse struct {
+	Quotes               []*ExchangeQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *DeleteStoreItemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteStoreItemResponse.Unmarshal(m, b)
+func (m *GetExchangeRatesResponse) Reset()         { *m = GetExchangeRatesResponse{} }
+func (m *GetExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeRatesResponse) ProtoMessage()    {}
+func (*GetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{25}
 }
-func (m *DeleteStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteStoreItemResponse.Marshal(b, m, deterministic)
+
+func (m *GetExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeRatesResponse.Unmarshal(m, b)
 }
-func (m *DeleteStoreItemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteStoreItemResponse.Merge(m, src)
+func (m *GetExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeRatesResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteStoreItemResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteStoreItemResponse.Size(m)
+func (m *GetExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeRatesResponse.Merge(m, src)
 }
-func (m *DeleteStoreItemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteStoreItemResponse.DiscardUnknown(m)
+func (m *GetExchangeRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeRatesResponse.Size(m)
+}
+func (m *GetExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeRatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteStoreItemResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangeRatesResponse proto.InternalMessageInfo
 
-type ListStoreItemsRequest struct {
-	Filter               *query.Filtering      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	OrderBy              *query.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Fields               *query.FieldSelection `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
-	Paging               *query.Pagination     `protobuf:"bytes,4,opt,name=paging,proto3" json:"paging,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *GetExchangeRatesResponse) GetQuotes() []*ExchangeQuote {
+	if m != nil {
+		return m.Quotes
+	}
+	return nil
 }
 
-func (m *ListStoreItemsRequest) Reset()         { *m = ListStoreItemsRequest{} }
-func (m *ListStoreItemsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListStoreItemsRequest) ProtoMessage()    {}
-func (*ListStoreItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{27}
+type ExchangeCurrencyRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromCurrency         string   `protobuf:"bytes,2,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency           string   `protobuf:"bytes,3,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	Amount               int32    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListStoreItemsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListStoreItemsRequest.Unmarshal(m, b)
+func (m *ExchangeCurrencyRequest) Reset()         { *m = ExchangeCurrencyRequest{} }
+func (m *ExchangeCurrencyRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyRequest) ProtoMessage()    {}
+func (*ExchangeCurrencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{26}
 }
-func (m *ListStoreItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListStoreItemsRequest.Marshal(b, m, deterministic)
+
+func (m *ExchangeCurrencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyRequest.Unmarshal(m, b)
 }
-func (m *ListStoreItemsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListStoreItemsRequest.Merge(m, src)
+func (m *ExchangeCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyRequest.Marshal(b, m, deterministic)
 }
-func (m *ListStoreItemsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListStoreItemsRequest.Size(m)
+func (m *ExchangeCurrencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyRequest.Merge(m, src)
 }
-func (m *ListStoreItemsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListStoreItemsRequest.DiscardUnknown(m)
+func (m *ExchangeCurrencyRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyRequest.Size(m)
+}
+func (m *ExchangeCurrencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListStoreItemsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeCurrencyRequest proto.InternalMessageInfo
 
-func (m *ListStoreItemsRequest) GetFilter() *query.Filtering {
+func (m *ExchangeCurrencyRequest) GetId() string {
 	if m != nil {
-		return m.Filter
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *ListStoreItemsRequest) GetOrderBy() *query.Sorting {
+func (m *ExchangeCurrencyRequest) GetFromCurrency() string {
 	if m != nil {
-		return m.OrderBy
+		return m.FromCurrency
 	}
-	return nil
+	return ""
 }
 
-func (m *ListStoreItemsRequest) GetFields() *query.FieldSelection {
+func (m *ExchangeCurrencyRequest) GetToCurrency() string {
 	if m != nil {
-		return m.Fields
+		return m.ToCurrency
 	}
-	return nil
+	return ""
 }
 
-func (m *ListStoreItemsRequest) GetPaging() *query.Pagination {
+func (m *ExchangeCurrencyRequest) GetAmount() int32 {
 	if m != nil {
-		return m.Paging
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-type ListStoreItemsResponse struct {
-	Results              []*StoreItem    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Page                 *query.PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ExchangeCurrencyResponse struct {
+	Spent                int32    `protobuf:"varint,1,opt,name=spent,proto3" json:"spent,omitempty"`
+	Received             int32    `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	Coins                int32    `protobuf:"varint,3,opt,name=coins,proto3" json:"coins,omitempty"`
+	Gems                 int32    `protobuf:"varint,4,opt,name=gems,proto3" json:"gems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListStoreItemsResponse) Reset()         { *m = ListStoreItemsResponse{} }
-func (m *ListStoreItemsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListStoreItemsResponse) ProtoMessage()    {}
-func (*ListStoreItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{28}
+func (m *ExchangeCurrencyResponse) Reset()         { *m = ExchangeCurrencyResponse{} }
+func (m *ExchangeCurrencyResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyResponse) ProtoMessage()    {}
+func (*ExchangeCurrencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{27}
 }
 
-func (m *ListStoreItemsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListStoreItemsResponse.Unmarshal(m, b)
+func (m *ExchangeCurrencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Unmarshal(m, b)
 }
-func (m *ListStoreItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListStoreItemsResponse.Marshal(b, m, deterministic)
+func (m *ExchangeCurrencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Marshal(b, m, deterministic)
 }
-func (m *ListStoreItemsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListStoreItemsResponse.Merge(m, src)
+func (m *ExchangeCurrencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyResponse.Merge(m, src)
 }
-func (m *ListStoreItemsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListStoreItemsResponse.Size(m)
+func (m *ExchangeCurrencyResponse) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Size(m)
 }
-func (m *ListStoreItemsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListStoreItemsResponse.DiscardUnknown(m)
+func (m *ExchangeCurrencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListStoreItemsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeCurrencyResponse proto.InternalMessageInfo
 
-func (m *ListStoreItemsResponse) GetResults() []*StoreItem {
+func (m *ExchangeCurrencyResponse) GetSpent() int32 {
 	if m != nil {
-		return m.Results
+		return m.Spent
 	}
-	return nil
+	return 0
 }
 
-func (m *ListStoreItemsResponse) GetPage() *query.PageInfo {
+func (m *ExchangeCurrencyResponse) GetReceived() int32 {
 	if m != nil {
-		return m.Page
+		return m.Received
 	}
-	return nil
+	return 0
 }
 
-type BuyByUserRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+func (m *ExchangeCurrencyResponse) GetCoins() int32 {
+	if m != nil {
+		return m.Coins
+	}
+	return 0
+}
+
+func (m *ExchangeCurrencyResponse) GetGems() int32 {
+	if m != nil {
+		return m.Gems
+	}
+	return 0
+}
+
+type StoreItem struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type                 int32    `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	CoinsPrice           int32    `protobuf:"varint,5,opt,name=coins_price,json=coinsPrice,proto3" json:"coins_price,omitempty"`
+	GemsPrice            int32    `protobuf:"varint,6,opt,name=gems_price,json=gemsPrice,proto3" json:"gems_price,omitempty"`
+	ImageId              string   `protobuf:"bytes,7,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	OnSale               bool     `protobuf:"varint,8,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
+	SaleCoinsPrice       int32    `protobuf:"varint,9,opt,name=sale_coins_price,json=saleCoinsPrice,proto3" json:"sale_coins_price,omitempty"`
+	SaleGemsPrice        int32    `protobuf:"varint,10,opt,name=sale_gems_price,json=saleGemsPrice,proto3" json:"sale_gems_price,omitempty"`
+	Consumable           bool     `protobuf:"varint,11,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	MaxStack             int32    `protobuf:"varint,12,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	RentalDays           int32    `protobuf:"varint,13,opt,name=rental_days,json=rentalDays,proto3" json:"rental_days,omitempty"`
+	RentalCoinsPrice     int32    `protobuf:"varint,14,opt,name=rental_coins_price,json=rentalCoinsPrice,proto3" json:"rental_coins_price,omitempty"`
+	RentalGemsPrice      int32    `protobuf:"varint,15,opt,name=rental_gems_price,json=rentalGemsPrice,proto3" json:"rental_gems_price,omitempty"`
+	Sku                  string   `protobuf:"bytes,16,opt,name=sku,proto3" json:"sku,omitempty"`
+	Retired              bool     `protobuf:"varint,17,opt,name=retired,proto3" json:"retired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuyByUserRequest) Reset()         { *m = BuyByUserRequest{} }
-func (m *BuyByUserRequest) String() string { return proto.CompactTextString(m) }
-func (*BuyByUserRequest) ProtoMessage()    {}
-func (*BuyByUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{29}
+func (m *StoreItem) Reset()         { *m = StoreItem{} }
+func (m *StoreItem) String() string { return proto.CompactTextString(m) }
+func (*StoreItem) ProtoMessage()    {}
+func (*StoreItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{28}
 }
 
-func (m *BuyByUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuyByUserRequest.Unmarshal(m, b)
+func (m *StoreItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreItem.Unmarshal(m, b)
 }
-func (m *BuyByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuyByUserRequest.Marshal(b, m, deterministic)
+func (m *StoreItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreItem.Marshal(b, m, deterministic)
 }
-func (m *BuyByUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuyByUserRequest.Merge(m, src)
+func (m *StoreItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreItem.Merge(m, src)
 }
-func (m *BuyByUserRequest) XXX_Size() int {
-	return xxx_messageInfo_BuyByUserRequest.Size(m)
+func (m *StoreItem) XXX_Size() int {
+	return xxx_messageInfo_StoreItem.Size(m)
 }
-func (m *BuyByUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuyByUserRequest.DiscardUnknown(m)
+func (m *StoreItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuyByUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_StoreItem proto.InternalMessageInfo
 
-func (m *BuyByUserRequest) GetUserId() string {
+func (m *StoreItem) GetId() string {
 	if m != nil {
-		return m.UserId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *BuyByUserRequest) GetItemId() string {
+func (m *StoreItem) GetName() string {
 	if m != nil {
-		return m.ItemId
+		return m.Name
 	}
 	return ""
 }
 
-type BuyByUserResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StoreItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *BuyByUserResponse) Reset()         { *m = BuyByUserResponse{} }
-func (m *BuyByUserResponse) String() string { return proto.CompactTextString(m) }
-func (*BuyByUserResponse) ProtoMessage()    {}
-func (*BuyByUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{30}
+func (m *StoreItem) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
 
-func (m *BuyByUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuyByUserResponse.Unmarshal(m, b)
+func (m *StoreItem) GetCoinsPrice() int32 {
+	if m != nil {
+		return m.CoinsPrice
+	}
+	return 0
 }
-func (m *BuyByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuyByUserResponse.Marshal(b, m, deterministic)
-}
-func (m *BuyByUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuyByUserResponse.Merge(m, src)
-}
-func (m *BuyByUserResponse) XXX_Size() int {
-	return xxx_messageInfo_BuyByUserResponse.Size(m)
-}
-func (m *BuyByUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuyByUserResponse.DiscardUnknown(m)
+
+func (m *StoreItem) GetGemsPrice() int32 {
+	if m != nil {
+		return m.GemsPrice
+	}
+	return 0
 }
 
-var xxx_messageInfo_BuyByUserResponse proto.InternalMessageInfo
+func (m *StoreItem) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
 
-type ThrowAwayByUserRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StoreItem) GetOnSale() bool {
+	if m != nil {
+		return m.OnSale
+	}
+	return false
 }
 
-func (m *ThrowAwayByUserRequest) Reset()         { *m = ThrowAwayByUserRequest{} }
-func (m *ThrowAwayByUserRequest) String() string { return proto.CompactTextString(m) }
-func (*ThrowAwayByUserRequest) ProtoMessage()    {}
-func (*ThrowAwayByUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{31}
+func (m *StoreItem) GetSaleCoinsPrice() int32 {
+	if m != nil {
+		return m.SaleCoinsPrice
+	}
+	return 0
 }
 
-func (m *ThrowAwayByUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ThrowAwayByUserRequest.Unmarshal(m, b)
+func (m *StoreItem) GetSaleGemsPrice() int32 {
+	if m != nil {
+		return m.SaleGemsPrice
+	}
+	return 0
 }
-func (m *ThrowAwayByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ThrowAwayByUserRequest.Marshal(b, m, deterministic)
+
+func (m *StoreItem) GetConsumable() bool {
+	if m != nil {
+		return m.Consumable
+	}
+	return false
 }
-func (m *ThrowAwayByUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ThrowAwayByUserRequest.Merge(m, src)
+
+func (m *StoreItem) GetMaxStack() int32 {
+	if m != nil {
+		return m.MaxStack
+	}
+	return 0
 }
-func (m *ThrowAwayByUserRequest) XXX_Size() int {
-	return xxx_messageInfo_ThrowAwayByUserRequest.Size(m)
+
+func (m *StoreItem) GetRentalDays() int32 {
+	if m != nil {
+		return m.RentalDays
+	}
+	return 0
 }
-func (m *ThrowAwayByUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ThrowAwayByUserRequest.DiscardUnknown(m)
+
+func (m *StoreItem) GetRentalCoinsPrice() int32 {
+	if m != nil {
+		return m.RentalCoinsPrice
+	}
+	return 0
 }
 
-var xxx_messageInfo_ThrowAwayByUserRequest proto.InternalMessageInfo
+func (m *StoreItem) GetRentalGemsPrice() int32 {
+	if m != nil {
+		return m.RentalGemsPrice
+	}
+	return 0
+}
 
-func (m *ThrowAwayByUserRequest) GetUserId() string {
+func (m *StoreItem) GetSku() string {
 	if m != nil {
-		return m.UserId
+		return m.Sku
 	}
 	return ""
 }
 
-func (m *ThrowAwayByUserRequest) GetItemId() string {
+func (m *StoreItem) GetRetired() bool {
 	if m != nil {
-		return m.ItemId
+		return m.Retired
 	}
-	return ""
+	return false
 }
 
-type ThrowAwayByUserResponse struct {
+type CreateStoreItemRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type                 int32    `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	CoinsPrice           int32    `protobuf:"varint,4,opt,name=coins_price,json=coinsPrice,proto3" json:"coins_price,omitempty"`
+	GemsPrice            int32    `protobuf:"varint,5,opt,name=gems_price,json=gemsPrice,proto3" json:"gems_price,omitempty"`
+	ImageId              string   `protobuf:"bytes,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	OnSale               bool     `protobuf:"varint,7,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
+	SaleCoinsPrice       int32    `protobuf:"varint,8,opt,name=sale_coins_price,json=saleCoinsPrice,proto3" json:"sale_coins_price,omitempty"`
+	SaleGemsPrice        int32    `protobuf:"varint,9,opt,name=sale_gems_price,json=saleGemsPrice,proto3" json:"sale_gems_price,omitempty"`
+	Consumable           bool     `protobuf:"varint,10,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	MaxStack             int32    `protobuf:"varint,11,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	RentalDays           int32    `protobuf:"varint,12,opt,name=rental_days,json=rentalDays,proto3" json:"rental_days,omitempty"`
+	RentalCoinsPrice     int32    `protobuf:"varint,13,opt,name=rental_coins_price,json=rentalCoinsPrice,proto3" json:"rental_coins_price,omitempty"`
+	RentalGemsPrice      int32    `protobuf:"varint,14,opt,name=rental_gems_price,json=rentalGemsPrice,proto3" json:"rental_gems_price,omitempty"`
+	Sku                  string   `protobuf:"bytes,15,opt,name=sku,proto3" json:"sku,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ThrowAwayByUserResponse) Reset()         { *m = ThrowAwayByUserResponse{} }
-func (m *ThrowAwayByUserResponse) String() string { return proto.CompactTextString(m) }
-func (*ThrowAwayByUserResponse) ProtoMessage()    {}
-func (*ThrowAwayByUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{32}
+func (m *CreateStoreItemRequest) Reset()         { *m = CreateStoreItemRequest{} }
+func (m *CreateStoreItemRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStoreItemRequest) ProtoMessage()    {}
+func (*CreateStoreItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{29}
 }
 
-func (m *ThrowAwayByUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ThrowAwayByUserResponse.Unmarshal(m, b)
+func (m *CreateStoreItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStoreItemRequest.Unmarshal(m, b)
 }
-func (m *ThrowAwayByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ThrowAwayByUserResponse.Marshal(b, m, deterministic)
+func (m *CreateStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStoreItemRequest.Marshal(b, m, deterministic)
 }
-func (m *ThrowAwayByUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ThrowAwayByUserResponse.Merge(m, src)
+func (m *CreateStoreItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStoreItemRequest.Merge(m, src)
 }
-func (m *ThrowAwayByUserResponse) XXX_Size() int {
-	return xxx_messageInfo_ThrowAwayByUserResponse.Size(m)
+func (m *CreateStoreItemRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateStoreItemRequest.Size(m)
 }
-func (m *ThrowAwayByUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ThrowAwayByUserResponse.DiscardUnknown(m)
+func (m *CreateStoreItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStoreItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ThrowAwayByUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateStoreItemRequest proto.InternalMessageInfo
 
-type EquipByUserRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateStoreItemRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *EquipByUserRequest) Reset()         { *m = EquipByUserRequest{} }
-func (m *EquipByUserRequest) String() string { return proto.CompactTextString(m) }
-func (*EquipByUserRequest) ProtoMessage()    {}
-func (*EquipByUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{33}
+func (m *CreateStoreItemRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *EquipByUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EquipByUserRequest.Unmarshal(m, b)
-}
-func (m *EquipByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EquipByUserRequest.Marshal(b, m, deterministic)
-}
-func (m *EquipByUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EquipByUserRequest.Merge(m, src)
-}
-func (m *EquipByUserRequest) XXX_Size() int {
-	return xxx_messageInfo_EquipByUserRequest.Size(m)
+func (m *CreateStoreItemRequest) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
-func (m *EquipByUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EquipByUserRequest.DiscardUnknown(m)
+
+func (m *CreateStoreItemRequest) GetCoinsPrice() int32 {
+	if m != nil {
+		return m.CoinsPrice
+	}
+	return 0
 }
 
-var xxx_messageInfo_EquipByUserRequest proto.InternalMessageInfo
+func (m *CreateStoreItemRequest) GetGemsPrice() int32 {
+	if m != nil {
+		return m.GemsPrice
+	}
+	return 0
+}
 
-func (m *EquipByUserRequest) GetUserId() string {
+func (m *CreateStoreItemRequest) GetImageId() string {
 	if m != nil {
-		return m.UserId
+		return m.ImageId
 	}
 	return ""
 }
 
-func (m *EquipByUserRequest) GetItemId() string {
+func (m *CreateStoreItemRequest) GetOnSale() bool {
 	if m != nil {
-		return m.ItemId
+		return m.OnSale
 	}
-	return ""
+	return false
 }
 
-type EquipByUserResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateStoreItemRequest) GetSaleCoinsPrice() int32 {
+	if m != nil {
+		return m.SaleCoinsPrice
+	}
+	return 0
 }
 
-func (m *EquipByUserResponse) Reset()         { *m = EquipByUserResponse{} }
-func (m *EquipByUserResponse) String() string { return proto.CompactTextString(m) }
-func (*EquipByUserResponse) ProtoMessage()    {}
-func (*EquipByUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{34}
+func (m *CreateStoreItemRequest) GetSaleGemsPrice() int32 {
+	if m != nil {
+		return m.SaleGemsPrice
+	}
+	return 0
 }
 
-func (m *EquipByUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EquipByUserResponse.Unmarshal(m, b)
+func (m *CreateStoreItemRequest) GetConsumable() bool {
+	if m != nil {
+		return m.Consumable
+	}
+	return false
 }
-func (m *EquipByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EquipByUserResponse.Marshal(b, m, deterministic)
+
+func (m *CreateStoreItemRequest) GetMaxStack() int32 {
+	if m != nil {
+		return m.MaxStack
+	}
+	return 0
 }
-func (m *EquipByUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EquipByUserResponse.Merge(m, src)
+
+func (m *CreateStoreItemRequest) GetRentalDays() int32 {
+	if m != nil {
+		return m.RentalDays
+	}
+	return 0
 }
-func (m *EquipByUserResponse) XXX_Size() int {
-	return xxx_messageInfo_EquipByUserResponse.Size(m)
+
+func (m *CreateStoreItemRequest) GetRentalCoinsPrice() int32 {
+	if m != nil {
+		return m.RentalCoinsPrice
+	}
+	return 0
 }
-func (m *EquipByUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EquipByUserResponse.DiscardUnknown(m)
+
+func (m *CreateStoreItemRequest) GetRentalGemsPrice() int32 {
+	if m != nil {
+		return m.RentalGemsPrice
+	}
+	return 0
 }
 
-var xxx_messageInfo_EquipByUserResponse proto.InternalMessageInfo
+func (m *CreateStoreItemRequest) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
 
-type GetUserItemsIdsRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateStoreItemResponse struct {
+	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetUserItemsIdsRequest) Reset()         { *m = GetUserItemsIdsRequest{} }
-func (m *GetUserItemsIdsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUserItemsIdsRequest) ProtoMessage()    {}
-func (*GetUserItemsIdsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{35}
+func (m *CreateStoreItemResponse) Reset()         { *m = CreateStoreItemResponse{} }
+func (m *CreateStoreItemResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateStoreItemResponse) ProtoMessage()    {}
+func (*CreateStoreItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{30}
 }
 
-func (m *GetUserItemsIdsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserItemsIdsRequest.Unmarshal(m, b)
+func (m *CreateStoreItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStoreItemResponse.Unmarshal(m, b)
 }
-func (m *GetUserItemsIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserItemsIdsRequest.Marshal(b, m, deterministic)
+func (m *CreateStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStoreItemResponse.Marshal(b, m, deterministic)
 }
-func (m *GetUserItemsIdsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserItemsIdsRequest.Merge(m, src)
+func (m *CreateStoreItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStoreItemResponse.Merge(m, src)
 }
-func (m *GetUserItemsIdsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetUserItemsIdsRequest.Size(m)
+func (m *CreateStoreItemResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateStoreItemResponse.Size(m)
 }
-func (m *GetUserItemsIdsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserItemsIdsRequest.DiscardUnknown(m)
+func (m *CreateStoreItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStoreItemResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserItemsIdsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateStoreItemResponse proto.InternalMessageInfo
 
-func (m *GetUserItemsIdsRequest) GetUserId() string {
+func (m *CreateStoreItemResponse) GetResult() *StoreItem {
 	if m != nil {
-		return m.UserId
+		return m.Result
 	}
-	return ""
+	return nil
 }
 
-type UserItemInfo struct {
-	ItemId               string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Equipped             bool     `protobuf:"varint,2,opt,name=equipped,proto3" json:"equipped,omitempty"`
+type ReadStoreItemRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserItemInfo) Reset()         { *m = UserItemInfo{} }
-func (m *UserItemInfo) String() string { return proto.CompactTextString(m) }
-func (*UserItemInfo) ProtoMessage()    {}
-func (*UserItemInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{36}
+func (m *ReadStoreItemRequest) Reset()         { *m = ReadStoreItemRequest{} }
+func (m *ReadStoreItemRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadStoreItemRequest) ProtoMessage()    {}
+func (*ReadStoreItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{31}
 }
 
-func (m *UserItemInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserItemInfo.Unmarshal(m, b)
+func (m *ReadStoreItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStoreItemRequest.Unmarshal(m, b)
 }
-func (m *UserItemInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserItemInfo.Marshal(b, m, deterministic)
+func (m *ReadStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStoreItemRequest.Marshal(b, m, deterministic)
 }
-func (m *UserItemInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserItemInfo.Merge(m, src)
+func (m *ReadStoreItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStoreItemRequest.Merge(m, src)
 }
-func (m *UserItemInfo) XXX_Size() int {
-	return xxx_messageInfo_UserItemInfo.Size(m)
+func (m *ReadStoreItemRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadStoreItemRequest.Size(m)
 }
-func (m *UserItemInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserItemInfo.DiscardUnknown(m)
+func (m *ReadStoreItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStoreItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserItemInfo proto.InternalMessageInfo
+var xxx_messageInfo_ReadStoreItemRequest proto.InternalMessageInfo
 
-func (m *UserItemInfo) GetItemId() string {
+func (m *ReadStoreItemRequest) GetId() string {
 	if m != nil {
-		return m.ItemId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *UserItemInfo) GetEquipped() bool {
-	if m != nil {
-		return m.Equipped
-	}
-	return false
-}
-
-type GetUserItemsIdsResponse struct {
-	Items                []*UserItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ReadStoreItemResponse struct {
+	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetUserItemsIdsResponse) Reset()         { *m = GetUserItemsIdsResponse{} }
-func (m *GetUserItemsIdsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserItemsIdsResponse) ProtoMessage()    {}
-func (*GetUserItemsIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{37}
+func (m *ReadStoreItemResponse) Reset()         { *m = ReadStoreItemResponse{} }
+func (m *ReadStoreItemResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadStoreItemResponse) ProtoMessage()    {}
+func (*ReadStoreItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{32}
 }
 
-func (m *GetUserItemsIdsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserItemsIdsResponse.Unmarshal(m, b)
+func (m *ReadStoreItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStoreItemResponse.Unmarshal(m, b)
 }
-func (m *GetUserItemsIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserItemsIdsResponse.Marshal(b, m, deterministic)
+func (m *ReadStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStoreItemResponse.Marshal(b, m, deterministic)
 }
-func (m *GetUserItemsIdsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserItemsIdsResponse.Merge(m, src)
+func (m *ReadStoreItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStoreItemResponse.Merge(m, src)
 }
-func (m *GetUserItemsIdsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetUserItemsIdsResponse.Size(m)
+func (m *ReadStoreItemResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadStoreItemResponse.Size(m)
 }
-func (m *GetUserItemsIdsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserItemsIdsResponse.DiscardUnknown(m)
+func (m *ReadStoreItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStoreItemResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserItemsIdsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReadStoreItemResponse proto.InternalMessageInfo
 
-func (m *GetUserItemsIdsResponse) GetItems() []*UserItemInfo {
+func (m *ReadStoreItemResponse) GetResult() *StoreItem {
 	if m != nil {
-		return m.Items
+		return m.Result
 	}
 	return nil
 }
 
-type GetEquippedUserItemsIdsRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UpdateStoreItemRequest struct {
+	Payload              *StoreItem            `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Fields               *field_mask.FieldMask `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *GetEquippedUserItemsIdsRequest) Reset()         { *m = GetEquippedUserItemsIdsRequest{} }
-func (m *GetEquippedUserItemsIdsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetEquippedUserItemsIdsRequest) ProtoMessage()    {}
-func (*GetEquippedUserItemsIdsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{38}
+func (m *UpdateStoreItemRequest) Reset()         { *m = UpdateStoreItemRequest{} }
+func (m *UpdateStoreItemRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateStoreItemRequest) ProtoMessage()    {}
+func (*UpdateStoreItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{33}
 }
 
-func (m *GetEquippedUserItemsIdsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Unmarshal(m, b)
+func (m *UpdateStoreItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStoreItemRequest.Unmarshal(m, b)
 }
-func (m *GetEquippedUserItemsIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Marshal(b, m, deterministic)
+func (m *UpdateStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStoreItemRequest.Marshal(b, m, deterministic)
 }
-func (m *GetEquippedUserItemsIdsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEquippedUserItemsIdsRequest.Merge(m, src)
+func (m *UpdateStoreItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStoreItemRequest.Merge(m, src)
 }
-func (m *GetEquippedUserItemsIdsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Size(m)
+func (m *UpdateStoreItemRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateStoreItemRequest.Size(m)
 }
-func (m *GetEquippedUserItemsIdsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEquippedUserItemsIdsRequest.DiscardUnknown(m)
+func (m *UpdateStoreItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStoreItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEquippedUserItemsIdsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateStoreItemRequest proto.InternalMessageInfo
 
-func (m *GetEquippedUserItemsIdsRequest) GetUserId() string {
+func (m *UpdateStoreItemRequest) GetPayload() *StoreItem {
 	if m != nil {
-		return m.UserId
+		return m.Payload
 	}
-	return ""
+	return nil
 }
 
-type GetEquippedUserItemsIdsResponse struct {
-	Items                []*UserItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *UpdateStoreItemRequest) GetFields() *field_mask.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
 }
 
-func (m *GetEquippedUserItemsIdsResponse) Reset()         { *m = GetEquippedUserItemsIdsResponse{} }
-func (m *GetEquippedUserItemsIdsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetEquippedUserItemsIdsResponse) ProtoMessage()    {}
-func (*GetEquippedUserItemsIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{39}
+type UpdateStoreItemResponse struct {
+	Result               *StoreItem `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetEquippedUserItemsIdsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Unmarshal(m, b)
+func (m *UpdateStoreItemResponse) Reset()         { *m = UpdateStoreItemResponse{} }
+func (m *UpdateStoreItemResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateStoreItemResponse) ProtoMessage()    {}
+func (*UpdateStoreItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{34}
 }
-func (m *GetEquippedUserItemsIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Marshal(b, m, deterministic)
+
+func (m *UpdateStoreItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStoreItemResponse.Unmarshal(m, b)
 }
-func (m *GetEquippedUserItemsIdsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEquippedUserItemsIdsResponse.Merge(m, src)
+func (m *UpdateStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStoreItemResponse.Marshal(b, m, deterministic)
 }
-func (m *GetEquippedUserItemsIdsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Size(m)
+func (m *UpdateStoreItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStoreItemResponse.Merge(m, src)
 }
-func (m *GetEquippedUserItemsIdsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEquippedUserItemsIdsResponse.DiscardUnknown(m)
+func (m *UpdateStoreItemResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateStoreItemResponse.Size(m)
+}
+func (m *UpdateStoreItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStoreItemResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEquippedUserItemsIdsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateStoreItemResponse proto.InternalMessageInfo
 
-func (m *GetEquippedUserItemsIdsResponse) GetItems() []*UserItemInfo {
+func (m *UpdateStoreItemResponse) GetResult() *StoreItem {
 	if m != nil {
-		return m.Items
+		return m.Result
 	}
 	return nil
 }
 
-type UserStats struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Games                int32    `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
-	Wins                 int32    `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
-	Top5                 int32    `protobuf:"varint,4,opt,name=top5,proto3" json:"top5,omitempty"`
-	Kills                int32    `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+type DeleteStoreItemRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserStats) Reset()         { *m = UserStats{} }
-func (m *UserStats) String() string { return proto.CompactTextString(m) }
-func (*UserStats) ProtoMessage()    {}
-func (*UserStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{40}
+func (m *DeleteStoreItemRequest) Reset()         { *m = DeleteStoreItemRequest{} }
+func (m *DeleteStoreItemRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteStoreItemRequest) ProtoMessage()    {}
+func (*DeleteStoreItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{35}
 }
 
-func (m *UserStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserStats.Unmarshal(m, b)
-}
-func (m *UserStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserStats.Marshal(b, m, deterministic)
+func (m *DeleteStoreItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteStoreItemRequest.Unmarshal(m, b)
 }
-func (m *UserStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserStats.Merge(m, src)
+func (m *DeleteStoreItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteStoreItemRequest.Marshal(b, m, deterministic)
 }
-func (m *UserStats) XXX_Size() int {
-	return xxx_messageInfo_UserStats.Size(m)
+func (m *DeleteStoreItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStoreItemRequest.Merge(m, src)
 }
-func (m *UserStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserStats.DiscardUnknown(m)
+func (m *DeleteStoreItemRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteStoreItemRequest.Size(m)
+}
+func (m *DeleteStoreItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStoreItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserStats proto.InternalMessageInfo
+var xxx_messageInfo_DeleteStoreItemRequest proto.InternalMessageInfo
 
-func (m *UserStats) GetId() int32 {
+func (m *DeleteStoreItemRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *UserStats) GetGames() int32 {
+type DeleteStoreItemResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteStoreItemResponse) Reset()         { *m = DeleteStoreItemResponse{} }
+func (m *DeleteStoreItemResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteStoreItemResponse) ProtoMessage()    {}
+func (*DeleteStoreItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{36}
+}
+
+func (m *DeleteStoreItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteStoreItemResponse.Unmarshal(m, b)
+}
+func (m *DeleteStoreItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteStoreItemResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteStoreItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStoreItemResponse.Merge(m, src)
+}
+func (m *DeleteStoreItemResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteStoreItemResponse.Size(m)
+}
+func (m *DeleteStoreItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStoreItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStoreItemResponse proto.InternalMessageInfo
+
+type ListStoreItemsRequest struct {
+	Filter               *query.Filtering      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy              *query.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Fields               *query.FieldSelection `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	Paging               *query.Pagination     `protobuf:"bytes,4,opt,name=paging,proto3" json:"paging,omitempty"`
+	Locale               string                `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	IncludeRetired       bool                  `protobuf:"varint,6,opt,name=include_retired,json=includeRetired,proto3" json:"include_retired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListStoreItemsRequest) Reset()         { *m = ListStoreItemsRequest{} }
+func (m *ListStoreItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStoreItemsRequest) ProtoMessage()    {}
+func (*ListStoreItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{37}
+}
+
+func (m *ListStoreItemsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStoreItemsRequest.Unmarshal(m, b)
+}
+func (m *ListStoreItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStoreItemsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListStoreItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStoreItemsRequest.Merge(m, src)
+}
+func (m *ListStoreItemsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStoreItemsRequest.Size(m)
+}
+func (m *ListStoreItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStoreItemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStoreItemsRequest proto.InternalMessageInfo
+
+func (m *ListStoreItemsRequest) GetFilter() *query.Filtering {
 	if m != nil {
-		return m.Games
+		return m.Filter
 	}
-	return 0
+	return nil
 }
 
-func (m *UserStats) GetWins() int32 {
+func (m *ListStoreItemsRequest) GetOrderBy() *query.Sorting {
 	if m != nil {
-		return m.Wins
+		return m.OrderBy
 	}
-	return 0
+	return nil
 }
 
-func (m *UserStats) GetTop5() int32 {
+func (m *ListStoreItemsRequest) GetFields() *query.FieldSelection {
 	if m != nil {
-		return m.Top5
+		return m.Fields
 	}
-	return 0
+	return nil
 }
 
-func (m *UserStats) GetKills() int32 {
+func (m *ListStoreItemsRequest) GetPaging() *query.Pagination {
 	if m != nil {
-		return m.Kills
+		return m.Paging
 	}
-	return 0
+	return nil
 }
 
-type ReadUserStatsRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListStoreItemsRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
 }
 
-func (m *ReadUserStatsRequest) Reset()         { *m = ReadUserStatsRequest{} }
-func (m *ReadUserStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadUserStatsRequest) ProtoMessage()    {}
-func (*ReadUserStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{41}
+func (m *ListStoreItemsRequest) GetIncludeRetired() bool {
+	if m != nil {
+		return m.IncludeRetired
+	}
+	return false
 }
 
-func (m *ReadUserStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadUserStatsRequest.Unmarshal(m, b)
+type ListStoreItemsResponse struct {
+	Results              []*StoreItem    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page                 *query.PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Locale               string          `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *ReadUserStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadUserStatsRequest.Marshal(b, m, deterministic)
+
+func (m *ListStoreItemsResponse) Reset()         { *m = ListStoreItemsResponse{} }
+func (m *ListStoreItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStoreItemsResponse) ProtoMessage()    {}
+func (*ListStoreItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{38}
 }
-func (m *ReadUserStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadUserStatsRequest.Merge(m, src)
+
+func (m *ListStoreItemsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStoreItemsResponse.Unmarshal(m, b)
 }
-func (m *ReadUserStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadUserStatsRequest.Size(m)
+func (m *ListStoreItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStoreItemsResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadUserStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadUserStatsRequest.DiscardUnknown(m)
+func (m *ListStoreItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStoreItemsResponse.Merge(m, src)
+}
+func (m *ListStoreItemsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStoreItemsResponse.Size(m)
+}
+func (m *ListStoreItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStoreItemsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadUserStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListStoreItemsResponse proto.InternalMessageInfo
 
-func (m *ReadUserStatsRequest) GetUsername() string {
+func (m *ListStoreItemsResponse) GetResults() []*StoreItem {
 	if m != nil {
-		return m.Username
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ListStoreItemsResponse) GetPage() *query.PageInfo {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListStoreItemsResponse) GetLocale() string {
+	if m != nil {
+		return m.Locale
 	}
 	return ""
 }
 
-type ReadUserStatsResponse struct {
-	Result               *UserStats `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type CatalogRowError struct {
+	Row                  int32    `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Sku                  string   `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadUserStatsResponse) Reset()         { *m = ReadUserStatsResponse{} }
-func (m *ReadUserStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadUserStatsResponse) ProtoMessage()    {}
-func (*ReadUserStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{42}
+func (m *CatalogRowError) Reset()         { *m = CatalogRowError{} }
+func (m *CatalogRowError) String() string { return proto.CompactTextString(m) }
+func (*CatalogRowError) ProtoMessage()    {}
+func (*CatalogRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{39}
 }
 
-func (m *ReadUserStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadUserStatsResponse.Unmarshal(m, b)
+func (m *CatalogRowError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogRowError.Unmarshal(m, b)
 }
-func (m *ReadUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadUserStatsResponse.Marshal(b, m, deterministic)
+func (m *CatalogRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogRowError.Marshal(b, m, deterministic)
 }
-func (m *ReadUserStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadUserStatsResponse.Merge(m, src)
+func (m *CatalogRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogRowError.Merge(m, src)
 }
-func (m *ReadUserStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadUserStatsResponse.Size(m)
+func (m *CatalogRowError) XXX_Size() int {
+	return xxx_messageInfo_CatalogRowError.Size(m)
 }
-func (m *ReadUserStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadUserStatsResponse.DiscardUnknown(m)
+func (m *CatalogRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogRowError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadUserStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CatalogRowError proto.InternalMessageInfo
 
-func (m *ReadUserStatsResponse) GetResult() *UserStats {
+func (m *CatalogRowError) GetRow() int32 {
 	if m != nil {
-		return m.Result
+		return m.Row
 	}
-	return nil
+	return 0
 }
 
-type UpdateUserStatsRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	AddGames             int32    `protobuf:"varint,2,opt,name=add_games,json=addGames,proto3" json:"add_games,omitempty"`
-	AddWins              int32    `protobuf:"varint,3,opt,name=add_wins,json=addWins,proto3" json:"add_wins,omitempty"`
-	AddTop5              int32    `protobuf:"varint,4,opt,name=add_top5,json=addTop5,proto3" json:"add_top5,omitempty"`
-	AddKills             int32    `protobuf:"varint,5,opt,name=add_kills,json=addKills,proto3" json:"add_kills,omitempty"`
+func (m *CatalogRowError) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func (m *CatalogRowError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportCatalogRequest struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserStatsRequest) Reset()         { *m = UpdateUserStatsRequest{} }
-func (m *UpdateUserStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserStatsRequest) ProtoMessage()    {}
-func (*UpdateUserStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{43}
+func (m *ImportCatalogRequest) Reset()         { *m = ImportCatalogRequest{} }
+func (m *ImportCatalogRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCatalogRequest) ProtoMessage()    {}
+func (*ImportCatalogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{40}
 }
 
-func (m *UpdateUserStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserStatsRequest.Unmarshal(m, b)
+func (m *ImportCatalogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCatalogRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserStatsRequest.Marshal(b, m, deterministic)
+func (m *ImportCatalogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCatalogRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserStatsRequest.Merge(m, src)
+func (m *ImportCatalogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCatalogRequest.Merge(m, src)
 }
-func (m *UpdateUserStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserStatsRequest.Size(m)
+func (m *ImportCatalogRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCatalogRequest.Size(m)
 }
-func (m *UpdateUserStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserStatsRequest.DiscardUnknown(m)
+func (m *ImportCatalogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCatalogRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImportCatalogRequest proto.InternalMessageInfo
 
-func (m *UpdateUserStatsRequest) GetUsername() string {
+func (m *ImportCatalogRequest) GetFormat() string {
 	if m != nil {
-		return m.Username
+		return m.Format
 	}
 	return ""
 }
 
-func (m *UpdateUserStatsRequest) GetAddGames() int32 {
+func (m *ImportCatalogRequest) GetData() string {
 	if m != nil {
-		return m.AddGames
+		return m.Data
 	}
-	return 0
+	return ""
 }
 
-func (m *UpdateUserStatsRequest) GetAddWins() int32 {
+func (m *ImportCatalogRequest) GetDryRun() bool {
 	if m != nil {
-		return m.AddWins
+		return m.DryRun
 	}
-	return 0
+	return false
 }
 
-func (m *UpdateUserStatsRequest) GetAddTop5() int32 {
-	if m != nil {
-		return m.AddTop5
-	}
-	return 0
+type ImportCatalogResponse struct {
+	Created              int32              `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated              int32              `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Applied              bool               `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	Errors               []*CatalogRowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *UpdateUserStatsRequest) GetAddKills() int32 {
-	if m != nil {
-		return m.AddKills
-	}
-	return 0
+func (m *ImportCatalogResponse) Reset()         { *m = ImportCatalogResponse{} }
+func (m *ImportCatalogResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCatalogResponse) ProtoMessage()    {}
+func (*ImportCatalogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{41}
 }
 
-type UpdateUserStatsResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UpdateUserStatsResponse) Reset()         { *m = UpdateUserStatsResponse{} }
-func (m *UpdateUserStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserStatsResponse) ProtoMessage()    {}
-func (*UpdateUserStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{44}
-}
-
-func (m *UpdateUserStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserStatsResponse.Unmarshal(m, b)
-}
-func (m *UpdateUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserStatsResponse.Marshal(b, m, deterministic)
-}
-func (m *UpdateUserStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserStatsResponse.Merge(m, src)
-}
-func (m *UpdateUserStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserStatsResponse.Size(m)
-}
-func (m *UpdateUserStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserStatsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateUserStatsResponse proto.InternalMessageInfo
-
-type News struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Title                string               `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ImageLink            string               `protobuf:"bytes,5,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
-}
-
-func (m *News) Reset()         { *m = News{} }
-func (m *News) String() string { return proto.CompactTextString(m) }
-func (*News) ProtoMessage()    {}
-func (*News) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{45}
-}
-
-func (m *News) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_News.Unmarshal(m, b)
+func (m *ImportCatalogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCatalogResponse.Unmarshal(m, b)
 }
-func (m *News) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_News.Marshal(b, m, deterministic)
+func (m *ImportCatalogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCatalogResponse.Marshal(b, m, deterministic)
 }
-func (m *News) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_News.Merge(m, src)
+func (m *ImportCatalogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCatalogResponse.Merge(m, src)
 }
-func (m *News) XXX_Size() int {
-	return xxx_messageInfo_News.Size(m)
+func (m *ImportCatalogResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportCatalogResponse.Size(m)
 }
-func (m *News) XXX_DiscardUnknown() {
-	xxx_messageInfo_News.DiscardUnknown(m)
+func (m *ImportCatalogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCatalogResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_News proto.InternalMessageInfo
-
-func (m *News) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_ImportCatalogResponse proto.InternalMessageInfo
 
-func (m *News) GetCreatedAt() *timestamp.Timestamp {
+func (m *ImportCatalogResponse) GetCreated() int32 {
 	if m != nil {
-		return m.CreatedAt
+		return m.Created
 	}
-	return nil
+	return 0
 }
 
-func (m *News) GetTitle() string {
+func (m *ImportCatalogResponse) GetUpdated() int32 {
 	if m != nil {
-		return m.Title
+		return m.Updated
 	}
-	return ""
+	return 0
 }
 
-func (m *News) GetDescription() string {
+func (m *ImportCatalogResponse) GetApplied() bool {
 	if m != nil {
-		return m.Description
+		return m.Applied
 	}
-	return ""
+	return false
 }
 
-func (m *News) GetImageLink() string {
+func (m *ImportCatalogResponse) GetErrors() []*CatalogRowError {
 	if m != nil {
-		return m.ImageLink
+		return m.Errors
 	}
-	return ""
+	return nil
 }
 
-type CreateNewsRequest struct {
-	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ImageLink            string   `protobuf:"bytes,3,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
+type ExportCatalogRequest struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateNewsRequest) Reset()         { *m = CreateNewsRequest{} }
-func (m *CreateNewsRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateNewsRequest) ProtoMessage()    {}
-func (*CreateNewsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{46}
+func (m *ExportCatalogRequest) Reset()         { *m = ExportCatalogRequest{} }
+func (m *ExportCatalogRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportCatalogRequest) ProtoMessage()    {}
+func (*ExportCatalogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{42}
 }
 
-func (m *CreateNewsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateNewsRequest.Unmarshal(m, b)
+func (m *ExportCatalogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportCatalogRequest.Unmarshal(m, b)
 }
-func (m *CreateNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateNewsRequest.Marshal(b, m, deterministic)
-}
-func (m *CreateNewsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateNewsRequest.Merge(m, src)
+func (m *ExportCatalogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportCatalogRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateNewsRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateNewsRequest.Size(m)
+func (m *ExportCatalogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCatalogRequest.Merge(m, src)
 }
-func (m *CreateNewsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateNewsRequest.DiscardUnknown(m)
+func (m *ExportCatalogRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportCatalogRequest.Size(m)
 }
-
-var xxx_messageInfo_CreateNewsRequest proto.InternalMessageInfo
-
-func (m *CreateNewsRequest) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
+func (m *ExportCatalogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCatalogRequest.DiscardUnknown(m)
 }
 
-func (m *CreateNewsRequest) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
-}
+var xxx_messageInfo_ExportCatalogRequest proto.InternalMessageInfo
 
-func (m *CreateNewsRequest) GetImageLink() string {
+func (m *ExportCatalogRequest) GetFormat() string {
 	if m != nil {
-		return m.ImageLink
+		return m.Format
 	}
 	return ""
 }
 
-type CreateNewsResponse struct {
-	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type ExportCatalogResponse struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateNewsResponse) Reset()         { *m = CreateNewsResponse{} }
-func (m *CreateNewsResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateNewsResponse) ProtoMessage()    {}
-func (*CreateNewsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{47}
+func (m *ExportCatalogResponse) Reset()         { *m = ExportCatalogResponse{} }
+func (m *ExportCatalogResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportCatalogResponse) ProtoMessage()    {}
+func (*ExportCatalogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{43}
 }
 
-func (m *CreateNewsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateNewsResponse.Unmarshal(m, b)
+func (m *ExportCatalogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportCatalogResponse.Unmarshal(m, b)
 }
-func (m *CreateNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateNewsResponse.Marshal(b, m, deterministic)
+func (m *ExportCatalogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportCatalogResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateNewsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateNewsResponse.Merge(m, src)
+func (m *ExportCatalogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCatalogResponse.Merge(m, src)
 }
-func (m *CreateNewsResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateNewsResponse.Size(m)
+func (m *ExportCatalogResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportCatalogResponse.Size(m)
 }
-func (m *CreateNewsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateNewsResponse.DiscardUnknown(m)
+func (m *ExportCatalogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCatalogResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateNewsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportCatalogResponse proto.InternalMessageInfo
 
-func (m *CreateNewsResponse) GetResult() *News {
+func (m *ExportCatalogResponse) GetFormat() string {
 	if m != nil {
-		return m.Result
+		return m.Format
 	}
-	return nil
+	return ""
 }
 
-type ReadNewsRequest struct {
+func (m *ExportCatalogResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type PurgeItemRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Refund               bool     `protobuf:"varint,2,opt,name=refund,proto3" json:"refund,omitempty"`
+	CompensationCoins    int32    `protobuf:"varint,3,opt,name=compensation_coins,json=compensationCoins,proto3" json:"compensation_coins,omitempty"`
+	CompensationGems     int32    `protobuf:"varint,4,opt,name=compensation_gems,json=compensationGems,proto3" json:"compensation_gems,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadNewsRequest) Reset()         { *m = ReadNewsRequest{} }
-func (m *ReadNewsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadNewsRequest) ProtoMessage()    {}
-func (*ReadNewsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{48}
+func (m *PurgeItemRequest) Reset()         { *m = PurgeItemRequest{} }
+func (m *PurgeItemRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeItemRequest) ProtoMessage()    {}
+func (*PurgeItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{44}
 }
 
-func (m *ReadNewsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadNewsRequest.Unmarshal(m, b)
+func (m *PurgeItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeItemRequest.Unmarshal(m, b)
 }
-func (m *ReadNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadNewsRequest.Marshal(b, m, deterministic)
+func (m *PurgeItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeItemRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadNewsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadNewsRequest.Merge(m, src)
+func (m *PurgeItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeItemRequest.Merge(m, src)
 }
-func (m *ReadNewsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadNewsRequest.Size(m)
+func (m *PurgeItemRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeItemRequest.Size(m)
 }
-func (m *ReadNewsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadNewsRequest.DiscardUnknown(m)
+func (m *PurgeItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeItemRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadNewsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PurgeItemRequest proto.InternalMessageInfo
 
-func (m *ReadNewsRequest) GetId() string {
+func (m *PurgeItemRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type ReadNewsResponse struct {
-	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *PurgeItemRequest) GetRefund() bool {
+	if m != nil {
+		return m.Refund
+	}
+	return false
+}
+
+func (m *PurgeItemRequest) GetCompensationCoins() int32 {
+	if m != nil {
+		return m.CompensationCoins
+	}
+	return 0
+}
+
+func (m *PurgeItemRequest) GetCompensationGems() int32 {
+	if m != nil {
+		return m.CompensationGems
+	}
+	return 0
+}
+
+type PurgeItemResponse struct {
+	AffectedOwners       int32    `protobuf:"varint,1,opt,name=affected_owners,json=affectedOwners,proto3" json:"affected_owners,omitempty"`
+	RefundedGiftSenders  int32    `protobuf:"varint,2,opt,name=refunded_gift_senders,json=refundedGiftSenders,proto3" json:"refunded_gift_senders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadNewsResponse) Reset()         { *m = ReadNewsResponse{} }
-func (m *ReadNewsResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadNewsResponse) ProtoMessage()    {}
-func (*ReadNewsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{49}
+func (m *PurgeItemResponse) Reset()         { *m = PurgeItemResponse{} }
+func (m *PurgeItemResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeItemResponse) ProtoMessage()    {}
+func (*PurgeItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{45}
 }
 
-func (m *ReadNewsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadNewsResponse.Unmarshal(m, b)
+func (m *PurgeItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeItemResponse.Unmarshal(m, b)
 }
-func (m *ReadNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadNewsResponse.Marshal(b, m, deterministic)
+func (m *PurgeItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeItemResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadNewsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadNewsResponse.Merge(m, src)
+func (m *PurgeItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeItemResponse.Merge(m, src)
 }
-func (m *ReadNewsResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadNewsResponse.Size(m)
+func (m *PurgeItemResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeItemResponse.Size(m)
 }
-func (m *ReadNewsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadNewsResponse.DiscardUnknown(m)
+func (m *PurgeItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeItemResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadNewsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PurgeItemResponse proto.InternalMessageInfo
 
-func (m *ReadNewsResponse) GetResult() *News {
+func (m *PurgeItemResponse) GetAffectedOwners() int32 {
 	if m != nil {
-		return m.Result
+		return m.AffectedOwners
 	}
-	return nil
+	return 0
 }
 
-type UpdateNewsRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ImageLink            string   `protobuf:"bytes,4,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
+func (m *PurgeItemResponse) GetRefundedGiftSenders() int32 {
+	if m != nil {
+		return m.RefundedGiftSenders
+	}
+	return 0
+}
+
+type StoreItemTranslation struct {
+	ItemId               string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateNewsRequest) Reset()         { *m = UpdateNewsRequest{} }
-func (m *UpdateNewsRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateNewsRequest) ProtoMessage()    {}
-func (*UpdateNewsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{50}
+func (m *StoreItemTranslation) Reset()         { *m = StoreItemTranslation{} }
+func (m *StoreItemTranslation) String() string { return proto.CompactTextString(m) }
+func (*StoreItemTranslation) ProtoMessage()    {}
+func (*StoreItemTranslation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{46}
 }
 
-func (m *UpdateNewsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateNewsRequest.Unmarshal(m, b)
+func (m *StoreItemTranslation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreItemTranslation.Unmarshal(m, b)
 }
-func (m *UpdateNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateNewsRequest.Marshal(b, m, deterministic)
+func (m *StoreItemTranslation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreItemTranslation.Marshal(b, m, deterministic)
 }
-func (m *UpdateNewsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateNewsRequest.Merge(m, src)
+func (m *StoreItemTranslation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreItemTranslation.Merge(m, src)
 }
-func (m *UpdateNewsRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateNewsRequest.Size(m)
+func (m *StoreItemTranslation) XXX_Size() int {
+	return xxx_messageInfo_StoreItemTranslation.Size(m)
 }
-func (m *UpdateNewsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateNewsRequest.DiscardUnknown(m)
+func (m *StoreItemTranslation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreItemTranslation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateNewsRequest proto.InternalMessageInfo
+var xxx_messageInfo_StoreItemTranslation proto.InternalMessageInfo
 
-func (m *UpdateNewsRequest) GetId() string {
+func (m *StoreItemTranslation) GetItemId() string {
 	if m != nil {
-		return m.Id
+		return m.ItemId
 	}
 	return ""
 }
 
-func (m *UpdateNewsRequest) GetTitle() string {
+func (m *StoreItemTranslation) GetLocale() string {
 	if m != nil {
-		return m.Title
+		return m.Locale
 	}
 	return ""
 }
 
-func (m *UpdateNewsRequest) GetDescription() string {
+func (m *StoreItemTranslation) GetName() string {
 	if m != nil {
-		return m.Description
+		return m.Name
 	}
 	return ""
 }
 
-func (m *UpdateNewsRequest) GetImageLink() string {
+func (m *StoreItemTranslation) GetDescription() string {
 	if m != nil {
-		return m.ImageLink
+		return m.Description
 	}
 	return ""
 }
 
-type UpdateNewsResponse struct {
+type SetItemTranslationRequest struct {
+	ItemId               string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateNewsResponse) Reset()         { *m = UpdateNewsResponse{} }
-func (m *UpdateNewsResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateNewsResponse) ProtoMessage()    {}
-func (*UpdateNewsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{51}
+func (m *SetItemTranslationRequest) Reset()         { *m = SetItemTranslationRequest{} }
+func (m *SetItemTranslationRequest) String() string { return proto.CompactTextString(m) }
+func (*SetItemTranslationRequest) ProtoMessage()    {}
+func (*SetItemTranslationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{47}
 }
 
-func (m *UpdateNewsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateNewsResponse.Unmarshal(m, b)
+func (m *SetItemTranslationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetItemTranslationRequest.Unmarshal(m, b)
 }
-func (m *UpdateNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateNewsResponse.Marshal(b, m, deterministic)
+func (m *SetItemTranslationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetItemTranslationRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateNewsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateNewsResponse.Merge(m, src)
+func (m *SetItemTranslationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetItemTranslationRequest.Merge(m, src)
 }
-func (m *UpdateNewsResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateNewsResponse.Size(m)
+func (m *SetItemTranslationRequest) XXX_Size() int {
+	return xxx_messageInfo_SetItemTranslationRequest.Size(m)
 }
-func (m *UpdateNewsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateNewsResponse.DiscardUnknown(m)
+func (m *SetItemTranslationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetItemTranslationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateNewsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetItemTranslationRequest proto.InternalMessageInfo
 
-type ListNewsRequest struct {
-	Filter               *query.Filtering      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	OrderBy              *query.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Fields               *query.FieldSelection `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
-	Paging               *query.Pagination     `protobuf:"bytes,4,opt,name=paging,proto3" json:"paging,omitempty"`
+func (m *SetItemTranslationRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *SetItemTranslationRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *SetItemTranslationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetItemTranslationRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SetItemTranslationResponse struct {
+	Result               *StoreItemTranslation `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ListNewsRequest) Reset()         { *m = ListNewsRequest{} }
-func (m *ListNewsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListNewsRequest) ProtoMessage()    {}
-func (*ListNewsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{52}
+func (m *SetItemTranslationResponse) Reset()         { *m = SetItemTranslationResponse{} }
+func (m *SetItemTranslationResponse) String() string { return proto.CompactTextString(m) }
+func (*SetItemTranslationResponse) ProtoMessage()    {}
+func (*SetItemTranslationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{48}
 }
 
-func (m *ListNewsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListNewsRequest.Unmarshal(m, b)
+func (m *SetItemTranslationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetItemTranslationResponse.Unmarshal(m, b)
 }
-func (m *ListNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListNewsRequest.Marshal(b, m, deterministic)
+func (m *SetItemTranslationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetItemTranslationResponse.Marshal(b, m, deterministic)
 }
-func (m *ListNewsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNewsRequest.Merge(m, src)
+func (m *SetItemTranslationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetItemTranslationResponse.Merge(m, src)
 }
-func (m *ListNewsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListNewsRequest.Size(m)
+func (m *SetItemTranslationResponse) XXX_Size() int {
+	return xxx_messageInfo_SetItemTranslationResponse.Size(m)
 }
-func (m *ListNewsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListNewsRequest.DiscardUnknown(m)
+func (m *SetItemTranslationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetItemTranslationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListNewsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetItemTranslationResponse proto.InternalMessageInfo
 
-func (m *ListNewsRequest) GetFilter() *query.Filtering {
+func (m *SetItemTranslationResponse) GetResult() *StoreItemTranslation {
 	if m != nil {
-		return m.Filter
+		return m.Result
 	}
 	return nil
 }
 
-func (m *ListNewsRequest) GetOrderBy() *query.Sorting {
-	if m != nil {
-		return m.OrderBy
-	}
-	return nil
+type DeleteItemTranslationRequest struct {
+	ItemId               string   `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListNewsRequest) GetFields() *query.FieldSelection {
+func (m *DeleteItemTranslationRequest) Reset()         { *m = DeleteItemTranslationRequest{} }
+func (m *DeleteItemTranslationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteItemTranslationRequest) ProtoMessage()    {}
+func (*DeleteItemTranslationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{49}
+}
+
+func (m *DeleteItemTranslationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteItemTranslationRequest.Unmarshal(m, b)
+}
+func (m *DeleteItemTranslationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteItemTranslationRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteItemTranslationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteItemTranslationRequest.Merge(m, src)
+}
+func (m *DeleteItemTranslationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteItemTranslationRequest.Size(m)
+}
+func (m *DeleteItemTranslationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteItemTranslationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteItemTranslationRequest proto.InternalMessageInfo
+
+func (m *DeleteItemTranslationRequest) GetItemId() string {
 	if m != nil {
-		return m.Fields
+		return m.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (m *ListNewsRequest) GetPaging() *query.Pagination {
+func (m *DeleteItemTranslationRequest) GetLocale() string {
 	if m != nil {
-		return m.Paging
+		return m.Locale
 	}
-	return nil
+	return ""
 }
 
-type ListNewsResponse struct {
-	Results              []*News         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Page                 *query.PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type DeleteItemTranslationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListNewsResponse) Reset()         { *m = ListNewsResponse{} }
-func (m *ListNewsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListNewsResponse) ProtoMessage()    {}
-func (*ListNewsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1742b9f6e5341e0, []int{53}
+func (m *DeleteItemTranslationResponse) Reset()         { *m = DeleteItemTranslationResponse{} }
+func (m *DeleteItemTranslationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteItemTranslationResponse) ProtoMessage()    {}
+func (*DeleteItemTranslationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{50}
 }
 
-func (m *ListNewsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListNewsResponse.Unmarshal(m, b)
+func (m *DeleteItemTranslationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteItemTranslationResponse.Unmarshal(m, b)
 }
-func (m *ListNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListNewsResponse.Marshal(b, m, deterministic)
+func (m *DeleteItemTranslationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteItemTranslationResponse.Marshal(b, m, deterministic)
 }
-func (m *ListNewsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNewsResponse.Merge(m, src)
+func (m *DeleteItemTranslationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteItemTranslationResponse.Merge(m, src)
 }
-func (m *ListNewsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListNewsResponse.Size(m)
+func (m *DeleteItemTranslationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteItemTranslationResponse.Size(m)
 }
-func (m *ListNewsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListNewsResponse.DiscardUnknown(m)
+func (m *DeleteItemTranslationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteItemTranslationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListNewsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteItemTranslationResponse proto.InternalMessageInfo
 
-func (m *ListNewsResponse) GetResults() []*News {
-	if m != nil {
-		return m.Results
-	}
-	return nil
+type BuyByUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity             int32    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Rent                 bool     `protobuf:"varint,4,opt,name=rent,proto3" json:"rent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListNewsResponse) GetPage() *query.PageInfo {
-	if m != nil {
-		return m.Page
-	}
-	return nil
+func (m *BuyByUserRequest) Reset()         { *m = BuyByUserRequest{} }
+func (m *BuyByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*BuyByUserRequest) ProtoMessage()    {}
+func (*BuyByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{51}
 }
 
-func init() {
-	proto.RegisterType((*VersionResponse)(nil), "service.VersionResponse")
-	proto.RegisterType((*User)(nil), "service.User")
-	proto.RegisterType((*CreateUserRequest)(nil), "service.CreateUserRequest")
-	proto.RegisterType((*CreateUserResponse)(nil), "service.CreateUserResponse")
-	proto.RegisterType((*ReadUserRequest)(nil), "service.ReadUserRequest")
-	proto.RegisterType((*ReadUserResponse)(nil), "service.ReadUserResponse")
-	proto.RegisterType((*UpdateUserRequest)(nil), "service.UpdateUserRequest")
-	proto.RegisterType((*UpdateUserResponse)(nil), "service.UpdateUserResponse")
-	proto.RegisterType((*DeleteUserRequest)(nil), "service.DeleteUserRequest")
-	proto.RegisterType((*DeleteUserResponse)(nil), "service.DeleteUserResponse")
-	proto.RegisterType((*ListUsersRequest)(nil), "service.ListUsersRequest")
-	proto.RegisterType((*ListUsersResponse)(nil), "service.ListUsersResponse")
-	proto.RegisterType((*LoginRequest)(nil), "service.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "service.LoginResponse")
-	proto.RegisterType((*GrantCurrenciesRequest)(nil), "service.GrantCurrenciesRequest")
-	proto.RegisterType((*GrantCurrenciesResponse)(nil), "service.GrantCurrenciesResponse")
-	proto.RegisterType((*GetUserCurrenciesRequest)(nil), "service.GetUserCurrenciesRequest")
-	proto.RegisterType((*GetUserCurrenciesResponse)(nil), "service.GetUserCurrenciesResponse")
-	proto.RegisterType((*StoreItem)(nil), "service.StoreItem")
-	proto.RegisterType((*CreateStoreItemRequest)(nil), "service.CreateStoreItemRequest")
-	proto.RegisterType((*CreateStoreItemResponse)(nil), "service.CreateStoreItemResponse")
-	proto.RegisterType((*ReadStoreItemRequest)(nil), "service.ReadStoreItemRequest")
-	proto.RegisterType((*ReadStoreItemResponse)(nil), "service.ReadStoreItemResponse")
-	proto.RegisterType((*UpdateStoreItemRequest)(nil), "service.UpdateStoreItemRequest")
-	proto.RegisterType((*UpdateStoreItemResponse)(nil), "service.UpdateStoreItemResponse")
-	proto.RegisterType((*DeleteStoreItemRequest)(nil), "service.DeleteStoreItemRequest")
-	proto.RegisterType((*DeleteStoreItemResponse)(nil), "service.DeleteStoreItemResponse")
-	proto.RegisterType((*ListStoreItemsRequest)(nil), "service.ListStoreItemsRequest")
-	proto.RegisterType((*ListStoreItemsResponse)(nil), "service.ListStoreItemsResponse")
-	proto.RegisterType((*BuyByUserRequest)(nil), "service.BuyByUserRequest")
-	proto.RegisterType((*BuyByUserResponse)(nil), "service.BuyByUserResponse")
-	proto.RegisterType((*ThrowAwayByUserRequest)(nil), "service.ThrowAwayByUserRequest")
-	proto.RegisterType((*ThrowAwayByUserResponse)(nil), "service.ThrowAwayByUserResponse")
-	proto.RegisterType((*EquipByUserRequest)(nil), "service.EquipByUserRequest")
-	proto.RegisterType((*EquipByUserResponse)(nil), "service.EquipByUserResponse")
-	proto.RegisterType((*GetUserItemsIdsRequest)(nil), "service.GetUserItemsIdsRequest")
-	proto.RegisterType((*UserItemInfo)(nil), "service.UserItemInfo")
-	proto.RegisterType((*GetUserItemsIdsResponse)(nil), "service.GetUserItemsIdsResponse")
-	proto.RegisterType((*GetEquippedUserItemsIdsRequest)(nil), "service.GetEquippedUserItemsIdsRequest")
-	proto.RegisterType((*GetEquippedUserItemsIdsResponse)(nil), "service.GetEquippedUserItemsIdsResponse")
-	proto.RegisterType((*UserStats)(nil), "service.UserStats")
-	proto.RegisterType((*ReadUserStatsRequest)(nil), "service.ReadUserStatsRequest")
-	proto.RegisterType((*ReadUserStatsResponse)(nil), "service.ReadUserStatsResponse")
-	proto.RegisterType((*UpdateUserStatsRequest)(nil), "service.UpdateUserStatsRequest")
-	proto.RegisterType((*UpdateUserStatsResponse)(nil), "service.UpdateUserStatsResponse")
-	proto.RegisterType((*News)(nil), "service.News")
-	proto.RegisterType((*CreateNewsRequest)(nil), "service.CreateNewsRequest")
-	proto.RegisterType((*CreateNewsResponse)(nil), "service.CreateNewsResponse")
-	proto.RegisterType((*ReadNewsRequest)(nil), "service.ReadNewsRequest")
-	proto.RegisterType((*ReadNewsResponse)(nil), "service.ReadNewsResponse")
-	proto.RegisterType((*UpdateNewsRequest)(nil), "service.UpdateNewsRequest")
-	proto.RegisterType((*UpdateNewsResponse)(nil), "service.UpdateNewsResponse")
-	proto.RegisterType((*ListNewsRequest)(nil), "service.ListNewsRequest")
-	proto.RegisterType((*ListNewsResponse)(nil), "service.ListNewsResponse")
+func (m *BuyByUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyByUserRequest.Unmarshal(m, b)
 }
-
-func init() {
-	proto.RegisterFile("github.com/amikhailau/users-service/pkg/pb/service.proto", fileDescriptor_b1742b9f6e5341e0)
+func (m *BuyByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyByUserRequest.Marshal(b, m, deterministic)
 }
-
-var fileDescriptor_b1742b9f6e5341e0 = []byte{
-	// 2403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xf1, 0xf7, 0x50, 0x7c, 0x16, 0x6d, 0x4b, 0x6a, 0x4b, 0x14, 0x39, 0xb2, 0x2c, 0x7a, 0x76, 0xff,
-	0x5e, 0x41, 0x6b, 0x91, 0xfb, 0x67, 0xb2, 0x40, 0xac, 0x3d, 0x59, 0xb2, 0xac, 0xc8, 0xf1, 0x7a,
-	0x0d, 0xca, 0xde, 0x20, 0x06, 0x02, 0xba, 0xc5, 0x69, 0xd1, 0x13, 0x0d, 0x67, 0xc6, 0x33, 0x43,
-	0xcb, 0xf4, 0xc2, 0x97, 0x5c, 0x37, 0x97, 0x60, 0x6f, 0xb9, 0x6e, 0x0e, 0xf9, 0x02, 0x39, 0x48,
-	0x97, 0x00, 0x01, 0xf2, 0x09, 0x72, 0x0b, 0x92, 0x4b, 0x90, 0x4f, 0x91, 0x53, 0xd0, 0x8f, 0x19,
-	0xf6, 0xbc, 0x48, 0x59, 0x7b, 0xdb, 0x1b, 0xbb, 0xab, 0xba, 0x7e, 0x55, 0xd5, 0x53, 0xaf, 0x06,
-	0xe1, 0x67, 0x03, 0xc3, 0x7f, 0x35, 0x3a, 0x6a, 0xf5, 0xed, 0x61, 0x1b, 0x0f, 0x8d, 0x93, 0x57,
-	0xd8, 0x30, 0xf1, 0xa8, 0x3d, 0xf2, 0x88, 0xeb, 0x6d, 0x79, 0xc4, 0x7d, 0x63, 0xf4, 0x49, 0xdb,
-	0x39, 0x19, 0xb4, 0x9d, 0xa3, 0xb6, 0x58, 0xb6, 0x1c, 0xd7, 0xf6, 0x6d, 0x54, 0x12, 0x4b, 0x75,
-	0x75, 0x60, 0xdb, 0x03, 0x93, 0xb4, 0xd9, 0xf6, 0xd1, 0xe8, 0xb8, 0x4d, 0x86, 0x8e, 0x3f, 0xe6,
-	0x5c, 0xea, 0x4d, 0x41, 0xc4, 0x8e, 0xd1, 0xc6, 0x96, 0x65, 0xfb, 0xd8, 0x37, 0x6c, 0xcb, 0x13,
-	0xd4, 0xfb, 0x12, 0x3a, 0xb1, 0xde, 0xd8, 0x63, 0xc7, 0xb5, 0xdf, 0x8e, 0xb9, 0xa4, 0xfe, 0xd6,
-	0x80, 0x58, 0x5b, 0x6f, 0xb0, 0x69, 0xe8, 0xd8, 0x27, 0xed, 0xc4, 0x0f, 0x21, 0xe2, 0xae, 0xc4,
-	0xec, 0x9d, 0xe2, 0xc1, 0x80, 0xb8, 0x6d, 0xdb, 0x61, 0x20, 0x29, 0x80, 0xdb, 0x12, 0xa0, 0x61,
-	0x1d, 0xdb, 0x47, 0xa6, 0xfd, 0xd6, 0x76, 0x88, 0x25, 0x43, 0x0e, 0x6c, 0x77, 0x18, 0x8a, 0xa0,
-	0x0b, 0x71, 0xb6, 0x19, 0xb7, 0xf3, 0xd8, 0x20, 0xa6, 0xde, 0x1b, 0x62, 0xef, 0x44, 0x70, 0xac,
-	0xc7, 0x39, 0x7c, 0x63, 0x48, 0x3c, 0x1f, 0x0f, 0x1d, 0xc1, 0xf0, 0x28, 0x0b, 0x1e, 0xfb, 0x26,
-	0xf6, 0xb6, 0xb0, 0xe3, 0x6c, 0xf9, 0xb6, 0x6d, 0x9e, 0x18, 0x7e, 0xfb, 0xf5, 0x88, 0xb8, 0xe3,
-	0x76, 0xdf, 0x36, 0x4d, 0xd2, 0xa7, 0xaa, 0xf4, 0x6c, 0x87, 0xb8, 0xd8, 0xb7, 0xdd, 0xc0, 0x94,
-	0x67, 0x17, 0x30, 0x85, 0x8b, 0x65, 0xa2, 0x26, 0x9e, 0x0c, 0x4c, 0x63, 0xdb, 0xbd, 0x98, 0x3b,
-	0x9f, 0x5c, 0x58, 0x6a, 0x42, 0x1e, 0xdb, 0x8e, 0xc9, 0xd3, 0x3e, 0x85, 0xf9, 0xaf, 0x89, 0xeb,
-	0x19, 0xb6, 0xd5, 0x25, 0x9e, 0x63, 0x5b, 0x1e, 0x41, 0x75, 0x28, 0xbd, 0xe1, 0x5b, 0x75, 0xa5,
-	0xa9, 0x6c, 0x54, 0xba, 0xc1, 0x52, 0xfb, 0x7d, 0x0e, 0xf2, 0xcf, 0x3d, 0xe2, 0xa2, 0x5b, 0x90,
-	0x33, 0x74, 0x4e, 0xdd, 0xb9, 0x7e, 0x7e, 0xd6, 0x00, 0x28, 0xa3, 0xfc, 0xf3, 0xe7, 0x07, 0x0f,
-	0x36, 0x94, 0x6e, 0xce, 0xd0, 0x11, 0x82, 0xbc, 0x85, 0x87, 0xa4, 0x9e, 0x63, 0xe7, 0xd9, 0x6f,
-	0xb4, 0x04, 0x05, 0x32, 0xc4, 0x86, 0x59, 0x9f, 0x63, 0x9b, 0x7c, 0x81, 0x54, 0x28, 0x3b, 0xd8,
-	0xf3, 0x4e, 0x6d, 0x57, 0xaf, 0xe7, 0x19, 0x21, 0x5c, 0xd3, 0x13, 0x7d, 0xdb, 0xb0, 0xbc, 0x7a,
-	0xa1, 0xa9, 0x6c, 0x14, 0xba, 0x7c, 0x41, 0x65, 0x0f, 0xc8, 0xd0, 0xab, 0x17, 0xd9, 0x26, 0xfb,
-	0x8d, 0xf6, 0xa0, 0x60, 0xf8, 0x74, 0xb3, 0xd4, 0x9c, 0xdb, 0xa8, 0x76, 0x50, 0x2b, 0x08, 0x85,
-	0x43, 0xdf, 0x76, 0xc9, 0x81, 0x4f, 0x86, 0x3b, 0xab, 0xe7, 0x67, 0x8d, 0x95, 0xce, 0x32, 0x2c,
-	0xb2, 0xd0, 0xe9, 0x79, 0x94, 0xd0, 0x63, 0x87, 0x7e, 0x7e, 0xa5, 0xcb, 0x4f, 0xa3, 0x0d, 0x28,
-	0x78, 0x3e, 0xf6, 0xbd, 0x7a, 0xb9, 0xa9, 0x44, 0xc4, 0x50, 0xa3, 0x0f, 0x29, 0xa5, 0xcb, 0x19,
-	0xb6, 0xcb, 0xe7, 0x67, 0x8d, 0x7c, 0x59, 0x69, 0x5e, 0xd1, 0x7e, 0x05, 0x8b, 0xbb, 0x2e, 0xc1,
-	0x3e, 0xa1, 0x3c, 0x5d, 0xf2, 0x7a, 0x44, 0x3c, 0x3f, 0xb4, 0x5f, 0x49, 0xb3, 0x3f, 0x97, 0x65,
-	0xff, 0x5c, 0xd4, 0x7e, 0xed, 0x0b, 0x40, 0xb2, 0x68, 0x71, 0x3d, 0xff, 0x07, 0x45, 0x97, 0x78,
-	0x23, 0xd3, 0x67, 0xd2, 0xab, 0x9d, 0x6b, 0x11, 0x2d, 0xbb, 0x82, 0xa8, 0xdd, 0x86, 0xf9, 0x2e,
-	0xc1, 0xba, 0xac, 0xd5, 0xf5, 0xc9, 0xad, 0xd1, 0x5b, 0xd2, 0xee, 0xc1, 0xc2, 0x84, 0xe5, 0xc3,
-	0xa4, 0x1f, 0xc2, 0xe2, 0x73, 0x47, 0x8f, 0x59, 0x1d, 0x93, 0x9f, 0xfa, 0x15, 0x4c, 0xb3, 0x77,
-	0x09, 0x90, 0x2c, 0x94, 0x6b, 0xa4, 0x7d, 0x04, 0x8b, 0x0f, 0x88, 0x49, 0xa6, 0x42, 0xd1, 0xa3,
-	0x32, 0x93, 0x38, 0xfa, 0x2f, 0x05, 0x16, 0x1e, 0x1b, 0x9e, 0x4f, 0x37, 0xbd, 0xe0, 0x68, 0x1b,
-	0x8a, 0xc7, 0x86, 0xe9, 0x13, 0x57, 0x58, 0xb8, 0xd2, 0x0a, 0xe2, 0xa8, 0x85, 0x1d, 0xa3, 0xf5,
-	0x90, 0xd1, 0x0c, 0x6b, 0xd0, 0x15, 0x6c, 0xe8, 0x33, 0x28, 0xdb, 0xae, 0x4e, 0xdc, 0xde, 0xd1,
-	0x98, 0x99, 0x52, 0xed, 0x2c, 0x47, 0x8f, 0x1c, 0xda, 0xae, 0x4f, 0x0f, 0x94, 0x18, 0xdb, 0xce,
-	0x18, 0xfd, 0x94, 0x42, 0x10, 0x53, 0xf7, 0x98, 0x89, 0xd5, 0xce, 0xcd, 0x38, 0x04, 0x31, 0xf5,
-	0x43, 0x22, 0x12, 0x47, 0x57, 0xf0, 0xa2, 0xcf, 0xa0, 0xe8, 0xe0, 0x81, 0x61, 0x0d, 0x58, 0x20,
-	0x54, 0x3b, 0xf5, 0xe8, 0xa9, 0xa7, 0x94, 0x86, 0xf9, 0x09, 0xce, 0xa7, 0xbd, 0x82, 0x45, 0xc9,
-	0x3c, 0x71, 0x83, 0x9f, 0x40, 0x89, 0x5f, 0x92, 0x57, 0x57, 0x58, 0x34, 0xc4, 0xae, 0x30, 0xa0,
-	0xa2, 0x4d, 0xc8, 0x3b, 0x78, 0x40, 0x84, 0x4d, 0xb5, 0x04, 0x1a, 0x39, 0xb0, 0x8e, 0xed, 0x2e,
-	0xe3, 0xd1, 0xb6, 0xe1, 0xea, 0x63, 0x7b, 0x60, 0x58, 0x59, 0x57, 0x2d, 0x5f, 0x6b, 0x2e, 0x76,
-	0xad, 0xdf, 0x29, 0x70, 0x4d, 0x1c, 0x16, 0x2a, 0x2e, 0x41, 0xc1, 0xb7, 0x4f, 0x48, 0x90, 0x5f,
-	0xf8, 0x02, 0xdd, 0x03, 0x20, 0x6f, 0x1d, 0xc3, 0x25, 0x5e, 0x0f, 0xfb, 0x42, 0x2b, 0xb5, 0xc5,
-	0x53, 0x76, 0x2b, 0x48, 0xd9, 0xad, 0x67, 0x41, 0xca, 0xee, 0x56, 0x04, 0xf7, 0x7d, 0x9f, 0xa6,
-	0x2c, 0xc3, 0xbb, 0xaf, 0x0f, 0x0d, 0x8b, 0x79, 0xbc, 0xdc, 0x0d, 0x96, 0x68, 0x05, 0x4a, 0x34,
-	0xe0, 0x7b, 0x46, 0x90, 0x5e, 0x8a, 0x74, 0x79, 0xa0, 0x6b, 0x2f, 0xa1, 0xb6, 0xef, 0x62, 0xcb,
-	0xdf, 0x1d, 0xb9, 0x2e, 0xb1, 0xfa, 0x06, 0xf1, 0xb2, 0x6c, 0x5b, 0x85, 0x0a, 0xd6, 0xf5, 0x1e,
-	0x4f, 0x45, 0x39, 0x96, 0x75, 0xca, 0x58, 0xd7, 0x77, 0x59, 0x36, 0x6a, 0x00, 0xfd, 0xdd, 0x63,
-	0x19, 0x69, 0x8e, 0xd1, 0x4a, 0x58, 0xd7, 0xf7, 0xc9, 0xd0, 0xd3, 0x1a, 0xb0, 0x92, 0x40, 0x10,
-	0x1f, 0xe6, 0x26, 0xd4, 0xf7, 0x09, 0xbb, 0xb7, 0x99, 0xf0, 0xda, 0x1e, 0x34, 0x52, 0x78, 0x27,
-	0x9e, 0xe4, 0x7a, 0x29, 0x69, 0x29, 0x32, 0x37, 0x49, 0x91, 0xda, 0xdf, 0x72, 0x50, 0x09, 0xb3,
-	0xe1, 0xa5, 0x12, 0x78, 0x13, 0xaa, 0x3a, 0xf1, 0xfa, 0xae, 0xc1, 0xea, 0x89, 0x88, 0x5e, 0x79,
-	0x8b, 0x9e, 0xf2, 0xc7, 0x0e, 0x61, 0x9e, 0x2e, 0x74, 0xd9, 0x6f, 0xb4, 0x0e, 0x55, 0xa6, 0x54,
-	0xcf, 0x71, 0x8d, 0x3e, 0x11, 0xa9, 0x1c, 0xd8, 0xd6, 0x53, 0xba, 0x83, 0xd6, 0x00, 0xa8, 0x82,
-	0x82, 0xce, 0xb3, 0x7a, 0x85, 0xee, 0x70, 0x72, 0x03, 0xca, 0xc6, 0x10, 0x0f, 0x08, 0xbd, 0xc1,
-	0x12, 0x2f, 0x47, 0x6c, 0x7d, 0xa0, 0xd3, 0xbb, 0xb5, 0xad, 0x9e, 0x87, 0x4d, 0xc2, 0x12, 0x76,
-	0xb9, 0x5b, 0xb4, 0xad, 0x43, 0x6c, 0x12, 0xb4, 0x01, 0x0b, 0x74, 0xb7, 0x27, 0x03, 0x57, 0x98,
-	0xe0, 0xeb, 0x74, 0x7f, 0x77, 0x02, 0x7e, 0x07, 0xe6, 0x19, 0xa7, 0xa4, 0x01, 0x30, 0xc6, 0x6b,
-	0x74, 0x7b, 0x3f, 0xd0, 0x42, 0xca, 0xf7, 0x7f, 0xca, 0x41, 0x8d, 0x67, 0xe5, 0xd0, 0x9b, 0xd3,
-	0xb2, 0x7e, 0xcc, 0x69, 0xb9, 0x6c, 0xa7, 0xcd, 0x65, 0x3b, 0x2d, 0x3f, 0xc3, 0x69, 0x85, 0x69,
-	0x4e, 0x2b, 0x66, 0x3a, 0xad, 0x34, 0xd3, 0x69, 0xe5, 0x8b, 0x3a, 0xad, 0x92, 0xe2, 0x34, 0x6d,
-	0x0f, 0x56, 0x12, 0x9e, 0x12, 0xdf, 0xed, 0x66, 0xac, 0xcc, 0xa4, 0x54, 0xec, 0xb0, 0xd6, 0xdc,
-	0x81, 0x25, 0x5a, 0xa6, 0x12, 0xee, 0x8e, 0x07, 0xca, 0x2e, 0x2c, 0xc7, 0xf8, 0x2e, 0x01, 0xf6,
-	0x0e, 0x6a, 0xbc, 0x06, 0x25, 0xe0, 0xee, 0x42, 0xc9, 0xc1, 0x63, 0xd3, 0xc6, 0xfa, 0x14, 0x31,
-	0x01, 0x0b, 0xea, 0x84, 0x25, 0x20, 0x2b, 0x91, 0xb1, 0x2a, 0xf0, 0x25, 0xf6, 0x4e, 0x82, 0x02,
-	0x40, 0xfd, 0x95, 0xc0, 0xbe, 0x84, 0x09, 0x1b, 0x50, 0xe3, 0xb5, 0x70, 0xa6, 0xc7, 0x1a, 0xb0,
-	0x92, 0xe0, 0x14, 0x19, 0xea, 0xdf, 0x0a, 0x2c, 0xd3, 0xda, 0x12, 0x52, 0x7e, 0x8c, 0xf5, 0xd3,
-	0x85, 0x5a, 0xdc, 0x46, 0xe1, 0xef, 0xbb, 0xf1, 0x22, 0x9a, 0x7a, 0xd9, 0x97, 0xa9, 0xa4, 0x0f,
-	0x60, 0x61, 0x67, 0x34, 0xde, 0x19, 0xcb, 0xdd, 0x8c, 0x54, 0xa4, 0x14, 0xb9, 0x48, 0x51, 0x02,
-	0xed, 0x4c, 0x29, 0x81, 0x67, 0x8e, 0x22, 0x5d, 0x1e, 0xe8, 0xda, 0x0d, 0x58, 0x94, 0xa4, 0x88,
-	0x3b, 0x7b, 0x04, 0xb5, 0x67, 0xaf, 0x5c, 0xfb, 0xf4, 0xfe, 0x29, 0xfe, 0xc1, 0x00, 0x0d, 0x58,
-	0x49, 0xc8, 0x12, 0x30, 0x0f, 0x01, 0xed, 0xbd, 0x1e, 0x19, 0xce, 0x0f, 0x85, 0x58, 0x86, 0x1b,
-	0x11, 0x39, 0x42, 0xfc, 0xff, 0x43, 0x4d, 0xd4, 0x3b, 0x76, 0x25, 0x07, 0xba, 0x37, 0x0b, 0x42,
-	0xdb, 0x85, 0xab, 0x01, 0x3f, 0xf5, 0xb4, 0x0c, 0xa9, 0xc8, 0x90, 0xb4, 0x4d, 0x21, 0x14, 0xd2,
-	0x21, 0x5c, 0x99, 0x72, 0x37, 0x5c, 0x6b, 0x0f, 0x61, 0x25, 0x81, 0x2b, 0xbe, 0x86, 0x4f, 0x83,
-	0xf1, 0x82, 0x7f, 0x0b, 0xcb, 0x91, 0x86, 0x2a, 0x40, 0x15, 0x43, 0x84, 0x76, 0x0f, 0x6e, 0xed,
-	0x13, 0x7f, 0x4f, 0x88, 0xfd, 0x20, 0x3b, 0x9e, 0xc0, 0x7a, 0xe6, 0xd1, 0xcb, 0xa8, 0xf2, 0xad,
-	0x02, 0x95, 0x70, 0x74, 0x41, 0xcd, 0x30, 0xfa, 0x0b, 0x3b, 0x0b, 0xe7, 0x67, 0x8d, 0xab, 0x00,
-	0xa8, 0xe8, 0x11, 0xd7, 0xc0, 0xa6, 0xa8, 0xfa, 0x4b, 0x50, 0x18, 0xe0, 0x21, 0x09, 0x1a, 0x07,
-	0xbe, 0xa0, 0x05, 0xea, 0x94, 0xb6, 0x18, 0xa2, 0x40, 0x9d, 0x8a, 0x0e, 0xc3, 0xb7, 0x9d, 0xcf,
-	0xc3, 0x4a, 0x6f, 0x3b, 0x9f, 0xd3, 0xd3, 0x27, 0x86, 0x69, 0x86, 0xe3, 0x1a, 0x5b, 0x48, 0x95,
-	0xb3, 0xc3, 0xf3, 0xf8, 0x64, 0x96, 0x12, 0xee, 0x50, 0xa1, 0x4c, 0xed, 0x97, 0x4a, 0x67, 0xb8,
-	0x0e, 0x72, 0xba, 0x74, 0x66, 0x66, 0x42, 0x9c, 0xf0, 0x06, 0x09, 0xf1, 0x8f, 0x4a, 0x90, 0xd4,
-	0x3f, 0x04, 0x3b, 0xe8, 0xfb, 0x64, 0x8f, 0xd0, 0x5e, 0x6f, 0x9f, 0x39, 0x45, 0xf4, 0x7d, 0x92,
-	0x63, 0x68, 0xdf, 0xf7, 0x4b, 0xa9, 0x25, 0x94, 0xfc, 0x43, 0x49, 0xcf, 0xa8, 0x8b, 0x84, 0x48,
-	0xd9, 0x4d, 0x94, 0xf7, 0x17, 0x74, 0x4d, 0x43, 0x2e, 0xa1, 0xa5, 0x88, 0x89, 0xbf, 0x2a, 0x90,
-	0x7f, 0x42, 0x4e, 0xbd, 0x99, 0x7d, 0xdb, 0x3d, 0x80, 0x3e, 0x2b, 0xb9, 0xfa, 0x05, 0x7b, 0x68,
-	0xc1, 0x7d, 0xdf, 0x67, 0x4d, 0xb9, 0xe1, 0x9b, 0x24, 0x98, 0xcf, 0xd9, 0x22, 0xde, 0xbf, 0xe4,
-	0x93, 0xfd, 0xcb, 0x1a, 0x00, 0xef, 0x35, 0x4c, 0xc3, 0x3a, 0x61, 0x46, 0x55, 0xba, 0x15, 0xb6,
-	0xf3, 0xd8, 0xb0, 0x4e, 0xa4, 0xfb, 0xff, 0x4d, 0x30, 0x29, 0x53, 0x4b, 0x82, 0x0b, 0x08, 0x51,
-	0x95, 0x29, 0xa8, 0xb9, 0x59, 0xa8, 0x73, 0x31, 0xd4, 0xc9, 0xe8, 0xcc, 0xb1, 0x66, 0x0e, 0xb7,
-	0x8c, 0x2d, 0x36, 0x3a, 0xcb, 0x6a, 0x66, 0x8c, 0xce, 0x97, 0x91, 0xfe, 0x2e, 0x18, 0x9d, 0xa7,
-	0xc8, 0x9f, 0xb8, 0x25, 0x37, 0xc5, 0x2d, 0x73, 0xb3, 0xdc, 0x92, 0x8f, 0xbb, 0x25, 0x9c, 0xb0,
-	0x65, 0xc5, 0xb5, 0x7f, 0x2a, 0x30, 0x4f, 0xeb, 0xa0, 0xac, 0xd0, 0x8f, 0xa8, 0xca, 0x0f, 0xf8,
-	0x23, 0x40, 0xe4, 0xae, 0xa6, 0x0c, 0xc9, 0x8c, 0xef, 0x32, 0xa5, 0xbd, 0xf3, 0x92, 0x97, 0x21,
-	0xef, 0x90, 0x4b, 0x42, 0x4f, 0x01, 0xf6, 0x89, 0x2f, 0x9e, 0xd7, 0x50, 0x2d, 0x11, 0x86, 0x7b,
-	0x43, 0xc7, 0x1f, 0xab, 0xf5, 0x10, 0x39, 0xf6, 0x10, 0xa7, 0x2d, 0xfc, 0xf6, 0xef, 0xff, 0xf9,
-	0x2e, 0x07, 0xa8, 0xdc, 0x16, 0x0f, 0x70, 0x9d, 0xef, 0x8b, 0x50, 0x60, 0x10, 0xe8, 0x19, 0x14,
-	0xf9, 0x07, 0x8e, 0xd4, 0xf0, 0x7c, 0xe2, 0x1d, 0x4a, 0x5d, 0x4d, 0xa5, 0x09, 0xf1, 0x8b, 0x4c,
-	0x7c, 0x55, 0x2b, 0xf2, 0xd7, 0xe4, 0x6d, 0x65, 0x13, 0x3d, 0x85, 0x3c, 0xfd, 0xac, 0xd1, 0x44,
-	0xa7, 0xd8, 0x1b, 0x92, 0xda, 0x48, 0xa1, 0x08, 0x79, 0x37, 0x98, 0xbc, 0x6b, 0xa8, 0xca, 0xe5,
-	0xb5, 0xbf, 0x31, 0xf4, 0xf7, 0xe8, 0x35, 0x14, 0xf9, 0x17, 0x27, 0xe9, 0x99, 0x78, 0x39, 0x92,
-	0xf4, 0x4c, 0x79, 0x00, 0x6a, 0xfd, 0xe3, 0x2f, 0x8d, 0x5c, 0xf9, 0x0a, 0x93, 0xae, 0xa9, 0xb2,
-	0xf4, 0x6d, 0x65, 0xf3, 0xc5, 0x42, 0x27, 0xb6, 0x83, 0x5e, 0x42, 0x91, 0x77, 0xb5, 0x12, 0x64,
-	0xe2, 0x05, 0x49, 0x82, 0x4c, 0x79, 0x38, 0x5a, 0x3b, 0x3f, 0x6b, 0x14, 0xf9, 0x5b, 0x27, 0x37,
-	0x6a, 0x33, 0x62, 0xd4, 0x97, 0x90, 0xa7, 0x5f, 0x14, 0x9a, 0x38, 0x23, 0xfe, 0xca, 0xa4, 0xaa,
-	0x69, 0x24, 0x21, 0xfd, 0x3a, 0x93, 0x59, 0x46, 0xc2, 0xf1, 0xe8, 0x2b, 0x28, 0xb0, 0xf7, 0x11,
-	0x34, 0xa9, 0xe6, 0xf2, 0x63, 0x8b, 0x5a, 0x8b, 0x6f, 0x0b, 0x39, 0x2b, 0x4c, 0xce, 0xa2, 0x76,
-	0x55, 0xe8, 0x66, 0x52, 0x2a, 0xf5, 0xc0, 0x29, 0xcc, 0xc7, 0x5e, 0x1e, 0xd0, 0x7a, 0x28, 0x23,
-	0xfd, 0xd5, 0x43, 0x6d, 0x66, 0x33, 0x08, 0xb8, 0xdb, 0x0c, 0x6e, 0x55, 0xab, 0x49, 0xae, 0x68,
-	0xf7, 0x43, 0x3e, 0x0a, 0xfc, 0x0e, 0x16, 0x13, 0x6f, 0x15, 0xe8, 0xf6, 0x44, 0x72, 0xc6, 0x9b,
-	0x87, 0xaa, 0x4d, 0x63, 0x11, 0xf0, 0xb7, 0x18, 0x7c, 0x1d, 0x65, 0xc0, 0xab, 0xa2, 0xd0, 0x2c,
-	0x5c, 0xe9, 0xfc, 0xb9, 0x02, 0x30, 0xe9, 0xe9, 0x91, 0x1e, 0x86, 0xca, 0x7a, 0x2c, 0x1c, 0xe2,
-	0x03, 0x92, 0xe4, 0x84, 0x8c, 0xc1, 0x55, 0xf2, 0xb9, 0xf4, 0x82, 0xcc, 0xbf, 0x3a, 0x1e, 0x3a,
-	0x6b, 0x91, 0x00, 0x49, 0x20, 0xdc, 0xca, 0x22, 0x0b, 0xf9, 0x0d, 0x26, 0xff, 0x06, 0x5a, 0x94,
-	0xe5, 0xf3, 0xaf, 0xee, 0x7b, 0x25, 0x8c, 0xa5, 0xf5, 0x58, 0xbc, 0x4c, 0x31, 0x24, 0x63, 0xa2,
-	0xd4, 0xbe, 0x96, 0xa2, 0xea, 0x91, 0xda, 0x88, 0xc2, 0x89, 0x29, 0xb6, 0x45, 0x23, 0x2a, 0x18,
-	0x69, 0x5f, 0x7c, 0xdc, 0xb9, 0x00, 0x17, 0x1a, 0x85, 0xd1, 0xb7, 0x1e, 0x8b, 0xb0, 0x29, 0x4a,
-	0x66, 0x4d, 0xa1, 0x1b, 0xe7, 0x67, 0x8d, 0xaa, 0xf4, 0x6e, 0xc5, 0x9d, 0xb3, 0x99, 0xe2, 0x9c,
-	0x5f, 0x8b, 0x90, 0xbc, 0x15, 0x89, 0xbb, 0xc4, 0xf4, 0xaa, 0xae, 0x67, 0xd2, 0x05, 0xe4, 0x12,
-	0xc3, 0xb8, 0x8e, 0x22, 0x17, 0x8c, 0x7a, 0x50, 0x09, 0xe7, 0x2d, 0x29, 0xec, 0xe3, 0x93, 0x9c,
-	0x14, 0xf6, 0xc9, 0xf1, 0x6c, 0x95, 0x49, 0x5e, 0xd6, 0x16, 0x22, 0xda, 0x1f, 0x8d, 0xc6, 0xf4,
-	0xf3, 0x19, 0xc3, 0x7c, 0x6c, 0xfa, 0x90, 0x43, 0x36, 0x75, 0x1e, 0x92, 0x43, 0x36, 0x7d, 0x70,
-	0xd1, 0x3e, 0x62, 0x90, 0x6b, 0x68, 0x35, 0x02, 0x49, 0xe3, 0xa7, 0xfd, 0x8d, 0x18, 0x41, 0xde,
-	0xa3, 0x3f, 0x28, 0x6c, 0xf2, 0x49, 0x1b, 0x3b, 0xd0, 0x27, 0x32, 0xc4, 0x94, 0x99, 0x46, 0xdd,
-	0x98, 0xcd, 0x28, 0x74, 0xba, 0xcb, 0x74, 0xba, 0x83, 0x3e, 0x9e, 0xa2, 0x53, 0x3b, 0x98, 0xca,
-	0xd0, 0x00, 0xaa, 0xd2, 0x90, 0x88, 0x26, 0x59, 0x3b, 0x39, 0x82, 0xaa, 0x37, 0xd3, 0x89, 0x41,
-	0x4e, 0x67, 0xb8, 0x2b, 0x1a, 0x8a, 0xe0, 0x32, 0x20, 0x91, 0x33, 0x63, 0x03, 0xaf, 0x74, 0x01,
-	0xe9, 0x63, 0xb5, 0x74, 0x01, 0x59, 0xb3, 0xf2, 0x24, 0x67, 0xca, 0xa0, 0x3e, 0xe5, 0xc6, 0xa7,
-	0x98, 0xde, 0xbc, 0x94, 0xb7, 0xbe, 0xcd, 0x01, 0xf0, 0x06, 0x82, 0xcd, 0x6b, 0x3a, 0x94, 0xf7,
-	0x89, 0xcf, 0x7f, 0xaf, 0x25, 0xca, 0xae, 0x3c, 0xc6, 0xc4, 0xb2, 0x4a, 0x72, 0x7e, 0x90, 0xb3,
-	0x0a, 0xf6, 0x3d, 0xee, 0x68, 0x3a, 0xe4, 0xbc, 0x47, 0xbf, 0x53, 0xa0, 0x1a, 0xe4, 0x08, 0x8a,
-	0xb4, 0x9e, 0x52, 0x8a, 0x23, 0x58, 0xcd, 0x6c, 0x06, 0x81, 0xb6, 0x2d, 0xa5, 0x96, 0x96, 0x9a,
-	0xc4, 0xa4, 0x65, 0xbb, 0xd6, 0x49, 0xdd, 0x97, 0xbc, 0xf1, 0xdf, 0x1c, 0x54, 0x69, 0x2f, 0x16,
-	0x74, 0x53, 0x87, 0x99, 0x1d, 0x8f, 0xd4, 0xb7, 0x26, 0x3a, 0x9e, 0x48, 0xa3, 0x2b, 0x1a, 0x2a,
-	0xad, 0xd0, 0xb6, 0xc8, 0x29, 0xcb, 0xda, 0x5f, 0xa5, 0x36, 0x3c, 0xb2, 0xc0, 0x46, 0x0a, 0x45,
-	0x88, 0x43, 0x4c, 0xdc, 0x55, 0x04, 0x4c, 0x1c, 0xcf, 0x43, 0x76, 0x66, 0xbf, 0x93, 0xae, 0x65,
-	0x4a, 0x3b, 0x7e, 0x57, 0x72, 0x5f, 0x53, 0x95, 0x84, 0x53, 0xbf, 0xcd, 0x77, 0xa2, 0x1b, 0xe8,
-	0x91, 0x48, 0x7c, 0xf5, 0x48, 0x62, 0x4b, 0xb7, 0x20, 0xde, 0x06, 0x6b, 0xd7, 0x18, 0x48, 0x09,
-	0x71, 0x87, 0x4c, 0x9c, 0xbf, 0xd3, 0x7e, 0xb1, 0x75, 0xf1, 0x3f, 0x1e, 0x7c, 0xe1, 0x1c, 0x1d,
-	0x15, 0x59, 0x57, 0xfb, 0x93, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xde, 0x76, 0x87, 0x55, 0xb0,
-	0x20, 0x00, 0x00,
+func (m *BuyByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyByUserRequest.Merge(m, src)
+}
+func (m *BuyByUserRequest) XXX_Size() int {
+	return xxx_messageInfo_BuyByUserRequest.Size(m)
+}
+func (m *BuyByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyByUserRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_BuyByUserRequest proto.InternalMessageInfo
 
-// UsersServiceClient is the client API for UsersService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UsersServiceClient interface {
-	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+func (m *BuyByUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
 }
 
-type usersServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BuyByUserRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
 }
 
-func NewUsersServiceClient(cc *grpc.ClientConn) UsersServiceClient {
-	return &usersServiceClient{cc}
+func (m *BuyByUserRequest) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
 
-func (c *usersServiceClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/service.UsersService/GetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BuyByUserRequest) GetRent() bool {
+	if m != nil {
+		return m.Rent
 	}
-	return out, nil
+	return false
 }
 
-// UsersServiceServer is the server API for UsersService service.
-type UsersServiceServer interface {
-	GetVersion(context.Context, *empty.Empty) (*VersionResponse, error)
+type BuyByUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterUsersServiceServer(s *grpc.Server, srv UsersServiceServer) {
-	s.RegisterService(&_UsersService_serviceDesc, srv)
+func (m *BuyByUserResponse) Reset()         { *m = BuyByUserResponse{} }
+func (m *BuyByUserResponse) String() string { return proto.CompactTextString(m) }
+func (*BuyByUserResponse) ProtoMessage()    {}
+func (*BuyByUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{52}
 }
 
-func _UsersService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UsersServiceServer).GetVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
+func (m *BuyByUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyByUserResponse.Unmarshal(m, b)
+}
+func (m *BuyByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyByUserResponse.Marshal(b, m, deterministic)
+}
+func (m *BuyByUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyByUserResponse.Merge(m, src)
+}
+func (m *BuyByUserResponse) XXX_Size() int {
+	return xxx_messageInfo_BuyByUserResponse.Size(m)
+}
+func (m *BuyByUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyByUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyByUserResponse proto.InternalMessageInfo
+
+type ThrowAwayByUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrowAwayByUserRequest) Reset()         { *m = ThrowAwayByUserRequest{} }
+func (m *ThrowAwayByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*ThrowAwayByUserRequest) ProtoMessage()    {}
+func (*ThrowAwayByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{53}
+}
+
+func (m *ThrowAwayByUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrowAwayByUserRequest.Unmarshal(m, b)
+}
+func (m *ThrowAwayByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrowAwayByUserRequest.Marshal(b, m, deterministic)
+}
+func (m *ThrowAwayByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrowAwayByUserRequest.Merge(m, src)
+}
+func (m *ThrowAwayByUserRequest) XXX_Size() int {
+	return xxx_messageInfo_ThrowAwayByUserRequest.Size(m)
+}
+func (m *ThrowAwayByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrowAwayByUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrowAwayByUserRequest proto.InternalMessageInfo
+
+func (m *ThrowAwayByUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ThrowAwayByUserRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+type ThrowAwayByUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrowAwayByUserResponse) Reset()         { *m = ThrowAwayByUserResponse{} }
+func (m *ThrowAwayByUserResponse) String() string { return proto.CompactTextString(m) }
+func (*ThrowAwayByUserResponse) ProtoMessage()    {}
+func (*ThrowAwayByUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{54}
+}
+
+func (m *ThrowAwayByUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrowAwayByUserResponse.Unmarshal(m, b)
+}
+func (m *ThrowAwayByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrowAwayByUserResponse.Marshal(b, m, deterministic)
+}
+func (m *ThrowAwayByUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrowAwayByUserResponse.Merge(m, src)
+}
+func (m *ThrowAwayByUserResponse) XXX_Size() int {
+	return xxx_messageInfo_ThrowAwayByUserResponse.Size(m)
+}
+func (m *ThrowAwayByUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrowAwayByUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrowAwayByUserResponse proto.InternalMessageInfo
+
+type EquipByUserRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EquipByUserRequest) Reset()         { *m = EquipByUserRequest{} }
+func (m *EquipByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*EquipByUserRequest) ProtoMessage()    {}
+func (*EquipByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{55}
+}
+
+func (m *EquipByUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EquipByUserRequest.Unmarshal(m, b)
+}
+func (m *EquipByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EquipByUserRequest.Marshal(b, m, deterministic)
+}
+func (m *EquipByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquipByUserRequest.Merge(m, src)
+}
+func (m *EquipByUserRequest) XXX_Size() int {
+	return xxx_messageInfo_EquipByUserRequest.Size(m)
+}
+func (m *EquipByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquipByUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquipByUserRequest proto.InternalMessageInfo
+
+func (m *EquipByUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *EquipByUserRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+type EquipByUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EquipByUserResponse) Reset()         { *m = EquipByUserResponse{} }
+func (m *EquipByUserResponse) String() string { return proto.CompactTextString(m) }
+func (*EquipByUserResponse) ProtoMessage()    {}
+func (*EquipByUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{56}
+}
+
+func (m *EquipByUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EquipByUserResponse.Unmarshal(m, b)
+}
+func (m *EquipByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EquipByUserResponse.Marshal(b, m, deterministic)
+}
+func (m *EquipByUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquipByUserResponse.Merge(m, src)
+}
+func (m *EquipByUserResponse) XXX_Size() int {
+	return xxx_messageInfo_EquipByUserResponse.Size(m)
+}
+func (m *EquipByUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquipByUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquipByUserResponse proto.InternalMessageInfo
+
+type GetUserItemsIdsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserItemsIdsRequest) Reset()         { *m = GetUserItemsIdsRequest{} }
+func (m *GetUserItemsIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserItemsIdsRequest) ProtoMessage()    {}
+func (*GetUserItemsIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{57}
+}
+
+func (m *GetUserItemsIdsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserItemsIdsRequest.Unmarshal(m, b)
+}
+func (m *GetUserItemsIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserItemsIdsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUserItemsIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserItemsIdsRequest.Merge(m, src)
+}
+func (m *GetUserItemsIdsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserItemsIdsRequest.Size(m)
+}
+func (m *GetUserItemsIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserItemsIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserItemsIdsRequest proto.InternalMessageInfo
+
+func (m *GetUserItemsIdsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type UserItemInfo struct {
+	ItemId               string               `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Equipped             bool                 `protobuf:"varint,2,opt,name=equipped,proto3" json:"equipped,omitempty"`
+	Quantity             int32                `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RemainingSeconds     int64                `protobuf:"varint,5,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserItemInfo) Reset()         { *m = UserItemInfo{} }
+func (m *UserItemInfo) String() string { return proto.CompactTextString(m) }
+func (*UserItemInfo) ProtoMessage()    {}
+func (*UserItemInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{58}
+}
+
+func (m *UserItemInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserItemInfo.Unmarshal(m, b)
+}
+func (m *UserItemInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserItemInfo.Marshal(b, m, deterministic)
+}
+func (m *UserItemInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserItemInfo.Merge(m, src)
+}
+func (m *UserItemInfo) XXX_Size() int {
+	return xxx_messageInfo_UserItemInfo.Size(m)
+}
+func (m *UserItemInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserItemInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserItemInfo proto.InternalMessageInfo
+
+func (m *UserItemInfo) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *UserItemInfo) GetEquipped() bool {
+	if m != nil {
+		return m.Equipped
+	}
+	return false
+}
+
+func (m *UserItemInfo) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *UserItemInfo) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *UserItemInfo) GetRemainingSeconds() int64 {
+	if m != nil {
+		return m.RemainingSeconds
+	}
+	return 0
+}
+
+type GetUserItemsIdsResponse struct {
+	Items                []*UserItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetUserItemsIdsResponse) Reset()         { *m = GetUserItemsIdsResponse{} }
+func (m *GetUserItemsIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserItemsIdsResponse) ProtoMessage()    {}
+func (*GetUserItemsIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{59}
+}
+
+func (m *GetUserItemsIdsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserItemsIdsResponse.Unmarshal(m, b)
+}
+func (m *GetUserItemsIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserItemsIdsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUserItemsIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserItemsIdsResponse.Merge(m, src)
+}
+func (m *GetUserItemsIdsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUserItemsIdsResponse.Size(m)
+}
+func (m *GetUserItemsIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserItemsIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserItemsIdsResponse proto.InternalMessageInfo
+
+func (m *GetUserItemsIdsResponse) GetItems() []*UserItemInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type GetEquippedUserItemsIdsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEquippedUserItemsIdsRequest) Reset()         { *m = GetEquippedUserItemsIdsRequest{} }
+func (m *GetEquippedUserItemsIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEquippedUserItemsIdsRequest) ProtoMessage()    {}
+func (*GetEquippedUserItemsIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{60}
+}
+
+func (m *GetEquippedUserItemsIdsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Unmarshal(m, b)
+}
+func (m *GetEquippedUserItemsIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEquippedUserItemsIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquippedUserItemsIdsRequest.Merge(m, src)
+}
+func (m *GetEquippedUserItemsIdsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEquippedUserItemsIdsRequest.Size(m)
+}
+func (m *GetEquippedUserItemsIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquippedUserItemsIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEquippedUserItemsIdsRequest proto.InternalMessageInfo
+
+func (m *GetEquippedUserItemsIdsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetEquippedUserItemsIdsResponse struct {
+	Items                []*UserItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetEquippedUserItemsIdsResponse) Reset()         { *m = GetEquippedUserItemsIdsResponse{} }
+func (m *GetEquippedUserItemsIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEquippedUserItemsIdsResponse) ProtoMessage()    {}
+func (*GetEquippedUserItemsIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{61}
+}
+
+func (m *GetEquippedUserItemsIdsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Unmarshal(m, b)
+}
+func (m *GetEquippedUserItemsIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEquippedUserItemsIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEquippedUserItemsIdsResponse.Merge(m, src)
+}
+func (m *GetEquippedUserItemsIdsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEquippedUserItemsIdsResponse.Size(m)
+}
+func (m *GetEquippedUserItemsIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEquippedUserItemsIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEquippedUserItemsIdsResponse proto.InternalMessageInfo
+
+func (m *GetEquippedUserItemsIdsResponse) GetItems() []*UserItemInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type GrantItemRequest struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string               `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GrantItemRequest) Reset()         { *m = GrantItemRequest{} }
+func (m *GrantItemRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantItemRequest) ProtoMessage()    {}
+func (*GrantItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{62}
+}
+
+func (m *GrantItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantItemRequest.Unmarshal(m, b)
+}
+func (m *GrantItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantItemRequest.Marshal(b, m, deterministic)
+}
+func (m *GrantItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantItemRequest.Merge(m, src)
+}
+func (m *GrantItemRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantItemRequest.Size(m)
+}
+func (m *GrantItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantItemRequest proto.InternalMessageInfo
+
+func (m *GrantItemRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *GrantItemRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *GrantItemRequest) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type GrantItemResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantItemResponse) Reset()         { *m = GrantItemResponse{} }
+func (m *GrantItemResponse) String() string { return proto.CompactTextString(m) }
+func (*GrantItemResponse) ProtoMessage()    {}
+func (*GrantItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{63}
+}
+
+func (m *GrantItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantItemResponse.Unmarshal(m, b)
+}
+func (m *GrantItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantItemResponse.Marshal(b, m, deterministic)
+}
+func (m *GrantItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantItemResponse.Merge(m, src)
+}
+func (m *GrantItemResponse) XXX_Size() int {
+	return xxx_messageInfo_GrantItemResponse.Size(m)
+}
+func (m *GrantItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantItemResponse proto.InternalMessageInfo
+
+type ConsumeItemRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity             int32    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsumeItemRequest) Reset()         { *m = ConsumeItemRequest{} }
+func (m *ConsumeItemRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumeItemRequest) ProtoMessage()    {}
+func (*ConsumeItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{64}
+}
+
+func (m *ConsumeItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsumeItemRequest.Unmarshal(m, b)
+}
+func (m *ConsumeItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsumeItemRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsumeItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumeItemRequest.Merge(m, src)
+}
+func (m *ConsumeItemRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsumeItemRequest.Size(m)
+}
+func (m *ConsumeItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumeItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumeItemRequest proto.InternalMessageInfo
+
+func (m *ConsumeItemRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ConsumeItemRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *ConsumeItemRequest) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+type ConsumeItemResponse struct {
+	Remaining            int32    `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsumeItemResponse) Reset()         { *m = ConsumeItemResponse{} }
+func (m *ConsumeItemResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumeItemResponse) ProtoMessage()    {}
+func (*ConsumeItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{65}
+}
+
+func (m *ConsumeItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsumeItemResponse.Unmarshal(m, b)
+}
+func (m *ConsumeItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsumeItemResponse.Marshal(b, m, deterministic)
+}
+func (m *ConsumeItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumeItemResponse.Merge(m, src)
+}
+func (m *ConsumeItemResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsumeItemResponse.Size(m)
+}
+func (m *ConsumeItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumeItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumeItemResponse proto.InternalMessageInfo
+
+func (m *ConsumeItemResponse) GetRemaining() int32 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type Gift struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId             string               `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId          string               `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	ItemId               string               `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Message              string               `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Gift) Reset()         { *m = Gift{} }
+func (m *Gift) String() string { return proto.CompactTextString(m) }
+func (*Gift) ProtoMessage()    {}
+func (*Gift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{66}
+}
+
+func (m *Gift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Gift.Unmarshal(m, b)
+}
+func (m *Gift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Gift.Marshal(b, m, deterministic)
+}
+func (m *Gift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gift.Merge(m, src)
+}
+func (m *Gift) XXX_Size() int {
+	return xxx_messageInfo_Gift.Size(m)
+}
+func (m *Gift) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gift proto.InternalMessageInfo
+
+func (m *Gift) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Gift) GetSenderId() string {
+	if m != nil {
+		return m.SenderId
+	}
+	return ""
+}
+
+func (m *Gift) GetRecipientId() string {
+	if m != nil {
+		return m.RecipientId
+	}
+	return ""
+}
+
+func (m *Gift) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *Gift) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Gift) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type GiftItemRequest struct {
+	SenderId             string   `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId          string   `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	ItemId               string   `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GiftItemRequest) Reset()         { *m = GiftItemRequest{} }
+func (m *GiftItemRequest) String() string { return proto.CompactTextString(m) }
+func (*GiftItemRequest) ProtoMessage()    {}
+func (*GiftItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{67}
+}
+
+func (m *GiftItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GiftItemRequest.Unmarshal(m, b)
+}
+func (m *GiftItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GiftItemRequest.Marshal(b, m, deterministic)
+}
+func (m *GiftItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GiftItemRequest.Merge(m, src)
+}
+func (m *GiftItemRequest) XXX_Size() int {
+	return xxx_messageInfo_GiftItemRequest.Size(m)
+}
+func (m *GiftItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GiftItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GiftItemRequest proto.InternalMessageInfo
+
+func (m *GiftItemRequest) GetSenderId() string {
+	if m != nil {
+		return m.SenderId
+	}
+	return ""
+}
+
+func (m *GiftItemRequest) GetRecipientId() string {
+	if m != nil {
+		return m.RecipientId
+	}
+	return ""
+}
+
+func (m *GiftItemRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *GiftItemRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GiftItemResponse struct {
+	GiftId               string   `protobuf:"bytes,1,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GiftItemResponse) Reset()         { *m = GiftItemResponse{} }
+func (m *GiftItemResponse) String() string { return proto.CompactTextString(m) }
+func (*GiftItemResponse) ProtoMessage()    {}
+func (*GiftItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{68}
+}
+
+func (m *GiftItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GiftItemResponse.Unmarshal(m, b)
+}
+func (m *GiftItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GiftItemResponse.Marshal(b, m, deterministic)
+}
+func (m *GiftItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GiftItemResponse.Merge(m, src)
+}
+func (m *GiftItemResponse) XXX_Size() int {
+	return xxx_messageInfo_GiftItemResponse.Size(m)
+}
+func (m *GiftItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GiftItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GiftItemResponse proto.InternalMessageInfo
+
+func (m *GiftItemResponse) GetGiftId() string {
+	if m != nil {
+		return m.GiftId
+	}
+	return ""
+}
+
+type ListPendingGiftsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPendingGiftsRequest) Reset()         { *m = ListPendingGiftsRequest{} }
+func (m *ListPendingGiftsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPendingGiftsRequest) ProtoMessage()    {}
+func (*ListPendingGiftsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{69}
+}
+
+func (m *ListPendingGiftsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingGiftsRequest.Unmarshal(m, b)
+}
+func (m *ListPendingGiftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingGiftsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPendingGiftsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingGiftsRequest.Merge(m, src)
+}
+func (m *ListPendingGiftsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPendingGiftsRequest.Size(m)
+}
+func (m *ListPendingGiftsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingGiftsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingGiftsRequest proto.InternalMessageInfo
+
+func (m *ListPendingGiftsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ListPendingGiftsResponse struct {
+	Gifts                []*Gift  `protobuf:"bytes,1,rep,name=gifts,proto3" json:"gifts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPendingGiftsResponse) Reset()         { *m = ListPendingGiftsResponse{} }
+func (m *ListPendingGiftsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPendingGiftsResponse) ProtoMessage()    {}
+func (*ListPendingGiftsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{70}
+}
+
+func (m *ListPendingGiftsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingGiftsResponse.Unmarshal(m, b)
+}
+func (m *ListPendingGiftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingGiftsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPendingGiftsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingGiftsResponse.Merge(m, src)
+}
+func (m *ListPendingGiftsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPendingGiftsResponse.Size(m)
+}
+func (m *ListPendingGiftsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingGiftsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingGiftsResponse proto.InternalMessageInfo
+
+func (m *ListPendingGiftsResponse) GetGifts() []*Gift {
+	if m != nil {
+		return m.Gifts
+	}
+	return nil
+}
+
+type AcceptGiftRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GiftId               string   `protobuf:"bytes,2,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptGiftRequest) Reset()         { *m = AcceptGiftRequest{} }
+func (m *AcceptGiftRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptGiftRequest) ProtoMessage()    {}
+func (*AcceptGiftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{71}
+}
+
+func (m *AcceptGiftRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptGiftRequest.Unmarshal(m, b)
+}
+func (m *AcceptGiftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptGiftRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptGiftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptGiftRequest.Merge(m, src)
+}
+func (m *AcceptGiftRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptGiftRequest.Size(m)
+}
+func (m *AcceptGiftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptGiftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptGiftRequest proto.InternalMessageInfo
+
+func (m *AcceptGiftRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *AcceptGiftRequest) GetGiftId() string {
+	if m != nil {
+		return m.GiftId
+	}
+	return ""
+}
+
+type AcceptGiftResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptGiftResponse) Reset()         { *m = AcceptGiftResponse{} }
+func (m *AcceptGiftResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptGiftResponse) ProtoMessage()    {}
+func (*AcceptGiftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{72}
+}
+
+func (m *AcceptGiftResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptGiftResponse.Unmarshal(m, b)
+}
+func (m *AcceptGiftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptGiftResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptGiftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptGiftResponse.Merge(m, src)
+}
+func (m *AcceptGiftResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptGiftResponse.Size(m)
+}
+func (m *AcceptGiftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptGiftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptGiftResponse proto.InternalMessageInfo
+
+type DeclineGiftRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GiftId               string   `protobuf:"bytes,2,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineGiftRequest) Reset()         { *m = DeclineGiftRequest{} }
+func (m *DeclineGiftRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclineGiftRequest) ProtoMessage()    {}
+func (*DeclineGiftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{73}
+}
+
+func (m *DeclineGiftRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineGiftRequest.Unmarshal(m, b)
+}
+func (m *DeclineGiftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineGiftRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclineGiftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineGiftRequest.Merge(m, src)
+}
+func (m *DeclineGiftRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclineGiftRequest.Size(m)
+}
+func (m *DeclineGiftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineGiftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclineGiftRequest proto.InternalMessageInfo
+
+func (m *DeclineGiftRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *DeclineGiftRequest) GetGiftId() string {
+	if m != nil {
+		return m.GiftId
+	}
+	return ""
+}
+
+type DeclineGiftResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineGiftResponse) Reset()         { *m = DeclineGiftResponse{} }
+func (m *DeclineGiftResponse) String() string { return proto.CompactTextString(m) }
+func (*DeclineGiftResponse) ProtoMessage()    {}
+func (*DeclineGiftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{74}
+}
+
+func (m *DeclineGiftResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineGiftResponse.Unmarshal(m, b)
+}
+func (m *DeclineGiftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineGiftResponse.Marshal(b, m, deterministic)
+}
+func (m *DeclineGiftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineGiftResponse.Merge(m, src)
+}
+func (m *DeclineGiftResponse) XXX_Size() int {
+	return xxx_messageInfo_DeclineGiftResponse.Size(m)
+}
+func (m *DeclineGiftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineGiftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclineGiftResponse proto.InternalMessageInfo
+
+type ItemType struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName          string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Slot                 string   `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	SlotCapacity         int32    `protobuf:"varint,5,opt,name=slot_capacity,json=slotCapacity,proto3" json:"slot_capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ItemType) Reset()         { *m = ItemType{} }
+func (m *ItemType) String() string { return proto.CompactTextString(m) }
+func (*ItemType) ProtoMessage()    {}
+func (*ItemType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{75}
+}
+
+func (m *ItemType) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ItemType.Unmarshal(m, b)
+}
+func (m *ItemType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ItemType.Marshal(b, m, deterministic)
+}
+func (m *ItemType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemType.Merge(m, src)
+}
+func (m *ItemType) XXX_Size() int {
+	return xxx_messageInfo_ItemType.Size(m)
+}
+func (m *ItemType) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemType proto.InternalMessageInfo
+
+func (m *ItemType) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ItemType) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ItemType) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ItemType) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *ItemType) GetSlotCapacity() int32 {
+	if m != nil {
+		return m.SlotCapacity
+	}
+	return 0
+}
+
+type SetItemTypeRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName          string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Slot                 string   `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	SlotCapacity         int32    `protobuf:"varint,5,opt,name=slot_capacity,json=slotCapacity,proto3" json:"slot_capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetItemTypeRequest) Reset()         { *m = SetItemTypeRequest{} }
+func (m *SetItemTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetItemTypeRequest) ProtoMessage()    {}
+func (*SetItemTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{76}
+}
+
+func (m *SetItemTypeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetItemTypeRequest.Unmarshal(m, b)
+}
+func (m *SetItemTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetItemTypeRequest.Marshal(b, m, deterministic)
+}
+func (m *SetItemTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetItemTypeRequest.Merge(m, src)
+}
+func (m *SetItemTypeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetItemTypeRequest.Size(m)
+}
+func (m *SetItemTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetItemTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetItemTypeRequest proto.InternalMessageInfo
+
+func (m *SetItemTypeRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SetItemTypeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetItemTypeRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *SetItemTypeRequest) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *SetItemTypeRequest) GetSlotCapacity() int32 {
+	if m != nil {
+		return m.SlotCapacity
+	}
+	return 0
+}
+
+type SetItemTypeResponse struct {
+	Result               *ItemType `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetItemTypeResponse) Reset()         { *m = SetItemTypeResponse{} }
+func (m *SetItemTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*SetItemTypeResponse) ProtoMessage()    {}
+func (*SetItemTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{77}
+}
+
+func (m *SetItemTypeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetItemTypeResponse.Unmarshal(m, b)
+}
+func (m *SetItemTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetItemTypeResponse.Marshal(b, m, deterministic)
+}
+func (m *SetItemTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetItemTypeResponse.Merge(m, src)
+}
+func (m *SetItemTypeResponse) XXX_Size() int {
+	return xxx_messageInfo_SetItemTypeResponse.Size(m)
+}
+func (m *SetItemTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetItemTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetItemTypeResponse proto.InternalMessageInfo
+
+func (m *SetItemTypeResponse) GetResult() *ItemType {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteItemTypeRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteItemTypeRequest) Reset()         { *m = DeleteItemTypeRequest{} }
+func (m *DeleteItemTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteItemTypeRequest) ProtoMessage()    {}
+func (*DeleteItemTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{78}
+}
+
+func (m *DeleteItemTypeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteItemTypeRequest.Unmarshal(m, b)
+}
+func (m *DeleteItemTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteItemTypeRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteItemTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteItemTypeRequest.Merge(m, src)
+}
+func (m *DeleteItemTypeRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteItemTypeRequest.Size(m)
+}
+func (m *DeleteItemTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteItemTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteItemTypeRequest proto.InternalMessageInfo
+
+func (m *DeleteItemTypeRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteItemTypeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteItemTypeResponse) Reset()         { *m = DeleteItemTypeResponse{} }
+func (m *DeleteItemTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteItemTypeResponse) ProtoMessage()    {}
+func (*DeleteItemTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{79}
+}
+
+func (m *DeleteItemTypeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteItemTypeResponse.Unmarshal(m, b)
+}
+func (m *DeleteItemTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteItemTypeResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteItemTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteItemTypeResponse.Merge(m, src)
+}
+func (m *DeleteItemTypeResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteItemTypeResponse.Size(m)
+}
+func (m *DeleteItemTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteItemTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteItemTypeResponse proto.InternalMessageInfo
+
+type ListItemTypesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListItemTypesRequest) Reset()         { *m = ListItemTypesRequest{} }
+func (m *ListItemTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListItemTypesRequest) ProtoMessage()    {}
+func (*ListItemTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{80}
+}
+
+func (m *ListItemTypesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListItemTypesRequest.Unmarshal(m, b)
+}
+func (m *ListItemTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListItemTypesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListItemTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListItemTypesRequest.Merge(m, src)
+}
+func (m *ListItemTypesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListItemTypesRequest.Size(m)
+}
+func (m *ListItemTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListItemTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListItemTypesRequest proto.InternalMessageInfo
+
+type ListItemTypesResponse struct {
+	Results              []*ItemType `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListItemTypesResponse) Reset()         { *m = ListItemTypesResponse{} }
+func (m *ListItemTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListItemTypesResponse) ProtoMessage()    {}
+func (*ListItemTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{81}
+}
+
+func (m *ListItemTypesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListItemTypesResponse.Unmarshal(m, b)
+}
+func (m *ListItemTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListItemTypesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListItemTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListItemTypesResponse.Merge(m, src)
+}
+func (m *ListItemTypesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListItemTypesResponse.Size(m)
+}
+func (m *ListItemTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListItemTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListItemTypesResponse proto.InternalMessageInfo
+
+func (m *ListItemTypesResponse) GetResults() []*ItemType {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type Loadout struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ItemIds              []string `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Loadout) Reset()         { *m = Loadout{} }
+func (m *Loadout) String() string { return proto.CompactTextString(m) }
+func (*Loadout) ProtoMessage()    {}
+func (*Loadout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{82}
+}
+
+func (m *Loadout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Loadout.Unmarshal(m, b)
+}
+func (m *Loadout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Loadout.Marshal(b, m, deterministic)
+}
+func (m *Loadout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loadout.Merge(m, src)
+}
+func (m *Loadout) XXX_Size() int {
+	return xxx_messageInfo_Loadout.Size(m)
+}
+func (m *Loadout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loadout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loadout proto.InternalMessageInfo
+
+func (m *Loadout) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Loadout) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Loadout) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Loadout) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
+	}
+	return nil
+}
+
+type CreateLoadoutRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemIds              []string `protobuf:"bytes,3,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLoadoutRequest) Reset()         { *m = CreateLoadoutRequest{} }
+func (m *CreateLoadoutRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateLoadoutRequest) ProtoMessage()    {}
+func (*CreateLoadoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{83}
+}
+
+func (m *CreateLoadoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLoadoutRequest.Unmarshal(m, b)
+}
+func (m *CreateLoadoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLoadoutRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateLoadoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLoadoutRequest.Merge(m, src)
+}
+func (m *CreateLoadoutRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateLoadoutRequest.Size(m)
+}
+func (m *CreateLoadoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLoadoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLoadoutRequest proto.InternalMessageInfo
+
+func (m *CreateLoadoutRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreateLoadoutRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateLoadoutRequest) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
+	}
+	return nil
+}
+
+type CreateLoadoutResponse struct {
+	Result               *Loadout `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateLoadoutResponse) Reset()         { *m = CreateLoadoutResponse{} }
+func (m *CreateLoadoutResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateLoadoutResponse) ProtoMessage()    {}
+func (*CreateLoadoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{84}
+}
+
+func (m *CreateLoadoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLoadoutResponse.Unmarshal(m, b)
+}
+func (m *CreateLoadoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLoadoutResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateLoadoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLoadoutResponse.Merge(m, src)
+}
+func (m *CreateLoadoutResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateLoadoutResponse.Size(m)
+}
+func (m *CreateLoadoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLoadoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLoadoutResponse proto.InternalMessageInfo
+
+func (m *CreateLoadoutResponse) GetResult() *Loadout {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateLoadoutRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                   int32    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ItemIds              []string `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateLoadoutRequest) Reset()         { *m = UpdateLoadoutRequest{} }
+func (m *UpdateLoadoutRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLoadoutRequest) ProtoMessage()    {}
+func (*UpdateLoadoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{85}
+}
+
+func (m *UpdateLoadoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLoadoutRequest.Unmarshal(m, b)
+}
+func (m *UpdateLoadoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLoadoutRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateLoadoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLoadoutRequest.Merge(m, src)
+}
+func (m *UpdateLoadoutRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLoadoutRequest.Size(m)
+}
+func (m *UpdateLoadoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLoadoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLoadoutRequest proto.InternalMessageInfo
+
+func (m *UpdateLoadoutRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UpdateLoadoutRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateLoadoutRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateLoadoutRequest) GetItemIds() []string {
+	if m != nil {
+		return m.ItemIds
+	}
+	return nil
+}
+
+type UpdateLoadoutResponse struct {
+	Result               *Loadout `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateLoadoutResponse) Reset()         { *m = UpdateLoadoutResponse{} }
+func (m *UpdateLoadoutResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLoadoutResponse) ProtoMessage()    {}
+func (*UpdateLoadoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{86}
+}
+
+func (m *UpdateLoadoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLoadoutResponse.Unmarshal(m, b)
+}
+func (m *UpdateLoadoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLoadoutResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateLoadoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLoadoutResponse.Merge(m, src)
+}
+func (m *UpdateLoadoutResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateLoadoutResponse.Size(m)
+}
+func (m *UpdateLoadoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLoadoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLoadoutResponse proto.InternalMessageInfo
+
+func (m *UpdateLoadoutResponse) GetResult() *Loadout {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteLoadoutRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                   int32    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteLoadoutRequest) Reset()         { *m = DeleteLoadoutRequest{} }
+func (m *DeleteLoadoutRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteLoadoutRequest) ProtoMessage()    {}
+func (*DeleteLoadoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{87}
+}
+
+func (m *DeleteLoadoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteLoadoutRequest.Unmarshal(m, b)
+}
+func (m *DeleteLoadoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteLoadoutRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteLoadoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteLoadoutRequest.Merge(m, src)
+}
+func (m *DeleteLoadoutRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteLoadoutRequest.Size(m)
+}
+func (m *DeleteLoadoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteLoadoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteLoadoutRequest proto.InternalMessageInfo
+
+func (m *DeleteLoadoutRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *DeleteLoadoutRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteLoadoutResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteLoadoutResponse) Reset()         { *m = DeleteLoadoutResponse{} }
+func (m *DeleteLoadoutResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteLoadoutResponse) ProtoMessage()    {}
+func (*DeleteLoadoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{88}
+}
+
+func (m *DeleteLoadoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteLoadoutResponse.Unmarshal(m, b)
+}
+func (m *DeleteLoadoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteLoadoutResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteLoadoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteLoadoutResponse.Merge(m, src)
+}
+func (m *DeleteLoadoutResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteLoadoutResponse.Size(m)
+}
+func (m *DeleteLoadoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteLoadoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteLoadoutResponse proto.InternalMessageInfo
+
+type ActivateLoadoutRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                   int32    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivateLoadoutRequest) Reset()         { *m = ActivateLoadoutRequest{} }
+func (m *ActivateLoadoutRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateLoadoutRequest) ProtoMessage()    {}
+func (*ActivateLoadoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{89}
+}
+
+func (m *ActivateLoadoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateLoadoutRequest.Unmarshal(m, b)
+}
+func (m *ActivateLoadoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateLoadoutRequest.Marshal(b, m, deterministic)
+}
+func (m *ActivateLoadoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateLoadoutRequest.Merge(m, src)
+}
+func (m *ActivateLoadoutRequest) XXX_Size() int {
+	return xxx_messageInfo_ActivateLoadoutRequest.Size(m)
+}
+func (m *ActivateLoadoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateLoadoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateLoadoutRequest proto.InternalMessageInfo
+
+func (m *ActivateLoadoutRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ActivateLoadoutRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ActivateLoadoutResponse struct {
+	Items                []*UserItemInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ActivateLoadoutResponse) Reset()         { *m = ActivateLoadoutResponse{} }
+func (m *ActivateLoadoutResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateLoadoutResponse) ProtoMessage()    {}
+func (*ActivateLoadoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{90}
+}
+
+func (m *ActivateLoadoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateLoadoutResponse.Unmarshal(m, b)
+}
+func (m *ActivateLoadoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateLoadoutResponse.Marshal(b, m, deterministic)
+}
+func (m *ActivateLoadoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateLoadoutResponse.Merge(m, src)
+}
+func (m *ActivateLoadoutResponse) XXX_Size() int {
+	return xxx_messageInfo_ActivateLoadoutResponse.Size(m)
+}
+func (m *ActivateLoadoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateLoadoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivateLoadoutResponse proto.InternalMessageInfo
+
+func (m *ActivateLoadoutResponse) GetItems() []*UserItemInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListLoadoutsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLoadoutsRequest) Reset()         { *m = ListLoadoutsRequest{} }
+func (m *ListLoadoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLoadoutsRequest) ProtoMessage()    {}
+func (*ListLoadoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{91}
+}
+
+func (m *ListLoadoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoadoutsRequest.Unmarshal(m, b)
+}
+func (m *ListLoadoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoadoutsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLoadoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoadoutsRequest.Merge(m, src)
+}
+func (m *ListLoadoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLoadoutsRequest.Size(m)
+}
+func (m *ListLoadoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoadoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoadoutsRequest proto.InternalMessageInfo
+
+func (m *ListLoadoutsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ListLoadoutsResponse struct {
+	Results              []*Loadout `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListLoadoutsResponse) Reset()         { *m = ListLoadoutsResponse{} }
+func (m *ListLoadoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLoadoutsResponse) ProtoMessage()    {}
+func (*ListLoadoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{92}
+}
+
+func (m *ListLoadoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoadoutsResponse.Unmarshal(m, b)
+}
+func (m *ListLoadoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoadoutsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLoadoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoadoutsResponse.Merge(m, src)
+}
+func (m *ListLoadoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLoadoutsResponse.Size(m)
+}
+func (m *ListLoadoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoadoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoadoutsResponse proto.InternalMessageInfo
+
+func (m *ListLoadoutsResponse) GetResults() []*Loadout {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// InventoryEntry is a row of the inventory_entries view joining owned items with the store
+type InventoryEntry struct {
+	UserId               string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId               string               `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Type                 int32                `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	CoinsPrice           int32                `protobuf:"varint,6,opt,name=coins_price,json=coinsPrice,proto3" json:"coins_price,omitempty"`
+	GemsPrice            int32                `protobuf:"varint,7,opt,name=gems_price,json=gemsPrice,proto3" json:"gems_price,omitempty"`
+	ImageId              string               `protobuf:"bytes,8,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	OnSale               bool                 `protobuf:"varint,9,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
+	SaleCoinsPrice       int32                `protobuf:"varint,10,opt,name=sale_coins_price,json=saleCoinsPrice,proto3" json:"sale_coins_price,omitempty"`
+	SaleGemsPrice        int32                `protobuf:"varint,11,opt,name=sale_gems_price,json=saleGemsPrice,proto3" json:"sale_gems_price,omitempty"`
+	Consumable           bool                 `protobuf:"varint,12,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	MaxStack             int32                `protobuf:"varint,13,opt,name=max_stack,json=maxStack,proto3" json:"max_stack,omitempty"`
+	Equipped             bool                 `protobuf:"varint,14,opt,name=equipped,proto3" json:"equipped,omitempty"`
+	Quantity             int32                `protobuf:"varint,15,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AcquiredAt           *timestamp.Timestamp `protobuf:"bytes,16,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	Source               string               `protobuf:"bytes,17,opt,name=source,proto3" json:"source,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,18,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RentalDays           int32                `protobuf:"varint,19,opt,name=rental_days,json=rentalDays,proto3" json:"rental_days,omitempty"`
+	RentalCoinsPrice     int32                `protobuf:"varint,20,opt,name=rental_coins_price,json=rentalCoinsPrice,proto3" json:"rental_coins_price,omitempty"`
+	RentalGemsPrice      int32                `protobuf:"varint,21,opt,name=rental_gems_price,json=rentalGemsPrice,proto3" json:"rental_gems_price,omitempty"`
+	Sku                  string               `protobuf:"bytes,22,opt,name=sku,proto3" json:"sku,omitempty"`
+	Retired              bool                 `protobuf:"varint,23,opt,name=retired,proto3" json:"retired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InventoryEntry) Reset()         { *m = InventoryEntry{} }
+func (m *InventoryEntry) String() string { return proto.CompactTextString(m) }
+func (*InventoryEntry) ProtoMessage()    {}
+func (*InventoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{93}
+}
+
+func (m *InventoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InventoryEntry.Unmarshal(m, b)
+}
+func (m *InventoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InventoryEntry.Marshal(b, m, deterministic)
+}
+func (m *InventoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryEntry.Merge(m, src)
+}
+func (m *InventoryEntry) XXX_Size() int {
+	return xxx_messageInfo_InventoryEntry.Size(m)
+}
+func (m *InventoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryEntry proto.InternalMessageInfo
+
+func (m *InventoryEntry) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetCoinsPrice() int32 {
+	if m != nil {
+		return m.CoinsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetGemsPrice() int32 {
+	if m != nil {
+		return m.GemsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetOnSale() bool {
+	if m != nil {
+		return m.OnSale
+	}
+	return false
+}
+
+func (m *InventoryEntry) GetSaleCoinsPrice() int32 {
+	if m != nil {
+		return m.SaleCoinsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetSaleGemsPrice() int32 {
+	if m != nil {
+		return m.SaleGemsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetConsumable() bool {
+	if m != nil {
+		return m.Consumable
+	}
+	return false
+}
+
+func (m *InventoryEntry) GetMaxStack() int32 {
+	if m != nil {
+		return m.MaxStack
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetEquipped() bool {
+	if m != nil {
+		return m.Equipped
+	}
+	return false
+}
+
+func (m *InventoryEntry) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetAcquiredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.AcquiredAt
+	}
+	return nil
+}
+
+func (m *InventoryEntry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *InventoryEntry) GetRentalDays() int32 {
+	if m != nil {
+		return m.RentalDays
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetRentalCoinsPrice() int32 {
+	if m != nil {
+		return m.RentalCoinsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetRentalGemsPrice() int32 {
+	if m != nil {
+		return m.RentalGemsPrice
+	}
+	return 0
+}
+
+func (m *InventoryEntry) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func (m *InventoryEntry) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+type InventoryItem struct {
+	Item                 *StoreItem           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Equipped             bool                 `protobuf:"varint,2,opt,name=equipped,proto3" json:"equipped,omitempty"`
+	Quantity             int32                `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AcquiredAt           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	Source               string               `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InventoryItem) Reset()         { *m = InventoryItem{} }
+func (m *InventoryItem) String() string { return proto.CompactTextString(m) }
+func (*InventoryItem) ProtoMessage()    {}
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{94}
+}
+
+func (m *InventoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InventoryItem.Unmarshal(m, b)
+}
+func (m *InventoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InventoryItem.Marshal(b, m, deterministic)
+}
+func (m *InventoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryItem.Merge(m, src)
+}
+func (m *InventoryItem) XXX_Size() int {
+	return xxx_messageInfo_InventoryItem.Size(m)
+}
+func (m *InventoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryItem proto.InternalMessageInfo
+
+func (m *InventoryItem) GetItem() *StoreItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *InventoryItem) GetEquipped() bool {
+	if m != nil {
+		return m.Equipped
+	}
+	return false
+}
+
+func (m *InventoryItem) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *InventoryItem) GetAcquiredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.AcquiredAt
+	}
+	return nil
+}
+
+func (m *InventoryItem) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *InventoryItem) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type ListUserInventoryRequest struct {
+	UserId               string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filter               *query.Filtering  `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy              *query.Sorting    `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Paging               *query.Pagination `protobuf:"bytes,4,opt,name=paging,proto3" json:"paging,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListUserInventoryRequest) Reset()         { *m = ListUserInventoryRequest{} }
+func (m *ListUserInventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserInventoryRequest) ProtoMessage()    {}
+func (*ListUserInventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{95}
+}
+
+func (m *ListUserInventoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserInventoryRequest.Unmarshal(m, b)
+}
+func (m *ListUserInventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserInventoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUserInventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserInventoryRequest.Merge(m, src)
+}
+func (m *ListUserInventoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserInventoryRequest.Size(m)
+}
+func (m *ListUserInventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserInventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserInventoryRequest proto.InternalMessageInfo
+
+func (m *ListUserInventoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ListUserInventoryRequest) GetFilter() *query.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListUserInventoryRequest) GetOrderBy() *query.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListUserInventoryRequest) GetPaging() *query.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListUserInventoryResponse struct {
+	Results              []*InventoryItem `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page                 *query.PageInfo  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListUserInventoryResponse) Reset()         { *m = ListUserInventoryResponse{} }
+func (m *ListUserInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUserInventoryResponse) ProtoMessage()    {}
+func (*ListUserInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{96}
+}
+
+func (m *ListUserInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserInventoryResponse.Unmarshal(m, b)
+}
+func (m *ListUserInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserInventoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUserInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserInventoryResponse.Merge(m, src)
+}
+func (m *ListUserInventoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUserInventoryResponse.Size(m)
+}
+func (m *ListUserInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserInventoryResponse proto.InternalMessageInfo
+
+func (m *ListUserInventoryResponse) GetResults() []*InventoryItem {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ListUserInventoryResponse) GetPage() *query.PageInfo {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type UserStats struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Games                int32    `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins                 int32    `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Top5                 int32    `protobuf:"varint,4,opt,name=top5,proto3" json:"top5,omitempty"`
+	Kills                int32    `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserStats) Reset()         { *m = UserStats{} }
+func (m *UserStats) String() string { return proto.CompactTextString(m) }
+func (*UserStats) ProtoMessage()    {}
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{97}
+}
+
+func (m *UserStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserStats.Unmarshal(m, b)
+}
+func (m *UserStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserStats.Marshal(b, m, deterministic)
+}
+func (m *UserStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserStats.Merge(m, src)
+}
+func (m *UserStats) XXX_Size() int {
+	return xxx_messageInfo_UserStats.Size(m)
+}
+func (m *UserStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserStats proto.InternalMessageInfo
+
+func (m *UserStats) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserStats) GetGames() int32 {
+	if m != nil {
+		return m.Games
+	}
+	return 0
+}
+
+func (m *UserStats) GetWins() int32 {
+	if m != nil {
+		return m.Wins
+	}
+	return 0
+}
+
+func (m *UserStats) GetTop5() int32 {
+	if m != nil {
+		return m.Top5
+	}
+	return 0
+}
+
+func (m *UserStats) GetKills() int32 {
+	if m != nil {
+		return m.Kills
+	}
+	return 0
+}
+
+type GetLeaderboardRequest struct {
+	Metric    LeaderboardMetric `protobuf:"varint,1,opt,name=metric,proto3,enum=service.LeaderboardMetric" json:"metric,omitempty"`
+	Ascending bool              `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Limit     int32             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next_cursor of the previous page
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// around_user centers the page on the given user instead of starting at the top
+	AroundUser string `protobuf:"bytes,5,opt,name=around_user,json=aroundUser,proto3" json:"around_user,omitempty"`
+	// season_id ranks by the stats of one season, archived seasons use their final standings
+	SeasonId             int32    `protobuf:"varint,6,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeaderboardRequest) Reset()         { *m = GetLeaderboardRequest{} }
+func (m *GetLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardRequest) ProtoMessage()    {}
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{98}
+}
+
+func (m *GetLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *GetLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardRequest.Merge(m, src)
+}
+func (m *GetLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardRequest.Size(m)
+}
+func (m *GetLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardRequest proto.InternalMessageInfo
+
+func (m *GetLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if m != nil {
+		return m.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (m *GetLeaderboardRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+func (m *GetLeaderboardRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetLeaderboardRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetAroundUser() string {
+	if m != nil {
+		return m.AroundUser
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetSeasonId() int32 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	// rank is dense, players with equal values share it
+	Rank                 int32    `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Value                int32    `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderboardEntry) Reset()         { *m = LeaderboardEntry{} }
+func (m *LeaderboardEntry) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardEntry) ProtoMessage()    {}
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{99}
+}
+
+func (m *LeaderboardEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardEntry.Unmarshal(m, b)
+}
+func (m *LeaderboardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardEntry.Marshal(b, m, deterministic)
+}
+func (m *LeaderboardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardEntry.Merge(m, src)
+}
+func (m *LeaderboardEntry) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardEntry.Size(m)
+}
+func (m *LeaderboardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderboardEntry proto.InternalMessageInfo
+
+func (m *LeaderboardEntry) GetRank() int32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *LeaderboardEntry) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *LeaderboardEntry) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *LeaderboardEntry) GetValue() int32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	Metric               LeaderboardMetric   `protobuf:"varint,1,opt,name=metric,proto3,enum=service.LeaderboardMetric" json:"metric,omitempty"`
+	Entries              []*LeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor           string              `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetLeaderboardResponse) Reset()         { *m = GetLeaderboardResponse{} }
+func (m *GetLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardResponse) ProtoMessage()    {}
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{100}
+}
+
+func (m *GetLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardResponse.Unmarshal(m, b)
+}
+func (m *GetLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardResponse.Merge(m, src)
+}
+func (m *GetLeaderboardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardResponse.Size(m)
+}
+func (m *GetLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardResponse proto.InternalMessageInfo
+
+func (m *GetLeaderboardResponse) GetMetric() LeaderboardMetric {
+	if m != nil {
+		return m.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (m *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *GetLeaderboardResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ReadUserStatsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// season_id reads the stats of one season instead of the lifetime ones
+	SeasonId int32 `protobuf:"varint,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// last_week adds the stats of the last 7 days
+	LastWeek bool `protobuf:"varint,3,opt,name=last_week,json=lastWeek,proto3" json:"last_week,omitempty"`
+	// last_month adds the stats of the last 30 days
+	LastMonth bool `protobuf:"varint,4,opt,name=last_month,json=lastMonth,proto3" json:"last_month,omitempty"`
+	// last_matches adds the stats of the last N stats updates that added games
+	LastMatches          int32    `protobuf:"varint,5,opt,name=last_matches,json=lastMatches,proto3" json:"last_matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadUserStatsRequest) Reset()         { *m = ReadUserStatsRequest{} }
+func (m *ReadUserStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadUserStatsRequest) ProtoMessage()    {}
+func (*ReadUserStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{101}
+}
+
+func (m *ReadUserStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadUserStatsRequest.Unmarshal(m, b)
+}
+func (m *ReadUserStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadUserStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadUserStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadUserStatsRequest.Merge(m, src)
+}
+func (m *ReadUserStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadUserStatsRequest.Size(m)
+}
+func (m *ReadUserStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadUserStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadUserStatsRequest proto.InternalMessageInfo
+
+func (m *ReadUserStatsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ReadUserStatsRequest) GetSeasonId() int32 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+func (m *ReadUserStatsRequest) GetLastWeek() bool {
+	if m != nil {
+		return m.LastWeek
+	}
+	return false
+}
+
+func (m *ReadUserStatsRequest) GetLastMonth() bool {
+	if m != nil {
+		return m.LastMonth
+	}
+	return false
+}
+
+func (m *ReadUserStatsRequest) GetLastMatches() int32 {
+	if m != nil {
+		return m.LastMatches
+	}
+	return 0
+}
+
+// DerivedStats are computed from the counters, a game that wasn't won counts as one death
+type DerivedStats struct {
+	KdRatio              float64  `protobuf:"fixed64,1,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	WinRate              float64  `protobuf:"fixed64,2,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	Top5Rate             float64  `protobuf:"fixed64,3,opt,name=top5_rate,json=top5Rate,proto3" json:"top5_rate,omitempty"`
+	AvgKills             float64  `protobuf:"fixed64,4,opt,name=avg_kills,json=avgKills,proto3" json:"avg_kills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DerivedStats) Reset()         { *m = DerivedStats{} }
+func (m *DerivedStats) String() string { return proto.CompactTextString(m) }
+func (*DerivedStats) ProtoMessage()    {}
+func (*DerivedStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{102}
+}
+
+func (m *DerivedStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DerivedStats.Unmarshal(m, b)
+}
+func (m *DerivedStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DerivedStats.Marshal(b, m, deterministic)
+}
+func (m *DerivedStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedStats.Merge(m, src)
+}
+func (m *DerivedStats) XXX_Size() int {
+	return xxx_messageInfo_DerivedStats.Size(m)
+}
+func (m *DerivedStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedStats proto.InternalMessageInfo
+
+func (m *DerivedStats) GetKdRatio() float64 {
+	if m != nil {
+		return m.KdRatio
+	}
+	return 0
+}
+
+func (m *DerivedStats) GetWinRate() float64 {
+	if m != nil {
+		return m.WinRate
+	}
+	return 0
+}
+
+func (m *DerivedStats) GetTop5Rate() float64 {
+	if m != nil {
+		return m.Top5Rate
+	}
+	return 0
+}
+
+func (m *DerivedStats) GetAvgKills() float64 {
+	if m != nil {
+		return m.AvgKills
+	}
+	return 0
+}
+
+type WindowedStats struct {
+	Totals               *UserStats    `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals,omitempty"`
+	Derived              *DerivedStats `protobuf:"bytes,2,opt,name=derived,proto3" json:"derived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WindowedStats) Reset()         { *m = WindowedStats{} }
+func (m *WindowedStats) String() string { return proto.CompactTextString(m) }
+func (*WindowedStats) ProtoMessage()    {}
+func (*WindowedStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{103}
+}
+
+func (m *WindowedStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WindowedStats.Unmarshal(m, b)
+}
+func (m *WindowedStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WindowedStats.Marshal(b, m, deterministic)
+}
+func (m *WindowedStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowedStats.Merge(m, src)
+}
+func (m *WindowedStats) XXX_Size() int {
+	return xxx_messageInfo_WindowedStats.Size(m)
+}
+func (m *WindowedStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowedStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowedStats proto.InternalMessageInfo
+
+func (m *WindowedStats) GetTotals() *UserStats {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+func (m *WindowedStats) GetDerived() *DerivedStats {
+	if m != nil {
+		return m.Derived
+	}
+	return nil
+}
+
+type ReadUserStatsResponse struct {
+	Result  *UserStats    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Derived *DerivedStats `protobuf:"bytes,2,opt,name=derived,proto3" json:"derived,omitempty"`
+	// windows are only set when requested
+	LastWeek             *WindowedStats `protobuf:"bytes,3,opt,name=last_week,json=lastWeek,proto3" json:"last_week,omitempty"`
+	LastMonth            *WindowedStats `protobuf:"bytes,4,opt,name=last_month,json=lastMonth,proto3" json:"last_month,omitempty"`
+	LastMatches          *WindowedStats `protobuf:"bytes,5,opt,name=last_matches,json=lastMatches,proto3" json:"last_matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReadUserStatsResponse) Reset()         { *m = ReadUserStatsResponse{} }
+func (m *ReadUserStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadUserStatsResponse) ProtoMessage()    {}
+func (*ReadUserStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{104}
+}
+
+func (m *ReadUserStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadUserStatsResponse.Unmarshal(m, b)
+}
+func (m *ReadUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadUserStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadUserStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadUserStatsResponse.Merge(m, src)
+}
+func (m *ReadUserStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadUserStatsResponse.Size(m)
+}
+func (m *ReadUserStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadUserStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadUserStatsResponse proto.InternalMessageInfo
+
+func (m *ReadUserStatsResponse) GetResult() *UserStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReadUserStatsResponse) GetDerived() *DerivedStats {
+	if m != nil {
+		return m.Derived
+	}
+	return nil
+}
+
+func (m *ReadUserStatsResponse) GetLastWeek() *WindowedStats {
+	if m != nil {
+		return m.LastWeek
+	}
+	return nil
+}
+
+func (m *ReadUserStatsResponse) GetLastMonth() *WindowedStats {
+	if m != nil {
+		return m.LastMonth
+	}
+	return nil
+}
+
+func (m *ReadUserStatsResponse) GetLastMatches() *WindowedStats {
+	if m != nil {
+		return m.LastMatches
+	}
+	return nil
+}
+
+type UpdateUserStatsRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AddGames             int32    `protobuf:"varint,2,opt,name=add_games,json=addGames,proto3" json:"add_games,omitempty"`
+	AddWins              int32    `protobuf:"varint,3,opt,name=add_wins,json=addWins,proto3" json:"add_wins,omitempty"`
+	AddTop5              int32    `protobuf:"varint,4,opt,name=add_top5,json=addTop5,proto3" json:"add_top5,omitempty"`
+	AddKills             int32    `protobuf:"varint,5,opt,name=add_kills,json=addKills,proto3" json:"add_kills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserStatsRequest) Reset()         { *m = UpdateUserStatsRequest{} }
+func (m *UpdateUserStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserStatsRequest) ProtoMessage()    {}
+func (*UpdateUserStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{105}
+}
+
+func (m *UpdateUserStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserStatsRequest.Unmarshal(m, b)
+}
+func (m *UpdateUserStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserStatsRequest.Merge(m, src)
+}
+func (m *UpdateUserStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserStatsRequest.Size(m)
+}
+func (m *UpdateUserStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserStatsRequest proto.InternalMessageInfo
+
+func (m *UpdateUserStatsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UpdateUserStatsRequest) GetAddGames() int32 {
+	if m != nil {
+		return m.AddGames
+	}
+	return 0
+}
+
+func (m *UpdateUserStatsRequest) GetAddWins() int32 {
+	if m != nil {
+		return m.AddWins
+	}
+	return 0
+}
+
+func (m *UpdateUserStatsRequest) GetAddTop5() int32 {
+	if m != nil {
+		return m.AddTop5
+	}
+	return 0
+}
+
+func (m *UpdateUserStatsRequest) GetAddKills() int32 {
+	if m != nil {
+		return m.AddKills
+	}
+	return 0
+}
+
+type UpdateUserStatsResponse struct {
+	// unlocked lists the achievements this update unlocked, their rewards are already granted
+	Unlocked []*Achievement `protobuf:"bytes,1,rep,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// flagged is set when the update broke plausibility rules and was queued for review
+	Flagged              bool     `protobuf:"varint,2,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserStatsResponse) Reset()         { *m = UpdateUserStatsResponse{} }
+func (m *UpdateUserStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserStatsResponse) ProtoMessage()    {}
+func (*UpdateUserStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{106}
+}
+
+func (m *UpdateUserStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserStatsResponse.Unmarshal(m, b)
+}
+func (m *UpdateUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserStatsResponse.Merge(m, src)
+}
+func (m *UpdateUserStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserStatsResponse.Size(m)
+}
+func (m *UpdateUserStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserStatsResponse proto.InternalMessageInfo
+
+func (m *UpdateUserStatsResponse) GetUnlocked() []*Achievement {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *UpdateUserStatsResponse) GetFlagged() bool {
+	if m != nil {
+		return m.Flagged
+	}
+	return false
+}
+
+// BatchUpdateStatsRequest submits the stats of every participant of a match, they are applied together
+type BatchUpdateStatsRequest struct {
+	// match_id makes the submission idempotent, retries get the results of the first submission
+	MatchId              string                    `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Updates              []*UpdateUserStatsRequest `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *BatchUpdateStatsRequest) Reset()         { *m = BatchUpdateStatsRequest{} }
+func (m *BatchUpdateStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateStatsRequest) ProtoMessage()    {}
+func (*BatchUpdateStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{107}
+}
+
+func (m *BatchUpdateStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateStatsRequest.Unmarshal(m, b)
+}
+func (m *BatchUpdateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateStatsRequest.Merge(m, src)
+}
+func (m *BatchUpdateStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateStatsRequest.Size(m)
+}
+func (m *BatchUpdateStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateStatsRequest proto.InternalMessageInfo
+
+func (m *BatchUpdateStatsRequest) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *BatchUpdateStatsRequest) GetUpdates() []*UpdateUserStatsRequest {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+type BatchStatsResult struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// user_id is empty when the username didn't match a user, such updates are skipped
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Applied bool   `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	// flagged is set when the update broke plausibility rules and was queued for review
+	Flagged  bool           `protobuf:"varint,4,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	Unlocked []*Achievement `protobuf:"bytes,5,rep,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// stats holds the lifetime stats after the update
+	Stats                *UserStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BatchStatsResult) Reset()         { *m = BatchStatsResult{} }
+func (m *BatchStatsResult) String() string { return proto.CompactTextString(m) }
+func (*BatchStatsResult) ProtoMessage()    {}
+func (*BatchStatsResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{108}
+}
+
+func (m *BatchStatsResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchStatsResult.Unmarshal(m, b)
+}
+func (m *BatchStatsResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchStatsResult.Marshal(b, m, deterministic)
+}
+func (m *BatchStatsResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchStatsResult.Merge(m, src)
+}
+func (m *BatchStatsResult) XXX_Size() int {
+	return xxx_messageInfo_BatchStatsResult.Size(m)
+}
+func (m *BatchStatsResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchStatsResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchStatsResult proto.InternalMessageInfo
+
+func (m *BatchStatsResult) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *BatchStatsResult) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *BatchStatsResult) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *BatchStatsResult) GetFlagged() bool {
+	if m != nil {
+		return m.Flagged
+	}
+	return false
+}
+
+func (m *BatchStatsResult) GetUnlocked() []*Achievement {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *BatchStatsResult) GetStats() *UserStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type BatchUpdateStatsResponse struct {
+	// results are in the order of the submitted updates
+	Results []*BatchStatsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// replayed is set when the match id was already submitted
+	Replayed             bool     `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateStatsResponse) Reset()         { *m = BatchUpdateStatsResponse{} }
+func (m *BatchUpdateStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateStatsResponse) ProtoMessage()    {}
+func (*BatchUpdateStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{109}
+}
+
+func (m *BatchUpdateStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateStatsResponse.Unmarshal(m, b)
+}
+func (m *BatchUpdateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateStatsResponse.Merge(m, src)
+}
+func (m *BatchUpdateStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateStatsResponse.Size(m)
+}
+func (m *BatchUpdateStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateStatsResponse proto.InternalMessageInfo
+
+func (m *BatchUpdateStatsResponse) GetResults() []*BatchStatsResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BatchUpdateStatsResponse) GetReplayed() bool {
+	if m != nil {
+		return m.Replayed
+	}
+	return false
+}
+
+type FlaggedStatUpdate struct {
+	Id         int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddGames   int32    `protobuf:"varint,3,opt,name=add_games,json=addGames,proto3" json:"add_games,omitempty"`
+	AddWins    int32    `protobuf:"varint,4,opt,name=add_wins,json=addWins,proto3" json:"add_wins,omitempty"`
+	AddTop5    int32    `protobuf:"varint,5,opt,name=add_top5,json=addTop5,proto3" json:"add_top5,omitempty"`
+	AddKills   int32    `protobuf:"varint,6,opt,name=add_kills,json=addKills,proto3" json:"add_kills,omitempty"`
+	Violations []string `protobuf:"bytes,7,rep,name=violations,proto3" json:"violations,omitempty"`
+	// status is pending, cleared or rolled_back
+	Status               string               `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FlaggedStatUpdate) Reset()         { *m = FlaggedStatUpdate{} }
+func (m *FlaggedStatUpdate) String() string { return proto.CompactTextString(m) }
+func (*FlaggedStatUpdate) ProtoMessage()    {}
+func (*FlaggedStatUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{110}
+}
+
+func (m *FlaggedStatUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlaggedStatUpdate.Unmarshal(m, b)
+}
+func (m *FlaggedStatUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlaggedStatUpdate.Marshal(b, m, deterministic)
+}
+func (m *FlaggedStatUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlaggedStatUpdate.Merge(m, src)
+}
+func (m *FlaggedStatUpdate) XXX_Size() int {
+	return xxx_messageInfo_FlaggedStatUpdate.Size(m)
+}
+func (m *FlaggedStatUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlaggedStatUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlaggedStatUpdate proto.InternalMessageInfo
+
+func (m *FlaggedStatUpdate) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FlaggedStatUpdate) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *FlaggedStatUpdate) GetAddGames() int32 {
+	if m != nil {
+		return m.AddGames
+	}
+	return 0
+}
+
+func (m *FlaggedStatUpdate) GetAddWins() int32 {
+	if m != nil {
+		return m.AddWins
+	}
+	return 0
+}
+
+func (m *FlaggedStatUpdate) GetAddTop5() int32 {
+	if m != nil {
+		return m.AddTop5
+	}
+	return 0
+}
+
+func (m *FlaggedStatUpdate) GetAddKills() int32 {
+	if m != nil {
+		return m.AddKills
+	}
+	return 0
+}
+
+func (m *FlaggedStatUpdate) GetViolations() []string {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+func (m *FlaggedStatUpdate) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FlaggedStatUpdate) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListFlaggedStatUpdatesRequest struct {
+	// include_resolved lists cleared and rolled back updates too
+	IncludeResolved      bool              `protobuf:"varint,1,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	Paging               *query.Pagination `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListFlaggedStatUpdatesRequest) Reset()         { *m = ListFlaggedStatUpdatesRequest{} }
+func (m *ListFlaggedStatUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFlaggedStatUpdatesRequest) ProtoMessage()    {}
+func (*ListFlaggedStatUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{111}
+}
+
+func (m *ListFlaggedStatUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFlaggedStatUpdatesRequest.Unmarshal(m, b)
+}
+func (m *ListFlaggedStatUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFlaggedStatUpdatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListFlaggedStatUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFlaggedStatUpdatesRequest.Merge(m, src)
+}
+func (m *ListFlaggedStatUpdatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFlaggedStatUpdatesRequest.Size(m)
+}
+func (m *ListFlaggedStatUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFlaggedStatUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFlaggedStatUpdatesRequest proto.InternalMessageInfo
+
+func (m *ListFlaggedStatUpdatesRequest) GetIncludeResolved() bool {
+	if m != nil {
+		return m.IncludeResolved
+	}
+	return false
+}
+
+func (m *ListFlaggedStatUpdatesRequest) GetPaging() *query.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListFlaggedStatUpdatesResponse struct {
+	Results              []*FlaggedStatUpdate `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page                 *query.PageInfo      `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListFlaggedStatUpdatesResponse) Reset()         { *m = ListFlaggedStatUpdatesResponse{} }
+func (m *ListFlaggedStatUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFlaggedStatUpdatesResponse) ProtoMessage()    {}
+func (*ListFlaggedStatUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{112}
+}
+
+func (m *ListFlaggedStatUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFlaggedStatUpdatesResponse.Unmarshal(m, b)
+}
+func (m *ListFlaggedStatUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFlaggedStatUpdatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListFlaggedStatUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFlaggedStatUpdatesResponse.Merge(m, src)
+}
+func (m *ListFlaggedStatUpdatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFlaggedStatUpdatesResponse.Size(m)
+}
+func (m *ListFlaggedStatUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFlaggedStatUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFlaggedStatUpdatesResponse proto.InternalMessageInfo
+
+func (m *ListFlaggedStatUpdatesResponse) GetResults() []*FlaggedStatUpdate {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ListFlaggedStatUpdatesResponse) GetPage() *query.PageInfo {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type ClearFlaggedStatUpdateRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearFlaggedStatUpdateRequest) Reset()         { *m = ClearFlaggedStatUpdateRequest{} }
+func (m *ClearFlaggedStatUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearFlaggedStatUpdateRequest) ProtoMessage()    {}
+func (*ClearFlaggedStatUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{113}
+}
+
+func (m *ClearFlaggedStatUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearFlaggedStatUpdateRequest.Unmarshal(m, b)
+}
+func (m *ClearFlaggedStatUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearFlaggedStatUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearFlaggedStatUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearFlaggedStatUpdateRequest.Merge(m, src)
+}
+func (m *ClearFlaggedStatUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearFlaggedStatUpdateRequest.Size(m)
+}
+func (m *ClearFlaggedStatUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearFlaggedStatUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearFlaggedStatUpdateRequest proto.InternalMessageInfo
+
+func (m *ClearFlaggedStatUpdateRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ClearFlaggedStatUpdateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearFlaggedStatUpdateResponse) Reset()         { *m = ClearFlaggedStatUpdateResponse{} }
+func (m *ClearFlaggedStatUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearFlaggedStatUpdateResponse) ProtoMessage()    {}
+func (*ClearFlaggedStatUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{114}
+}
+
+func (m *ClearFlaggedStatUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearFlaggedStatUpdateResponse.Unmarshal(m, b)
+}
+func (m *ClearFlaggedStatUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearFlaggedStatUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *ClearFlaggedStatUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearFlaggedStatUpdateResponse.Merge(m, src)
+}
+func (m *ClearFlaggedStatUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_ClearFlaggedStatUpdateResponse.Size(m)
+}
+func (m *ClearFlaggedStatUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearFlaggedStatUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearFlaggedStatUpdateResponse proto.InternalMessageInfo
+
+type RollbackFlaggedStatUpdateRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackFlaggedStatUpdateRequest) Reset()         { *m = RollbackFlaggedStatUpdateRequest{} }
+func (m *RollbackFlaggedStatUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackFlaggedStatUpdateRequest) ProtoMessage()    {}
+func (*RollbackFlaggedStatUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{115}
+}
+
+func (m *RollbackFlaggedStatUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateRequest.Unmarshal(m, b)
+}
+func (m *RollbackFlaggedStatUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackFlaggedStatUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackFlaggedStatUpdateRequest.Merge(m, src)
+}
+func (m *RollbackFlaggedStatUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateRequest.Size(m)
+}
+func (m *RollbackFlaggedStatUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackFlaggedStatUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackFlaggedStatUpdateRequest proto.InternalMessageInfo
+
+func (m *RollbackFlaggedStatUpdateRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RollbackFlaggedStatUpdateResponse struct {
+	// result holds the lifetime stats after the rollback
+	Result *UserStats `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// revoked lists the achievements the rollback locked again, their rewards
+	// are taken back
+	Revoked              []*Achievement `protobuf:"bytes,2,rep,name=revoked,proto3" json:"revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RollbackFlaggedStatUpdateResponse) Reset()         { *m = RollbackFlaggedStatUpdateResponse{} }
+func (m *RollbackFlaggedStatUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackFlaggedStatUpdateResponse) ProtoMessage()    {}
+func (*RollbackFlaggedStatUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{116}
+}
+
+func (m *RollbackFlaggedStatUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateResponse.Unmarshal(m, b)
+}
+func (m *RollbackFlaggedStatUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *RollbackFlaggedStatUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackFlaggedStatUpdateResponse.Merge(m, src)
+}
+func (m *RollbackFlaggedStatUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackFlaggedStatUpdateResponse.Size(m)
+}
+func (m *RollbackFlaggedStatUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackFlaggedStatUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackFlaggedStatUpdateResponse proto.InternalMessageInfo
+
+func (m *RollbackFlaggedStatUpdateResponse) GetResult() *UserStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *RollbackFlaggedStatUpdateResponse) GetRevoked() []*Achievement {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+type MatchParticipant struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// placement starts at 1 for the winner
+	Placement            int32    `protobuf:"varint,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	Kills                int32    `protobuf:"varint,3,opt,name=kills,proto3" json:"kills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchParticipant) Reset()         { *m = MatchParticipant{} }
+func (m *MatchParticipant) String() string { return proto.CompactTextString(m) }
+func (*MatchParticipant) ProtoMessage()    {}
+func (*MatchParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{117}
+}
+
+func (m *MatchParticipant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchParticipant.Unmarshal(m, b)
+}
+func (m *MatchParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchParticipant.Marshal(b, m, deterministic)
+}
+func (m *MatchParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchParticipant.Merge(m, src)
+}
+func (m *MatchParticipant) XXX_Size() int {
+	return xxx_messageInfo_MatchParticipant.Size(m)
+}
+func (m *MatchParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchParticipant proto.InternalMessageInfo
+
+func (m *MatchParticipant) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *MatchParticipant) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *MatchParticipant) GetKills() int32 {
+	if m != nil {
+		return m.Kills
+	}
+	return 0
+}
+
+// RecordMatchRequest submits the results of a match, the stats and the coin rewards
+// of all participants are applied together
+type RecordMatchRequest struct {
+	// match_id is generated when empty, recording the same id twice fails
+	MatchId         string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Mode            string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	DurationSeconds int32  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// played_at defaults to the time the match is recorded
+	PlayedAt             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	Participants         []*MatchParticipant  `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RecordMatchRequest) Reset()         { *m = RecordMatchRequest{} }
+func (m *RecordMatchRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordMatchRequest) ProtoMessage()    {}
+func (*RecordMatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{118}
+}
+
+func (m *RecordMatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordMatchRequest.Unmarshal(m, b)
+}
+func (m *RecordMatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordMatchRequest.Marshal(b, m, deterministic)
+}
+func (m *RecordMatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordMatchRequest.Merge(m, src)
+}
+func (m *RecordMatchRequest) XXX_Size() int {
+	return xxx_messageInfo_RecordMatchRequest.Size(m)
+}
+func (m *RecordMatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordMatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordMatchRequest proto.InternalMessageInfo
+
+func (m *RecordMatchRequest) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *RecordMatchRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *RecordMatchRequest) GetDurationSeconds() int32 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *RecordMatchRequest) GetPlayedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PlayedAt
+	}
+	return nil
+}
+
+func (m *RecordMatchRequest) GetParticipants() []*MatchParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// MatchReward is the coin reward a participant got for a match, already added to the balance
+type MatchReward struct {
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlacementCoins int32  `protobuf:"varint,2,opt,name=placement_coins,json=placementCoins,proto3" json:"placement_coins,omitempty"`
+	KillCoins      int32  `protobuf:"varint,3,opt,name=kill_coins,json=killCoins,proto3" json:"kill_coins,omitempty"`
+	FirstWinCoins  int32  `protobuf:"varint,4,opt,name=first_win_coins,json=firstWinCoins,proto3" json:"first_win_coins,omitempty"`
+	TotalCoins     int32  `protobuf:"varint,5,opt,name=total_coins,json=totalCoins,proto3" json:"total_coins,omitempty"`
+	// flagged is set when the participant's stats broke plausibility rules and
+	// were queued for review, their kills aren't paid for
+	Flagged              bool     `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchReward) Reset()         { *m = MatchReward{} }
+func (m *MatchReward) String() string { return proto.CompactTextString(m) }
+func (*MatchReward) ProtoMessage()    {}
+func (*MatchReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{119}
+}
+
+func (m *MatchReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchReward.Unmarshal(m, b)
+}
+func (m *MatchReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchReward.Marshal(b, m, deterministic)
+}
+func (m *MatchReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchReward.Merge(m, src)
+}
+func (m *MatchReward) XXX_Size() int {
+	return xxx_messageInfo_MatchReward.Size(m)
+}
+func (m *MatchReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchReward proto.InternalMessageInfo
+
+func (m *MatchReward) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *MatchReward) GetPlacementCoins() int32 {
+	if m != nil {
+		return m.PlacementCoins
+	}
+	return 0
+}
+
+func (m *MatchReward) GetKillCoins() int32 {
+	if m != nil {
+		return m.KillCoins
+	}
+	return 0
+}
+
+func (m *MatchReward) GetFirstWinCoins() int32 {
+	if m != nil {
+		return m.FirstWinCoins
+	}
+	return 0
+}
+
+func (m *MatchReward) GetTotalCoins() int32 {
+	if m != nil {
+		return m.TotalCoins
+	}
+	return 0
+}
+
+func (m *MatchReward) GetFlagged() bool {
+	if m != nil {
+		return m.Flagged
+	}
+	return false
+}
+
+type RecordMatchResponse struct {
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// rewards are in the order of the participants
+	Rewards              []*MatchReward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RecordMatchResponse) Reset()         { *m = RecordMatchResponse{} }
+func (m *RecordMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordMatchResponse) ProtoMessage()    {}
+func (*RecordMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{120}
+}
+
+func (m *RecordMatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecordMatchResponse.Unmarshal(m, b)
+}
+func (m *RecordMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecordMatchResponse.Marshal(b, m, deterministic)
+}
+func (m *RecordMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordMatchResponse.Merge(m, src)
+}
+func (m *RecordMatchResponse) XXX_Size() int {
+	return xxx_messageInfo_RecordMatchResponse.Size(m)
+}
+func (m *RecordMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordMatchResponse proto.InternalMessageInfo
+
+func (m *RecordMatchResponse) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *RecordMatchResponse) GetRewards() []*MatchReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+type MatchHistoryEntry struct {
+	MatchId              string               `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Mode                 string               `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	DurationSeconds      int32                `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	PlayedAt             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	Players              int32                `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`
+	Placement            int32                `protobuf:"varint,6,opt,name=placement,proto3" json:"placement,omitempty"`
+	Kills                int32                `protobuf:"varint,7,opt,name=kills,proto3" json:"kills,omitempty"`
+	RewardCoins          int32                `protobuf:"varint,8,opt,name=reward_coins,json=rewardCoins,proto3" json:"reward_coins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MatchHistoryEntry) Reset()         { *m = MatchHistoryEntry{} }
+func (m *MatchHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*MatchHistoryEntry) ProtoMessage()    {}
+func (*MatchHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{121}
+}
+
+func (m *MatchHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchHistoryEntry.Unmarshal(m, b)
+}
+func (m *MatchHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchHistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *MatchHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchHistoryEntry.Merge(m, src)
+}
+func (m *MatchHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_MatchHistoryEntry.Size(m)
+}
+func (m *MatchHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchHistoryEntry proto.InternalMessageInfo
+
+func (m *MatchHistoryEntry) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *MatchHistoryEntry) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *MatchHistoryEntry) GetDurationSeconds() int32 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *MatchHistoryEntry) GetPlayedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PlayedAt
+	}
+	return nil
+}
+
+func (m *MatchHistoryEntry) GetPlayers() int32 {
+	if m != nil {
+		return m.Players
+	}
+	return 0
+}
+
+func (m *MatchHistoryEntry) GetPlacement() int32 {
+	if m != nil {
+		return m.Placement
+	}
+	return 0
+}
+
+func (m *MatchHistoryEntry) GetKills() int32 {
+	if m != nil {
+		return m.Kills
+	}
+	return 0
+}
+
+func (m *MatchHistoryEntry) GetRewardCoins() int32 {
+	if m != nil {
+		return m.RewardCoins
+	}
+	return 0
+}
+
+type ListMatchHistoryRequest struct {
+	Username             string            `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Paging               *query.Pagination `protobuf:"bytes,2,opt,name=paging,proto3" json:"paging,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListMatchHistoryRequest) Reset()         { *m = ListMatchHistoryRequest{} }
+func (m *ListMatchHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMatchHistoryRequest) ProtoMessage()    {}
+func (*ListMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{122}
+}
+
+func (m *ListMatchHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMatchHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListMatchHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMatchHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMatchHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMatchHistoryRequest.Merge(m, src)
+}
+func (m *ListMatchHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMatchHistoryRequest.Size(m)
+}
+func (m *ListMatchHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMatchHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMatchHistoryRequest proto.InternalMessageInfo
+
+func (m *ListMatchHistoryRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListMatchHistoryRequest) GetPaging() *query.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListMatchHistoryResponse struct {
+	Results              []*MatchHistoryEntry `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page                 *query.PageInfo      `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListMatchHistoryResponse) Reset()         { *m = ListMatchHistoryResponse{} }
+func (m *ListMatchHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMatchHistoryResponse) ProtoMessage()    {}
+func (*ListMatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{123}
+}
+
+func (m *ListMatchHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMatchHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListMatchHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMatchHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMatchHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMatchHistoryResponse.Merge(m, src)
+}
+func (m *ListMatchHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMatchHistoryResponse.Size(m)
+}
+func (m *ListMatchHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMatchHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMatchHistoryResponse proto.InternalMessageInfo
+
+func (m *ListMatchHistoryResponse) GetResults() []*MatchHistoryEntry {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ListMatchHistoryResponse) GetPage() *query.PageInfo {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// RebuildStatsRequest recomputes the lifetime and running season stats from
+// the stats update log, on top of the stats counted before the log existed
+type RebuildStatsRequest struct {
+	// username limits the rebuild to one user, all users are rebuilt when empty
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildStatsRequest) Reset()         { *m = RebuildStatsRequest{} }
+func (m *RebuildStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildStatsRequest) ProtoMessage()    {}
+func (*RebuildStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{124}
+}
+
+func (m *RebuildStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildStatsRequest.Unmarshal(m, b)
+}
+func (m *RebuildStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *RebuildStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildStatsRequest.Merge(m, src)
+}
+func (m *RebuildStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_RebuildStatsRequest.Size(m)
+}
+func (m *RebuildStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildStatsRequest proto.InternalMessageInfo
+
+func (m *RebuildStatsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type RebuildStatsResponse struct {
+	RebuiltUsers         int32    `protobuf:"varint,1,opt,name=rebuilt_users,json=rebuiltUsers,proto3" json:"rebuilt_users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildStatsResponse) Reset()         { *m = RebuildStatsResponse{} }
+func (m *RebuildStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*RebuildStatsResponse) ProtoMessage()    {}
+func (*RebuildStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{125}
+}
+
+func (m *RebuildStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildStatsResponse.Unmarshal(m, b)
+}
+func (m *RebuildStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *RebuildStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildStatsResponse.Merge(m, src)
+}
+func (m *RebuildStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_RebuildStatsResponse.Size(m)
+}
+func (m *RebuildStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildStatsResponse proto.InternalMessageInfo
+
+func (m *RebuildStatsResponse) GetRebuiltUsers() int32 {
+	if m != nil {
+		return m.RebuiltUsers
+	}
+	return 0
+}
+
+type Season struct {
+	Id       int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartsAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// archived seasons have their final standings frozen
+	Archived             bool     `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Season) Reset()         { *m = Season{} }
+func (m *Season) String() string { return proto.CompactTextString(m) }
+func (*Season) ProtoMessage()    {}
+func (*Season) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{126}
+}
+
+func (m *Season) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Season.Unmarshal(m, b)
+}
+func (m *Season) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Season.Marshal(b, m, deterministic)
+}
+func (m *Season) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Season.Merge(m, src)
+}
+func (m *Season) XXX_Size() int {
+	return xxx_messageInfo_Season.Size(m)
+}
+func (m *Season) XXX_DiscardUnknown() {
+	xxx_messageInfo_Season.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Season proto.InternalMessageInfo
+
+func (m *Season) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Season) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Season) GetStartsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartsAt
+	}
+	return nil
+}
+
+func (m *Season) GetEndsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndsAt
+	}
+	return nil
+}
+
+func (m *Season) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+type CreateSeasonRequest struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartsAt             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt               *timestamp.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateSeasonRequest) Reset()         { *m = CreateSeasonRequest{} }
+func (m *CreateSeasonRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSeasonRequest) ProtoMessage()    {}
+func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{127}
+}
+
+func (m *CreateSeasonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSeasonRequest.Unmarshal(m, b)
+}
+func (m *CreateSeasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSeasonRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSeasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSeasonRequest.Merge(m, src)
+}
+func (m *CreateSeasonRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSeasonRequest.Size(m)
+}
+func (m *CreateSeasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSeasonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSeasonRequest proto.InternalMessageInfo
+
+func (m *CreateSeasonRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateSeasonRequest) GetStartsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartsAt
+	}
+	return nil
+}
+
+func (m *CreateSeasonRequest) GetEndsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndsAt
+	}
+	return nil
+}
+
+type CreateSeasonResponse struct {
+	Result               *Season  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSeasonResponse) Reset()         { *m = CreateSeasonResponse{} }
+func (m *CreateSeasonResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSeasonResponse) ProtoMessage()    {}
+func (*CreateSeasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{128}
+}
+
+func (m *CreateSeasonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSeasonResponse.Unmarshal(m, b)
+}
+func (m *CreateSeasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSeasonResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateSeasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSeasonResponse.Merge(m, src)
+}
+func (m *CreateSeasonResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSeasonResponse.Size(m)
+}
+func (m *CreateSeasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSeasonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSeasonResponse proto.InternalMessageInfo
+
+func (m *CreateSeasonResponse) GetResult() *Season {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListSeasonsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSeasonsRequest) Reset()         { *m = ListSeasonsRequest{} }
+func (m *ListSeasonsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSeasonsRequest) ProtoMessage()    {}
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{129}
+}
+
+func (m *ListSeasonsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSeasonsRequest.Unmarshal(m, b)
+}
+func (m *ListSeasonsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSeasonsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSeasonsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSeasonsRequest.Merge(m, src)
+}
+func (m *ListSeasonsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSeasonsRequest.Size(m)
+}
+func (m *ListSeasonsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSeasonsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSeasonsRequest proto.InternalMessageInfo
+
+type ListSeasonsResponse struct {
+	Results              []*Season `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListSeasonsResponse) Reset()         { *m = ListSeasonsResponse{} }
+func (m *ListSeasonsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSeasonsResponse) ProtoMessage()    {}
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{130}
+}
+
+func (m *ListSeasonsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSeasonsResponse.Unmarshal(m, b)
+}
+func (m *ListSeasonsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSeasonsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSeasonsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSeasonsResponse.Merge(m, src)
+}
+func (m *ListSeasonsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSeasonsResponse.Size(m)
+}
+func (m *ListSeasonsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSeasonsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSeasonsResponse proto.InternalMessageInfo
+
+func (m *ListSeasonsResponse) GetResults() []*Season {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Rating is a Glicko-2 skill rating, players that haven't played yet have the default rating
+type Rating struct {
+	UserId string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating float64 `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	// deviation grows back while the player is inactive
+	Deviation            float64              `protobuf:"fixed64,3,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility           float64              `protobuf:"fixed64,4,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	Games                int32                `protobuf:"varint,5,opt,name=games,proto3" json:"games,omitempty"`
+	LastPlayedAt         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_played_at,json=lastPlayedAt,proto3" json:"last_played_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Rating) Reset()         { *m = Rating{} }
+func (m *Rating) String() string { return proto.CompactTextString(m) }
+func (*Rating) ProtoMessage()    {}
+func (*Rating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{131}
+}
+
+func (m *Rating) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rating.Unmarshal(m, b)
+}
+func (m *Rating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rating.Marshal(b, m, deterministic)
+}
+func (m *Rating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rating.Merge(m, src)
+}
+func (m *Rating) XXX_Size() int {
+	return xxx_messageInfo_Rating.Size(m)
+}
+func (m *Rating) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rating.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rating proto.InternalMessageInfo
+
+func (m *Rating) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Rating) GetRating() float64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *Rating) GetDeviation() float64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *Rating) GetVolatility() float64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+func (m *Rating) GetGames() int32 {
+	if m != nil {
+		return m.Games
+	}
+	return 0
+}
+
+func (m *Rating) GetLastPlayedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastPlayedAt
+	}
+	return nil
+}
+
+type RatingHistoryEntry struct {
+	MatchId              string               `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Rating               float64              `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Deviation            float64              `protobuf:"fixed64,3,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility           float64              `protobuf:"fixed64,4,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	PlayedAt             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RatingHistoryEntry) Reset()         { *m = RatingHistoryEntry{} }
+func (m *RatingHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*RatingHistoryEntry) ProtoMessage()    {}
+func (*RatingHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{132}
+}
+
+func (m *RatingHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingHistoryEntry.Unmarshal(m, b)
+}
+func (m *RatingHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingHistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *RatingHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingHistoryEntry.Merge(m, src)
+}
+func (m *RatingHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_RatingHistoryEntry.Size(m)
+}
+func (m *RatingHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingHistoryEntry proto.InternalMessageInfo
+
+func (m *RatingHistoryEntry) GetMatchId() string {
+	if m != nil {
+		return m.MatchId
+	}
+	return ""
+}
+
+func (m *RatingHistoryEntry) GetRating() float64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *RatingHistoryEntry) GetDeviation() float64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *RatingHistoryEntry) GetVolatility() float64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+func (m *RatingHistoryEntry) GetPlayedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PlayedAt
+	}
+	return nil
+}
+
+type GetRatingRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	HistoryLimit         int32    `protobuf:"varint,2,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRatingRequest) Reset()         { *m = GetRatingRequest{} }
+func (m *GetRatingRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRatingRequest) ProtoMessage()    {}
+func (*GetRatingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{133}
+}
+
+func (m *GetRatingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRatingRequest.Unmarshal(m, b)
+}
+func (m *GetRatingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRatingRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRatingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRatingRequest.Merge(m, src)
+}
+func (m *GetRatingRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRatingRequest.Size(m)
+}
+func (m *GetRatingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRatingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRatingRequest proto.InternalMessageInfo
+
+func (m *GetRatingRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetRatingRequest) GetHistoryLimit() int32 {
+	if m != nil {
+		return m.HistoryLimit
+	}
+	return 0
+}
+
+type GetRatingResponse struct {
+	Result *Rating `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// history is ordered from the latest match
+	History              []*RatingHistoryEntry `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetRatingResponse) Reset()         { *m = GetRatingResponse{} }
+func (m *GetRatingResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRatingResponse) ProtoMessage()    {}
+func (*GetRatingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{134}
+}
+
+func (m *GetRatingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRatingResponse.Unmarshal(m, b)
+}
+func (m *GetRatingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRatingResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRatingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRatingResponse.Merge(m, src)
+}
+func (m *GetRatingResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRatingResponse.Size(m)
+}
+func (m *GetRatingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRatingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRatingResponse proto.InternalMessageInfo
+
+func (m *GetRatingResponse) GetResult() *Rating {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetRatingResponse) GetHistory() []*RatingHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type GetRatingsRequest struct {
+	UserIds              []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRatingsRequest) Reset()         { *m = GetRatingsRequest{} }
+func (m *GetRatingsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRatingsRequest) ProtoMessage()    {}
+func (*GetRatingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{135}
+}
+
+func (m *GetRatingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRatingsRequest.Unmarshal(m, b)
+}
+func (m *GetRatingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRatingsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRatingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRatingsRequest.Merge(m, src)
+}
+func (m *GetRatingsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRatingsRequest.Size(m)
+}
+func (m *GetRatingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRatingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRatingsRequest proto.InternalMessageInfo
+
+func (m *GetRatingsRequest) GetUserIds() []string {
+	if m != nil {
+		return m.UserIds
+	}
+	return nil
+}
+
+type GetRatingsResponse struct {
+	// results are in the order of the requested user ids
+	Results              []*Rating `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetRatingsResponse) Reset()         { *m = GetRatingsResponse{} }
+func (m *GetRatingsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRatingsResponse) ProtoMessage()    {}
+func (*GetRatingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{136}
+}
+
+func (m *GetRatingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRatingsResponse.Unmarshal(m, b)
+}
+func (m *GetRatingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRatingsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRatingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRatingsResponse.Merge(m, src)
+}
+func (m *GetRatingsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRatingsResponse.Size(m)
+}
+func (m *GetRatingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRatingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRatingsResponse proto.InternalMessageInfo
+
+func (m *GetRatingsResponse) GetResults() []*Rating {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type Achievement struct {
+	Id                   int32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Metric               LeaderboardMetric `protobuf:"varint,4,opt,name=metric,proto3,enum=service.LeaderboardMetric" json:"metric,omitempty"`
+	Scope                AchievementScope  `protobuf:"varint,5,opt,name=scope,proto3,enum=service.AchievementScope" json:"scope,omitempty"`
+	Threshold            int32             `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	RewardCoins          int32             `protobuf:"varint,7,opt,name=reward_coins,json=rewardCoins,proto3" json:"reward_coins,omitempty"`
+	RewardGems           int32             `protobuf:"varint,8,opt,name=reward_gems,json=rewardGems,proto3" json:"reward_gems,omitempty"`
+	RewardItemId         string            `protobuf:"bytes,9,opt,name=reward_item_id,json=rewardItemId,proto3" json:"reward_item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Achievement) Reset()         { *m = Achievement{} }
+func (m *Achievement) String() string { return proto.CompactTextString(m) }
+func (*Achievement) ProtoMessage()    {}
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{137}
+}
+
+func (m *Achievement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Achievement.Unmarshal(m, b)
+}
+func (m *Achievement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Achievement.Marshal(b, m, deterministic)
+}
+func (m *Achievement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Achievement.Merge(m, src)
+}
+func (m *Achievement) XXX_Size() int {
+	return xxx_messageInfo_Achievement.Size(m)
+}
+func (m *Achievement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Achievement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Achievement proto.InternalMessageInfo
+
+func (m *Achievement) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Achievement) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Achievement) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Achievement) GetMetric() LeaderboardMetric {
+	if m != nil {
+		return m.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (m *Achievement) GetScope() AchievementScope {
+	if m != nil {
+		return m.Scope
+	}
+	return AchievementScope_LIFETIME
+}
+
+func (m *Achievement) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Achievement) GetRewardCoins() int32 {
+	if m != nil {
+		return m.RewardCoins
+	}
+	return 0
+}
+
+func (m *Achievement) GetRewardGems() int32 {
+	if m != nil {
+		return m.RewardGems
+	}
+	return 0
+}
+
+func (m *Achievement) GetRewardItemId() string {
+	if m != nil {
+		return m.RewardItemId
+	}
+	return ""
+}
+
+type CreateAchievementRequest struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metric               LeaderboardMetric `protobuf:"varint,3,opt,name=metric,proto3,enum=service.LeaderboardMetric" json:"metric,omitempty"`
+	Scope                AchievementScope  `protobuf:"varint,4,opt,name=scope,proto3,enum=service.AchievementScope" json:"scope,omitempty"`
+	Threshold            int32             `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	RewardCoins          int32             `protobuf:"varint,6,opt,name=reward_coins,json=rewardCoins,proto3" json:"reward_coins,omitempty"`
+	RewardGems           int32             `protobuf:"varint,7,opt,name=reward_gems,json=rewardGems,proto3" json:"reward_gems,omitempty"`
+	RewardItemId         string            `protobuf:"bytes,8,opt,name=reward_item_id,json=rewardItemId,proto3" json:"reward_item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateAchievementRequest) Reset()         { *m = CreateAchievementRequest{} }
+func (m *CreateAchievementRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAchievementRequest) ProtoMessage()    {}
+func (*CreateAchievementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{138}
+}
+
+func (m *CreateAchievementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAchievementRequest.Unmarshal(m, b)
+}
+func (m *CreateAchievementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAchievementRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAchievementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAchievementRequest.Merge(m, src)
+}
+func (m *CreateAchievementRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAchievementRequest.Size(m)
+}
+func (m *CreateAchievementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAchievementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAchievementRequest proto.InternalMessageInfo
+
+func (m *CreateAchievementRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAchievementRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateAchievementRequest) GetMetric() LeaderboardMetric {
+	if m != nil {
+		return m.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (m *CreateAchievementRequest) GetScope() AchievementScope {
+	if m != nil {
+		return m.Scope
+	}
+	return AchievementScope_LIFETIME
+}
+
+func (m *CreateAchievementRequest) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *CreateAchievementRequest) GetRewardCoins() int32 {
+	if m != nil {
+		return m.RewardCoins
+	}
+	return 0
+}
+
+func (m *CreateAchievementRequest) GetRewardGems() int32 {
+	if m != nil {
+		return m.RewardGems
+	}
+	return 0
+}
+
+func (m *CreateAchievementRequest) GetRewardItemId() string {
+	if m != nil {
+		return m.RewardItemId
+	}
+	return ""
+}
+
+type CreateAchievementResponse struct {
+	Result               *Achievement `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateAchievementResponse) Reset()         { *m = CreateAchievementResponse{} }
+func (m *CreateAchievementResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAchievementResponse) ProtoMessage()    {}
+func (*CreateAchievementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{139}
+}
+
+func (m *CreateAchievementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAchievementResponse.Unmarshal(m, b)
+}
+func (m *CreateAchievementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAchievementResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAchievementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAchievementResponse.Merge(m, src)
+}
+func (m *CreateAchievementResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAchievementResponse.Size(m)
+}
+func (m *CreateAchievementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAchievementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAchievementResponse proto.InternalMessageInfo
+
+func (m *CreateAchievementResponse) GetResult() *Achievement {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteAchievementRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAchievementRequest) Reset()         { *m = DeleteAchievementRequest{} }
+func (m *DeleteAchievementRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAchievementRequest) ProtoMessage()    {}
+func (*DeleteAchievementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{140}
+}
+
+func (m *DeleteAchievementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAchievementRequest.Unmarshal(m, b)
+}
+func (m *DeleteAchievementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAchievementRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAchievementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAchievementRequest.Merge(m, src)
+}
+func (m *DeleteAchievementRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAchievementRequest.Size(m)
+}
+func (m *DeleteAchievementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAchievementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAchievementRequest proto.InternalMessageInfo
+
+func (m *DeleteAchievementRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteAchievementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAchievementResponse) Reset()         { *m = DeleteAchievementResponse{} }
+func (m *DeleteAchievementResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAchievementResponse) ProtoMessage()    {}
+func (*DeleteAchievementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{141}
+}
+
+func (m *DeleteAchievementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAchievementResponse.Unmarshal(m, b)
+}
+func (m *DeleteAchievementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAchievementResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAchievementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAchievementResponse.Merge(m, src)
+}
+func (m *DeleteAchievementResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAchievementResponse.Size(m)
+}
+func (m *DeleteAchievementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAchievementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAchievementResponse proto.InternalMessageInfo
+
+type ListAchievementsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAchievementsRequest) Reset()         { *m = ListAchievementsRequest{} }
+func (m *ListAchievementsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAchievementsRequest) ProtoMessage()    {}
+func (*ListAchievementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{142}
+}
+
+func (m *ListAchievementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAchievementsRequest.Unmarshal(m, b)
+}
+func (m *ListAchievementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAchievementsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAchievementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAchievementsRequest.Merge(m, src)
+}
+func (m *ListAchievementsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAchievementsRequest.Size(m)
+}
+func (m *ListAchievementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAchievementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAchievementsRequest proto.InternalMessageInfo
+
+type ListAchievementsResponse struct {
+	Results              []*Achievement `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListAchievementsResponse) Reset()         { *m = ListAchievementsResponse{} }
+func (m *ListAchievementsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAchievementsResponse) ProtoMessage()    {}
+func (*ListAchievementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{143}
+}
+
+func (m *ListAchievementsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAchievementsResponse.Unmarshal(m, b)
+}
+func (m *ListAchievementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAchievementsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAchievementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAchievementsResponse.Merge(m, src)
+}
+func (m *ListAchievementsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAchievementsResponse.Size(m)
+}
+func (m *ListAchievementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAchievementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAchievementsResponse proto.InternalMessageInfo
+
+func (m *ListAchievementsResponse) GetResults() []*Achievement {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type UserAchievement struct {
+	Achievement          *Achievement         `protobuf:"bytes,1,opt,name=achievement,proto3" json:"achievement,omitempty"`
+	Progress             int32                `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	Unlocked             bool                 `protobuf:"varint,3,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserAchievement) Reset()         { *m = UserAchievement{} }
+func (m *UserAchievement) String() string { return proto.CompactTextString(m) }
+func (*UserAchievement) ProtoMessage()    {}
+func (*UserAchievement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{144}
+}
+
+func (m *UserAchievement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserAchievement.Unmarshal(m, b)
+}
+func (m *UserAchievement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserAchievement.Marshal(b, m, deterministic)
+}
+func (m *UserAchievement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserAchievement.Merge(m, src)
+}
+func (m *UserAchievement) XXX_Size() int {
+	return xxx_messageInfo_UserAchievement.Size(m)
+}
+func (m *UserAchievement) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserAchievement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserAchievement proto.InternalMessageInfo
+
+func (m *UserAchievement) GetAchievement() *Achievement {
+	if m != nil {
+		return m.Achievement
+	}
+	return nil
+}
+
+func (m *UserAchievement) GetProgress() int32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *UserAchievement) GetUnlocked() bool {
+	if m != nil {
+		return m.Unlocked
+	}
+	return false
+}
+
+func (m *UserAchievement) GetUnlockedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnlockedAt
+	}
+	return nil
+}
+
+type GetUserAchievementsRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserAchievementsRequest) Reset()         { *m = GetUserAchievementsRequest{} }
+func (m *GetUserAchievementsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserAchievementsRequest) ProtoMessage()    {}
+func (*GetUserAchievementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{145}
+}
+
+func (m *GetUserAchievementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserAchievementsRequest.Unmarshal(m, b)
+}
+func (m *GetUserAchievementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserAchievementsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUserAchievementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserAchievementsRequest.Merge(m, src)
+}
+func (m *GetUserAchievementsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserAchievementsRequest.Size(m)
+}
+func (m *GetUserAchievementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserAchievementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserAchievementsRequest proto.InternalMessageInfo
+
+func (m *GetUserAchievementsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetUserAchievementsResponse struct {
+	// results list every achievement, the ones without progress yet included
+	Results              []*UserAchievement `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetUserAchievementsResponse) Reset()         { *m = GetUserAchievementsResponse{} }
+func (m *GetUserAchievementsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserAchievementsResponse) ProtoMessage()    {}
+func (*GetUserAchievementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{146}
+}
+
+func (m *GetUserAchievementsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserAchievementsResponse.Unmarshal(m, b)
+}
+func (m *GetUserAchievementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserAchievementsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUserAchievementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserAchievementsResponse.Merge(m, src)
+}
+func (m *GetUserAchievementsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUserAchievementsResponse.Size(m)
+}
+func (m *GetUserAchievementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserAchievementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserAchievementsResponse proto.InternalMessageInfo
+
+func (m *GetUserAchievementsResponse) GetResults() []*UserAchievement {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type News struct {
+	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Title       string               `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageLink   string               `protobuf:"bytes,5,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
+	Status      NewsStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=service.NewsStatus" json:"status,omitempty"`
+	// publish_at is when a scheduled news goes live
+	PublishAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	PublishedAt          *timestamp.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *News) Reset()         { *m = News{} }
+func (m *News) String() string { return proto.CompactTextString(m) }
+func (*News) ProtoMessage()    {}
+func (*News) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{147}
+}
+
+func (m *News) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_News.Unmarshal(m, b)
+}
+func (m *News) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_News.Marshal(b, m, deterministic)
+}
+func (m *News) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_News.Merge(m, src)
+}
+func (m *News) XXX_Size() int {
+	return xxx_messageInfo_News.Size(m)
+}
+func (m *News) XXX_DiscardUnknown() {
+	xxx_messageInfo_News.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_News proto.InternalMessageInfo
+
+func (m *News) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *News) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *News) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *News) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *News) GetImageLink() string {
+	if m != nil {
+		return m.ImageLink
+	}
+	return ""
+}
+
+func (m *News) GetStatus() NewsStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NewsStatus_DRAFT
+}
+
+func (m *News) GetPublishAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishAt
+	}
+	return nil
+}
+
+func (m *News) GetPublishedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return nil
+}
+
+type CreateNewsRequest struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ImageLink   string `protobuf:"bytes,3,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
+	// publish_at schedules the news, it's published right away when in the past and kept as a draft
+	// when neither publish_at nor publish is set
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// publish makes the news visible right away
+	Publish              bool     `protobuf:"varint,5,opt,name=publish,proto3" json:"publish,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateNewsRequest) Reset()         { *m = CreateNewsRequest{} }
+func (m *CreateNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateNewsRequest) ProtoMessage()    {}
+func (*CreateNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{148}
+}
+
+func (m *CreateNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateNewsRequest.Unmarshal(m, b)
+}
+func (m *CreateNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNewsRequest.Merge(m, src)
+}
+func (m *CreateNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateNewsRequest.Size(m)
+}
+func (m *CreateNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateNewsRequest proto.InternalMessageInfo
+
+func (m *CreateNewsRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateNewsRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateNewsRequest) GetImageLink() string {
+	if m != nil {
+		return m.ImageLink
+	}
+	return ""
+}
+
+func (m *CreateNewsRequest) GetPublishAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishAt
+	}
+	return nil
+}
+
+func (m *CreateNewsRequest) GetPublish() bool {
+	if m != nil {
+		return m.Publish
+	}
+	return false
+}
+
+type CreateNewsResponse struct {
+	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateNewsResponse) Reset()         { *m = CreateNewsResponse{} }
+func (m *CreateNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateNewsResponse) ProtoMessage()    {}
+func (*CreateNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{149}
+}
+
+func (m *CreateNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateNewsResponse.Unmarshal(m, b)
+}
+func (m *CreateNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNewsResponse.Merge(m, src)
+}
+func (m *CreateNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateNewsResponse.Size(m)
+}
+func (m *CreateNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateNewsResponse proto.InternalMessageInfo
+
+func (m *CreateNewsResponse) GetResult() *News {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ReadNewsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadNewsRequest) Reset()         { *m = ReadNewsRequest{} }
+func (m *ReadNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadNewsRequest) ProtoMessage()    {}
+func (*ReadNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{150}
+}
+
+func (m *ReadNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadNewsRequest.Unmarshal(m, b)
+}
+func (m *ReadNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadNewsRequest.Merge(m, src)
+}
+func (m *ReadNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadNewsRequest.Size(m)
+}
+func (m *ReadNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadNewsRequest proto.InternalMessageInfo
+
+func (m *ReadNewsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ReadNewsResponse struct {
+	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadNewsResponse) Reset()         { *m = ReadNewsResponse{} }
+func (m *ReadNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadNewsResponse) ProtoMessage()    {}
+func (*ReadNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{151}
+}
+
+func (m *ReadNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadNewsResponse.Unmarshal(m, b)
+}
+func (m *ReadNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadNewsResponse.Merge(m, src)
+}
+func (m *ReadNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadNewsResponse.Size(m)
+}
+func (m *ReadNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadNewsResponse proto.InternalMessageInfo
+
+func (m *ReadNewsResponse) GetResult() *News {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateNewsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageLink            string   `protobuf:"bytes,4,opt,name=image_link,json=imageLink,proto3" json:"image_link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateNewsRequest) Reset()         { *m = UpdateNewsRequest{} }
+func (m *UpdateNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNewsRequest) ProtoMessage()    {}
+func (*UpdateNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{152}
+}
+
+func (m *UpdateNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNewsRequest.Unmarshal(m, b)
+}
+func (m *UpdateNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNewsRequest.Merge(m, src)
+}
+func (m *UpdateNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateNewsRequest.Size(m)
+}
+func (m *UpdateNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNewsRequest proto.InternalMessageInfo
+
+func (m *UpdateNewsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateNewsRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateNewsRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateNewsRequest) GetImageLink() string {
+	if m != nil {
+		return m.ImageLink
+	}
+	return ""
+}
+
+type UpdateNewsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateNewsResponse) Reset()         { *m = UpdateNewsResponse{} }
+func (m *UpdateNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNewsResponse) ProtoMessage()    {}
+func (*UpdateNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{153}
+}
+
+func (m *UpdateNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNewsResponse.Unmarshal(m, b)
+}
+func (m *UpdateNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNewsResponse.Merge(m, src)
+}
+func (m *UpdateNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateNewsResponse.Size(m)
+}
+func (m *UpdateNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNewsResponse proto.InternalMessageInfo
+
+type DeleteNewsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNewsRequest) Reset()         { *m = DeleteNewsRequest{} }
+func (m *DeleteNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNewsRequest) ProtoMessage()    {}
+func (*DeleteNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{154}
+}
+
+func (m *DeleteNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNewsRequest.Unmarshal(m, b)
+}
+func (m *DeleteNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNewsRequest.Merge(m, src)
+}
+func (m *DeleteNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteNewsRequest.Size(m)
+}
+func (m *DeleteNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNewsRequest proto.InternalMessageInfo
+
+func (m *DeleteNewsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteNewsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNewsResponse) Reset()         { *m = DeleteNewsResponse{} }
+func (m *DeleteNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteNewsResponse) ProtoMessage()    {}
+func (*DeleteNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{155}
+}
+
+func (m *DeleteNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNewsResponse.Unmarshal(m, b)
+}
+func (m *DeleteNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNewsResponse.Merge(m, src)
+}
+func (m *DeleteNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteNewsResponse.Size(m)
+}
+func (m *DeleteNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNewsResponse proto.InternalMessageInfo
+
+type PublishNewsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// publish_at schedules the news, it's published right away when empty or in the past
+	PublishAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PublishNewsRequest) Reset()         { *m = PublishNewsRequest{} }
+func (m *PublishNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishNewsRequest) ProtoMessage()    {}
+func (*PublishNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{156}
+}
+
+func (m *PublishNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishNewsRequest.Unmarshal(m, b)
+}
+func (m *PublishNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishNewsRequest.Merge(m, src)
+}
+func (m *PublishNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishNewsRequest.Size(m)
+}
+func (m *PublishNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishNewsRequest proto.InternalMessageInfo
+
+func (m *PublishNewsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PublishNewsRequest) GetPublishAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishAt
+	}
+	return nil
+}
+
+type PublishNewsResponse struct {
+	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishNewsResponse) Reset()         { *m = PublishNewsResponse{} }
+func (m *PublishNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishNewsResponse) ProtoMessage()    {}
+func (*PublishNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{157}
+}
+
+func (m *PublishNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishNewsResponse.Unmarshal(m, b)
+}
+func (m *PublishNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishNewsResponse.Merge(m, src)
+}
+func (m *PublishNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishNewsResponse.Size(m)
+}
+func (m *PublishNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishNewsResponse proto.InternalMessageInfo
+
+func (m *PublishNewsResponse) GetResult() *News {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UnpublishNewsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// archive moves the news to the archive instead of back to drafts
+	Archive              bool     `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpublishNewsRequest) Reset()         { *m = UnpublishNewsRequest{} }
+func (m *UnpublishNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpublishNewsRequest) ProtoMessage()    {}
+func (*UnpublishNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{158}
+}
+
+func (m *UnpublishNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpublishNewsRequest.Unmarshal(m, b)
+}
+func (m *UnpublishNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpublishNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpublishNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpublishNewsRequest.Merge(m, src)
+}
+func (m *UnpublishNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpublishNewsRequest.Size(m)
+}
+func (m *UnpublishNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpublishNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpublishNewsRequest proto.InternalMessageInfo
+
+func (m *UnpublishNewsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UnpublishNewsRequest) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+type UnpublishNewsResponse struct {
+	Result               *News    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpublishNewsResponse) Reset()         { *m = UnpublishNewsResponse{} }
+func (m *UnpublishNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpublishNewsResponse) ProtoMessage()    {}
+func (*UnpublishNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{159}
+}
+
+func (m *UnpublishNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpublishNewsResponse.Unmarshal(m, b)
+}
+func (m *UnpublishNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpublishNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *UnpublishNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpublishNewsResponse.Merge(m, src)
+}
+func (m *UnpublishNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_UnpublishNewsResponse.Size(m)
+}
+func (m *UnpublishNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpublishNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpublishNewsResponse proto.InternalMessageInfo
+
+func (m *UnpublishNewsResponse) GetResult() *News {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// ListNewsRequest lists published news, editors get news in every status
+type ListNewsRequest struct {
+	Filter               *query.Filtering      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy              *query.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Fields               *query.FieldSelection `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	Paging               *query.Pagination     `protobuf:"bytes,4,opt,name=paging,proto3" json:"paging,omitempty"`
+	Locale               string                `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListNewsRequest) Reset()         { *m = ListNewsRequest{} }
+func (m *ListNewsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNewsRequest) ProtoMessage()    {}
+func (*ListNewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{160}
+}
+
+func (m *ListNewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNewsRequest.Unmarshal(m, b)
+}
+func (m *ListNewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNewsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNewsRequest.Merge(m, src)
+}
+func (m *ListNewsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNewsRequest.Size(m)
+}
+func (m *ListNewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNewsRequest proto.InternalMessageInfo
+
+func (m *ListNewsRequest) GetFilter() *query.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListNewsRequest) GetOrderBy() *query.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListNewsRequest) GetFields() *query.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListNewsRequest) GetPaging() *query.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *ListNewsRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type ListNewsResponse struct {
+	Results              []*News         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page                 *query.PageInfo `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Locale               string          `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListNewsResponse) Reset()         { *m = ListNewsResponse{} }
+func (m *ListNewsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNewsResponse) ProtoMessage()    {}
+func (*ListNewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{161}
+}
+
+func (m *ListNewsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNewsResponse.Unmarshal(m, b)
+}
+func (m *ListNewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNewsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListNewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNewsResponse.Merge(m, src)
+}
+func (m *ListNewsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNewsResponse.Size(m)
+}
+func (m *ListNewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNewsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNewsResponse proto.InternalMessageInfo
+
+func (m *ListNewsResponse) GetResults() []*News {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ListNewsResponse) GetPage() *query.PageInfo {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListNewsResponse) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type NewsTranslation struct {
+	NewsId               string   `protobuf:"bytes,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NewsTranslation) Reset()         { *m = NewsTranslation{} }
+func (m *NewsTranslation) String() string { return proto.CompactTextString(m) }
+func (*NewsTranslation) ProtoMessage()    {}
+func (*NewsTranslation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{162}
+}
+
+func (m *NewsTranslation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewsTranslation.Unmarshal(m, b)
+}
+func (m *NewsTranslation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewsTranslation.Marshal(b, m, deterministic)
+}
+func (m *NewsTranslation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewsTranslation.Merge(m, src)
+}
+func (m *NewsTranslation) XXX_Size() int {
+	return xxx_messageInfo_NewsTranslation.Size(m)
+}
+func (m *NewsTranslation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewsTranslation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewsTranslation proto.InternalMessageInfo
+
+func (m *NewsTranslation) GetNewsId() string {
+	if m != nil {
+		return m.NewsId
+	}
+	return ""
+}
+
+func (m *NewsTranslation) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *NewsTranslation) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *NewsTranslation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SetNewsTranslationRequest struct {
+	NewsId               string   `protobuf:"bytes,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetNewsTranslationRequest) Reset()         { *m = SetNewsTranslationRequest{} }
+func (m *SetNewsTranslationRequest) String() string { return proto.CompactTextString(m) }
+func (*SetNewsTranslationRequest) ProtoMessage()    {}
+func (*SetNewsTranslationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{163}
+}
+
+func (m *SetNewsTranslationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNewsTranslationRequest.Unmarshal(m, b)
+}
+func (m *SetNewsTranslationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNewsTranslationRequest.Marshal(b, m, deterministic)
+}
+func (m *SetNewsTranslationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNewsTranslationRequest.Merge(m, src)
+}
+func (m *SetNewsTranslationRequest) XXX_Size() int {
+	return xxx_messageInfo_SetNewsTranslationRequest.Size(m)
+}
+func (m *SetNewsTranslationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNewsTranslationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNewsTranslationRequest proto.InternalMessageInfo
+
+func (m *SetNewsTranslationRequest) GetNewsId() string {
+	if m != nil {
+		return m.NewsId
+	}
+	return ""
+}
+
+func (m *SetNewsTranslationRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *SetNewsTranslationRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetNewsTranslationRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SetNewsTranslationResponse struct {
+	Result               *NewsTranslation `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetNewsTranslationResponse) Reset()         { *m = SetNewsTranslationResponse{} }
+func (m *SetNewsTranslationResponse) String() string { return proto.CompactTextString(m) }
+func (*SetNewsTranslationResponse) ProtoMessage()    {}
+func (*SetNewsTranslationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{164}
+}
+
+func (m *SetNewsTranslationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNewsTranslationResponse.Unmarshal(m, b)
+}
+func (m *SetNewsTranslationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNewsTranslationResponse.Marshal(b, m, deterministic)
+}
+func (m *SetNewsTranslationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNewsTranslationResponse.Merge(m, src)
+}
+func (m *SetNewsTranslationResponse) XXX_Size() int {
+	return xxx_messageInfo_SetNewsTranslationResponse.Size(m)
+}
+func (m *SetNewsTranslationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNewsTranslationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNewsTranslationResponse proto.InternalMessageInfo
+
+func (m *SetNewsTranslationResponse) GetResult() *NewsTranslation {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteNewsTranslationRequest struct {
+	NewsId               string   `protobuf:"bytes,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNewsTranslationRequest) Reset()         { *m = DeleteNewsTranslationRequest{} }
+func (m *DeleteNewsTranslationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNewsTranslationRequest) ProtoMessage()    {}
+func (*DeleteNewsTranslationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{165}
+}
+
+func (m *DeleteNewsTranslationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNewsTranslationRequest.Unmarshal(m, b)
+}
+func (m *DeleteNewsTranslationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNewsTranslationRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteNewsTranslationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNewsTranslationRequest.Merge(m, src)
+}
+func (m *DeleteNewsTranslationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteNewsTranslationRequest.Size(m)
+}
+func (m *DeleteNewsTranslationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNewsTranslationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNewsTranslationRequest proto.InternalMessageInfo
+
+func (m *DeleteNewsTranslationRequest) GetNewsId() string {
+	if m != nil {
+		return m.NewsId
+	}
+	return ""
+}
+
+func (m *DeleteNewsTranslationRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type DeleteNewsTranslationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNewsTranslationResponse) Reset()         { *m = DeleteNewsTranslationResponse{} }
+func (m *DeleteNewsTranslationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteNewsTranslationResponse) ProtoMessage()    {}
+func (*DeleteNewsTranslationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{166}
+}
+
+func (m *DeleteNewsTranslationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNewsTranslationResponse.Unmarshal(m, b)
+}
+func (m *DeleteNewsTranslationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNewsTranslationResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteNewsTranslationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNewsTranslationResponse.Merge(m, src)
+}
+func (m *DeleteNewsTranslationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteNewsTranslationResponse.Size(m)
+}
+func (m *DeleteNewsTranslationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNewsTranslationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNewsTranslationResponse proto.InternalMessageInfo
+
+type StorefrontSlot struct {
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemsCount           int32                  `protobuf:"varint,3,opt,name=items_count,json=itemsCount,proto3" json:"items_count,omitempty"`
+	Pool                 []*StorefrontPoolEntry `protobuf:"bytes,4,rep,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *StorefrontSlot) Reset()         { *m = StorefrontSlot{} }
+func (m *StorefrontSlot) String() string { return proto.CompactTextString(m) }
+func (*StorefrontSlot) ProtoMessage()    {}
+func (*StorefrontSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{167}
+}
+
+func (m *StorefrontSlot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorefrontSlot.Unmarshal(m, b)
+}
+func (m *StorefrontSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorefrontSlot.Marshal(b, m, deterministic)
+}
+func (m *StorefrontSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorefrontSlot.Merge(m, src)
+}
+func (m *StorefrontSlot) XXX_Size() int {
+	return xxx_messageInfo_StorefrontSlot.Size(m)
+}
+func (m *StorefrontSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorefrontSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorefrontSlot proto.InternalMessageInfo
+
+func (m *StorefrontSlot) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorefrontSlot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorefrontSlot) GetItemsCount() int32 {
+	if m != nil {
+		return m.ItemsCount
+	}
+	return 0
+}
+
+func (m *StorefrontSlot) GetPool() []*StorefrontPoolEntry {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type StorefrontPoolEntry struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId               string   `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Weight               int32    `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorefrontPoolEntry) Reset()         { *m = StorefrontPoolEntry{} }
+func (m *StorefrontPoolEntry) String() string { return proto.CompactTextString(m) }
+func (*StorefrontPoolEntry) ProtoMessage()    {}
+func (*StorefrontPoolEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{168}
+}
+
+func (m *StorefrontPoolEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorefrontPoolEntry.Unmarshal(m, b)
+}
+func (m *StorefrontPoolEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorefrontPoolEntry.Marshal(b, m, deterministic)
+}
+func (m *StorefrontPoolEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorefrontPoolEntry.Merge(m, src)
+}
+func (m *StorefrontPoolEntry) XXX_Size() int {
+	return xxx_messageInfo_StorefrontPoolEntry.Size(m)
+}
+func (m *StorefrontPoolEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorefrontPoolEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorefrontPoolEntry proto.InternalMessageInfo
+
+func (m *StorefrontPoolEntry) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorefrontPoolEntry) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *StorefrontPoolEntry) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type StorefrontRotationSlot struct {
+	SlotId               int32        `protobuf:"varint,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items                []*StoreItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StorefrontRotationSlot) Reset()         { *m = StorefrontRotationSlot{} }
+func (m *StorefrontRotationSlot) String() string { return proto.CompactTextString(m) }
+func (*StorefrontRotationSlot) ProtoMessage()    {}
+func (*StorefrontRotationSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{169}
+}
+
+func (m *StorefrontRotationSlot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorefrontRotationSlot.Unmarshal(m, b)
+}
+func (m *StorefrontRotationSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorefrontRotationSlot.Marshal(b, m, deterministic)
+}
+func (m *StorefrontRotationSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorefrontRotationSlot.Merge(m, src)
+}
+func (m *StorefrontRotationSlot) XXX_Size() int {
+	return xxx_messageInfo_StorefrontRotationSlot.Size(m)
+}
+func (m *StorefrontRotationSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorefrontRotationSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorefrontRotationSlot proto.InternalMessageInfo
+
+func (m *StorefrontRotationSlot) GetSlotId() int32 {
+	if m != nil {
+		return m.SlotId
+	}
+	return 0
+}
+
+func (m *StorefrontRotationSlot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorefrontRotationSlot) GetItems() []*StoreItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type GetStorefrontRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorefrontRequest) Reset()         { *m = GetStorefrontRequest{} }
+func (m *GetStorefrontRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorefrontRequest) ProtoMessage()    {}
+func (*GetStorefrontRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{170}
+}
+
+func (m *GetStorefrontRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorefrontRequest.Unmarshal(m, b)
+}
+func (m *GetStorefrontRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorefrontRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStorefrontRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorefrontRequest.Merge(m, src)
+}
+func (m *GetStorefrontRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorefrontRequest.Size(m)
+}
+func (m *GetStorefrontRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorefrontRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorefrontRequest proto.InternalMessageInfo
+
+type GetStorefrontResponse struct {
+	Day                  string                    `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Slots                []*StorefrontRotationSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	NextResetAt          *timestamp.Timestamp      `protobuf:"bytes,3,opt,name=next_reset_at,json=nextResetAt,proto3" json:"next_reset_at,omitempty"`
+	SecondsUntilReset    int64                     `protobuf:"varint,4,opt,name=seconds_until_reset,json=secondsUntilReset,proto3" json:"seconds_until_reset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetStorefrontResponse) Reset()         { *m = GetStorefrontResponse{} }
+func (m *GetStorefrontResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStorefrontResponse) ProtoMessage()    {}
+func (*GetStorefrontResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{171}
+}
+
+func (m *GetStorefrontResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorefrontResponse.Unmarshal(m, b)
+}
+func (m *GetStorefrontResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorefrontResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStorefrontResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorefrontResponse.Merge(m, src)
+}
+func (m *GetStorefrontResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStorefrontResponse.Size(m)
+}
+func (m *GetStorefrontResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorefrontResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorefrontResponse proto.InternalMessageInfo
+
+func (m *GetStorefrontResponse) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *GetStorefrontResponse) GetSlots() []*StorefrontRotationSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *GetStorefrontResponse) GetNextResetAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextResetAt
+	}
+	return nil
+}
+
+func (m *GetStorefrontResponse) GetSecondsUntilReset() int64 {
+	if m != nil {
+		return m.SecondsUntilReset
+	}
+	return 0
+}
+
+type PreviewStorefrontRequest struct {
+	Day                  string   `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewStorefrontRequest) Reset()         { *m = PreviewStorefrontRequest{} }
+func (m *PreviewStorefrontRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewStorefrontRequest) ProtoMessage()    {}
+func (*PreviewStorefrontRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{172}
+}
+
+func (m *PreviewStorefrontRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewStorefrontRequest.Unmarshal(m, b)
+}
+func (m *PreviewStorefrontRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewStorefrontRequest.Marshal(b, m, deterministic)
+}
+func (m *PreviewStorefrontRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewStorefrontRequest.Merge(m, src)
+}
+func (m *PreviewStorefrontRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewStorefrontRequest.Size(m)
+}
+func (m *PreviewStorefrontRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewStorefrontRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewStorefrontRequest proto.InternalMessageInfo
+
+func (m *PreviewStorefrontRequest) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+type PreviewStorefrontResponse struct {
+	Day                  string                    `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Slots                []*StorefrontRotationSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PreviewStorefrontResponse) Reset()         { *m = PreviewStorefrontResponse{} }
+func (m *PreviewStorefrontResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewStorefrontResponse) ProtoMessage()    {}
+func (*PreviewStorefrontResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{173}
+}
+
+func (m *PreviewStorefrontResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewStorefrontResponse.Unmarshal(m, b)
+}
+func (m *PreviewStorefrontResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewStorefrontResponse.Marshal(b, m, deterministic)
+}
+func (m *PreviewStorefrontResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewStorefrontResponse.Merge(m, src)
+}
+func (m *PreviewStorefrontResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewStorefrontResponse.Size(m)
+}
+func (m *PreviewStorefrontResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewStorefrontResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewStorefrontResponse proto.InternalMessageInfo
+
+func (m *PreviewStorefrontResponse) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *PreviewStorefrontResponse) GetSlots() []*StorefrontRotationSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type CreateStorefrontSlotRequest struct {
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ItemsCount           int32                  `protobuf:"varint,2,opt,name=items_count,json=itemsCount,proto3" json:"items_count,omitempty"`
+	Pool                 []*StorefrontPoolEntry `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CreateStorefrontSlotRequest) Reset()         { *m = CreateStorefrontSlotRequest{} }
+func (m *CreateStorefrontSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStorefrontSlotRequest) ProtoMessage()    {}
+func (*CreateStorefrontSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{174}
+}
+
+func (m *CreateStorefrontSlotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStorefrontSlotRequest.Unmarshal(m, b)
+}
+func (m *CreateStorefrontSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStorefrontSlotRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateStorefrontSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStorefrontSlotRequest.Merge(m, src)
+}
+func (m *CreateStorefrontSlotRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateStorefrontSlotRequest.Size(m)
+}
+func (m *CreateStorefrontSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStorefrontSlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStorefrontSlotRequest proto.InternalMessageInfo
+
+func (m *CreateStorefrontSlotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateStorefrontSlotRequest) GetItemsCount() int32 {
+	if m != nil {
+		return m.ItemsCount
+	}
+	return 0
+}
+
+func (m *CreateStorefrontSlotRequest) GetPool() []*StorefrontPoolEntry {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type CreateStorefrontSlotResponse struct {
+	Result               *StorefrontSlot `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateStorefrontSlotResponse) Reset()         { *m = CreateStorefrontSlotResponse{} }
+func (m *CreateStorefrontSlotResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateStorefrontSlotResponse) ProtoMessage()    {}
+func (*CreateStorefrontSlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{175}
+}
+
+func (m *CreateStorefrontSlotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStorefrontSlotResponse.Unmarshal(m, b)
+}
+func (m *CreateStorefrontSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStorefrontSlotResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateStorefrontSlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStorefrontSlotResponse.Merge(m, src)
+}
+func (m *CreateStorefrontSlotResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateStorefrontSlotResponse.Size(m)
+}
+func (m *CreateStorefrontSlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStorefrontSlotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStorefrontSlotResponse proto.InternalMessageInfo
+
+func (m *CreateStorefrontSlotResponse) GetResult() *StorefrontSlot {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateStorefrontSlotRequest struct {
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ItemsCount           int32                  `protobuf:"varint,3,opt,name=items_count,json=itemsCount,proto3" json:"items_count,omitempty"`
+	Pool                 []*StorefrontPoolEntry `protobuf:"bytes,4,rep,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpdateStorefrontSlotRequest) Reset()         { *m = UpdateStorefrontSlotRequest{} }
+func (m *UpdateStorefrontSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateStorefrontSlotRequest) ProtoMessage()    {}
+func (*UpdateStorefrontSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{176}
+}
+
+func (m *UpdateStorefrontSlotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStorefrontSlotRequest.Unmarshal(m, b)
+}
+func (m *UpdateStorefrontSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStorefrontSlotRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateStorefrontSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStorefrontSlotRequest.Merge(m, src)
+}
+func (m *UpdateStorefrontSlotRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateStorefrontSlotRequest.Size(m)
+}
+func (m *UpdateStorefrontSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStorefrontSlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStorefrontSlotRequest proto.InternalMessageInfo
+
+func (m *UpdateStorefrontSlotRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateStorefrontSlotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateStorefrontSlotRequest) GetItemsCount() int32 {
+	if m != nil {
+		return m.ItemsCount
+	}
+	return 0
+}
+
+func (m *UpdateStorefrontSlotRequest) GetPool() []*StorefrontPoolEntry {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type UpdateStorefrontSlotResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateStorefrontSlotResponse) Reset()         { *m = UpdateStorefrontSlotResponse{} }
+func (m *UpdateStorefrontSlotResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateStorefrontSlotResponse) ProtoMessage()    {}
+func (*UpdateStorefrontSlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{177}
+}
+
+func (m *UpdateStorefrontSlotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStorefrontSlotResponse.Unmarshal(m, b)
+}
+func (m *UpdateStorefrontSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStorefrontSlotResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateStorefrontSlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStorefrontSlotResponse.Merge(m, src)
+}
+func (m *UpdateStorefrontSlotResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateStorefrontSlotResponse.Size(m)
+}
+func (m *UpdateStorefrontSlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStorefrontSlotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStorefrontSlotResponse proto.InternalMessageInfo
+
+type DeleteStorefrontSlotRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteStorefrontSlotRequest) Reset()         { *m = DeleteStorefrontSlotRequest{} }
+func (m *DeleteStorefrontSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteStorefrontSlotRequest) ProtoMessage()    {}
+func (*DeleteStorefrontSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{178}
+}
+
+func (m *DeleteStorefrontSlotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteStorefrontSlotRequest.Unmarshal(m, b)
+}
+func (m *DeleteStorefrontSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteStorefrontSlotRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteStorefrontSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStorefrontSlotRequest.Merge(m, src)
+}
+func (m *DeleteStorefrontSlotRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteStorefrontSlotRequest.Size(m)
+}
+func (m *DeleteStorefrontSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStorefrontSlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStorefrontSlotRequest proto.InternalMessageInfo
+
+func (m *DeleteStorefrontSlotRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteStorefrontSlotResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteStorefrontSlotResponse) Reset()         { *m = DeleteStorefrontSlotResponse{} }
+func (m *DeleteStorefrontSlotResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteStorefrontSlotResponse) ProtoMessage()    {}
+func (*DeleteStorefrontSlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{179}
+}
+
+func (m *DeleteStorefrontSlotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteStorefrontSlotResponse.Unmarshal(m, b)
+}
+func (m *DeleteStorefrontSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteStorefrontSlotResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteStorefrontSlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStorefrontSlotResponse.Merge(m, src)
+}
+func (m *DeleteStorefrontSlotResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteStorefrontSlotResponse.Size(m)
+}
+func (m *DeleteStorefrontSlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStorefrontSlotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStorefrontSlotResponse proto.InternalMessageInfo
+
+type ListStorefrontSlotsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStorefrontSlotsRequest) Reset()         { *m = ListStorefrontSlotsRequest{} }
+func (m *ListStorefrontSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStorefrontSlotsRequest) ProtoMessage()    {}
+func (*ListStorefrontSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{180}
+}
+
+func (m *ListStorefrontSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStorefrontSlotsRequest.Unmarshal(m, b)
+}
+func (m *ListStorefrontSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStorefrontSlotsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListStorefrontSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStorefrontSlotsRequest.Merge(m, src)
+}
+func (m *ListStorefrontSlotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStorefrontSlotsRequest.Size(m)
+}
+func (m *ListStorefrontSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStorefrontSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStorefrontSlotsRequest proto.InternalMessageInfo
+
+type ListStorefrontSlotsResponse struct {
+	Results              []*StorefrontSlot `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListStorefrontSlotsResponse) Reset()         { *m = ListStorefrontSlotsResponse{} }
+func (m *ListStorefrontSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStorefrontSlotsResponse) ProtoMessage()    {}
+func (*ListStorefrontSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{181}
+}
+
+func (m *ListStorefrontSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStorefrontSlotsResponse.Unmarshal(m, b)
+}
+func (m *ListStorefrontSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStorefrontSlotsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListStorefrontSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStorefrontSlotsResponse.Merge(m, src)
+}
+func (m *ListStorefrontSlotsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStorefrontSlotsResponse.Size(m)
+}
+func (m *ListStorefrontSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStorefrontSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStorefrontSlotsResponse proto.InternalMessageInfo
+
+func (m *ListStorefrontSlotsResponse) GetResults() []*StorefrontSlot {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type PinStorefrontItemRequest struct {
+	SlotId               int32    `protobuf:"varint,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Day                  string   `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	ItemId               string   `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PinStorefrontItemRequest) Reset()         { *m = PinStorefrontItemRequest{} }
+func (m *PinStorefrontItemRequest) String() string { return proto.CompactTextString(m) }
+func (*PinStorefrontItemRequest) ProtoMessage()    {}
+func (*PinStorefrontItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{182}
+}
+
+func (m *PinStorefrontItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinStorefrontItemRequest.Unmarshal(m, b)
+}
+func (m *PinStorefrontItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinStorefrontItemRequest.Marshal(b, m, deterministic)
+}
+func (m *PinStorefrontItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinStorefrontItemRequest.Merge(m, src)
+}
+func (m *PinStorefrontItemRequest) XXX_Size() int {
+	return xxx_messageInfo_PinStorefrontItemRequest.Size(m)
+}
+func (m *PinStorefrontItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinStorefrontItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinStorefrontItemRequest proto.InternalMessageInfo
+
+func (m *PinStorefrontItemRequest) GetSlotId() int32 {
+	if m != nil {
+		return m.SlotId
+	}
+	return 0
+}
+
+func (m *PinStorefrontItemRequest) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *PinStorefrontItemRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+type PinStorefrontItemResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PinStorefrontItemResponse) Reset()         { *m = PinStorefrontItemResponse{} }
+func (m *PinStorefrontItemResponse) String() string { return proto.CompactTextString(m) }
+func (*PinStorefrontItemResponse) ProtoMessage()    {}
+func (*PinStorefrontItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{183}
+}
+
+func (m *PinStorefrontItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinStorefrontItemResponse.Unmarshal(m, b)
+}
+func (m *PinStorefrontItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinStorefrontItemResponse.Marshal(b, m, deterministic)
+}
+func (m *PinStorefrontItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinStorefrontItemResponse.Merge(m, src)
+}
+func (m *PinStorefrontItemResponse) XXX_Size() int {
+	return xxx_messageInfo_PinStorefrontItemResponse.Size(m)
+}
+func (m *PinStorefrontItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinStorefrontItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinStorefrontItemResponse proto.InternalMessageInfo
+
+type UnpinStorefrontItemRequest struct {
+	SlotId               int32    `protobuf:"varint,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Day                  string   `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	ItemId               string   `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpinStorefrontItemRequest) Reset()         { *m = UnpinStorefrontItemRequest{} }
+func (m *UnpinStorefrontItemRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinStorefrontItemRequest) ProtoMessage()    {}
+func (*UnpinStorefrontItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{184}
+}
+
+func (m *UnpinStorefrontItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinStorefrontItemRequest.Unmarshal(m, b)
+}
+func (m *UnpinStorefrontItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinStorefrontItemRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpinStorefrontItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinStorefrontItemRequest.Merge(m, src)
+}
+func (m *UnpinStorefrontItemRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpinStorefrontItemRequest.Size(m)
+}
+func (m *UnpinStorefrontItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinStorefrontItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinStorefrontItemRequest proto.InternalMessageInfo
+
+func (m *UnpinStorefrontItemRequest) GetSlotId() int32 {
+	if m != nil {
+		return m.SlotId
+	}
+	return 0
+}
+
+func (m *UnpinStorefrontItemRequest) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *UnpinStorefrontItemRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+type UnpinStorefrontItemResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpinStorefrontItemResponse) Reset()         { *m = UnpinStorefrontItemResponse{} }
+func (m *UnpinStorefrontItemResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpinStorefrontItemResponse) ProtoMessage()    {}
+func (*UnpinStorefrontItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{185}
+}
+
+func (m *UnpinStorefrontItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinStorefrontItemResponse.Unmarshal(m, b)
+}
+func (m *UnpinStorefrontItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinStorefrontItemResponse.Marshal(b, m, deterministic)
+}
+func (m *UnpinStorefrontItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinStorefrontItemResponse.Merge(m, src)
+}
+func (m *UnpinStorefrontItemResponse) XXX_Size() int {
+	return xxx_messageInfo_UnpinStorefrontItemResponse.Size(m)
+}
+func (m *UnpinStorefrontItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinStorefrontItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinStorefrontItemResponse proto.InternalMessageInfo
+
+type GemPack struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gems                 int32    `protobuf:"varint,3,opt,name=gems,proto3" json:"gems,omitempty"`
+	PriceCents           int64    `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GemPack) Reset()         { *m = GemPack{} }
+func (m *GemPack) String() string { return proto.CompactTextString(m) }
+func (*GemPack) ProtoMessage()    {}
+func (*GemPack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{186}
+}
+
+func (m *GemPack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GemPack.Unmarshal(m, b)
+}
+func (m *GemPack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GemPack.Marshal(b, m, deterministic)
+}
+func (m *GemPack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GemPack.Merge(m, src)
+}
+func (m *GemPack) XXX_Size() int {
+	return xxx_messageInfo_GemPack.Size(m)
+}
+func (m *GemPack) XXX_DiscardUnknown() {
+	xxx_messageInfo_GemPack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GemPack proto.InternalMessageInfo
+
+func (m *GemPack) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GemPack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GemPack) GetGems() int32 {
+	if m != nil {
+		return m.Gems
+	}
+	return 0
+}
+
+func (m *GemPack) GetPriceCents() int64 {
+	if m != nil {
+		return m.PriceCents
+	}
+	return 0
+}
+
+func (m *GemPack) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type CreateGemPackRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Gems                 int32    `protobuf:"varint,2,opt,name=gems,proto3" json:"gems,omitempty"`
+	PriceCents           int64    `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGemPackRequest) Reset()         { *m = CreateGemPackRequest{} }
+func (m *CreateGemPackRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGemPackRequest) ProtoMessage()    {}
+func (*CreateGemPackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{187}
+}
+
+func (m *CreateGemPackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGemPackRequest.Unmarshal(m, b)
+}
+func (m *CreateGemPackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGemPackRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateGemPackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGemPackRequest.Merge(m, src)
+}
+func (m *CreateGemPackRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGemPackRequest.Size(m)
+}
+func (m *CreateGemPackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGemPackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGemPackRequest proto.InternalMessageInfo
+
+func (m *CreateGemPackRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateGemPackRequest) GetGems() int32 {
+	if m != nil {
+		return m.Gems
+	}
+	return 0
+}
+
+func (m *CreateGemPackRequest) GetPriceCents() int64 {
+	if m != nil {
+		return m.PriceCents
+	}
+	return 0
+}
+
+func (m *CreateGemPackRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type CreateGemPackResponse struct {
+	Result               *GemPack `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGemPackResponse) Reset()         { *m = CreateGemPackResponse{} }
+func (m *CreateGemPackResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGemPackResponse) ProtoMessage()    {}
+func (*CreateGemPackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{188}
+}
+
+func (m *CreateGemPackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGemPackResponse.Unmarshal(m, b)
+}
+func (m *CreateGemPackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGemPackResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateGemPackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGemPackResponse.Merge(m, src)
+}
+func (m *CreateGemPackResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateGemPackResponse.Size(m)
+}
+func (m *CreateGemPackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGemPackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGemPackResponse proto.InternalMessageInfo
+
+func (m *CreateGemPackResponse) GetResult() *GemPack {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteGemPackRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGemPackRequest) Reset()         { *m = DeleteGemPackRequest{} }
+func (m *DeleteGemPackRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGemPackRequest) ProtoMessage()    {}
+func (*DeleteGemPackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{189}
+}
+
+func (m *DeleteGemPackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGemPackRequest.Unmarshal(m, b)
+}
+func (m *DeleteGemPackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGemPackRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteGemPackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGemPackRequest.Merge(m, src)
+}
+func (m *DeleteGemPackRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteGemPackRequest.Size(m)
+}
+func (m *DeleteGemPackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGemPackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGemPackRequest proto.InternalMessageInfo
+
+func (m *DeleteGemPackRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteGemPackResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGemPackResponse) Reset()         { *m = DeleteGemPackResponse{} }
+func (m *DeleteGemPackResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGemPackResponse) ProtoMessage()    {}
+func (*DeleteGemPackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{190}
+}
+
+func (m *DeleteGemPackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGemPackResponse.Unmarshal(m, b)
+}
+func (m *DeleteGemPackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGemPackResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteGemPackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGemPackResponse.Merge(m, src)
+}
+func (m *DeleteGemPackResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteGemPackResponse.Size(m)
+}
+func (m *DeleteGemPackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGemPackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGemPackResponse proto.InternalMessageInfo
+
+type ListGemPacksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGemPacksRequest) Reset()         { *m = ListGemPacksRequest{} }
+func (m *ListGemPacksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGemPacksRequest) ProtoMessage()    {}
+func (*ListGemPacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{191}
+}
+
+func (m *ListGemPacksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGemPacksRequest.Unmarshal(m, b)
+}
+func (m *ListGemPacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGemPacksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGemPacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGemPacksRequest.Merge(m, src)
+}
+func (m *ListGemPacksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGemPacksRequest.Size(m)
+}
+func (m *ListGemPacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGemPacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGemPacksRequest proto.InternalMessageInfo
+
+type ListGemPacksResponse struct {
+	Results              []*GemPack `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListGemPacksResponse) Reset()         { *m = ListGemPacksResponse{} }
+func (m *ListGemPacksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGemPacksResponse) ProtoMessage()    {}
+func (*ListGemPacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{192}
+}
+
+func (m *ListGemPacksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGemPacksResponse.Unmarshal(m, b)
+}
+func (m *ListGemPacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGemPacksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGemPacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGemPacksResponse.Merge(m, src)
+}
+func (m *ListGemPacksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGemPacksResponse.Size(m)
+}
+func (m *ListGemPacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGemPacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGemPacksResponse proto.InternalMessageInfo
+
+func (m *ListGemPacksResponse) GetResults() []*GemPack {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type CreatePurchaseRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PackId               string   `protobuf:"bytes,2,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePurchaseRequest) Reset()         { *m = CreatePurchaseRequest{} }
+func (m *CreatePurchaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePurchaseRequest) ProtoMessage()    {}
+func (*CreatePurchaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{193}
+}
+
+func (m *CreatePurchaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePurchaseRequest.Unmarshal(m, b)
+}
+func (m *CreatePurchaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePurchaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePurchaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePurchaseRequest.Merge(m, src)
+}
+func (m *CreatePurchaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePurchaseRequest.Size(m)
+}
+func (m *CreatePurchaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePurchaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePurchaseRequest proto.InternalMessageInfo
+
+func (m *CreatePurchaseRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreatePurchaseRequest) GetPackId() string {
+	if m != nil {
+		return m.PackId
+	}
+	return ""
+}
+
+type CreatePurchaseResponse struct {
+	IntentId             string   `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	ClientSecret         string   `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	PriceCents           int64    `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePurchaseResponse) Reset()         { *m = CreatePurchaseResponse{} }
+func (m *CreatePurchaseResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePurchaseResponse) ProtoMessage()    {}
+func (*CreatePurchaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{194}
+}
+
+func (m *CreatePurchaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePurchaseResponse.Unmarshal(m, b)
+}
+func (m *CreatePurchaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePurchaseResponse.Marshal(b, m, deterministic)
+}
+func (m *CreatePurchaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePurchaseResponse.Merge(m, src)
+}
+func (m *CreatePurchaseResponse) XXX_Size() int {
+	return xxx_messageInfo_CreatePurchaseResponse.Size(m)
+}
+func (m *CreatePurchaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePurchaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePurchaseResponse proto.InternalMessageInfo
+
+func (m *CreatePurchaseResponse) GetIntentId() string {
+	if m != nil {
+		return m.IntentId
+	}
+	return ""
+}
+
+func (m *CreatePurchaseResponse) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *CreatePurchaseResponse) GetPriceCents() int64 {
+	if m != nil {
+		return m.PriceCents
+	}
+	return 0
+}
+
+func (m *CreatePurchaseResponse) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type ValidateReceiptRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Receipt              string   `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateReceiptRequest) Reset()         { *m = ValidateReceiptRequest{} }
+func (m *ValidateReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateReceiptRequest) ProtoMessage()    {}
+func (*ValidateReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{195}
+}
+
+func (m *ValidateReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateReceiptRequest.Unmarshal(m, b)
+}
+func (m *ValidateReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateReceiptRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateReceiptRequest.Merge(m, src)
+}
+func (m *ValidateReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateReceiptRequest.Size(m)
+}
+func (m *ValidateReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateReceiptRequest proto.InternalMessageInfo
+
+func (m *ValidateReceiptRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ValidateReceiptRequest) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
+	}
+	return ""
+}
+
+type ValidateReceiptResponse struct {
+	GemsCredited         int32    `protobuf:"varint,1,opt,name=gems_credited,json=gemsCredited,proto3" json:"gems_credited,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateReceiptResponse) Reset()         { *m = ValidateReceiptResponse{} }
+func (m *ValidateReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateReceiptResponse) ProtoMessage()    {}
+func (*ValidateReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1742b9f6e5341e0, []int{196}
+}
+
+func (m *ValidateReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateReceiptResponse.Unmarshal(m, b)
+}
+func (m *ValidateReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateReceiptResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateReceiptResponse.Merge(m, src)
+}
+func (m *ValidateReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateReceiptResponse.Size(m)
+}
+func (m *ValidateReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateReceiptResponse proto.InternalMessageInfo
+
+func (m *ValidateReceiptResponse) GetGemsCredited() int32 {
+	if m != nil {
+		return m.GemsCredited
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("service.LeaderboardMetric", LeaderboardMetric_name, LeaderboardMetric_value)
+	proto.RegisterEnum("service.AchievementScope", AchievementScope_name, AchievementScope_value)
+	proto.RegisterEnum("service.NewsStatus", NewsStatus_name, NewsStatus_value)
+	proto.RegisterType((*VersionResponse)(nil), "service.VersionResponse")
+	proto.RegisterType((*User)(nil), "service.User")
+	proto.RegisterType((*CreateUserRequest)(nil), "service.CreateUserRequest")
+	proto.RegisterType((*CreateUserResponse)(nil), "service.CreateUserResponse")
+	proto.RegisterType((*ReadUserRequest)(nil), "service.ReadUserRequest")
+	proto.RegisterType((*ReadUserResponse)(nil), "service.ReadUserResponse")
+	proto.RegisterType((*UpdateUserRequest)(nil), "service.UpdateUserRequest")
+	proto.RegisterType((*UpdateUserResponse)(nil), "service.UpdateUserResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "service.DeleteUserRequest")
+	proto.RegisterType((*DeleteUserResponse)(nil), "service.DeleteUserResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "service.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "service.ListUsersResponse")
+	proto.RegisterType((*LoginRequest)(nil), "service.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "service.LoginResponse")
+	proto.RegisterType((*GrantCurrenciesRequest)(nil), "service.GrantCurrenciesRequest")
+	proto.RegisterType((*GrantCurrenciesResponse)(nil), "service.GrantCurrenciesResponse")
+	proto.RegisterType((*GetUserCurrenciesRequest)(nil), "service.GetUserCurrenciesRequest")
+	proto.RegisterType((*GetUserCurrenciesResponse)(nil), "service.GetUserCurrenciesResponse")
+	proto.RegisterType((*ExchangeRate)(nil), "service.ExchangeRate")
+	proto.RegisterType((*SetExchangeRateRequest)(nil), "service.SetExchangeRateRequest")
+	proto.RegisterType((*SetExchangeRateResponse)(nil), "service.SetExchangeRateResponse")
+	proto.RegisterType((*DeleteExchangeRateRequest)(nil), "service.DeleteExchangeRateRequest")
+	proto.RegisterType((*DeleteExchangeRateResponse)(nil), "service.DeleteExchangeRateResponse")
+	proto.RegisterType((*ExchangeQuote)(nil), "service.ExchangeQuote")
+	proto.RegisterType((*GetExchangeRatesRequest)(nil), "service.GetExchangeRatesRequest")
+	proto.RegisterType((*GetExchangeRatesResponse)(nil), "service.GetExchangeRatesResponse")
+	proto.RegisterType((*ExchangeCurrencyRequest)(nil), "service.ExchangeCurrencyRequest")
+	proto.RegisterType((*ExchangeCurrencyResponse)(nil), "service.ExchangeCurrencyResponse")
+	proto.RegisterType((*StoreItem)(nil), "service.StoreItem")
+	proto.RegisterType((*CreateStoreItemRequest)(nil), "service.CreateStoreItemRequest")
+	proto.RegisterType((*CreateStoreItemResponse)(nil), "service.CreateStoreItemResponse")
+	proto.RegisterType((*ReadStoreItemRequest)(nil), "service.ReadStoreItemRequest")
+	proto.RegisterType((*ReadStoreItemResponse)(nil), "service.ReadStoreItemResponse")
+	proto.RegisterType((*UpdateStoreItemRequest)(nil), "service.UpdateStoreItemRequest")
+	proto.RegisterType((*UpdateStoreItemResponse)(nil), "service.UpdateStoreItemResponse")
+	proto.RegisterType((*DeleteStoreItemRequest)(nil), "service.DeleteStoreItemRequest")
+	proto.RegisterType((*DeleteStoreItemResponse)(nil), "service.DeleteStoreItemResponse")
+	proto.RegisterType((*ListStoreItemsRequest)(nil), "service.ListStoreItemsRequest")
+	proto.RegisterType((*ListStoreItemsResponse)(nil), "service.ListStoreItemsResponse")
+	proto.RegisterType((*CatalogRowError)(nil), "service.CatalogRowError")
+	proto.RegisterType((*ImportCatalogRequest)(nil), "service.ImportCatalogRequest")
+	proto.RegisterType((*ImportCatalogResponse)(nil), "service.ImportCatalogResponse")
+	proto.RegisterType((*ExportCatalogRequest)(nil), "service.ExportCatalogRequest")
+	proto.RegisterType((*ExportCatalogResponse)(nil), "service.ExportCatalogResponse")
+	proto.RegisterType((*PurgeItemRequest)(nil), "service.PurgeItemRequest")
+	proto.RegisterType((*PurgeItemResponse)(nil), "service.PurgeItemResponse")
+	proto.RegisterType((*StoreItemTranslation)(nil), "service.StoreItemTranslation")
+	proto.RegisterType((*SetItemTranslationRequest)(nil), "service.SetItemTranslationRequest")
+	proto.RegisterType((*SetItemTranslationResponse)(nil), "service.SetItemTranslationResponse")
+	proto.RegisterType((*DeleteItemTranslationRequest)(nil), "service.DeleteItemTranslationRequest")
+	proto.RegisterType((*DeleteItemTranslationResponse)(nil), "service.DeleteItemTranslationResponse")
+	proto.RegisterType((*BuyByUserRequest)(nil), "service.BuyByUserRequest")
+	proto.RegisterType((*BuyByUserResponse)(nil), "service.BuyByUserResponse")
+	proto.RegisterType((*ThrowAwayByUserRequest)(nil), "service.ThrowAwayByUserRequest")
+	proto.RegisterType((*ThrowAwayByUserResponse)(nil), "service.ThrowAwayByUserResponse")
+	proto.RegisterType((*EquipByUserRequest)(nil), "service.EquipByUserRequest")
+	proto.RegisterType((*EquipByUserResponse)(nil), "service.EquipByUserResponse")
+	proto.RegisterType((*GetUserItemsIdsRequest)(nil), "service.GetUserItemsIdsRequest")
+	proto.RegisterType((*UserItemInfo)(nil), "service.UserItemInfo")
+	proto.RegisterType((*GetUserItemsIdsResponse)(nil), "service.GetUserItemsIdsResponse")
+	proto.RegisterType((*GetEquippedUserItemsIdsRequest)(nil), "service.GetEquippedUserItemsIdsRequest")
+	proto.RegisterType((*GetEquippedUserItemsIdsResponse)(nil), "service.GetEquippedUserItemsIdsResponse")
+	proto.RegisterType((*GrantItemRequest)(nil), "service.GrantItemRequest")
+	proto.RegisterType((*GrantItemResponse)(nil), "service.GrantItemResponse")
+	proto.RegisterType((*ConsumeItemRequest)(nil), "service.ConsumeItemRequest")
+	proto.RegisterType((*ConsumeItemResponse)(nil), "service.ConsumeItemResponse")
+	proto.RegisterType((*Gift)(nil), "service.Gift")
+	proto.RegisterType((*GiftItemRequest)(nil), "service.GiftItemRequest")
+	proto.RegisterType((*GiftItemResponse)(nil), "service.GiftItemResponse")
+	proto.RegisterType((*ListPendingGiftsRequest)(nil), "service.ListPendingGiftsRequest")
+	proto.RegisterType((*ListPendingGiftsResponse)(nil), "service.ListPendingGiftsResponse")
+	proto.RegisterType((*AcceptGiftRequest)(nil), "service.AcceptGiftRequest")
+	proto.RegisterType((*AcceptGiftResponse)(nil), "service.AcceptGiftResponse")
+	proto.RegisterType((*DeclineGiftRequest)(nil), "service.DeclineGiftRequest")
+	proto.RegisterType((*DeclineGiftResponse)(nil), "service.DeclineGiftResponse")
+	proto.RegisterType((*ItemType)(nil), "service.ItemType")
+	proto.RegisterType((*SetItemTypeRequest)(nil), "service.SetItemTypeRequest")
+	proto.RegisterType((*SetItemTypeResponse)(nil), "service.SetItemTypeResponse")
+	proto.RegisterType((*DeleteItemTypeRequest)(nil), "service.DeleteItemTypeRequest")
+	proto.RegisterType((*DeleteItemTypeResponse)(nil), "service.DeleteItemTypeResponse")
+	proto.RegisterType((*ListItemTypesRequest)(nil), "service.ListItemTypesRequest")
+	proto.RegisterType((*ListItemTypesResponse)(nil), "service.ListItemTypesResponse")
+	proto.RegisterType((*Loadout)(nil), "service.Loadout")
+	proto.RegisterType((*CreateLoadoutRequest)(nil), "service.CreateLoadoutRequest")
+	proto.RegisterType((*CreateLoadoutResponse)(nil), "service.CreateLoadoutResponse")
+	proto.RegisterType((*UpdateLoadoutRequest)(nil), "service.UpdateLoadoutRequest")
+	proto.RegisterType((*UpdateLoadoutResponse)(nil), "service.UpdateLoadoutResponse")
+	proto.RegisterType((*DeleteLoadoutRequest)(nil), "service.DeleteLoadoutRequest")
+	proto.RegisterType((*DeleteLoadoutResponse)(nil), "service.DeleteLoadoutResponse")
+	proto.RegisterType((*ActivateLoadoutRequest)(nil), "service.ActivateLoadoutRequest")
+	proto.RegisterType((*ActivateLoadoutResponse)(nil), "service.ActivateLoadoutResponse")
+	proto.RegisterType((*ListLoadoutsRequest)(nil), "service.ListLoadoutsRequest")
+	proto.RegisterType((*ListLoadoutsResponse)(nil), "service.ListLoadoutsResponse")
+	proto.RegisterType((*InventoryEntry)(nil), "service.InventoryEntry")
+	proto.RegisterType((*InventoryItem)(nil), "service.InventoryItem")
+	proto.RegisterType((*ListUserInventoryRequest)(nil), "service.ListUserInventoryRequest")
+	proto.RegisterType((*ListUserInventoryResponse)(nil), "service.ListUserInventoryResponse")
+	proto.RegisterType((*UserStats)(nil), "service.UserStats")
+	proto.RegisterType((*GetLeaderboardRequest)(nil), "service.GetLeaderboardRequest")
+	proto.RegisterType((*LeaderboardEntry)(nil), "service.LeaderboardEntry")
+	proto.RegisterType((*GetLeaderboardResponse)(nil), "service.GetLeaderboardResponse")
+	proto.RegisterType((*ReadUserStatsRequest)(nil), "service.ReadUserStatsRequest")
+	proto.RegisterType((*DerivedStats)(nil), "service.DerivedStats")
+	proto.RegisterType((*WindowedStats)(nil), "service.WindowedStats")
+	proto.RegisterType((*ReadUserStatsResponse)(nil), "service.ReadUserStatsResponse")
+	proto.RegisterType((*UpdateUserStatsRequest)(nil), "service.UpdateUserStatsRequest")
+	proto.RegisterType((*UpdateUserStatsResponse)(nil), "service.UpdateUserStatsResponse")
+	proto.RegisterType((*BatchUpdateStatsRequest)(nil), "service.BatchUpdateStatsRequest")
+	proto.RegisterType((*BatchStatsResult)(nil), "service.BatchStatsResult")
+	proto.RegisterType((*BatchUpdateStatsResponse)(nil), "service.BatchUpdateStatsResponse")
+	proto.RegisterType((*FlaggedStatUpdate)(nil), "service.FlaggedStatUpdate")
+	proto.RegisterType((*ListFlaggedStatUpdatesRequest)(nil), "service.ListFlaggedStatUpdatesRequest")
+	proto.RegisterType((*ListFlaggedStatUpdatesResponse)(nil), "service.ListFlaggedStatUpdatesResponse")
+	proto.RegisterType((*ClearFlaggedStatUpdateRequest)(nil), "service.ClearFlaggedStatUpdateRequest")
+	proto.RegisterType((*ClearFlaggedStatUpdateResponse)(nil), "service.ClearFlaggedStatUpdateResponse")
+	proto.RegisterType((*RollbackFlaggedStatUpdateRequest)(nil), "service.RollbackFlaggedStatUpdateRequest")
+	proto.RegisterType((*RollbackFlaggedStatUpdateResponse)(nil), "service.RollbackFlaggedStatUpdateResponse")
+	proto.RegisterType((*MatchParticipant)(nil), "service.MatchParticipant")
+	proto.RegisterType((*RecordMatchRequest)(nil), "service.RecordMatchRequest")
+	proto.RegisterType((*MatchReward)(nil), "service.MatchReward")
+	proto.RegisterType((*RecordMatchResponse)(nil), "service.RecordMatchResponse")
+	proto.RegisterType((*MatchHistoryEntry)(nil), "service.MatchHistoryEntry")
+	proto.RegisterType((*ListMatchHistoryRequest)(nil), "service.ListMatchHistoryRequest")
+	proto.RegisterType((*ListMatchHistoryResponse)(nil), "service.ListMatchHistoryResponse")
+	proto.RegisterType((*RebuildStatsRequest)(nil), "service.RebuildStatsRequest")
+	proto.RegisterType((*RebuildStatsResponse)(nil), "service.RebuildStatsResponse")
+	proto.RegisterType((*Season)(nil), "service.Season")
+	proto.RegisterType((*CreateSeasonRequest)(nil), "service.CreateSeasonRequest")
+	proto.RegisterType((*CreateSeasonResponse)(nil), "service.CreateSeasonResponse")
+	proto.RegisterType((*ListSeasonsRequest)(nil), "service.ListSeasonsRequest")
+	proto.RegisterType((*ListSeasonsResponse)(nil), "service.ListSeasonsResponse")
+	proto.RegisterType((*Rating)(nil), "service.Rating")
+	proto.RegisterType((*RatingHistoryEntry)(nil), "service.RatingHistoryEntry")
+	proto.RegisterType((*GetRatingRequest)(nil), "service.GetRatingRequest")
+	proto.RegisterType((*GetRatingResponse)(nil), "service.GetRatingResponse")
+	proto.RegisterType((*GetRatingsRequest)(nil), "service.GetRatingsRequest")
+	proto.RegisterType((*GetRatingsResponse)(nil), "service.GetRatingsResponse")
+	proto.RegisterType((*Achievement)(nil), "service.Achievement")
+	proto.RegisterType((*CreateAchievementRequest)(nil), "service.CreateAchievementRequest")
+	proto.RegisterType((*CreateAchievementResponse)(nil), "service.CreateAchievementResponse")
+	proto.RegisterType((*DeleteAchievementRequest)(nil), "service.DeleteAchievementRequest")
+	proto.RegisterType((*DeleteAchievementResponse)(nil), "service.DeleteAchievementResponse")
+	proto.RegisterType((*ListAchievementsRequest)(nil), "service.ListAchievementsRequest")
+	proto.RegisterType((*ListAchievementsResponse)(nil), "service.ListAchievementsResponse")
+	proto.RegisterType((*UserAchievement)(nil), "service.UserAchievement")
+	proto.RegisterType((*GetUserAchievementsRequest)(nil), "service.GetUserAchievementsRequest")
+	proto.RegisterType((*GetUserAchievementsResponse)(nil), "service.GetUserAchievementsResponse")
+	proto.RegisterType((*News)(nil), "service.News")
+	proto.RegisterType((*CreateNewsRequest)(nil), "service.CreateNewsRequest")
+	proto.RegisterType((*CreateNewsResponse)(nil), "service.CreateNewsResponse")
+	proto.RegisterType((*ReadNewsRequest)(nil), "service.ReadNewsRequest")
+	proto.RegisterType((*ReadNewsResponse)(nil), "service.ReadNewsResponse")
+	proto.RegisterType((*UpdateNewsRequest)(nil), "service.UpdateNewsRequest")
+	proto.RegisterType((*UpdateNewsResponse)(nil), "service.UpdateNewsResponse")
+	proto.RegisterType((*DeleteNewsRequest)(nil), "service.DeleteNewsRequest")
+	proto.RegisterType((*DeleteNewsResponse)(nil), "service.DeleteNewsResponse")
+	proto.RegisterType((*PublishNewsRequest)(nil), "service.PublishNewsRequest")
+	proto.RegisterType((*PublishNewsResponse)(nil), "service.PublishNewsResponse")
+	proto.RegisterType((*UnpublishNewsRequest)(nil), "service.UnpublishNewsRequest")
+	proto.RegisterType((*UnpublishNewsResponse)(nil), "service.UnpublishNewsResponse")
+	proto.RegisterType((*ListNewsRequest)(nil), "service.ListNewsRequest")
+	proto.RegisterType((*ListNewsResponse)(nil), "service.ListNewsResponse")
+	proto.RegisterType((*NewsTranslation)(nil), "service.NewsTranslation")
+	proto.RegisterType((*SetNewsTranslationRequest)(nil), "service.SetNewsTranslationRequest")
+	proto.RegisterType((*SetNewsTranslationResponse)(nil), "service.SetNewsTranslationResponse")
+	proto.RegisterType((*DeleteNewsTranslationRequest)(nil), "service.DeleteNewsTranslationRequest")
+	proto.RegisterType((*DeleteNewsTranslationResponse)(nil), "service.DeleteNewsTranslationResponse")
+	proto.RegisterType((*StorefrontSlot)(nil), "service.StorefrontSlot")
+	proto.RegisterType((*StorefrontPoolEntry)(nil), "service.StorefrontPoolEntry")
+	proto.RegisterType((*StorefrontRotationSlot)(nil), "service.StorefrontRotationSlot")
+	proto.RegisterType((*GetStorefrontRequest)(nil), "service.GetStorefrontRequest")
+	proto.RegisterType((*GetStorefrontResponse)(nil), "service.GetStorefrontResponse")
+	proto.RegisterType((*PreviewStorefrontRequest)(nil), "service.PreviewStorefrontRequest")
+	proto.RegisterType((*PreviewStorefrontResponse)(nil), "service.PreviewStorefrontResponse")
+	proto.RegisterType((*CreateStorefrontSlotRequest)(nil), "service.CreateStorefrontSlotRequest")
+	proto.RegisterType((*CreateStorefrontSlotResponse)(nil), "service.CreateStorefrontSlotResponse")
+	proto.RegisterType((*UpdateStorefrontSlotRequest)(nil), "service.UpdateStorefrontSlotRequest")
+	proto.RegisterType((*UpdateStorefrontSlotResponse)(nil), "service.UpdateStorefrontSlotResponse")
+	proto.RegisterType((*DeleteStorefrontSlotRequest)(nil), "service.DeleteStorefrontSlotRequest")
+	proto.RegisterType((*DeleteStorefrontSlotResponse)(nil), "service.DeleteStorefrontSlotResponse")
+	proto.RegisterType((*ListStorefrontSlotsRequest)(nil), "service.ListStorefrontSlotsRequest")
+	proto.RegisterType((*ListStorefrontSlotsResponse)(nil), "service.ListStorefrontSlotsResponse")
+	proto.RegisterType((*PinStorefrontItemRequest)(nil), "service.PinStorefrontItemRequest")
+	proto.RegisterType((*PinStorefrontItemResponse)(nil), "service.PinStorefrontItemResponse")
+	proto.RegisterType((*UnpinStorefrontItemRequest)(nil), "service.UnpinStorefrontItemRequest")
+	proto.RegisterType((*UnpinStorefrontItemResponse)(nil), "service.UnpinStorefrontItemResponse")
+	proto.RegisterType((*GemPack)(nil), "service.GemPack")
+	proto.RegisterType((*CreateGemPackRequest)(nil), "service.CreateGemPackRequest")
+	proto.RegisterType((*CreateGemPackResponse)(nil), "service.CreateGemPackResponse")
+	proto.RegisterType((*DeleteGemPackRequest)(nil), "service.DeleteGemPackRequest")
+	proto.RegisterType((*DeleteGemPackResponse)(nil), "service.DeleteGemPackResponse")
+	proto.RegisterType((*ListGemPacksRequest)(nil), "service.ListGemPacksRequest")
+	proto.RegisterType((*ListGemPacksResponse)(nil), "service.ListGemPacksResponse")
+	proto.RegisterType((*CreatePurchaseRequest)(nil), "service.CreatePurchaseRequest")
+	proto.RegisterType((*CreatePurchaseResponse)(nil), "service.CreatePurchaseResponse")
+	proto.RegisterType((*ValidateReceiptRequest)(nil), "service.ValidateReceiptRequest")
+	proto.RegisterType((*ValidateReceiptResponse)(nil), "service.ValidateReceiptResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/amikhailau/users-service/pkg/pb/service.proto", fileDescriptor_b1742b9f6e5341e0)
+}
+
+var fileDescriptor_b1742b9f6e5341e0 = []byte{
+	// 8022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x5b, 0x6c, 0x24, 0xc7,
+	0x71, 0x9a, 0xdd, 0xe5, 0x72, 0xb7, 0xf8, 0x5a, 0x36, 0x5f, 0xbb, 0xc3, 0xc7, 0x91, 0x73, 0x6f,
+	0x9e, 0x8e, 0x2b, 0x51, 0x56, 0x64, 0x49, 0x7e, 0x88, 0xc7, 0xa3, 0x4e, 0x94, 0x4f, 0x12, 0xbd,
+	0xbc, 0xb3, 0x10, 0x05, 0xf6, 0x6a, 0x6e, 0xa7, 0xb9, 0x37, 0xe1, 0xee, 0xcc, 0xde, 0xcc, 0x2c,
+	0x79, 0xd4, 0xf9, 0x62, 0x58, 0x30, 0x62, 0xc7, 0x81, 0x61, 0x04, 0x7e, 0x05, 0x76, 0x90, 0x20,
+	0x71, 0x7e, 0xf2, 0x93, 0xcf, 0x00, 0x77, 0x41, 0xec, 0x7c, 0x24, 0x48, 0x82, 0x7c, 0x24, 0x41,
+	0x10, 0x04, 0x08, 0x90, 0xbf, 0x20, 0x40, 0x90, 0xbf, 0x20, 0xf9, 0x4e, 0xd0, 0xaf, 0x99, 0x9e,
+	0xe7, 0x2e, 0x29, 0xd9, 0x08, 0xfc, 0xc5, 0xed, 0xae, 0x9a, 0xae, 0xea, 0xea, 0xea, 0xea, 0xee,
+	0xea, 0xea, 0x22, 0x7c, 0xb2, 0x6d, 0x7a, 0xf7, 0xfb, 0xf7, 0x36, 0x5a, 0x76, 0xb7, 0xae, 0x77,
+	0xcd, 0xc3, 0xfb, 0xba, 0xd9, 0xd1, 0xfb, 0xf5, 0xbe, 0x8b, 0x1d, 0xf7, 0xba, 0x8b, 0x9d, 0x23,
+	0xb3, 0x85, 0xeb, 0xbd, 0xc3, 0x76, 0xbd, 0x77, 0xaf, 0xce, 0x8b, 0x1b, 0x3d, 0xc7, 0xf6, 0x6c,
+	0x34, 0xca, 0x8b, 0xea, 0x62, 0xdb, 0xb6, 0xdb, 0x1d, 0x5c, 0xa7, 0xd5, 0xf7, 0xfa, 0x07, 0x75,
+	0xdc, 0xed, 0x79, 0x27, 0x0c, 0x4b, 0x5d, 0xe2, 0x40, 0xbd, 0x67, 0xd6, 0x75, 0xcb, 0xb2, 0x3d,
+	0xdd, 0x33, 0x6d, 0xcb, 0xe5, 0xd0, 0x2d, 0x89, 0x3a, 0xb6, 0x8e, 0xec, 0x93, 0x9e, 0x63, 0x3f,
+	0x3c, 0x61, 0x2d, 0xb5, 0xae, 0xb7, 0xb1, 0x75, 0xfd, 0x48, 0xef, 0x98, 0x86, 0xee, 0xe1, 0x7a,
+	0xec, 0x07, 0x6f, 0xe2, 0x59, 0x09, 0xd9, 0x3d, 0xd6, 0xdb, 0x6d, 0xec, 0xd4, 0xed, 0x1e, 0x25,
+	0x92, 0x40, 0xf0, 0x15, 0x89, 0xa0, 0x69, 0x1d, 0xd8, 0xf7, 0x3a, 0xf6, 0x43, 0xbb, 0x87, 0x2d,
+	0x99, 0x64, 0xdb, 0x76, 0xba, 0x7e, 0x13, 0xa4, 0xc0, 0xbf, 0x5d, 0x8d, 0xf6, 0xf3, 0xc0, 0xc4,
+	0x1d, 0xa3, 0xd9, 0xd5, 0xdd, 0x43, 0x8e, 0x71, 0x2e, 0x8a, 0xe1, 0x99, 0x5d, 0xec, 0x7a, 0x7a,
+	0xb7, 0xc7, 0x11, 0xde, 0x4c, 0x23, 0xaf, 0x7b, 0x1d, 0xdd, 0xbd, 0xae, 0xf7, 0x7a, 0xd7, 0x3d,
+	0xdb, 0xee, 0x1c, 0x9a, 0x5e, 0xfd, 0x41, 0x1f, 0x3b, 0x27, 0xf5, 0x96, 0xdd, 0xe9, 0xe0, 0x16,
+	0x61, 0xa5, 0x69, 0xf7, 0xb0, 0xa3, 0x7b, 0xb6, 0x23, 0xba, 0x72, 0x67, 0x88, 0xae, 0xb0, 0x66,
+	0x69, 0x53, 0x81, 0x24, 0x45, 0xd7, 0x68, 0x75, 0x33, 0x22, 0xce, 0xb7, 0x87, 0x6e, 0x35, 0xd6,
+	0x1e, 0xad, 0x8e, 0xb4, 0xa7, 0x5d, 0x83, 0xa9, 0x2f, 0x60, 0xc7, 0x35, 0x6d, 0xab, 0x81, 0xdd,
+	0x9e, 0x6d, 0xb9, 0x18, 0x55, 0x61, 0xf4, 0x88, 0x55, 0x55, 0x95, 0x55, 0xe5, 0x4a, 0xb9, 0x21,
+	0x8a, 0xda, 0x6f, 0xe5, 0xa0, 0x70, 0xd7, 0xc5, 0x0e, 0x5a, 0x81, 0x9c, 0x69, 0x30, 0xe8, 0x8d,
+	0xc9, 0xa7, 0x4f, 0x6a, 0x00, 0x25, 0x54, 0xb8, 0x7b, 0x77, 0xf7, 0xe6, 0x15, 0xa5, 0x91, 0x33,
+	0x0d, 0x84, 0xa0, 0x60, 0xe9, 0x5d, 0x5c, 0xcd, 0xd1, 0xef, 0xe9, 0x6f, 0x34, 0x0b, 0x23, 0xb8,
+	0xab, 0x9b, 0x9d, 0x6a, 0x9e, 0x56, 0xb2, 0x02, 0x52, 0xa1, 0xd4, 0xd3, 0x5d, 0xf7, 0xd8, 0x76,
+	0x8c, 0x6a, 0x81, 0x02, 0xfc, 0x32, 0xf9, 0xa2, 0x65, 0x9b, 0x96, 0x5b, 0x1d, 0x59, 0x55, 0xae,
+	0x8c, 0x34, 0x58, 0x81, 0xb4, 0xdd, 0xc6, 0x5d, 0xb7, 0x5a, 0xa4, 0x95, 0xf4, 0x37, 0xda, 0x81,
+	0x11, 0xd3, 0x23, 0x95, 0xa3, 0xab, 0xf9, 0x2b, 0x63, 0x9b, 0x68, 0x43, 0x4c, 0x85, 0x7d, 0xcf,
+	0x76, 0xf0, 0xae, 0x87, 0xbb, 0x37, 0x16, 0x9f, 0x3e, 0xa9, 0x2d, 0x6c, 0xce, 0xc1, 0x34, 0x9d,
+	0x3a, 0x4d, 0x97, 0x00, 0x9a, 0xf4, 0xa3, 0x37, 0x9e, 0x69, 0xb0, 0xaf, 0xd1, 0x15, 0x18, 0x71,
+	0x3d, 0xdd, 0x73, 0xab, 0xa5, 0x55, 0x25, 0xd4, 0x0c, 0xe9, 0xf4, 0x3e, 0x81, 0x34, 0x18, 0xc2,
+	0x2b, 0xa5, 0xa7, 0x4f, 0x6a, 0x85, 0x92, 0xb2, 0xfa, 0x8c, 0xf6, 0xcb, 0x30, 0xbd, 0xed, 0x60,
+	0xdd, 0xc3, 0x04, 0xa7, 0x81, 0x1f, 0xf4, 0xb1, 0xeb, 0xf9, 0xfd, 0x57, 0x92, 0xfa, 0x9f, 0x4b,
+	0xeb, 0x7f, 0x3e, 0xdc, 0x7f, 0xed, 0x55, 0x40, 0x72, 0xd3, 0x7c, 0x78, 0x2e, 0x42, 0xd1, 0xc1,
+	0x6e, 0xbf, 0xe3, 0xd1, 0xd6, 0xc7, 0x36, 0x27, 0x42, 0x5c, 0x36, 0x38, 0x50, 0x5b, 0x83, 0xa9,
+	0x06, 0xd6, 0x0d, 0x99, 0xab, 0xc9, 0x60, 0xd4, 0xc8, 0x28, 0x69, 0x2f, 0x43, 0x25, 0x40, 0x39,
+	0x5d, 0xeb, 0xfb, 0x30, 0x7d, 0xb7, 0x67, 0x44, 0x7a, 0x1d, 0x69, 0x3f, 0x51, 0x0b, 0xb2, 0xfa,
+	0x3b, 0x0b, 0x48, 0x6e, 0x94, 0x71, 0xa4, 0x9d, 0x87, 0xe9, 0x9b, 0xb8, 0x83, 0x33, 0x49, 0x91,
+	0x4f, 0x65, 0x24, 0xfe, 0xe9, 0xbf, 0x2a, 0x50, 0xb9, 0x6d, 0xba, 0x1e, 0xa9, 0x74, 0xc5, 0xa7,
+	0x75, 0x28, 0x1e, 0x98, 0x1d, 0x0f, 0x3b, 0xbc, 0x87, 0x0b, 0x1b, 0x62, 0x1e, 0x6d, 0xe8, 0x3d,
+	0x73, 0xe3, 0x75, 0x0a, 0x33, 0xad, 0x76, 0x83, 0xa3, 0xa1, 0xe7, 0xa0, 0x64, 0x3b, 0x06, 0x76,
+	0x9a, 0xf7, 0x4e, 0x68, 0x57, 0xc6, 0x36, 0xe7, 0xc2, 0x9f, 0xec, 0xdb, 0x8e, 0x47, 0x3e, 0x18,
+	0xa5, 0x68, 0x37, 0x4e, 0xd0, 0x27, 0x08, 0x09, 0xdc, 0x31, 0x5c, 0xda, 0xc5, 0xb1, 0xcd, 0xa5,
+	0x28, 0x09, 0xdc, 0x31, 0xf6, 0x31, 0x37, 0x1c, 0x0d, 0x8e, 0x8b, 0x9e, 0x83, 0x62, 0x4f, 0x6f,
+	0x9b, 0x56, 0x9b, 0x4e, 0x84, 0xb1, 0xcd, 0x6a, 0xf8, 0xab, 0x3d, 0x02, 0xd3, 0xd9, 0x17, 0x0c,
+	0x4f, 0xbb, 0x0f, 0xd3, 0x52, 0xf7, 0xf8, 0x08, 0x5e, 0x86, 0x51, 0x36, 0x48, 0x6e, 0x55, 0x59,
+	0xcd, 0xc7, 0x87, 0x50, 0x40, 0xd1, 0x3a, 0x14, 0x7a, 0x7a, 0x1b, 0xf3, 0x3e, 0xcd, 0xc7, 0xa8,
+	0xe1, 0x5d, 0xeb, 0xc0, 0x6e, 0x50, 0x1c, 0xed, 0x15, 0x18, 0xbf, 0x6d, 0xb7, 0x4d, 0x2b, 0x6d,
+	0xa8, 0xe5, 0x61, 0xcd, 0x45, 0x86, 0xf5, 0x3b, 0x0a, 0x4c, 0xf0, 0x8f, 0x39, 0x8b, 0xb3, 0x30,
+	0xe2, 0xd9, 0x87, 0x58, 0xd8, 0x17, 0x56, 0x40, 0x2f, 0x03, 0xe0, 0x87, 0x3d, 0xd3, 0xc1, 0x6e,
+	0x53, 0xf7, 0x38, 0x57, 0xea, 0x06, 0x33, 0xd9, 0x1b, 0xc2, 0x64, 0x6f, 0xdc, 0x11, 0x26, 0xbb,
+	0x51, 0xe6, 0xd8, 0x5b, 0x1e, 0x31, 0x59, 0xa6, 0xbb, 0x65, 0x74, 0x4d, 0x8b, 0x4a, 0xbc, 0xd4,
+	0x10, 0x45, 0xb4, 0x00, 0xa3, 0x64, 0xc2, 0x37, 0x4d, 0x61, 0x5e, 0x8a, 0xa4, 0xb8, 0x6b, 0x68,
+	0xef, 0xc3, 0xfc, 0x2d, 0x47, 0xb7, 0xbc, 0xed, 0xbe, 0xe3, 0x60, 0xab, 0x65, 0x62, 0x37, 0xad,
+	0x6f, 0x8b, 0x50, 0xd6, 0x0d, 0xa3, 0xc9, 0x4c, 0x51, 0x8e, 0x5a, 0x9d, 0x92, 0x6e, 0x18, 0xdb,
+	0xa4, 0x8c, 0x6a, 0x40, 0x7e, 0x37, 0xa9, 0x45, 0xca, 0x53, 0xd8, 0xa8, 0x6e, 0x18, 0xb7, 0x70,
+	0xd7, 0xd5, 0x6a, 0xb0, 0x10, 0xa3, 0xc0, 0x15, 0x73, 0x1d, 0xaa, 0xb7, 0x30, 0x1d, 0xb7, 0x81,
+	0xe4, 0xb5, 0x1d, 0xa8, 0x25, 0xe0, 0x06, 0x92, 0x64, 0x7c, 0x29, 0x49, 0x26, 0x32, 0x17, 0x98,
+	0x48, 0xed, 0x07, 0x39, 0x18, 0xdf, 0x79, 0xd8, 0xba, 0xaf, 0x5b, 0x6d, 0xdc, 0xd0, 0x3d, 0x8c,
+	0x56, 0x7d, 0x3a, 0x23, 0x37, 0x2a, 0x4f, 0x9f, 0xd4, 0xc6, 0x01, 0x50, 0xd1, 0xc5, 0x8e, 0xa9,
+	0x77, 0xb8, 0x15, 0x3f, 0x0f, 0x13, 0x07, 0x8e, 0xdd, 0x6d, 0xb6, 0x18, 0xdd, 0x13, 0x3e, 0xb2,
+	0xe3, 0xa4, 0x92, 0xf3, 0x72, 0x82, 0xce, 0xc1, 0x98, 0x67, 0x07, 0x28, 0x6c, 0x4e, 0x83, 0x67,
+	0xfb, 0x08, 0x8b, 0x50, 0x76, 0x74, 0x0f, 0x33, 0x11, 0x15, 0x98, 0xf8, 0x48, 0x05, 0x91, 0x11,
+	0x5a, 0x06, 0xe8, 0x9a, 0x56, 0x53, 0xef, 0xda, 0x7d, 0xcb, 0xe3, 0x76, 0xbe, 0xdc, 0x35, 0xad,
+	0x2d, 0x5a, 0x41, 0xc1, 0xfa, 0x43, 0x01, 0x2e, 0x72, 0xb0, 0xfe, 0x90, 0x83, 0x17, 0xa1, 0x6c,
+	0xe8, 0x66, 0xe7, 0xa4, 0xd9, 0xd2, 0x7b, 0xd5, 0x51, 0xd6, 0x34, 0xad, 0xd8, 0xd6, 0x7b, 0xe4,
+	0x5b, 0x4a, 0x97, 0xc9, 0xa7, 0xc4, 0xbe, 0x25, 0x35, 0x74, 0xe0, 0x24, 0x0b, 0xfe, 0x3f, 0x0a,
+	0xcc, 0xef, 0x63, 0x4f, 0x16, 0x8e, 0x18, 0x8b, 0x98, 0x04, 0x94, 0xc1, 0x12, 0xc8, 0x65, 0x4b,
+	0x20, 0x9f, 0x29, 0x81, 0x42, 0xb6, 0x04, 0x46, 0x32, 0x25, 0x50, 0xcc, 0x94, 0xc0, 0x68, 0x44,
+	0x02, 0xda, 0x1b, 0xb0, 0x10, 0xeb, 0x36, 0x57, 0xab, 0xeb, 0x91, 0x55, 0x60, 0xce, 0x37, 0x21,
+	0x21, 0x74, 0xb1, 0x1a, 0x5c, 0x83, 0x1a, 0xb3, 0xbe, 0x49, 0x32, 0x0c, 0xf4, 0x79, 0x84, 0xea,
+	0xf3, 0x12, 0xa8, 0x49, 0xc8, 0x7c, 0x66, 0x7c, 0x4f, 0x81, 0x09, 0x01, 0xf8, 0x7c, 0xdf, 0xf6,
+	0x30, 0xba, 0x0a, 0x05, 0xc2, 0x73, 0x36, 0x27, 0x14, 0x05, 0xcd, 0x43, 0x91, 0x0b, 0x8a, 0x69,
+	0x3e, 0x2f, 0x11, 0xf3, 0xe0, 0xe0, 0x16, 0x36, 0x8f, 0xb0, 0x98, 0xa3, 0xbc, 0x88, 0x2e, 0xc3,
+	0x94, 0x43, 0x16, 0x62, 0xcb, 0xb4, 0xda, 0x4d, 0xcf, 0x36, 0xf4, 0x13, 0x3e, 0x04, 0x93, 0x7e,
+	0xf5, 0x1d, 0x52, 0xab, 0x6d, 0xc1, 0xc2, 0xad, 0xb0, 0xb0, 0x52, 0xed, 0x45, 0x0a, 0x17, 0xda,
+	0x9b, 0x50, 0x8d, 0x37, 0xc1, 0x05, 0xbe, 0x01, 0xc5, 0x07, 0xa4, 0xb7, 0xc2, 0x66, 0xcf, 0xc7,
+	0xba, 0x49, 0x85, 0xd1, 0xe0, 0x58, 0xda, 0xd7, 0x15, 0x58, 0x10, 0x10, 0xa1, 0x67, 0x69, 0xfc,
+	0x7c, 0x3c, 0xd3, 0x38, 0xe8, 0x55, 0x21, 0xd4, 0xab, 0x23, 0xa8, 0xc6, 0x19, 0x09, 0xac, 0x93,
+	0xdb, 0xc3, 0x96, 0x27, 0xac, 0x13, 0x2d, 0x90, 0xb5, 0x82, 0x8b, 0xdf, 0x10, 0xe6, 0x54, 0x94,
+	0x03, 0x7b, 0x96, 0x4f, 0xb2, 0x67, 0x05, 0xc9, 0x9e, 0xfd, 0x51, 0x01, 0xca, 0xfe, 0xee, 0xee,
+	0x4c, 0x1b, 0xd2, 0x55, 0x18, 0x33, 0xb0, 0xdb, 0x72, 0x4c, 0xba, 0x3f, 0xe6, 0x5d, 0x96, 0xab,
+	0xc8, 0x57, 0xde, 0x49, 0x0f, 0x0b, 0xba, 0xe4, 0x37, 0x11, 0x14, 0x65, 0xaa, 0xd9, 0x73, 0xcc,
+	0x16, 0xe6, 0x33, 0x12, 0x68, 0xd5, 0x1e, 0xa9, 0x21, 0xb3, 0x8e, 0x30, 0xc8, 0xe1, 0xdc, 0x66,
+	0x91, 0x1a, 0x06, 0xae, 0x41, 0xc9, 0xec, 0xea, 0x6d, 0x4c, 0x56, 0xa4, 0x51, 0xb6, 0xbd, 0xa6,
+	0xe5, 0x5d, 0x83, 0xac, 0x55, 0xb6, 0xd5, 0x74, 0xf5, 0x0e, 0xa6, 0xe6, 0xaa, 0xd4, 0x28, 0xda,
+	0xd6, 0xbe, 0xde, 0xc1, 0xe8, 0x0a, 0x54, 0x48, 0x6d, 0x53, 0x26, 0x5c, 0x66, 0x6a, 0x4a, 0xea,
+	0xb7, 0x03, 0xe2, 0x97, 0x60, 0x8a, 0x62, 0x4a, 0x1c, 0x00, 0x45, 0x9c, 0x20, 0xd5, 0xb7, 0x7c,
+	0x2e, 0x56, 0x00, 0x5a, 0xb6, 0xe5, 0xf6, 0xbb, 0xfa, 0xbd, 0x0e, 0xae, 0x8e, 0x51, 0x6a, 0x52,
+	0x0d, 0xb1, 0x2b, 0xc4, 0xec, 0xb8, 0x9e, 0xde, 0x3a, 0xac, 0x8e, 0xb3, 0x41, 0xea, 0xea, 0x0f,
+	0xf7, 0x49, 0x99, 0x88, 0xc0, 0xc1, 0x96, 0xa7, 0x77, 0x9a, 0x86, 0x7e, 0xe2, 0x56, 0x27, 0x98,
+	0x08, 0x58, 0xd5, 0x4d, 0xfd, 0xc4, 0x45, 0xcf, 0x02, 0xe2, 0x08, 0x32, 0xc7, 0x93, 0x14, 0xaf,
+	0xc2, 0x20, 0x12, 0xcf, 0xeb, 0x30, 0xcd, 0xb1, 0x25, 0xae, 0xa7, 0x28, 0xf2, 0x14, 0x03, 0x04,
+	0x7c, 0x57, 0x20, 0xef, 0x1e, 0xf6, 0xab, 0x15, 0x2a, 0x38, 0xf2, 0x93, 0xcd, 0x6d, 0xcf, 0x74,
+	0xb0, 0x51, 0x9d, 0x66, 0x4b, 0x3f, 0x2f, 0x4a, 0x16, 0xfe, 0xbf, 0xf2, 0x30, 0xcf, 0x76, 0xd2,
+	0xbe, 0xc6, 0x64, 0xed, 0xd4, 0x23, 0x8a, 0x91, 0x4b, 0x57, 0x8c, 0x7c, 0xba, 0x62, 0x14, 0x06,
+	0x28, 0xc6, 0x48, 0x96, 0x62, 0x14, 0x53, 0x15, 0x63, 0x74, 0xa0, 0x62, 0x94, 0x86, 0x55, 0x8c,
+	0xf2, 0x60, 0xc5, 0x80, 0x6c, 0xc5, 0x18, 0xcb, 0x56, 0x8c, 0xf1, 0x21, 0x15, 0x63, 0xe2, 0x34,
+	0x8a, 0x31, 0x99, 0xa9, 0x18, 0x53, 0xbe, 0x62, 0x68, 0x3b, 0xb0, 0x10, 0x1b, 0x73, 0x6e, 0x97,
+	0xd6, 0x23, 0xcb, 0x5b, 0xc2, 0x79, 0xd1, 0x5f, 0xdb, 0x2e, 0xc1, 0x2c, 0x39, 0x24, 0xc5, 0x14,
+	0x27, 0xba, 0x4d, 0xdb, 0x86, 0xb9, 0x08, 0xde, 0x19, 0x88, 0x7d, 0x00, 0xf3, 0xec, 0x04, 0x14,
+	0x23, 0xf7, 0x2c, 0x8c, 0xf6, 0xf4, 0x93, 0x8e, 0xad, 0x1b, 0x19, 0xcd, 0x08, 0x14, 0xb4, 0xe9,
+	0x1f, 0x40, 0xd2, 0xb6, 0xd1, 0xf4, 0x0c, 0xf2, 0x96, 0xee, 0x1e, 0x8a, 0xe3, 0x07, 0x91, 0x57,
+	0x8c, 0xf6, 0x19, 0xba, 0x70, 0x05, 0xe6, 0xd9, 0xf2, 0x3e, 0x50, 0x62, 0x35, 0x58, 0x88, 0x61,
+	0xf2, 0x5d, 0xc0, 0x8f, 0x73, 0x30, 0x47, 0x4e, 0x36, 0x3e, 0xe4, 0x17, 0xf0, 0xf4, 0x46, 0x56,
+	0xd4, 0x8e, 0xdd, 0xd2, 0x3b, 0xcc, 0x16, 0x94, 0x1b, 0xbc, 0x44, 0xf6, 0x24, 0xa6, 0xd5, 0xea,
+	0xf4, 0x0d, 0xdc, 0x14, 0x96, 0xad, 0x48, 0xe7, 0xe1, 0x24, 0xaf, 0x6e, 0xb0, 0x5a, 0xed, 0x9b,
+	0x0a, 0xcc, 0x47, 0xa5, 0xc4, 0x47, 0xec, 0xd9, 0xe8, 0x21, 0x30, 0x51, 0x5d, 0xce, 0x70, 0x12,
+	0x94, 0xb8, 0xce, 0xcb, 0x5c, 0x6b, 0xef, 0xc0, 0xd4, 0xb6, 0xee, 0xe9, 0x1d, 0xbb, 0xdd, 0xb0,
+	0x8f, 0x77, 0x1c, 0xc7, 0x76, 0xc8, 0x9c, 0x74, 0xec, 0x63, 0xbe, 0xf8, 0x93, 0x9f, 0x62, 0x96,
+	0xe6, 0x42, 0xe6, 0xbb, 0x8b, 0x5d, 0x57, 0x6f, 0x8b, 0xf6, 0x44, 0x51, 0xfb, 0x15, 0x98, 0xdd,
+	0xed, 0xf6, 0x6c, 0xc7, 0x13, 0xcd, 0x72, 0x0d, 0x98, 0x87, 0xe2, 0x81, 0xed, 0x74, 0x75, 0x8f,
+	0xab, 0x12, 0x2f, 0x11, 0x9b, 0x6c, 0xe8, 0x9e, 0x2e, 0x96, 0x78, 0xf2, 0x9b, 0x18, 0x4e, 0xc3,
+	0x39, 0x69, 0x3a, 0x7d, 0x71, 0x2e, 0x2c, 0x1a, 0xce, 0x49, 0xa3, 0x6f, 0x69, 0x3f, 0x50, 0x60,
+	0x2e, 0xd2, 0x7a, 0xe0, 0xfd, 0x6a, 0x51, 0xb3, 0x21, 0xf6, 0xac, 0xa2, 0x48, 0x20, 0x7d, 0x3a,
+	0x41, 0xc4, 0xb6, 0x45, 0x14, 0x09, 0x44, 0xef, 0xf5, 0x3a, 0x26, 0x36, 0xc4, 0xf1, 0x93, 0x17,
+	0x89, 0x56, 0x60, 0x22, 0x0b, 0xb2, 0x77, 0xc9, 0x53, 0xad, 0x10, 0xc3, 0x10, 0x11, 0x56, 0x83,
+	0xe3, 0x69, 0x1b, 0x30, 0xbb, 0xf3, 0x70, 0xf8, 0x6e, 0x13, 0xbb, 0xb3, 0xf3, 0x30, 0xa9, 0x23,
+	0xa7, 0x90, 0x93, 0xf6, 0x7d, 0x05, 0x2a, 0x7b, 0x7d, 0xa7, 0x9d, 0x35, 0x5f, 0x49, 0x83, 0x0e,
+	0x3e, 0xe8, 0x5b, 0xac, 0xfb, 0xa5, 0x06, 0x2f, 0xa1, 0xeb, 0x80, 0x5a, 0x76, 0xb7, 0x87, 0x2d,
+	0x97, 0xea, 0x77, 0x53, 0xde, 0xc0, 0x4d, 0xcb, 0x10, 0x76, 0x62, 0xbe, 0x06, 0xa1, 0x4a, 0xf9,
+	0x5c, 0x58, 0x91, 0x01, 0xf4, 0x0c, 0xdd, 0x83, 0x69, 0x89, 0x2f, 0xdf, 0xc3, 0x31, 0xa5, 0x1f,
+	0x1c, 0xe0, 0x96, 0x87, 0x8d, 0xa6, 0x7d, 0x6c, 0x61, 0x47, 0x1c, 0x7f, 0x27, 0x45, 0xf5, 0x3b,
+	0xb4, 0x16, 0x6d, 0xc2, 0x1c, 0xe3, 0x11, 0x1b, 0xcd, 0xb6, 0x79, 0xe0, 0x35, 0x5d, 0x6c, 0x19,
+	0x04, 0x9d, 0x8d, 0xdf, 0x8c, 0x00, 0xde, 0x32, 0x0f, 0xbc, 0x7d, 0x06, 0xd2, 0x1e, 0xc3, 0xac,
+	0x3f, 0x43, 0xee, 0x38, 0xba, 0xe5, 0x76, 0x28, 0x37, 0x44, 0x95, 0x4c, 0x0f, 0x77, 0x9b, 0xbe,
+	0x48, 0x8a, 0xa4, 0xb8, 0x6b, 0x48, 0x13, 0x22, 0x17, 0x9a, 0xc6, 0x62, 0x67, 0x91, 0x4f, 0xdf,
+	0x59, 0x14, 0x62, 0x3b, 0x0b, 0xed, 0x43, 0x05, 0x6a, 0xfb, 0xd8, 0x8b, 0x50, 0x17, 0x43, 0xf2,
+	0x73, 0x62, 0x62, 0x1f, 0xd4, 0x24, 0x1e, 0xb8, 0xf8, 0x5f, 0x8c, 0xac, 0x06, 0xcb, 0x71, 0xd3,
+	0x22, 0x7f, 0x26, 0x16, 0x86, 0x77, 0x60, 0x89, 0x99, 0xfb, 0x8f, 0xa9, 0x6f, 0xda, 0x39, 0x58,
+	0x4e, 0x69, 0x90, 0xaf, 0x22, 0x1e, 0x54, 0x6e, 0xf4, 0x4f, 0x6e, 0x9c, 0xc8, 0x8e, 0x43, 0xc9,
+	0x1f, 0xa4, 0xc8, 0xfe, 0x20, 0x99, 0x7c, 0x2e, 0x44, 0x5e, 0x85, 0xd2, 0x83, 0xbe, 0x6e, 0x79,
+	0xa6, 0x77, 0x22, 0x0e, 0xef, 0xa2, 0x4c, 0xc4, 0xeb, 0x60, 0x7e, 0x24, 0x2a, 0x35, 0xe8, 0x6f,
+	0x6d, 0x06, 0xa6, 0x25, 0xaa, 0x9c, 0x95, 0x37, 0x61, 0xfe, 0xce, 0x7d, 0xc7, 0x3e, 0xde, 0x3a,
+	0xd6, 0x3f, 0x2a, 0x43, 0x64, 0xdd, 0x8c, 0xb5, 0xc5, 0xc9, 0xbc, 0x0e, 0x68, 0xe7, 0x41, 0xdf,
+	0xec, 0x7d, 0x54, 0x12, 0x73, 0x30, 0x13, 0x6a, 0x87, 0x37, 0xff, 0x3c, 0xcc, 0x73, 0x57, 0x14,
+	0x5d, 0x6d, 0x76, 0x0d, 0x77, 0x10, 0x09, 0xed, 0xaf, 0x14, 0x18, 0x17, 0x1f, 0x90, 0x55, 0x24,
+	0x7d, 0x98, 0x55, 0x28, 0x61, 0x42, 0xb3, 0x87, 0x85, 0x81, 0xf1, 0xcb, 0x99, 0x63, 0x10, 0x76,
+	0x1b, 0x16, 0x4e, 0xe3, 0x36, 0xbc, 0x06, 0xd3, 0xfe, 0x31, 0xbf, 0xe9, 0xe2, 0x96, 0x6d, 0x19,
+	0xec, 0xb2, 0x21, 0xdf, 0xa8, 0xf8, 0x80, 0x7d, 0x56, 0xaf, 0xbd, 0x4e, 0x3d, 0x00, 0xe1, 0xce,
+	0xf3, 0x19, 0x71, 0x4d, 0x5c, 0x3f, 0xb0, 0xb5, 0x76, 0x2e, 0xe4, 0x70, 0x15, 0x3d, 0xe7, 0x97,
+	0x0c, 0xda, 0xcb, 0xb0, 0x42, 0xdc, 0x00, 0xbc, 0x6b, 0xa7, 0x12, 0xe6, 0xdb, 0x70, 0x2e, 0xf5,
+	0xd3, 0xb3, 0xb0, 0xf2, 0x15, 0xa8, 0x50, 0x0f, 0xa5, 0x6c, 0xf5, 0x4f, 0x3f, 0x41, 0xc2, 0x03,
+	0x90, 0x3f, 0xc5, 0x00, 0x90, 0xb9, 0x22, 0x31, 0xc0, 0xb5, 0xec, 0x1e, 0xa0, 0x6d, 0x7a, 0xe0,
+	0xc0, 0x1f, 0x8d, 0xaf, 0x0c, 0xa5, 0xd1, 0x5e, 0x80, 0x99, 0x10, 0x0d, 0x2e, 0xbd, 0x25, 0x28,
+	0xfb, 0xe3, 0xce, 0xd7, 0x94, 0xa0, 0x42, 0xfb, 0x0b, 0x05, 0x0a, 0x64, 0xa9, 0x48, 0xf2, 0x10,
+	0xb3, 0x95, 0x25, 0x60, 0xa2, 0xc4, 0x2a, 0x76, 0x0d, 0xb4, 0x06, 0xe3, 0x0e, 0x6e, 0x99, 0x3d,
+	0x13, 0x5b, 0x1e, 0x81, 0x73, 0x3f, 0x83, 0x5f, 0x17, 0xee, 0x42, 0x21, 0xd4, 0x05, 0x69, 0x77,
+	0x34, 0x12, 0xda, 0x1d, 0x11, 0xa1, 0xf3, 0x7d, 0x09, 0x11, 0x7a, 0x71, 0xb0, 0xd0, 0x39, 0xf6,
+	0x96, 0xa7, 0x7d, 0x4d, 0x81, 0x29, 0xd2, 0x0d, 0x59, 0xba, 0xa1, 0x1e, 0x28, 0x03, 0x7a, 0x90,
+	0xcb, 0xec, 0x41, 0x3e, 0xad, 0x07, 0x85, 0xf0, 0xfe, 0xee, 0x1a, 0x54, 0x02, 0x2e, 0xb8, 0xfc,
+	0x17, 0x60, 0x94, 0xae, 0xd3, 0xc1, 0x20, 0x93, 0xe2, 0xae, 0xa1, 0x6d, 0xc2, 0x02, 0xd9, 0xe9,
+	0xee, 0x61, 0xcb, 0x30, 0xad, 0x36, 0xf9, 0x6e, 0xf0, 0x6c, 0xf9, 0x2c, 0x54, 0xe3, 0xdf, 0x70,
+	0x42, 0xe7, 0x61, 0x84, 0xb4, 0x1c, 0xbf, 0x22, 0x21, 0x68, 0x0d, 0x06, 0xd3, 0x76, 0x60, 0x7a,
+	0xab, 0xd5, 0xc2, 0x3d, 0x8f, 0x56, 0x0e, 0xa1, 0x87, 0x82, 0xf7, 0x5c, 0x88, 0xf7, 0x59, 0x40,
+	0x72, 0x33, 0x81, 0xa9, 0xbe, 0x89, 0x5b, 0x1d, 0xd3, 0xc2, 0x1f, 0xad, 0xf5, 0x39, 0x98, 0x09,
+	0xb5, 0xc3, 0x9b, 0xff, 0x5d, 0x05, 0x4a, 0x74, 0x5d, 0x24, 0xae, 0x89, 0xaa, 0xe4, 0xea, 0xa7,
+	0x5e, 0x11, 0xc8, 0x65, 0xf8, 0xc5, 0xd6, 0x60, 0xdc, 0x30, 0xdd, 0x5e, 0x47, 0x3f, 0x69, 0x4a,
+	0x7b, 0x87, 0x31, 0x5e, 0xf7, 0x36, 0x41, 0x41, 0x50, 0x70, 0x3b, 0xb6, 0xc7, 0x87, 0x94, 0xfe,
+	0x26, 0x6e, 0x46, 0xf2, 0x97, 0x78, 0xa2, 0xf5, 0x16, 0x99, 0x73, 0xcc, 0xc3, 0x31, 0x4e, 0x2a,
+	0xb7, 0x79, 0x9d, 0xe4, 0x93, 0xf9, 0xae, 0x02, 0x48, 0x6c, 0x32, 0x4e, 0x7a, 0x69, 0xde, 0xe2,
+	0x9f, 0x37, 0x83, 0xda, 0x6b, 0x30, 0x13, 0xe2, 0x8a, 0xeb, 0xcb, 0xd5, 0xc8, 0x9e, 0x67, 0xda,
+	0x57, 0x18, 0x1f, 0x55, 0xec, 0x73, 0x2e, 0xc3, 0x9c, 0xb4, 0x2d, 0x49, 0xef, 0x9a, 0x56, 0x85,
+	0xf9, 0x28, 0x22, 0x1f, 0xbc, 0x79, 0x98, 0x25, 0x9a, 0x2b, 0xea, 0x85, 0xaa, 0x6b, 0x37, 0x61,
+	0x2e, 0x52, 0xef, 0x5b, 0xfd, 0xc8, 0x71, 0x2f, 0x81, 0x3f, 0x81, 0xa1, 0xe9, 0x30, 0x7a, 0xdb,
+	0xd6, 0x0d, 0xbb, 0x1f, 0x97, 0xb6, 0xa4, 0x7e, 0xb9, 0x90, 0xfa, 0x25, 0xed, 0x23, 0x89, 0xc3,
+	0x8a, 0xcd, 0x79, 0x76, 0xba, 0x21, 0x0e, 0x2b, 0x3a, 0xe9, 0x5d, 0xed, 0x4b, 0x30, 0xcb, 0x7c,
+	0x2f, 0x9c, 0xd0, 0x40, 0xf5, 0x4e, 0x1a, 0x66, 0xb9, 0xfd, 0x7c, 0xb8, 0xfd, 0x2d, 0x98, 0x8b,
+	0xb4, 0xcf, 0x05, 0x71, 0x25, 0x32, 0x4e, 0x15, 0x5f, 0x0e, 0x02, 0x53, 0x0c, 0x93, 0x05, 0xb3,
+	0xcc, 0xdd, 0x31, 0x2c, 0x8b, 0x4c, 0x56, 0xb9, 0x98, 0x66, 0x0e, 0x29, 0x92, 0x2d, 0x98, 0x8b,
+	0xd0, 0x3b, 0x35, 0xcb, 0x9f, 0x85, 0x59, 0xa6, 0x30, 0x67, 0x64, 0x59, 0x5b, 0x80, 0xb9, 0x48,
+	0x03, 0x5c, 0xe1, 0xb6, 0x60, 0x7e, 0xab, 0xe5, 0x99, 0x47, 0x67, 0x17, 0x07, 0xd9, 0x1e, 0xc5,
+	0x9a, 0x38, 0xcb, 0x9e, 0x64, 0x03, 0x66, 0x88, 0x8e, 0xf3, 0x36, 0x06, 0x5b, 0xf9, 0x1b, 0x30,
+	0x1b, 0xc6, 0xf7, 0x7d, 0x56, 0x91, 0x29, 0x11, 0x97, 0xab, 0x3f, 0x23, 0xbe, 0x51, 0x84, 0xc9,
+	0x5d, 0xeb, 0x08, 0x5b, 0x9e, 0xed, 0x9c, 0xec, 0x58, 0x9e, 0x73, 0x72, 0x86, 0xed, 0xc6, 0x99,
+	0x8e, 0x5a, 0xbe, 0x27, 0x79, 0x24, 0xdd, 0x93, 0x5c, 0x1c, 0xe0, 0x49, 0x1e, 0xcd, 0xf2, 0x24,
+	0x97, 0x52, 0x3d, 0xc9, 0xe5, 0x81, 0x9e, 0x64, 0x18, 0xd6, 0x93, 0x3c, 0x36, 0xd8, 0x93, 0x3c,
+	0x9e, 0xed, 0x49, 0x9e, 0x88, 0x78, 0x92, 0xe5, 0xc3, 0xc0, 0x64, 0xc6, 0x61, 0x60, 0x2a, 0x72,
+	0x18, 0x78, 0x15, 0xc6, 0xf4, 0xd6, 0x83, 0xbe, 0xe9, 0xb0, 0x7d, 0x51, 0x65, 0xe0, 0xbe, 0x08,
+	0x04, 0xfa, 0x16, 0x75, 0xb1, 0xb8, 0x76, 0xdf, 0x69, 0x61, 0x7a, 0x93, 0x50, 0x6e, 0xf0, 0x52,
+	0x64, 0x83, 0x8b, 0x4e, 0x73, 0xc2, 0x88, 0x78, 0xc4, 0x67, 0x86, 0xf4, 0x88, 0xcf, 0x9e, 0xc6,
+	0x23, 0x3e, 0x97, 0xe9, 0x11, 0x9f, 0x4f, 0xbc, 0x2a, 0x59, 0x48, 0xbb, 0x2a, 0xf9, 0x5f, 0x05,
+	0x26, 0xfc, 0xa9, 0x40, 0xaf, 0xd6, 0x2e, 0x41, 0x81, 0x68, 0x78, 0x86, 0xeb, 0x97, 0xc2, 0xcf,
+	0x7c, 0x7e, 0x8b, 0x0c, 0x59, 0xe1, 0x8c, 0x43, 0x36, 0x92, 0x31, 0x64, 0xc5, 0xd3, 0x9c, 0x49,
+	0xfe, 0x5a, 0x61, 0xfb, 0x46, 0x6a, 0x9c, 0x84, 0x24, 0x06, 0x9a, 0xc3, 0xc0, 0x2f, 0x9d, 0x3b,
+	0xbd, 0x5f, 0x3a, 0x3f, 0x94, 0x5f, 0xfa, 0xf4, 0xf1, 0x41, 0x27, 0x50, 0x4b, 0xe8, 0x09, 0x37,
+	0x90, 0xcf, 0x45, 0x0d, 0x64, 0x70, 0xe7, 0x1c, 0x52, 0x80, 0xb3, 0x05, 0x0c, 0xfd, 0xa6, 0x02,
+	0x65, 0x3f, 0x6a, 0x6e, 0x88, 0x58, 0x93, 0x59, 0x18, 0x69, 0xeb, 0x5d, 0x2c, 0x5c, 0x73, 0xac,
+	0x40, 0xac, 0xe3, 0x71, 0xe0, 0x4c, 0xa4, 0xbf, 0x49, 0x9d, 0x67, 0xf7, 0x5e, 0xf4, 0x2f, 0x65,
+	0xed, 0xde, 0x8b, 0xe4, 0xeb, 0x43, 0xb3, 0xd3, 0xf1, 0x23, 0x05, 0x69, 0x41, 0xd2, 0xea, 0x7f,
+	0x52, 0x60, 0xee, 0x16, 0xf6, 0x6e, 0x63, 0xdd, 0xc0, 0xce, 0x3d, 0x5b, 0x77, 0x0c, 0x31, 0xa0,
+	0x9b, 0x50, 0xec, 0x62, 0xcf, 0x31, 0x5b, 0x94, 0xbb, 0xc9, 0x4d, 0x35, 0x58, 0x25, 0x02, 0xe4,
+	0xb7, 0x28, 0x46, 0x83, 0x63, 0x92, 0x53, 0xa2, 0xee, 0xb6, 0xd8, 0xb1, 0x82, 0xab, 0x7a, 0x50,
+	0x41, 0x78, 0xe9, 0x98, 0x5d, 0xd3, 0x13, 0x57, 0xd8, 0xb4, 0x40, 0x14, 0xb5, 0xd5, 0x77, 0x5c,
+	0xdb, 0x11, 0x27, 0x3c, 0x56, 0x22, 0x06, 0x42, 0x77, 0xec, 0xbe, 0x65, 0x34, 0x89, 0x22, 0x71,
+	0x2d, 0x06, 0x56, 0x45, 0xe4, 0xc7, 0x4e, 0x66, 0xba, 0x6b, 0x5b, 0xe2, 0x5e, 0x70, 0xa4, 0x51,
+	0x62, 0x15, 0xbb, 0x86, 0xf6, 0x00, 0x2a, 0x12, 0x9b, 0x6c, 0xe5, 0x22, 0x3e, 0x29, 0xdd, 0x3a,
+	0xe4, 0xbb, 0x3a, 0xfa, 0x3b, 0x7d, 0x5f, 0xa7, 0x42, 0x89, 0xfc, 0x92, 0x16, 0x2e, 0xbf, 0x4c,
+	0x3a, 0x72, 0xa4, 0x77, 0xfa, 0xe2, 0x2a, 0x93, 0x15, 0xb4, 0x3f, 0x50, 0xa8, 0x13, 0x28, 0x24,
+	0x4a, 0xae, 0x51, 0x67, 0x91, 0xe5, 0x0b, 0x30, 0x8a, 0x2d, 0xcf, 0x31, 0xe9, 0xc8, 0x13, 0x2d,
+	0xac, 0x25, 0x7d, 0x44, 0x7b, 0xd6, 0x10, 0x98, 0x44, 0x68, 0x16, 0x7e, 0xe8, 0x35, 0xb9, 0x44,
+	0x19, 0xe3, 0x40, 0xaa, 0xb6, 0x69, 0x8d, 0xf6, 0xc7, 0x0a, 0xbb, 0xb5, 0x0b, 0xe2, 0x36, 0xf9,
+	0x70, 0xcb, 0xfd, 0x55, 0x22, 0xfd, 0x0d, 0x49, 0x3a, 0x17, 0x96, 0x34, 0x01, 0x76, 0x74, 0xd7,
+	0x6b, 0x1e, 0x63, 0x7c, 0xc8, 0x9d, 0xfc, 0x25, 0x52, 0xf1, 0x2e, 0xc6, 0x87, 0x64, 0x3d, 0xa6,
+	0xc0, 0xae, 0x6d, 0x79, 0xf7, 0xb9, 0x33, 0x90, 0xa2, 0xbf, 0x45, 0x2a, 0xc8, 0x79, 0x85, 0x81,
+	0x75, 0xaf, 0x75, 0x1f, 0x0b, 0x25, 0x1d, 0xa3, 0x08, 0xac, 0x4a, 0xfb, 0x35, 0x18, 0xbf, 0x89,
+	0x1d, 0xf3, 0x08, 0x1b, 0x6c, 0xc2, 0xd4, 0xa0, 0x74, 0x68, 0x34, 0x1d, 0x32, 0x9d, 0x29, 0x9f,
+	0x4a, 0x63, 0xf4, 0xd0, 0x68, 0x90, 0x22, 0x01, 0x1d, 0x9b, 0x56, 0x93, 0xc6, 0xc4, 0xe4, 0x18,
+	0xe8, 0xd8, 0xb4, 0x68, 0x48, 0xd7, 0x22, 0x94, 0xc9, 0x74, 0x60, 0xb0, 0x3c, 0x85, 0x95, 0x48,
+	0x85, 0x00, 0xea, 0x47, 0xed, 0x26, 0x9b, 0x27, 0x05, 0x06, 0xd4, 0x8f, 0xda, 0x9f, 0x23, 0x65,
+	0xad, 0x03, 0x13, 0xef, 0x9a, 0x96, 0x61, 0x1f, 0x0b, 0x06, 0xd6, 0xa1, 0xe8, 0xd9, 0x9e, 0xde,
+	0x71, 0x63, 0x76, 0x3f, 0x90, 0x29, 0xc7, 0x40, 0x75, 0x18, 0x35, 0x18, 0xf3, 0xfe, 0x0d, 0x9b,
+	0x40, 0x96, 0x3b, 0xd5, 0x10, 0x58, 0xda, 0x8f, 0x72, 0xec, 0xb2, 0x34, 0x68, 0x6a, 0xf0, 0x4d,
+	0xa3, 0x44, 0x96, 0x61, 0x9c, 0x9a, 0x2c, 0x7a, 0x21, 0x3a, 0x86, 0xb2, 0xcd, 0x0b, 0x75, 0x5f,
+	0x1a, 0xdb, 0x17, 0x63, 0x63, 0x9b, 0xfe, 0x95, 0x34, 0xe6, 0x2f, 0x27, 0x8c, 0x79, 0xfa, 0x87,
+	0x21, 0x5d, 0xf8, 0x43, 0x45, 0xdc, 0x02, 0x9f, 0x56, 0x7d, 0x69, 0x24, 0xa2, 0x64, 0x45, 0x49,
+	0x68, 0xe2, 0x2d, 0x52, 0x16, 0x61, 0x8a, 0x92, 0x31, 0x25, 0x61, 0x8a, 0xef, 0x4a, 0x11, 0x8c,
+	0x92, 0x4d, 0x25, 0xa0, 0x3b, 0xc4, 0xac, 0xf2, 0x26, 0x65, 0xd3, 0x4a, 0x70, 0x99, 0xca, 0x60,
+	0x58, 0x88, 0x71, 0xe9, 0x2f, 0x2d, 0xa5, 0xbe, 0xd5, 0xb1, 0x5b, 0x87, 0xf4, 0x12, 0x8d, 0xcc,
+	0xea, 0x59, 0xbf, 0xe3, 0x5b, 0xad, 0xfb, 0x26, 0x3e, 0xc2, 0x5d, 0x6c, 0x79, 0x0d, 0x1f, 0x8b,
+	0x6c, 0x4d, 0x0e, 0x3a, 0xe4, 0x71, 0x80, 0xd8, 0x3b, 0x88, 0xa2, 0x66, 0xc3, 0xc2, 0x0d, 0x22,
+	0x18, 0x71, 0x37, 0x2d, 0x49, 0xa3, 0x06, 0x25, 0x2a, 0xde, 0x60, 0x35, 0x1e, 0xa5, 0x65, 0xea,
+	0x93, 0xe4, 0x97, 0x73, 0xc2, 0xac, 0x9c, 0x0b, 0x14, 0x29, 0x51, 0xb4, 0xe2, 0x32, 0xcf, 0xd5,
+	0xfe, 0x59, 0x81, 0x0a, 0xa5, 0x28, 0xfa, 0xd4, 0xef, 0x64, 0x0b, 0x3e, 0xd5, 0xb8, 0xa6, 0x5f,
+	0x0b, 0x4a, 0xdd, 0x2d, 0x84, 0xba, 0x1b, 0x12, 0xdd, 0xc8, 0x50, 0xa2, 0xf3, 0x83, 0xd6, 0x8b,
+	0x03, 0x82, 0xd6, 0xb5, 0x43, 0xa8, 0xc6, 0x45, 0xc9, 0x87, 0xec, 0x85, 0xe8, 0x6e, 0x20, 0xb0,
+	0xc3, 0x51, 0x61, 0x04, 0x1b, 0x02, 0x1a, 0xc9, 0x45, 0x7c, 0x2e, 0xc1, 0x96, 0x4f, 0x94, 0xb5,
+	0xdf, 0xcb, 0xc1, 0xf4, 0xeb, 0xac, 0x53, 0xe4, 0x5b, 0x46, 0x73, 0x78, 0x87, 0x43, 0x48, 0x9b,
+	0xf3, 0x19, 0xda, 0x5c, 0x48, 0xd7, 0xe6, 0x91, 0x0c, 0x6d, 0x2e, 0x86, 0xb5, 0x99, 0x9c, 0x56,
+	0x8e, 0x4c, 0x9b, 0x5d, 0x20, 0xb1, 0x67, 0x04, 0xe5, 0x86, 0x54, 0x43, 0x37, 0x9a, 0x9e, 0xee,
+	0xf5, 0x5d, 0x7e, 0xa2, 0xe2, 0xa5, 0x88, 0x1f, 0xb6, 0x7c, 0x1a, 0x3f, 0xec, 0x97, 0x61, 0x99,
+	0xec, 0xce, 0x62, 0x42, 0xf2, 0xf5, 0xfb, 0x2a, 0x54, 0x82, 0x40, 0x00, 0xd7, 0xee, 0x1c, 0xf1,
+	0x3b, 0xe9, 0x52, 0x43, 0x04, 0x08, 0x34, 0x78, 0xb5, 0xb4, 0x37, 0xcc, 0x0d, 0xb9, 0x37, 0xfc,
+	0x50, 0x81, 0x95, 0x34, 0xf2, 0x5c, 0x27, 0x3e, 0x11, 0xd5, 0x89, 0x60, 0x41, 0x8f, 0x7d, 0x75,
+	0xb6, 0x5d, 0x62, 0x1d, 0x96, 0xb7, 0x3b, 0x58, 0x77, 0xe2, 0xcd, 0xa5, 0xf8, 0xcc, 0x56, 0x61,
+	0x25, 0xed, 0x03, 0xee, 0xca, 0xd8, 0x84, 0xd5, 0x86, 0xdd, 0xe9, 0xdc, 0xd3, 0x5b, 0x87, 0x43,
+	0xb7, 0xfa, 0x15, 0x58, 0xcb, 0xf8, 0xe6, 0x0c, 0x4b, 0xd3, 0x06, 0x91, 0xdc, 0x91, 0x7d, 0x48,
+	0xe7, 0x45, 0xfa, 0x24, 0x16, 0x48, 0x5a, 0x13, 0x2a, 0xd4, 0xfa, 0xef, 0xe9, 0x8e, 0x67, 0xb6,
+	0xcc, 0x9e, 0x6e, 0x65, 0x1c, 0x35, 0x96, 0xa0, 0xdc, 0xeb, 0xe8, 0x2d, 0xda, 0x04, 0x37, 0xf4,
+	0x41, 0x45, 0xb0, 0x15, 0xce, 0x4b, 0x5b, 0x61, 0xed, 0xdf, 0x15, 0x40, 0x0d, 0xdc, 0xb2, 0x1d,
+	0x83, 0xd2, 0x19, 0xc2, 0x82, 0x22, 0x28, 0x74, 0x6d, 0xc3, 0xf7, 0xc8, 0x91, 0xdf, 0x44, 0x21,
+	0x8d, 0xbe, 0xc3, 0xae, 0xed, 0xc5, 0x75, 0x19, 0x23, 0x33, 0x25, 0xea, 0xf9, 0x6d, 0x19, 0x7a,
+	0x89, 0x32, 0x79, 0x32, 0xec, 0x99, 0xae, 0xc4, 0x90, 0xb7, 0x3c, 0xf4, 0x69, 0x18, 0xef, 0x05,
+	0x52, 0x70, 0xab, 0x23, 0x11, 0x6b, 0x14, 0x95, 0x53, 0x23, 0x84, 0xae, 0xfd, 0x83, 0x02, 0x63,
+	0xbc, 0x8b, 0xc7, 0xba, 0x63, 0xa4, 0x4b, 0xf1, 0x32, 0x4c, 0xf9, 0x42, 0x0b, 0xc5, 0xf6, 0x4f,
+	0xfa, 0xd5, 0x2c, 0x5e, 0x61, 0x19, 0x80, 0xc8, 0x30, 0x14, 0xd6, 0x50, 0x26, 0x35, 0x0c, 0x7c,
+	0x09, 0xa6, 0x0e, 0x4c, 0x87, 0xec, 0x2a, 0x4c, 0x11, 0xfa, 0xc0, 0x4c, 0xd2, 0x04, 0xad, 0x7e,
+	0xd7, 0xe4, 0x61, 0x0f, 0x34, 0xc0, 0xd6, 0x3f, 0xe7, 0x8b, 0xb8, 0x51, 0x5a, 0xc5, 0x10, 0xa4,
+	0x35, 0xa1, 0x18, 0x5e, 0x02, 0xdf, 0x87, 0x99, 0xd0, 0xd8, 0x71, 0x85, 0xcc, 0x18, 0x3c, 0xaa,
+	0x7f, 0xa4, 0xff, 0x6e, 0x4c, 0xff, 0x24, 0xe1, 0x34, 0x04, 0x12, 0x79, 0x1c, 0x30, 0x4d, 0x01,
+	0x6f, 0x98, 0x6e, 0xe0, 0x03, 0xfb, 0x7f, 0xa8, 0x1d, 0x55, 0x18, 0xa5, 0xbf, 0x1d, 0x21, 0x41,
+	0x51, 0x0c, 0xcf, 0x8a, 0x62, 0xea, 0xac, 0x18, 0x95, 0x66, 0x05, 0xbb, 0xd8, 0x22, 0x12, 0x08,
+	0x3d, 0x12, 0x18, 0x63, 0x75, 0x2c, 0x48, 0xbe, 0xcd, 0x2e, 0x9e, 0x64, 0xe1, 0x0c, 0xb3, 0x19,
+	0x3b, 0xbd, 0x3d, 0xfe, 0x32, 0x54, 0xe3, 0x84, 0x06, 0x1b, 0xe2, 0xd8, 0xa8, 0x9d, 0xcd, 0x10,
+	0x3f, 0x4f, 0x54, 0xec, 0x5e, 0xdf, 0xec, 0x18, 0xc3, 0xee, 0x37, 0xb5, 0x57, 0x61, 0x36, 0xfc,
+	0x89, 0x7f, 0xb5, 0x36, 0xe1, 0xd0, 0x7a, 0x8f, 0x1e, 0x69, 0x45, 0x6c, 0xce, 0x38, 0xaf, 0xa4,
+	0x8f, 0x95, 0xb4, 0x3f, 0x51, 0xa0, 0xb8, 0x4f, 0xcf, 0x56, 0x43, 0xdd, 0xf8, 0xbc, 0x04, 0x65,
+	0xd7, 0xd3, 0x1d, 0x6f, 0xc8, 0x1b, 0xe6, 0x12, 0x43, 0xde, 0xf2, 0xd8, 0xf1, 0xd2, 0x18, 0x32,
+	0x32, 0xa0, 0x48, 0x50, 0xb7, 0x68, 0xaf, 0x75, 0xa7, 0x75, 0x9f, 0x9e, 0x2c, 0x46, 0xd8, 0xb6,
+	0x46, 0x94, 0x49, 0xe0, 0xd8, 0x0c, 0x0f, 0x2b, 0xa5, 0xec, 0x67, 0xc5, 0x11, 0x87, 0xb8, 0xce,
+	0x9d, 0x8d, 0xeb, 0xfc, 0xb0, 0x5c, 0x93, 0xdb, 0x81, 0x30, 0x63, 0x7e, 0xb4, 0x54, 0x78, 0xdd,
+	0x9a, 0x0a, 0x3c, 0x78, 0x0c, 0x91, 0x83, 0xc9, 0x3d, 0x25, 0x8d, 0x26, 0xa4, 0xb5, 0xfe, 0x9d,
+	0xd3, 0x6b, 0x30, 0x13, 0xaa, 0xf5, 0x2f, 0xc4, 0x22, 0x2a, 0x19, 0x6b, 0x56, 0xc0, 0xb5, 0xbf,
+	0x53, 0xa0, 0x48, 0x8e, 0xae, 0x56, 0x3b, 0xdd, 0x1a, 0x93, 0xd8, 0x32, 0x8a, 0xc2, 0x8f, 0xb4,
+	0xbc, 0x44, 0x66, 0xb5, 0x81, 0x8f, 0x4c, 0xdd, 0x8f, 0xd0, 0x57, 0x1a, 0x41, 0x05, 0xdd, 0xb4,
+	0xd1, 0x1d, 0x5a, 0x87, 0x38, 0x16, 0xd9, 0x99, 0x56, 0xaa, 0x09, 0x9c, 0x4a, 0x23, 0xb2, 0x53,
+	0xe9, 0x35, 0x98, 0xa4, 0x47, 0xb3, 0xc0, 0x02, 0x0d, 0xf6, 0x0f, 0xd2, 0xc3, 0xdc, 0x1e, 0xb7,
+	0x42, 0xda, 0x4f, 0xc8, 0x6a, 0x4a, 0x19, 0x1c, 0xd6, 0x5e, 0xfe, 0x6c, 0xfa, 0x17, 0x32, 0xa3,
+	0x23, 0xc3, 0x9b, 0x51, 0x6d, 0x1f, 0x2a, 0xb7, 0xb0, 0xc7, 0xba, 0x30, 0x8c, 0x39, 0x3b, 0x0f,
+	0x13, 0xf7, 0x59, 0x4f, 0x9b, 0xcc, 0xb7, 0xc5, 0x96, 0xca, 0x71, 0x5e, 0x79, 0x9b, 0xd4, 0x69,
+	0x2e, 0x4c, 0x4b, 0x8d, 0x0e, 0xd4, 0x3e, 0x8e, 0xc8, 0xc1, 0xe8, 0x45, 0x18, 0xe5, 0xad, 0xf1,
+	0x25, 0x6b, 0x31, 0x82, 0x19, 0x36, 0x72, 0x1c, 0x57, 0xdb, 0x90, 0x88, 0xca, 0x07, 0x43, 0xae,
+	0x66, 0x4c, 0x3b, 0xcb, 0x8d, 0x51, 0xa6, 0x67, 0xae, 0xf6, 0x59, 0x40, 0x32, 0xfe, 0x60, 0x6d,
+	0xe6, 0x6c, 0xfa, 0xda, 0xfc, 0xe7, 0x39, 0x18, 0x93, 0xf6, 0x70, 0x43, 0x99, 0xaf, 0xc1, 0x2f,
+	0x4d, 0x02, 0xd7, 0x59, 0x61, 0x68, 0xd7, 0x59, 0x1d, 0x46, 0xdc, 0x96, 0xcd, 0xef, 0x8e, 0x26,
+	0xa5, 0x2d, 0x92, 0xc4, 0xde, 0x3e, 0x41, 0x68, 0x30, 0x3c, 0xa2, 0x6c, 0xde, 0x7d, 0x07, 0xbb,
+	0xf7, 0xed, 0x8e, 0x70, 0x25, 0x06, 0x15, 0xb1, 0xc5, 0x70, 0x34, 0xb6, 0x18, 0xb2, 0xdb, 0x0c,
+	0x8a, 0x42, 0x83, 0x36, 0x4b, 0xe2, 0x36, 0x83, 0x54, 0xd1, 0xc7, 0x6c, 0x17, 0x60, 0x92, 0x23,
+	0x88, 0x3b, 0xb2, 0x32, 0x7b, 0x6b, 0xc4, 0x6a, 0x77, 0x59, 0x74, 0xd9, 0x9f, 0xe5, 0xa0, 0xca,
+	0x4c, 0x95, 0xbc, 0x19, 0xfe, 0x48, 0x0f, 0x32, 0x02, 0xf9, 0xe5, 0x4f, 0x2f, 0xbf, 0xc2, 0x59,
+	0xe4, 0x37, 0x32, 0x48, 0x7e, 0xc5, 0x81, 0xf2, 0x1b, 0x1d, 0x42, 0x7e, 0xa5, 0x04, 0xf9, 0xed,
+	0x42, 0x2d, 0x41, 0x7c, 0x7e, 0xe4, 0x77, 0x78, 0xc2, 0x25, 0x9f, 0x3c, 0x84, 0xcd, 0x5f, 0x87,
+	0x2a, 0xbb, 0x11, 0x4e, 0x18, 0x89, 0xe8, 0x29, 0x69, 0x11, 0x6a, 0x09, 0xb8, 0xfc, 0xd8, 0x55,
+	0x63, 0xfb, 0x24, 0x09, 0xe4, 0xaf, 0x20, 0x6f, 0x42, 0x35, 0x0e, 0xf2, 0xdf, 0xbd, 0x45, 0x26,
+	0x5e, 0xea, 0x41, 0x89, 0xcd, 0xbe, 0x9f, 0x28, 0x30, 0x45, 0x76, 0x10, 0x12, 0x10, 0xfd, 0x12,
+	0xb9, 0x40, 0xf2, 0x8b, 0x99, 0xdd, 0x96, 0x11, 0xe9, 0x9b, 0x65, 0xc7, 0x6e, 0x3b, 0xd8, 0xf5,
+	0xfd, 0x65, 0xa2, 0x4c, 0x60, 0xbe, 0x1b, 0x86, 0x7b, 0x7b, 0x45, 0x99, 0x5c, 0x58, 0x89, 0xdf,
+	0x43, 0x5e, 0x58, 0x09, 0xf4, 0x2d, 0x4f, 0xfb, 0x24, 0xa8, 0x3c, 0x8a, 0x30, 0x41, 0x54, 0x99,
+	0xfb, 0xad, 0xcf, 0xc3, 0x62, 0xe2, 0x97, 0xbe, 0xf3, 0x3d, 0x22, 0xc9, 0x6a, 0xe8, 0x7c, 0x9a,
+	0x28, 0xcd, 0xff, 0xc8, 0x41, 0xe1, 0x6d, 0x7c, 0xec, 0x0e, 0x7c, 0x3e, 0x17, 0xf6, 0x72, 0xe4,
+	0x4e, 0xe1, 0xe5, 0xa0, 0x6f, 0xbd, 0x4d, 0xcf, 0x7f, 0x2e, 0xc0, 0x0a, 0x43, 0x5c, 0x8c, 0x2f,
+	0x03, 0xb0, 0x4b, 0xec, 0x8e, 0x69, 0x1d, 0xf2, 0x7b, 0x91, 0x32, 0xad, 0xb9, 0x6d, 0x5a, 0x87,
+	0xe8, 0x9a, 0xef, 0x8f, 0x29, 0xd2, 0xd9, 0x3b, 0xe3, 0xf7, 0x96, 0x74, 0x68, 0x9f, 0x82, 0x64,
+	0x27, 0x4d, 0xaf, 0x7f, 0xaf, 0x63, 0xba, 0xf7, 0x09, 0xfb, 0xa3, 0x83, 0xd9, 0xe7, 0xd8, 0xfc,
+	0x38, 0xca, 0x0a, 0xac, 0xef, 0xa5, 0x81, 0x1f, 0x8f, 0xf9, 0xf8, 0x5b, 0x9e, 0x74, 0x05, 0xf5,
+	0x53, 0x45, 0x24, 0x8a, 0x20, 0x0c, 0x8a, 0x01, 0xf7, 0xa5, 0xa3, 0x64, 0x48, 0x27, 0x37, 0x48,
+	0x3a, 0xf9, 0xa8, 0x74, 0xc2, 0x1d, 0x2e, 0x9c, 0xa6, 0xc3, 0xe4, 0x84, 0xc5, 0x0a, 0x7c, 0xef,
+	0x2b, 0x8a, 0x41, 0x3a, 0x0a, 0xd6, 0x81, 0x81, 0x09, 0x23, 0x28, 0x5a, 0x24, 0x1d, 0x85, 0xdc,
+	0xf7, 0x94, 0x74, 0x14, 0x67, 0x69, 0xfd, 0x03, 0x91, 0x8e, 0x22, 0xa3, 0xfd, 0x40, 0xd6, 0xb9,
+	0x0c, 0x59, 0xe7, 0x07, 0xc9, 0xba, 0x10, 0x91, 0x75, 0x90, 0xb5, 0x42, 0x66, 0x3c, 0xc8, 0x5a,
+	0x91, 0xd5, 0x63, 0x3f, 0x6b, 0x45, 0xe8, 0xd3, 0x26, 0xa0, 0x3d, 0x26, 0xf2, 0xac, 0xde, 0x84,
+	0x87, 0x38, 0x77, 0x8a, 0x21, 0xd6, 0x3e, 0x05, 0x33, 0x21, 0x02, 0xa7, 0x93, 0xf5, 0x6b, 0x30,
+	0x7b, 0xd7, 0xea, 0x0d, 0x66, 0x90, 0x78, 0xbf, 0xd9, 0xa9, 0x49, 0xb8, 0xf4, 0x79, 0x51, 0xfb,
+	0x0c, 0xcc, 0x45, 0x5a, 0x38, 0x1d, 0x07, 0xff, 0xad, 0xc0, 0x14, 0x59, 0x51, 0x64, 0xea, 0xbf,
+	0xf8, 0xef, 0xc2, 0x48, 0xb4, 0x76, 0xd0, 0xeb, 0xc1, 0xc9, 0x3e, 0x28, 0xde, 0xc7, 0xfa, 0xc4,
+	0xeb, 0x03, 0x98, 0x22, 0x8d, 0x46, 0x5e, 0xc5, 0x58, 0xf8, 0xd8, 0x95, 0x0e, 0x74, 0xa4, 0x98,
+	0xf1, 0x20, 0xe5, 0x8c, 0xcb, 0x81, 0xf6, 0x35, 0xf6, 0x2e, 0x26, 0x42, 0x5f, 0x0a, 0xcb, 0xf8,
+	0xf9, 0xb0, 0xf1, 0x36, 0xa8, 0x49, 0x5c, 0xf8, 0xf7, 0x5e, 0x61, 0xfd, 0xad, 0x86, 0x06, 0x23,
+	0xf3, 0x51, 0xcc, 0xc7, 0xd4, 0xb1, 0xe0, 0x51, 0x4c, 0x0a, 0x8f, 0xda, 0x8f, 0x15, 0x98, 0xa4,
+	0xa1, 0x3b, 0x07, 0x8e, 0x6d, 0x79, 0xfb, 0x24, 0x30, 0x76, 0x70, 0x74, 0x46, 0xd2, 0xa1, 0xe6,
+	0x1c, 0x8c, 0xd1, 0x88, 0xbd, 0x66, 0x8b, 0x66, 0x05, 0x60, 0x9e, 0x3e, 0xa0, 0x55, 0xdb, 0xa4,
+	0x06, 0x3d, 0x07, 0x85, 0x9e, 0x6d, 0x77, 0xf8, 0xcb, 0xb7, 0xa5, 0x70, 0xe0, 0x10, 0xa5, 0xbe,
+	0x67, 0xdb, 0x1d, 0x76, 0x9e, 0xa3, 0x98, 0xd2, 0x6a, 0xe9, 0xc0, 0x4c, 0x02, 0xda, 0x10, 0x9c,
+	0xa6, 0x86, 0xe7, 0xcd, 0x43, 0xf1, 0x18, 0x9b, 0xed, 0xfb, 0x82, 0x53, 0x5e, 0x92, 0x68, 0xda,
+	0x30, 0x1f, 0xd0, 0x6c, 0xf0, 0xc4, 0x64, 0x54, 0x40, 0x0b, 0x30, 0x4a, 0x23, 0x87, 0x05, 0xed,
+	0x46, 0x91, 0x14, 0x53, 0xc2, 0x56, 0xaf, 0x88, 0x68, 0xc7, 0x7c, 0xea, 0xc3, 0x4b, 0x86, 0x40,
+	0xc2, 0x7c, 0x6f, 0x61, 0x4f, 0xa2, 0xc9, 0x37, 0xcc, 0x7f, 0xcf, 0xa2, 0x55, 0x64, 0x00, 0x57,
+	0xb0, 0x0a, 0xe4, 0x49, 0x8a, 0x0a, 0xa6, 0x0a, 0xe4, 0x27, 0x7a, 0x11, 0x46, 0x08, 0x2f, 0xf1,
+	0x6b, 0xce, 0xe4, 0xae, 0x34, 0x18, 0x36, 0xfa, 0x0c, 0x4c, 0xd0, 0x08, 0x0a, 0x07, 0xbb, 0xd8,
+	0x1b, 0xce, 0xcf, 0x44, 0x43, 0x2e, 0x1a, 0x04, 0x7f, 0x8b, 0x5c, 0x70, 0xcc, 0x70, 0x17, 0x6f,
+	0xb3, 0x6f, 0x79, 0x66, 0x87, 0x35, 0x44, 0x67, 0x4c, 0xbe, 0x31, 0xcd, 0x41, 0x77, 0x09, 0x84,
+	0x7e, 0xa1, 0x3d, 0x0b, 0xd5, 0x3d, 0x07, 0x1f, 0x99, 0xf8, 0x38, 0xd6, 0xdd, 0x78, 0xa7, 0x34,
+	0x03, 0x6a, 0x09, 0xd8, 0x1f, 0xb3, 0x0c, 0x88, 0x49, 0x59, 0x94, 0x5e, 0x88, 0xfb, 0xf3, 0x21,
+	0xeb, 0x24, 0x1a, 0x51, 0xfa, 0x5c, 0xaa, 0xd2, 0xe7, 0x87, 0x55, 0x7a, 0x62, 0x02, 0x92, 0xb9,
+	0xe0, 0xfd, 0xad, 0x47, 0x8c, 0xca, 0x42, 0x42, 0x9b, 0xf4, 0x03, 0x61, 0x53, 0xbe, 0xab, 0xc0,
+	0xa2, 0xf4, 0x92, 0x3b, 0xd6, 0xaf, 0x61, 0x3c, 0x16, 0x1f, 0xff, 0xe4, 0xd6, 0x56, 0x60, 0x29,
+	0x99, 0x2b, 0x6e, 0x98, 0xae, 0xc3, 0xa2, 0xf4, 0x1c, 0x7c, 0x10, 0xd7, 0xa4, 0xb9, 0x64, 0x74,
+	0xde, 0xdc, 0x12, 0xa8, 0xfe, 0xdb, 0x68, 0x1f, 0xea, 0x9f, 0x49, 0xf7, 0x60, 0x31, 0x11, 0xca,
+	0x65, 0xfe, 0x7c, 0x74, 0x59, 0x4d, 0x15, 0xba, 0x7f, 0x96, 0xfa, 0x12, 0x54, 0xf7, 0x4c, 0x2b,
+	0x80, 0x46, 0xde, 0x2e, 0x25, 0xdb, 0x0f, 0xae, 0xcb, 0xb9, 0x40, 0x97, 0xd3, 0x1e, 0xd2, 0x90,
+	0xd3, 0x77, 0x42, 0xfb, 0xbc, 0xb3, 0xef, 0x83, 0x7a, 0xd7, 0xea, 0xfd, 0x2c, 0xc9, 0x2f, 0xc3,
+	0x62, 0x22, 0x05, 0xce, 0xc0, 0x6f, 0x2b, 0x30, 0x7a, 0x0b, 0x77, 0xf7, 0x48, 0xec, 0xee, 0x59,
+	0x72, 0xb1, 0x88, 0x0c, 0x2f, 0x79, 0x29, 0xa9, 0xdf, 0x39, 0x18, 0xa3, 0x01, 0xae, 0xcd, 0x16,
+	0x26, 0x17, 0x81, 0xcc, 0xb6, 0x00, 0xad, 0xda, 0x26, 0x35, 0xe4, 0xb4, 0xec, 0x27, 0xac, 0x61,
+	0x5b, 0x25, 0xbf, 0x2c, 0x99, 0xf5, 0x47, 0xc2, 0x2f, 0xce, 0xf9, 0xcb, 0x9a, 0xde, 0x09, 0x89,
+	0xb3, 0xa2, 0x6c, 0xe4, 0x33, 0xd9, 0x28, 0x84, 0xd9, 0x08, 0x1e, 0x2a, 0xf8, 0xc4, 0x07, 0x46,
+	0xfd, 0x0b, 0x4c, 0x29, 0x01, 0x05, 0x53, 0xf4, 0x08, 0xff, 0xd1, 0xc3, 0x84, 0x1f, 0xdc, 0x1f,
+	0x21, 0x45, 0x5e, 0x08, 0x11, 0x5d, 0xe7, 0xd5, 0xfe, 0x14, 0xe0, 0x81, 0xf3, 0x41, 0xf5, 0xe0,
+	0xc0, 0x79, 0xd1, 0xb2, 0xaf, 0xf4, 0xbb, 0xa2, 0x7b, 0x7b, 0x7d, 0xa7, 0x75, 0x5f, 0x77, 0xf1,
+	0x30, 0xef, 0x98, 0x7a, 0x7a, 0xeb, 0x50, 0x5a, 0x9f, 0x49, 0x71, 0x97, 0x5e, 0xac, 0xcc, 0x47,
+	0xdb, 0xe2, 0x1c, 0x2d, 0x42, 0xd9, 0xb4, 0x3c, 0xfe, 0xf8, 0x8c, 0xbb, 0x45, 0x58, 0xc5, 0x2e,
+	0xcd, 0x6e, 0xd4, 0xea, 0xd0, 0x97, 0x69, 0x2e, 0x6e, 0x39, 0xd8, 0x13, 0xd9, 0x8d, 0x58, 0xe5,
+	0x3e, 0xad, 0xfb, 0x68, 0x63, 0xf8, 0x39, 0x98, 0xff, 0x02, 0x4f, 0x9a, 0xd9, 0xc0, 0x2d, 0x6c,
+	0xf6, 0x06, 0x3f, 0x8e, 0x10, 0x09, 0xa7, 0x7a, 0x82, 0x1d, 0x51, 0xd4, 0x3e, 0x03, 0x0b, 0xb1,
+	0xc6, 0x82, 0x8b, 0x33, 0x1a, 0xd5, 0xdd, 0x72, 0xb0, 0x61, 0x06, 0xf9, 0x07, 0xc6, 0x49, 0xe5,
+	0x36, 0xaf, 0x5b, 0xff, 0x34, 0x4c, 0xc7, 0x3c, 0x9a, 0xa8, 0x04, 0x85, 0x77, 0x77, 0xdf, 0xde,
+	0xaf, 0x3c, 0x83, 0xca, 0x30, 0xf2, 0xb9, 0xdd, 0xdb, 0xb7, 0xf7, 0x2b, 0x0a, 0xf9, 0x79, 0x6b,
+	0xeb, 0xad, 0x9d, 0xfd, 0x4a, 0x8e, 0xc0, 0xef, 0xbc, 0xb3, 0xf7, 0x62, 0x25, 0xbf, 0x7e, 0x0d,
+	0x2a, 0x51, 0xef, 0x26, 0x1a, 0x87, 0xd2, 0xed, 0xdd, 0xd7, 0x77, 0xee, 0xec, 0xbe, 0xb5, 0xc3,
+	0x5a, 0x78, 0x6b, 0xeb, 0xce, 0xf6, 0x1b, 0x15, 0x65, 0x7d, 0x1b, 0x20, 0x70, 0xa6, 0x10, 0xc0,
+	0xcd, 0xc6, 0xd6, 0xeb, 0x77, 0x2a, 0xcf, 0xa0, 0x09, 0x28, 0xef, 0x6f, 0xbf, 0xb1, 0x73, 0xf3,
+	0xee, 0xed, 0x9d, 0x9b, 0x15, 0x85, 0x14, 0xf7, 0xee, 0xde, 0xb8, 0xbd, 0xbb, 0xff, 0xc6, 0xce,
+	0xcd, 0x4a, 0x8e, 0xb4, 0xb7, 0xd5, 0xd8, 0x7e, 0x63, 0xf7, 0x0b, 0x3b, 0x37, 0x2b, 0xf9, 0xcd,
+	0xf7, 0xd9, 0xfb, 0x5f, 0x77, 0x9f, 0xe9, 0x10, 0xda, 0x03, 0xb8, 0x85, 0x3d, 0x9e, 0x73, 0x14,
+	0xcd, 0xc7, 0x36, 0x1c, 0x3b, 0x24, 0x39, 0xad, 0x1a, 0xec, 0x9c, 0x23, 0xd9, 0x49, 0xb5, 0xca,
+	0x87, 0xff, 0xf8, 0x6f, 0xdf, 0xc9, 0x01, 0x2a, 0xd5, 0x79, 0x56, 0xd2, 0xcd, 0x1f, 0x02, 0x8c,
+	0x50, 0x12, 0xe8, 0x0e, 0x14, 0x99, 0x0a, 0xa1, 0xc0, 0xff, 0x1b, 0x4b, 0xce, 0xa9, 0x2e, 0x26,
+	0xc2, 0x78, 0xf3, 0xd3, 0xb4, 0xf9, 0x31, 0xad, 0xc8, 0x52, 0xec, 0xbe, 0xa2, 0xac, 0xa3, 0x3d,
+	0x28, 0x34, 0xb0, 0x6e, 0xa0, 0x80, 0xa7, 0x48, 0x62, 0x4d, 0xb5, 0x96, 0x00, 0xe1, 0xed, 0xcd,
+	0xd0, 0xf6, 0x26, 0xd0, 0x18, 0x6b, 0xaf, 0xfe, 0xc8, 0x34, 0x1e, 0x23, 0x1b, 0x8a, 0x3c, 0x1e,
+	0x4a, 0x4d, 0x08, 0x4b, 0x8b, 0xf3, 0x99, 0x90, 0x15, 0xf3, 0xd9, 0x7f, 0xf9, 0x69, 0xed, 0x19,
+	0xda, 0xb6, 0xa6, 0xca, 0x6d, 0xbf, 0xa2, 0xac, 0xbf, 0x57, 0xd9, 0x8c, 0xd4, 0xa0, 0xf7, 0xa1,
+	0xc8, 0x6c, 0x83, 0x44, 0x30, 0x96, 0x54, 0x53, 0x5d, 0x4c, 0x84, 0x71, 0x82, 0xcb, 0x4f, 0x9f,
+	0xd4, 0x8a, 0x2c, 0xfd, 0x2b, 0xeb, 0xd2, 0x7a, 0xa8, 0x4b, 0x6f, 0x41, 0x81, 0x58, 0x13, 0x24,
+	0x85, 0xef, 0x46, 0x12, 0x6f, 0xaa, 0x6a, 0x12, 0x88, 0xb7, 0x3e, 0x49, 0xdb, 0x2c, 0x21, 0x2e,
+	0x76, 0xf4, 0x0e, 0x8c, 0xd0, 0x94, 0x91, 0x28, 0x88, 0xe9, 0x94, 0xf3, 0x4f, 0xaa, 0xf3, 0xd1,
+	0x6a, 0xde, 0xce, 0x02, 0x6d, 0x67, 0x5a, 0x1b, 0xe7, 0xbc, 0x75, 0x08, 0x94, 0x48, 0xe0, 0x18,
+	0xa6, 0x22, 0xc9, 0x18, 0x51, 0xb0, 0x4f, 0x4c, 0x4e, 0x04, 0xa9, 0xae, 0xa6, 0x23, 0x70, 0x72,
+	0x6b, 0x94, 0xdc, 0xa2, 0x36, 0x2f, 0x89, 0xa2, 0xde, 0xf2, 0xf1, 0x08, 0xe1, 0x0f, 0xe8, 0x05,
+	0x55, 0x38, 0x7d, 0x23, 0x5a, 0x0b, 0x5a, 0x4e, 0x49, 0x03, 0xa9, 0x6a, 0x59, 0x28, 0x9c, 0xfc,
+	0x0a, 0x25, 0x5f, 0x45, 0x29, 0xe4, 0x51, 0x0f, 0xa6, 0x22, 0x19, 0xfe, 0xa4, 0x4e, 0x27, 0xa7,
+	0x3c, 0x54, 0x57, 0xd3, 0x11, 0x38, 0x55, 0x95, 0x52, 0x9d, 0xd5, 0xa6, 0xea, 0x98, 0x83, 0x69,
+	0xc0, 0x31, 0xed, 0xed, 0xb7, 0x14, 0xe1, 0xd2, 0x0a, 0x51, 0xd5, 0x22, 0x9a, 0x95, 0x44, 0xf8,
+	0x7c, 0x26, 0x0e, 0xa7, 0xbd, 0xf1, 0xf4, 0x49, 0x6d, 0x32, 0x9c, 0xc8, 0x92, 0x72, 0x33, 0xbf,
+	0x3e, 0x1b, 0xe1, 0x86, 0xa9, 0xe5, 0x23, 0x7a, 0xd1, 0x29, 0xa3, 0xbb, 0x68, 0x55, 0x96, 0x6c,
+	0x52, 0x46, 0x3f, 0x75, 0x2d, 0x03, 0x83, 0x33, 0xa2, 0x51, 0xb2, 0x4b, 0x48, 0x95, 0x45, 0x1f,
+	0xe6, 0x00, 0x3d, 0x84, 0x4a, 0x34, 0x35, 0x9e, 0x44, 0x3c, 0x25, 0x7d, 0x9f, 0xba, 0x96, 0x81,
+	0xc1, 0x89, 0x9f, 0xa3, 0xc4, 0x6b, 0xda, 0x6c, 0x12, 0xf1, 0x57, 0x94, 0x75, 0x95, 0xef, 0x7e,
+	0x2a, 0xcf, 0x6c, 0xfe, 0xe9, 0x12, 0x40, 0x90, 0x1f, 0x08, 0x19, 0xbe, 0x85, 0x3c, 0x17, 0xb1,
+	0x82, 0xd1, 0x74, 0x4d, 0xea, 0x6a, 0x3a, 0x42, 0x6c, 0xb2, 0x49, 0xd9, 0x94, 0x99, 0xb9, 0x61,
+	0x16, 0x73, 0x39, 0x64, 0x17, 0x63, 0x14, 0x56, 0xd2, 0xc0, 0xe2, 0x4e, 0x89, 0xb6, 0x3f, 0x83,
+	0xa6, 0xe5, 0xf6, 0xd9, 0xb8, 0xfe, 0xbe, 0xe2, 0x9b, 0xd0, 0x68, 0x64, 0x6f, 0x46, 0x47, 0x52,
+	0xf2, 0x5b, 0x69, 0x77, 0x7c, 0x63, 0xfa, 0xa6, 0x5a, 0x0b, 0x13, 0xe3, 0x19, 0xb5, 0x36, 0x88,
+	0x21, 0x15, 0xe9, 0xb5, 0xde, 0xbb, 0xb0, 0x39, 0x04, 0x16, 0xea, 0xfb, 0x46, 0xf7, 0x5c, 0x44,
+	0xb5, 0x33, 0x58, 0x4c, 0xcb, 0x88, 0x75, 0xe5, 0xe9, 0x93, 0xda, 0x98, 0x94, 0xf1, 0x90, 0x89,
+	0x66, 0x3d, 0x41, 0x34, 0x5f, 0xe4, 0x96, 0x78, 0x25, 0x64, 0x6e, 0x63, 0x99, 0xb4, 0xd4, 0x73,
+	0xa9, 0x70, 0x4e, 0x72, 0x96, 0xd2, 0x98, 0x44, 0xa1, 0xe1, 0x45, 0x4d, 0x28, 0xfb, 0xe9, 0x4d,
+	0x24, 0x6b, 0x1f, 0x4d, 0xb4, 0xa2, 0xaa, 0x49, 0x20, 0xde, 0xf2, 0x22, 0x6d, 0x79, 0x4e, 0xab,
+	0x84, 0xb8, 0xbf, 0xd7, 0x3f, 0x21, 0xca, 0x73, 0x02, 0x53, 0x91, 0x3c, 0x1b, 0xb2, 0xa5, 0x4e,
+	0x4c, 0x3f, 0xa2, 0xae, 0xa6, 0x23, 0x08, 0x7f, 0x3c, 0x25, 0xb9, 0x8c, 0x16, 0x43, 0x24, 0xc9,
+	0xf4, 0xa9, 0x3f, 0xe2, 0x7b, 0xb8, 0xc7, 0xe8, 0x87, 0x0a, 0xcb, 0xf2, 0x99, 0x90, 0x60, 0x03,
+	0x5d, 0x0e, 0xd9, 0x84, 0xf4, 0xec, 0x1d, 0xea, 0x95, 0xc1, 0x88, 0x62, 0x0d, 0xa7, 0x3c, 0x5d,
+	0x42, 0x17, 0x32, 0x78, 0xaa, 0xfb, 0x6f, 0xe8, 0xda, 0x30, 0x26, 0xe5, 0x64, 0x41, 0xc1, 0x62,
+	0x1d, 0xcf, 0xf8, 0xa2, 0x2e, 0x25, 0x03, 0xc5, 0x52, 0x4e, 0xe9, 0x2e, 0x68, 0x28, 0x44, 0x97,
+	0x12, 0xe2, 0x4b, 0x65, 0x24, 0xbf, 0x8c, 0x34, 0x00, 0xc9, 0x59, 0x6c, 0xd4, 0xd5, 0x74, 0x84,
+	0xd8, 0x52, 0x29, 0x13, 0xf5, 0x08, 0xb6, 0x7e, 0xac, 0xd3, 0x91, 0xd7, 0xa1, 0xec, 0x67, 0x03,
+	0x91, 0x54, 0x2b, 0x9a, 0xa2, 0x44, 0x55, 0x93, 0x40, 0x99, 0x7d, 0x6b, 0x13, 0x3c, 0x42, 0xc2,
+	0x84, 0x31, 0x29, 0xef, 0x87, 0x24, 0xc4, 0x78, 0xc6, 0x11, 0x75, 0x29, 0x19, 0x18, 0xb3, 0xc1,
+	0x32, 0x21, 0xf6, 0xbe, 0x95, 0xd8, 0x60, 0xf4, 0x45, 0x28, 0x89, 0xfc, 0x16, 0xd2, 0xd6, 0x31,
+	0x92, 0x78, 0x43, 0xad, 0x25, 0x40, 0x84, 0x07, 0x83, 0xad, 0x6c, 0x5a, 0x78, 0x8e, 0x93, 0xb4,
+	0x0f, 0xa4, 0xf9, 0x0f, 0x79, 0x6e, 0x73, 0x39, 0xbd, 0x85, 0xb4, 0xba, 0xa4, 0x64, 0xcb, 0x50,
+	0xd7, 0x32, 0x30, 0x38, 0xdd, 0xab, 0x94, 0xee, 0x79, 0xb4, 0x96, 0xa5, 0x96, 0x6d, 0x4a, 0xef,
+	0x10, 0x20, 0x48, 0x6d, 0x21, 0xed, 0x2d, 0x63, 0x69, 0x33, 0xd4, 0xc5, 0x44, 0x18, 0xa7, 0x78,
+	0x81, 0x52, 0x5c, 0xd1, 0x6a, 0xb1, 0x9e, 0xba, 0x75, 0x9d, 0xa2, 0x93, 0x1e, 0xdb, 0x30, 0x26,
+	0x65, 0xba, 0x40, 0xf2, 0x6e, 0x35, 0x9a, 0x47, 0x43, 0x5d, 0x4a, 0x06, 0x72, 0x7a, 0x17, 0x29,
+	0xbd, 0x73, 0x9a, 0x9a, 0x40, 0xcf, 0x60, 0xf8, 0x84, 0xe0, 0x11, 0x4c, 0x84, 0x72, 0xc4, 0x49,
+	0xeb, 0x59, 0x52, 0x66, 0x3a, 0x75, 0x25, 0x0d, 0xcc, 0xc9, 0x5e, 0xa2, 0x64, 0x57, 0xb5, 0xb0,
+	0x0d, 0x6a, 0x31, 0xac, 0xba, 0x49, 0xbf, 0x21, 0x74, 0x5d, 0x92, 0x02, 0x39, 0x99, 0xee, 0xce,
+	0xc3, 0x4c, 0xba, 0x89, 0x99, 0xe0, 0x52, 0x6c, 0x9f, 0xa0, 0x8b, 0xe9, 0x37, 0xe8, 0x00, 0xca,
+	0x7e, 0xa6, 0x35, 0x69, 0xf2, 0x45, 0xb3, 0xc2, 0xa9, 0x6a, 0x12, 0x28, 0xbc, 0x29, 0xd2, 0x16,
+	0x62, 0xab, 0x52, 0xbd, 0x47, 0x90, 0x49, 0xe7, 0xbe, 0x2f, 0xe5, 0xfd, 0x90, 0x2e, 0x92, 0x34,
+	0x79, 0xdb, 0x99, 0x9c, 0x21, 0x4c, 0x3d, 0x9f, 0x89, 0xc3, 0x79, 0x78, 0x89, 0xf2, 0xf0, 0xbc,
+	0xfa, 0x6c, 0x84, 0x07, 0xe6, 0xd5, 0x7a, 0x5c, 0xf7, 0x82, 0x6f, 0xdc, 0xfa, 0x23, 0x76, 0x6b,
+	0x42, 0xcf, 0x48, 0xbf, 0xa3, 0x84, 0x12, 0x77, 0x48, 0xbc, 0x5d, 0x8c, 0xac, 0xce, 0x29, 0xec,
+	0x5d, 0x1a, 0x84, 0xc6, 0x39, 0xfc, 0x04, 0xe5, 0x70, 0x63, 0xfd, 0x54, 0x1c, 0xa2, 0xf7, 0x61,
+	0x4c, 0x4a, 0x4c, 0x22, 0x69, 0x7f, 0x3c, 0x89, 0x8a, 0xba, 0x94, 0x0c, 0x14, 0xd9, 0x45, 0x28,
+	0xfd, 0x8a, 0x36, 0x56, 0xa7, 0x24, 0xbd, 0x93, 0x1e, 0xdb, 0xbb, 0x9f, 0xc0, 0x64, 0x38, 0x1f,
+	0x89, 0xb4, 0x85, 0x48, 0xcc, 0x68, 0xa2, 0x9e, 0x4b, 0x85, 0x0b, 0x8d, 0x67, 0xee, 0x3f, 0x51,
+	0x4f, 0x09, 0xa3, 0xf5, 0x8a, 0x44, 0x98, 0xed, 0x59, 0x5a, 0x30, 0x11, 0x4a, 0x6c, 0x22, 0x69,
+	0x7c, 0x52, 0x22, 0x14, 0x75, 0x25, 0x0d, 0x1c, 0x3b, 0x75, 0x07, 0x94, 0xd0, 0x57, 0x60, 0x22,
+	0x94, 0x34, 0x44, 0x22, 0x92, 0x94, 0xac, 0x44, 0x5d, 0x49, 0x03, 0x73, 0x22, 0x75, 0x4a, 0xe4,
+	0xaa, 0x96, 0xb9, 0x7c, 0x77, 0xd8, 0x47, 0x54, 0xc0, 0x5f, 0x53, 0x60, 0x22, 0x94, 0x03, 0x44,
+	0xe2, 0x20, 0x29, 0x17, 0x89, 0xba, 0x92, 0x06, 0x0e, 0x6b, 0x92, 0x7a, 0x75, 0x18, 0x0e, 0x7c,
+	0x67, 0xc0, 0x57, 0x15, 0x98, 0x08, 0xa5, 0x01, 0x91, 0xd8, 0x48, 0xca, 0x2f, 0xa2, 0xae, 0xa4,
+	0x81, 0x45, 0x5a, 0x38, 0xca, 0xc6, 0xb5, 0xf5, 0xe1, 0xd9, 0x40, 0xdf, 0x51, 0x60, 0x2a, 0x92,
+	0x2e, 0x44, 0xda, 0x64, 0x24, 0xe7, 0x22, 0x51, 0x57, 0xd3, 0x11, 0x38, 0x27, 0x9f, 0xa6, 0x9c,
+	0xbc, 0xa4, 0x6d, 0x0e, 0xcd, 0x49, 0x5d, 0xe7, 0x4d, 0xb1, 0x19, 0x30, 0x2e, 0xe7, 0x12, 0x41,
+	0x4b, 0x21, 0x35, 0x8b, 0xa4, 0x24, 0x51, 0x97, 0x53, 0xa0, 0xa7, 0xd9, 0xdd, 0x09, 0x5e, 0xd0,
+	0x6f, 0x28, 0xc1, 0xff, 0xf2, 0xf0, 0x9f, 0xdf, 0xa3, 0xb5, 0x98, 0xcb, 0x24, 0x9a, 0x91, 0x40,
+	0xd5, 0xb2, 0x50, 0xc4, 0xd5, 0x0a, 0x65, 0xe5, 0x32, 0xba, 0x98, 0xc5, 0x8a, 0x29, 0x3e, 0x93,
+	0x4e, 0x8f, 0xff, 0x59, 0x01, 0x60, 0xde, 0x3b, 0xfa, 0x28, 0xf8, 0x5b, 0x0a, 0x94, 0xe8, 0xc5,
+	0x24, 0x29, 0x2c, 0xc7, 0x9c, 0x5e, 0xf2, 0xd3, 0x01, 0x75, 0x25, 0x0d, 0xcc, 0x79, 0xba, 0x41,
+	0x79, 0xfa, 0x14, 0x3d, 0xdc, 0xe9, 0x9e, 0xcb, 0x18, 0x21, 0x4e, 0xf8, 0xc7, 0xef, 0x31, 0x46,
+	0xc3, 0x95, 0x75, 0xf6, 0x12, 0xdb, 0xad, 0x3f, 0xf2, 0xdf, 0x68, 0x3f, 0x46, 0xdf, 0x54, 0x60,
+	0x4c, 0x7a, 0xcc, 0x88, 0x06, 0x3d, 0xf2, 0x54, 0x57, 0xd3, 0x11, 0x38, 0x5b, 0x9f, 0xf4, 0x8f,
+	0x82, 0x1b, 0x6a, 0x9c, 0x35, 0xe2, 0x5d, 0x9b, 0xdf, 0x4c, 0xac, 0x47, 0x3d, 0xfe, 0x6c, 0x54,
+	0x66, 0x68, 0x35, 0xfc, 0x88, 0x32, 0xfe, 0x86, 0x55, 0x5d, 0xcb, 0xc0, 0x48, 0x38, 0x66, 0x13,
+	0xb2, 0xf7, 0x08, 0x22, 0xa1, 0xd8, 0x86, 0xc9, 0xf0, 0x4b, 0x7c, 0xc9, 0x60, 0x27, 0x66, 0x3b,
+	0x50, 0xcf, 0xa5, 0xc2, 0x63, 0x67, 0xbe, 0x8e, 0xd4, 0xec, 0x17, 0x61, 0x4c, 0x7a, 0x80, 0x24,
+	0xad, 0x3d, 0xf1, 0x27, 0x65, 0xea, 0x52, 0x32, 0x30, 0x6c, 0x98, 0xb5, 0x52, 0x9d, 0x3f, 0x8c,
+	0x66, 0x2e, 0xb2, 0x4a, 0xf4, 0xe9, 0x4b, 0x64, 0x27, 0x9b, 0xf0, 0xfc, 0x46, 0x5d, 0xcb, 0xc0,
+	0x08, 0x9f, 0x39, 0x50, 0x2d, 0xae, 0x4e, 0x9c, 0x3c, 0x3a, 0x80, 0x71, 0xf9, 0x15, 0x0b, 0x92,
+	0xd9, 0x8f, 0xbd, 0x87, 0x51, 0x97, 0x53, 0xa0, 0x61, 0x87, 0x85, 0x36, 0xc9, 0xe9, 0xb1, 0x27,
+	0x2f, 0x06, 0x73, 0x89, 0x8c, 0xcb, 0xaf, 0x33, 0x24, 0x3a, 0x09, 0xaf, 0x49, 0xd4, 0xe5, 0x14,
+	0x68, 0x4c, 0x8a, 0x7c, 0x56, 0x10, 0x0a, 0xef, 0xc1, 0x98, 0xf4, 0x50, 0x43, 0x1a, 0xa4, 0xf8,
+	0xa3, 0x0e, 0x75, 0x29, 0x19, 0x18, 0x73, 0xb1, 0xf3, 0xe6, 0x91, 0x01, 0x65, 0x3f, 0x6a, 0x5e,
+	0x3e, 0x99, 0x45, 0xde, 0x10, 0xa8, 0x6a, 0x12, 0x88, 0xb7, 0xba, 0x4a, 0x5b, 0x55, 0x51, 0x35,
+	0x3e, 0x18, 0xfc, 0x31, 0xc4, 0xbb, 0x00, 0xfe, 0x67, 0x2e, 0x4a, 0x68, 0xcb, 0x8d, 0x9f, 0x26,
+	0xe2, 0xc1, 0xfc, 0x12, 0xfb, 0x0e, 0x6f, 0xca, 0x13, 0xa1, 0x97, 0x72, 0xd8, 0xf0, 0x5a, 0x44,
+	0xc6, 0xf1, 0x08, 0x68, 0x55, 0xcb, 0x42, 0xe1, 0xd4, 0xaa, 0x94, 0x1a, 0xd2, 0x26, 0xea, 0x52,
+	0x6c, 0xb1, 0xcb, 0x8e, 0x0f, 0xd3, 0xb1, 0x78, 0x69, 0x89, 0x6a, 0x5a, 0xdc, 0xb5, 0xaa, 0x65,
+	0xa1, 0x84, 0x7d, 0xb0, 0xeb, 0x28, 0x44, 0x95, 0xad, 0xad, 0x16, 0x9b, 0x4e, 0xd2, 0x67, 0xd1,
+	0x83, 0x61, 0x42, 0xe8, 0xb1, 0xba, 0x96, 0x81, 0x21, 0x2e, 0x12, 0x29, 0xd1, 0x29, 0x14, 0xee,
+	0x2a, 0xfa, 0x86, 0x02, 0x33, 0x09, 0x91, 0xc9, 0xe8, 0x7c, 0xd4, 0x29, 0x93, 0x44, 0xf6, 0x42,
+	0x36, 0x52, 0xf8, 0xe4, 0x84, 0x56, 0xe2, 0xba, 0x13, 0x62, 0xe5, 0xab, 0x3c, 0x23, 0x7a, 0xfc,
+	0x51, 0x33, 0xba, 0x14, 0xea, 0x5f, 0xea, 0xa3, 0x6b, 0xf5, 0xf2, 0x40, 0xbc, 0xf0, 0x36, 0x1a,
+	0x4d, 0xd6, 0xf9, 0x3b, 0xcd, 0x26, 0xe5, 0x0d, 0x7d, 0x9b, 0x5c, 0x64, 0x26, 0xbe, 0x51, 0x96,
+	0x78, 0xc8, 0x7c, 0xf5, 0xac, 0x5e, 0x1e, 0x88, 0x17, 0x3b, 0x38, 0x87, 0x78, 0xe0, 0x17, 0x01,
+	0xe4, 0x5b, 0xa2, 0x88, 0x3f, 0x52, 0xa0, 0x96, 0xfa, 0xbe, 0x19, 0x5d, 0x0d, 0x6c, 0xda, 0x80,
+	0x77, 0xd3, 0xea, 0xfa, 0x30, 0xa8, 0x9c, 0xb5, 0xcb, 0x94, 0xb5, 0x35, 0x6d, 0x29, 0x89, 0x35,
+	0x87, 0x7f, 0x1e, 0xf6, 0x55, 0xff, 0xed, 0x28, 0x8c, 0xd1, 0x0b, 0x47, 0x46, 0x04, 0xed, 0xa7,
+	0x5e, 0xe7, 0x49, 0x91, 0x9f, 0xea, 0x62, 0x22, 0x2c, 0x6c, 0x0b, 0xb4, 0x91, 0x3a, 0x89, 0x9b,
+	0x23, 0xc2, 0x78, 0x27, 0xf1, 0x36, 0x4f, 0x6e, 0xb0, 0x96, 0x00, 0xe1, 0xcd, 0x21, 0xda, 0xdc,
+	0x38, 0x02, 0xda, 0x1c, 0x9b, 0x6e, 0xdd, 0xd4, 0xcb, 0xbc, 0x64, 0x2e, 0x13, 0x82, 0x85, 0xd7,
+	0xfd, 0x4d, 0xc7, 0xaa, 0x2a, 0x35, 0x4d, 0x76, 0x1b, 0x53, 0x9b, 0xe1, 0x0a, 0xf4, 0x26, 0x77,
+	0xef, 0x56, 0x43, 0x7a, 0x9a, 0xcc, 0x7f, 0x34, 0x5c, 0x54, 0x9b, 0xa0, 0x44, 0x46, 0x11, 0x13,
+	0x07, 0xba, 0x9b, 0x7a, 0x2d, 0x98, 0xcc, 0x7a, 0x42, 0xb0, 0x32, 0x97, 0xc8, 0xba, 0x2c, 0x91,
+	0x16, 0x8c, 0xf2, 0xf8, 0x62, 0x69, 0x15, 0x8a, 0x87, 0x34, 0xab, 0x4b, 0xc9, 0xc0, 0x98, 0x27,
+	0xcf, 0x6f, 0xb9, 0xce, 0x83, 0x86, 0x89, 0x1c, 0x0e, 0xa1, 0xec, 0x07, 0x11, 0xcb, 0xe7, 0xa8,
+	0x84, 0xd0, 0x64, 0x75, 0x25, 0x0d, 0x1c, 0xf3, 0xe5, 0x05, 0xa4, 0xfa, 0x96, 0x44, 0xec, 0xdb,
+	0xcc, 0x69, 0x11, 0x8d, 0x7e, 0x0d, 0x39, 0x2d, 0x92, 0x23, 0x38, 0xd5, 0xf3, 0x99, 0x38, 0x9c,
+	0x81, 0xe7, 0x28, 0x03, 0xeb, 0xea, 0x45, 0xce, 0x00, 0x8f, 0xf9, 0xcc, 0xf0, 0x56, 0x7c, 0xcf,
+	0xf7, 0x56, 0x44, 0x99, 0xba, 0x98, 0x30, 0x5c, 0x43, 0x78, 0x2b, 0xd2, 0x58, 0xe3, 0x67, 0x87,
+	0xf5, 0xe1, 0x58, 0x93, 0x66, 0xf3, 0x5f, 0x96, 0x00, 0x82, 0x68, 0x21, 0x72, 0xc4, 0x0f, 0xc5,
+	0x34, 0x4a, 0x63, 0x96, 0x14, 0x04, 0xa9, 0xae, 0xa4, 0x81, 0x63, 0x47, 0x7c, 0x37, 0x68, 0xf3,
+	0x31, 0x4c, 0xc7, 0x02, 0x07, 0xa5, 0x25, 0x37, 0x2d, 0x04, 0x51, 0xd5, 0xb2, 0x50, 0x12, 0x36,
+	0x93, 0x02, 0x58, 0xef, 0x31, 0xf4, 0xfa, 0x23, 0x43, 0x3f, 0x79, 0x4c, 0x96, 0x9f, 0xd9, 0xa4,
+	0x58, 0x3e, 0x74, 0x21, 0xe9, 0x2e, 0x2d, 0x1a, 0xe2, 0xa6, 0x5e, 0x1c, 0x80, 0x95, 0xec, 0x17,
+	0x66, 0x8c, 0xd0, 0x90, 0x46, 0xa2, 0x18, 0xbf, 0xae, 0x88, 0xc4, 0xa6, 0xa9, 0x3c, 0x64, 0x04,
+	0x07, 0xaa, 0x17, 0x07, 0x60, 0x85, 0x85, 0xa1, 0xce, 0xc7, 0x78, 0xf0, 0x0d, 0xd5, 0x0f, 0x14,
+	0x11, 0xb8, 0x94, 0xca, 0x48, 0x46, 0xbc, 0x9f, 0x7a, 0x71, 0x00, 0x96, 0x48, 0xf7, 0xf1, 0xf4,
+	0x49, 0xad, 0x12, 0x8d, 0x68, 0x66, 0xd7, 0xe2, 0xeb, 0x29, 0xcc, 0xa1, 0x47, 0xfc, 0x49, 0x73,
+	0xe8, 0x1b, 0x79, 0xbf, 0x92, 0x1e, 0x38, 0xa8, 0x5e, 0xc8, 0x46, 0x4a, 0xbe, 0xb9, 0x94, 0x38,
+	0x40, 0x5f, 0x57, 0x60, 0x3a, 0x16, 0xc8, 0x27, 0xeb, 0x68, 0x4a, 0x14, 0x9f, 0xaa, 0x65, 0xa1,
+	0x70, 0xba, 0xd7, 0x28, 0xdd, 0x8b, 0xda, 0x6a, 0x42, 0xcf, 0x79, 0x08, 0xe0, 0xe3, 0x7a, 0xcf,
+	0x64, 0x07, 0x86, 0x1f, 0x2a, 0x30, 0x93, 0x10, 0xd3, 0x27, 0xc9, 0x21, 0x3d, 0xa6, 0x50, 0xbd,
+	0x90, 0x8d, 0x24, 0x4e, 0xd3, 0x94, 0x9f, 0xcd, 0xf5, 0xe7, 0x06, 0xf1, 0xc3, 0x26, 0x50, 0xe0,
+	0x04, 0x95, 0xec, 0xc8, 0xdf, 0x14, 0xa0, 0xb4, 0xa7, 0x9f, 0xb0, 0x0d, 0xde, 0xaf, 0x0a, 0x1f,
+	0x9e, 0x08, 0x36, 0x8c, 0x9e, 0x94, 0xc2, 0x41, 0x72, 0xea, 0x4a, 0x1a, 0x38, 0x16, 0xcb, 0xd0,
+	0xe3, 0x24, 0xea, 0x24, 0x1e, 0x8d, 0xfb, 0x43, 0x27, 0x42, 0x01, 0x75, 0x31, 0x37, 0x59, 0x2a,
+	0xad, 0xe4, 0x38, 0xbc, 0xab, 0x4f, 0x9f, 0xd4, 0xca, 0x7e, 0x98, 0xa4, 0x1f, 0xb6, 0x10, 0x26,
+	0xcc, 0x34, 0xd4, 0x60, 0x8e, 0x28, 0x8e, 0x1a, 0x75, 0x44, 0x45, 0x22, 0xf9, 0xd4, 0xe5, 0x14,
+	0x68, 0xd8, 0x7f, 0x80, 0xa2, 0x7d, 0x44, 0x0f, 0x60, 0x32, 0x1c, 0x71, 0x87, 0xa2, 0xe2, 0x8a,
+	0x84, 0xf5, 0xa9, 0xe7, 0x52, 0xe1, 0xe1, 0x88, 0x14, 0x6d, 0x46, 0xa2, 0xc5, 0x71, 0x5c, 0x76,
+	0xb5, 0x31, 0x15, 0x09, 0x7f, 0x93, 0x9c, 0x36, 0xc9, 0x51, 0x76, 0xea, 0x6a, 0x3a, 0x42, 0x6c,
+	0xab, 0xe0, 0x53, 0xe5, 0xf1, 0x76, 0x6e, 0x68, 0x87, 0x79, 0xa3, 0xfe, 0xde, 0xf5, 0xe1, 0xff,
+	0x9f, 0xfa, 0xab, 0xbd, 0x7b, 0xf7, 0x8a, 0x34, 0x2e, 0xed, 0x85, 0xff, 0x1b, 0x00, 0x34, 0x69,
+	0x50, 0x3a, 0x87, 0x7d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UsersServiceClient is the client API for UsersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersServiceClient interface {
+	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type usersServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsersServiceClient(cc *grpc.ClientConn) UsersServiceClient {
+	return &usersServiceClient{cc}
+}
+
+func (c *usersServiceClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersService/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersServiceServer is the server API for UsersService service.
+type UsersServiceServer interface {
+	GetVersion(context.Context, *empty.Empty) (*VersionResponse, error)
+}
+
+func RegisterUsersServiceServer(s *grpc.Server, srv UsersServiceServer) {
+	s.RegisterService(&_UsersService_serviceDesc, srv)
+}
+
+func _UsersService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetVersion(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UsersService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.UsersService",
+	HandlerType: (*UsersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersion",
+			Handler:    _UsersService_GetVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
+}
+
+// UsersClient is the client API for Users service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersClient interface {
+	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	Read(ctx context.Context, in *ReadUserRequest, opts ...grpc.CallOption) (*ReadUserResponse, error)
+	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// List ordered by a stats field is deprecated, use UsersStats.GetLeaderboard instead
+	List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GrantCurrencies(ctx context.Context, in *GrantCurrenciesRequest, opts ...grpc.CallOption) (*GrantCurrenciesResponse, error)
+	GetUserCurrencies(ctx context.Context, in *GetUserCurrenciesRequest, opts ...grpc.CallOption) (*GetUserCurrenciesResponse, error)
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error)
+	ExchangeCurrency(ctx context.Context, in *ExchangeCurrencyRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+}
+
+type usersClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsersClient(cc *grpc.ClientConn) UsersClient {
+	return &usersClient{cc}
+}
+
+func (c *usersClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Read(ctx context.Context, in *ReadUserRequest, opts ...grpc.CallOption) (*ReadUserResponse, error) {
+	out := new(ReadUserResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GrantCurrencies(ctx context.Context, in *GrantCurrenciesRequest, opts ...grpc.CallOption) (*GrantCurrenciesResponse, error) {
+	out := new(GrantCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/GrantCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetUserCurrencies(ctx context.Context, in *GetUserCurrenciesRequest, opts ...grpc.CallOption) (*GetUserCurrenciesResponse, error) {
+	out := new(GetUserCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/GetUserCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error) {
+	out := new(SetExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/SetExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/DeleteExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error) {
+	out := new(GetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/GetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ExchangeCurrency(ctx context.Context, in *ExchangeCurrencyRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/service.Users/ExchangeCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersServer is the server API for Users service.
+type UsersServer interface {
+	Create(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	Read(context.Context, *ReadUserRequest) (*ReadUserResponse, error)
+	Update(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// List ordered by a stats field is deprecated, use UsersStats.GetLeaderboard instead
+	List(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	GrantCurrencies(context.Context, *GrantCurrenciesRequest) (*GrantCurrenciesResponse, error)
+	GetUserCurrencies(context.Context, *GetUserCurrenciesRequest) (*GetUserCurrenciesResponse, error)
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error)
+	ExchangeCurrency(context.Context, *ExchangeCurrencyRequest) (*ExchangeCurrencyResponse, error)
+}
+
+func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
+	s.RegisterService(&_Users_serviceDesc, srv)
+}
+
+func _Users_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Create(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Read(ctx, req.(*ReadUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Update(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Delete(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).List(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GrantCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GrantCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/GrantCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GrantCurrencies(ctx, req.(*GrantCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetUserCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetUserCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/GetUserCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetUserCurrencies(ctx, req.(*GetUserCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/SetExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/DeleteExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/GetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ExchangeCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ExchangeCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Users/ExchangeCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ExchangeCurrency(ctx, req.(*ExchangeCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Users_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.Users",
+	HandlerType: (*UsersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Users_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Users_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Users_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Users_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Users_List_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Users_Login_Handler,
+		},
+		{
+			MethodName: "GrantCurrencies",
+			Handler:    _Users_GrantCurrencies_Handler,
+		},
+		{
+			MethodName: "GetUserCurrencies",
+			Handler:    _Users_GetUserCurrencies_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _Users_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _Users_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _Users_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ExchangeCurrency",
+			Handler:    _Users_ExchangeCurrency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
+}
+
+// StoreItemsClient is the client API for StoreItems service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StoreItemsClient interface {
+	Create(ctx context.Context, in *CreateStoreItemRequest, opts ...grpc.CallOption) (*CreateStoreItemResponse, error)
+	Read(ctx context.Context, in *ReadStoreItemRequest, opts ...grpc.CallOption) (*ReadStoreItemResponse, error)
+	Update(ctx context.Context, in *UpdateStoreItemRequest, opts ...grpc.CallOption) (*UpdateStoreItemResponse, error)
+	Delete(ctx context.Context, in *DeleteStoreItemRequest, opts ...grpc.CallOption) (*DeleteStoreItemResponse, error)
+	List(ctx context.Context, in *ListStoreItemsRequest, opts ...grpc.CallOption) (*ListStoreItemsResponse, error)
+	BuyByUser(ctx context.Context, in *BuyByUserRequest, opts ...grpc.CallOption) (*BuyByUserResponse, error)
+	GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest, opts ...grpc.CallOption) (*GetUserItemsIdsResponse, error)
+	GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest, opts ...grpc.CallOption) (*GetEquippedUserItemsIdsResponse, error)
+	EquipByUser(ctx context.Context, in *EquipByUserRequest, opts ...grpc.CallOption) (*EquipByUserResponse, error)
+	ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest, opts ...grpc.CallOption) (*ThrowAwayByUserResponse, error)
+	GrantItem(ctx context.Context, in *GrantItemRequest, opts ...grpc.CallOption) (*GrantItemResponse, error)
+	ConsumeItem(ctx context.Context, in *ConsumeItemRequest, opts ...grpc.CallOption) (*ConsumeItemResponse, error)
+	GiftItem(ctx context.Context, in *GiftItemRequest, opts ...grpc.CallOption) (*GiftItemResponse, error)
+	ListPendingGifts(ctx context.Context, in *ListPendingGiftsRequest, opts ...grpc.CallOption) (*ListPendingGiftsResponse, error)
+	AcceptGift(ctx context.Context, in *AcceptGiftRequest, opts ...grpc.CallOption) (*AcceptGiftResponse, error)
+	DeclineGift(ctx context.Context, in *DeclineGiftRequest, opts ...grpc.CallOption) (*DeclineGiftResponse, error)
+	ImportCatalog(ctx context.Context, in *ImportCatalogRequest, opts ...grpc.CallOption) (*ImportCatalogResponse, error)
+	ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (*ExportCatalogResponse, error)
+	PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error)
+	SetItemTranslation(ctx context.Context, in *SetItemTranslationRequest, opts ...grpc.CallOption) (*SetItemTranslationResponse, error)
+	DeleteItemTranslation(ctx context.Context, in *DeleteItemTranslationRequest, opts ...grpc.CallOption) (*DeleteItemTranslationResponse, error)
+	SetItemType(ctx context.Context, in *SetItemTypeRequest, opts ...grpc.CallOption) (*SetItemTypeResponse, error)
+	DeleteItemType(ctx context.Context, in *DeleteItemTypeRequest, opts ...grpc.CallOption) (*DeleteItemTypeResponse, error)
+	ListItemTypes(ctx context.Context, in *ListItemTypesRequest, opts ...grpc.CallOption) (*ListItemTypesResponse, error)
+	CreateLoadout(ctx context.Context, in *CreateLoadoutRequest, opts ...grpc.CallOption) (*CreateLoadoutResponse, error)
+	UpdateLoadout(ctx context.Context, in *UpdateLoadoutRequest, opts ...grpc.CallOption) (*UpdateLoadoutResponse, error)
+	DeleteLoadout(ctx context.Context, in *DeleteLoadoutRequest, opts ...grpc.CallOption) (*DeleteLoadoutResponse, error)
+	ActivateLoadout(ctx context.Context, in *ActivateLoadoutRequest, opts ...grpc.CallOption) (*ActivateLoadoutResponse, error)
+	ListLoadouts(ctx context.Context, in *ListLoadoutsRequest, opts ...grpc.CallOption) (*ListLoadoutsResponse, error)
+	ListUserInventory(ctx context.Context, in *ListUserInventoryRequest, opts ...grpc.CallOption) (*ListUserInventoryResponse, error)
+}
+
+type storeItemsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStoreItemsClient(cc *grpc.ClientConn) StoreItemsClient {
+	return &storeItemsClient{cc}
+}
+
+func (c *storeItemsClient) Create(ctx context.Context, in *CreateStoreItemRequest, opts ...grpc.CallOption) (*CreateStoreItemResponse, error) {
+	out := new(CreateStoreItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) Read(ctx context.Context, in *ReadStoreItemRequest, opts ...grpc.CallOption) (*ReadStoreItemResponse, error) {
+	out := new(ReadStoreItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) Update(ctx context.Context, in *UpdateStoreItemRequest, opts ...grpc.CallOption) (*UpdateStoreItemResponse, error) {
+	out := new(UpdateStoreItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) Delete(ctx context.Context, in *DeleteStoreItemRequest, opts ...grpc.CallOption) (*DeleteStoreItemResponse, error) {
+	out := new(DeleteStoreItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) List(ctx context.Context, in *ListStoreItemsRequest, opts ...grpc.CallOption) (*ListStoreItemsResponse, error) {
+	out := new(ListStoreItemsResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) BuyByUser(ctx context.Context, in *BuyByUserRequest, opts ...grpc.CallOption) (*BuyByUserResponse, error) {
+	out := new(BuyByUserResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/BuyByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest, opts ...grpc.CallOption) (*GetUserItemsIdsResponse, error) {
+	out := new(GetUserItemsIdsResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/GetUserItemsIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest, opts ...grpc.CallOption) (*GetEquippedUserItemsIdsResponse, error) {
+	out := new(GetEquippedUserItemsIdsResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/GetEquippedUserItemsIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) EquipByUser(ctx context.Context, in *EquipByUserRequest, opts ...grpc.CallOption) (*EquipByUserResponse, error) {
+	out := new(EquipByUserResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/EquipByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest, opts ...grpc.CallOption) (*ThrowAwayByUserResponse, error) {
+	out := new(ThrowAwayByUserResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ThrowAwayByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) GrantItem(ctx context.Context, in *GrantItemRequest, opts ...grpc.CallOption) (*GrantItemResponse, error) {
+	out := new(GrantItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/GrantItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ConsumeItem(ctx context.Context, in *ConsumeItemRequest, opts ...grpc.CallOption) (*ConsumeItemResponse, error) {
+	out := new(ConsumeItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ConsumeItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) GiftItem(ctx context.Context, in *GiftItemRequest, opts ...grpc.CallOption) (*GiftItemResponse, error) {
+	out := new(GiftItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/GiftItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ListPendingGifts(ctx context.Context, in *ListPendingGiftsRequest, opts ...grpc.CallOption) (*ListPendingGiftsResponse, error) {
+	out := new(ListPendingGiftsResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ListPendingGifts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) AcceptGift(ctx context.Context, in *AcceptGiftRequest, opts ...grpc.CallOption) (*AcceptGiftResponse, error) {
+	out := new(AcceptGiftResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/AcceptGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) DeclineGift(ctx context.Context, in *DeclineGiftRequest, opts ...grpc.CallOption) (*DeclineGiftResponse, error) {
+	out := new(DeclineGiftResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/DeclineGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ImportCatalog(ctx context.Context, in *ImportCatalogRequest, opts ...grpc.CallOption) (*ImportCatalogResponse, error) {
+	out := new(ImportCatalogResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ImportCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (*ExportCatalogResponse, error) {
+	out := new(ExportCatalogResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ExportCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error) {
+	out := new(PurgeItemResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/PurgeItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) SetItemTranslation(ctx context.Context, in *SetItemTranslationRequest, opts ...grpc.CallOption) (*SetItemTranslationResponse, error) {
+	out := new(SetItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/SetItemTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) DeleteItemTranslation(ctx context.Context, in *DeleteItemTranslationRequest, opts ...grpc.CallOption) (*DeleteItemTranslationResponse, error) {
+	out := new(DeleteItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/DeleteItemTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) SetItemType(ctx context.Context, in *SetItemTypeRequest, opts ...grpc.CallOption) (*SetItemTypeResponse, error) {
+	out := new(SetItemTypeResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/SetItemType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) DeleteItemType(ctx context.Context, in *DeleteItemTypeRequest, opts ...grpc.CallOption) (*DeleteItemTypeResponse, error) {
+	out := new(DeleteItemTypeResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/DeleteItemType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ListItemTypes(ctx context.Context, in *ListItemTypesRequest, opts ...grpc.CallOption) (*ListItemTypesResponse, error) {
+	out := new(ListItemTypesResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ListItemTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) CreateLoadout(ctx context.Context, in *CreateLoadoutRequest, opts ...grpc.CallOption) (*CreateLoadoutResponse, error) {
+	out := new(CreateLoadoutResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/CreateLoadout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) UpdateLoadout(ctx context.Context, in *UpdateLoadoutRequest, opts ...grpc.CallOption) (*UpdateLoadoutResponse, error) {
+	out := new(UpdateLoadoutResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/UpdateLoadout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) DeleteLoadout(ctx context.Context, in *DeleteLoadoutRequest, opts ...grpc.CallOption) (*DeleteLoadoutResponse, error) {
+	out := new(DeleteLoadoutResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/DeleteLoadout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ActivateLoadout(ctx context.Context, in *ActivateLoadoutRequest, opts ...grpc.CallOption) (*ActivateLoadoutResponse, error) {
+	out := new(ActivateLoadoutResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ActivateLoadout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ListLoadouts(ctx context.Context, in *ListLoadoutsRequest, opts ...grpc.CallOption) (*ListLoadoutsResponse, error) {
+	out := new(ListLoadoutsResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ListLoadouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeItemsClient) ListUserInventory(ctx context.Context, in *ListUserInventoryRequest, opts ...grpc.CallOption) (*ListUserInventoryResponse, error) {
+	out := new(ListUserInventoryResponse)
+	err := c.cc.Invoke(ctx, "/service.StoreItems/ListUserInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StoreItemsServer is the server API for StoreItems service.
+type StoreItemsServer interface {
+	Create(context.Context, *CreateStoreItemRequest) (*CreateStoreItemResponse, error)
+	Read(context.Context, *ReadStoreItemRequest) (*ReadStoreItemResponse, error)
+	Update(context.Context, *UpdateStoreItemRequest) (*UpdateStoreItemResponse, error)
+	Delete(context.Context, *DeleteStoreItemRequest) (*DeleteStoreItemResponse, error)
+	List(context.Context, *ListStoreItemsRequest) (*ListStoreItemsResponse, error)
+	BuyByUser(context.Context, *BuyByUserRequest) (*BuyByUserResponse, error)
+	GetUserItemsIds(context.Context, *GetUserItemsIdsRequest) (*GetUserItemsIdsResponse, error)
+	GetEquippedUserItemsIds(context.Context, *GetEquippedUserItemsIdsRequest) (*GetEquippedUserItemsIdsResponse, error)
+	EquipByUser(context.Context, *EquipByUserRequest) (*EquipByUserResponse, error)
+	ThrowAwayByUser(context.Context, *ThrowAwayByUserRequest) (*ThrowAwayByUserResponse, error)
+	GrantItem(context.Context, *GrantItemRequest) (*GrantItemResponse, error)
+	ConsumeItem(context.Context, *ConsumeItemRequest) (*ConsumeItemResponse, error)
+	GiftItem(context.Context, *GiftItemRequest) (*GiftItemResponse, error)
+	ListPendingGifts(context.Context, *ListPendingGiftsRequest) (*ListPendingGiftsResponse, error)
+	AcceptGift(context.Context, *AcceptGiftRequest) (*AcceptGiftResponse, error)
+	DeclineGift(context.Context, *DeclineGiftRequest) (*DeclineGiftResponse, error)
+	ImportCatalog(context.Context, *ImportCatalogRequest) (*ImportCatalogResponse, error)
+	ExportCatalog(context.Context, *ExportCatalogRequest) (*ExportCatalogResponse, error)
+	PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error)
+	SetItemTranslation(context.Context, *SetItemTranslationRequest) (*SetItemTranslationResponse, error)
+	DeleteItemTranslation(context.Context, *DeleteItemTranslationRequest) (*DeleteItemTranslationResponse, error)
+	SetItemType(context.Context, *SetItemTypeRequest) (*SetItemTypeResponse, error)
+	DeleteItemType(context.Context, *DeleteItemTypeRequest) (*DeleteItemTypeResponse, error)
+	ListItemTypes(context.Context, *ListItemTypesRequest) (*ListItemTypesResponse, error)
+	CreateLoadout(context.Context, *CreateLoadoutRequest) (*CreateLoadoutResponse, error)
+	UpdateLoadout(context.Context, *UpdateLoadoutRequest) (*UpdateLoadoutResponse, error)
+	DeleteLoadout(context.Context, *DeleteLoadoutRequest) (*DeleteLoadoutResponse, error)
+	ActivateLoadout(context.Context, *ActivateLoadoutRequest) (*ActivateLoadoutResponse, error)
+	ListLoadouts(context.Context, *ListLoadoutsRequest) (*ListLoadoutsResponse, error)
+	ListUserInventory(context.Context, *ListUserInventoryRequest) (*ListUserInventoryResponse, error)
+}
+
+func RegisterStoreItemsServer(s *grpc.Server, srv StoreItemsServer) {
+	s.RegisterService(&_StoreItems_serviceDesc, srv)
+}
+
+func _StoreItems_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).Create(ctx, req.(*CreateStoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).Read(ctx, req.(*ReadStoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).Update(ctx, req.(*UpdateStoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).Delete(ctx, req.(*DeleteStoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).List(ctx, req.(*ListStoreItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_BuyByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).BuyByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/BuyByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).BuyByUser(ctx, req.(*BuyByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_GetUserItemsIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserItemsIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).GetUserItemsIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/GetUserItemsIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).GetUserItemsIds(ctx, req.(*GetUserItemsIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_GetEquippedUserItemsIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquippedUserItemsIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).GetEquippedUserItemsIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/GetEquippedUserItemsIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).GetEquippedUserItemsIds(ctx, req.(*GetEquippedUserItemsIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_EquipByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).EquipByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/EquipByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).EquipByUser(ctx, req.(*EquipByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ThrowAwayByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThrowAwayByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ThrowAwayByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ThrowAwayByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ThrowAwayByUser(ctx, req.(*ThrowAwayByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_GrantItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).GrantItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/GrantItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).GrantItem(ctx, req.(*GrantItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ConsumeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ConsumeItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ConsumeItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ConsumeItem(ctx, req.(*ConsumeItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_GiftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiftItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).GiftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/GiftItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).GiftItem(ctx, req.(*GiftItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ListPendingGifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingGiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ListPendingGifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ListPendingGifts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ListPendingGifts(ctx, req.(*ListPendingGiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_AcceptGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).AcceptGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/AcceptGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).AcceptGift(ctx, req.(*AcceptGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_DeclineGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).DeclineGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/DeclineGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).DeclineGift(ctx, req.(*DeclineGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ImportCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ImportCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ImportCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ImportCatalog(ctx, req.(*ImportCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ExportCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ExportCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ExportCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ExportCatalog(ctx, req.(*ExportCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_PurgeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).PurgeItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/PurgeItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).PurgeItem(ctx, req.(*PurgeItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_SetItemTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).SetItemTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/SetItemTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).SetItemTranslation(ctx, req.(*SetItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_DeleteItemTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).DeleteItemTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/DeleteItemTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).DeleteItemTranslation(ctx, req.(*DeleteItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_SetItemType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).SetItemType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/SetItemType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).SetItemType(ctx, req.(*SetItemTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_DeleteItemType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).DeleteItemType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/DeleteItemType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).DeleteItemType(ctx, req.(*DeleteItemTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ListItemTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ListItemTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ListItemTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ListItemTypes(ctx, req.(*ListItemTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_CreateLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoadoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).CreateLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/CreateLoadout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).CreateLoadout(ctx, req.(*CreateLoadoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_UpdateLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLoadoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).UpdateLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/UpdateLoadout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).UpdateLoadout(ctx, req.(*UpdateLoadoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_DeleteLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoadoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).DeleteLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/DeleteLoadout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).DeleteLoadout(ctx, req.(*DeleteLoadoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ActivateLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateLoadoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ActivateLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ActivateLoadout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ActivateLoadout(ctx, req.(*ActivateLoadoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ListLoadouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoadoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ListLoadouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ListLoadouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ListLoadouts(ctx, req.(*ListLoadoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreItems_ListUserInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreItemsServer).ListUserInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.StoreItems/ListUserInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreItemsServer).ListUserInventory(ctx, req.(*ListUserInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StoreItems_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.StoreItems",
+	HandlerType: (*StoreItemsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _StoreItems_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _StoreItems_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _StoreItems_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _StoreItems_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _StoreItems_List_Handler,
+		},
+		{
+			MethodName: "BuyByUser",
+			Handler:    _StoreItems_BuyByUser_Handler,
+		},
+		{
+			MethodName: "GetUserItemsIds",
+			Handler:    _StoreItems_GetUserItemsIds_Handler,
+		},
+		{
+			MethodName: "GetEquippedUserItemsIds",
+			Handler:    _StoreItems_GetEquippedUserItemsIds_Handler,
+		},
+		{
+			MethodName: "EquipByUser",
+			Handler:    _StoreItems_EquipByUser_Handler,
+		},
+		{
+			MethodName: "ThrowAwayByUser",
+			Handler:    _StoreItems_ThrowAwayByUser_Handler,
+		},
+		{
+			MethodName: "GrantItem",
+			Handler:    _StoreItems_GrantItem_Handler,
+		},
+		{
+			MethodName: "ConsumeItem",
+			Handler:    _StoreItems_ConsumeItem_Handler,
+		},
+		{
+			MethodName: "GiftItem",
+			Handler:    _StoreItems_GiftItem_Handler,
+		},
+		{
+			MethodName: "ListPendingGifts",
+			Handler:    _StoreItems_ListPendingGifts_Handler,
+		},
+		{
+			MethodName: "AcceptGift",
+			Handler:    _StoreItems_AcceptGift_Handler,
+		},
+		{
+			MethodName: "DeclineGift",
+			Handler:    _StoreItems_DeclineGift_Handler,
+		},
+		{
+			MethodName: "ImportCatalog",
+			Handler:    _StoreItems_ImportCatalog_Handler,
+		},
+		{
+			MethodName: "ExportCatalog",
+			Handler:    _StoreItems_ExportCatalog_Handler,
+		},
+		{
+			MethodName: "PurgeItem",
+			Handler:    _StoreItems_PurgeItem_Handler,
+		},
+		{
+			MethodName: "SetItemTranslation",
+			Handler:    _StoreItems_SetItemTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteItemTranslation",
+			Handler:    _StoreItems_DeleteItemTranslation_Handler,
+		},
+		{
+			MethodName: "SetItemType",
+			Handler:    _StoreItems_SetItemType_Handler,
+		},
+		{
+			MethodName: "DeleteItemType",
+			Handler:    _StoreItems_DeleteItemType_Handler,
+		},
+		{
+			MethodName: "ListItemTypes",
+			Handler:    _StoreItems_ListItemTypes_Handler,
+		},
+		{
+			MethodName: "CreateLoadout",
+			Handler:    _StoreItems_CreateLoadout_Handler,
+		},
+		{
+			MethodName: "UpdateLoadout",
+			Handler:    _StoreItems_UpdateLoadout_Handler,
+		},
+		{
+			MethodName: "DeleteLoadout",
+			Handler:    _StoreItems_DeleteLoadout_Handler,
+		},
+		{
+			MethodName: "ActivateLoadout",
+			Handler:    _StoreItems_ActivateLoadout_Handler,
+		},
+		{
+			MethodName: "ListLoadouts",
+			Handler:    _StoreItems_ListLoadouts_Handler,
+		},
+		{
+			MethodName: "ListUserInventory",
+			Handler:    _StoreItems_ListUserInventory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
+}
+
+// UsersStatsClient is the client API for UsersStats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersStatsClient interface {
+	GetStats(ctx context.Context, in *ReadUserStatsRequest, opts ...grpc.CallOption) (*ReadUserStatsResponse, error)
+	UpdateStats(ctx context.Context, in *UpdateUserStatsRequest, opts ...grpc.CallOption) (*UpdateUserStatsResponse, error)
+	BatchUpdateStats(ctx context.Context, in *BatchUpdateStatsRequest, opts ...grpc.CallOption) (*BatchUpdateStatsResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	RecordMatch(ctx context.Context, in *RecordMatchRequest, opts ...grpc.CallOption) (*RecordMatchResponse, error)
+	ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error)
+	RebuildStats(ctx context.Context, in *RebuildStatsRequest, opts ...grpc.CallOption) (*RebuildStatsResponse, error)
+	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error)
+	ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	GetRating(ctx context.Context, in *GetRatingRequest, opts ...grpc.CallOption) (*GetRatingResponse, error)
+	GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error)
+	CreateAchievement(ctx context.Context, in *CreateAchievementRequest, opts ...grpc.CallOption) (*CreateAchievementResponse, error)
+	DeleteAchievement(ctx context.Context, in *DeleteAchievementRequest, opts ...grpc.CallOption) (*DeleteAchievementResponse, error)
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error)
+	ListFlaggedStatUpdates(ctx context.Context, in *ListFlaggedStatUpdatesRequest, opts ...grpc.CallOption) (*ListFlaggedStatUpdatesResponse, error)
+	ClearFlaggedStatUpdate(ctx context.Context, in *ClearFlaggedStatUpdateRequest, opts ...grpc.CallOption) (*ClearFlaggedStatUpdateResponse, error)
+	RollbackFlaggedStatUpdate(ctx context.Context, in *RollbackFlaggedStatUpdateRequest, opts ...grpc.CallOption) (*RollbackFlaggedStatUpdateResponse, error)
+}
+
+type usersStatsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsersStatsClient(cc *grpc.ClientConn) UsersStatsClient {
+	return &usersStatsClient{cc}
+}
+
+func (c *usersStatsClient) GetStats(ctx context.Context, in *ReadUserStatsRequest, opts ...grpc.CallOption) (*ReadUserStatsResponse, error) {
+	out := new(ReadUserStatsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) UpdateStats(ctx context.Context, in *UpdateUserStatsRequest, opts ...grpc.CallOption) (*UpdateUserStatsResponse, error) {
+	out := new(UpdateUserStatsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/UpdateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) BatchUpdateStats(ctx context.Context, in *BatchUpdateStatsRequest, opts ...grpc.CallOption) (*BatchUpdateStatsResponse, error) {
+	out := new(BatchUpdateStatsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/BatchUpdateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) RecordMatch(ctx context.Context, in *RecordMatchRequest, opts ...grpc.CallOption) (*RecordMatchResponse, error) {
+	out := new(RecordMatchResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/RecordMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error) {
+	out := new(ListMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/ListMatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) RebuildStats(ctx context.Context, in *RebuildStatsRequest, opts ...grpc.CallOption) (*RebuildStatsResponse, error) {
+	out := new(RebuildStatsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/RebuildStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error) {
+	out := new(CreateSeasonResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/CreateSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/ListSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) GetRating(ctx context.Context, in *GetRatingRequest, opts ...grpc.CallOption) (*GetRatingResponse, error) {
+	out := new(GetRatingResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/GetRating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error) {
+	out := new(GetRatingsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/GetRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) CreateAchievement(ctx context.Context, in *CreateAchievementRequest, opts ...grpc.CallOption) (*CreateAchievementResponse, error) {
+	out := new(CreateAchievementResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/CreateAchievement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) DeleteAchievement(ctx context.Context, in *DeleteAchievementRequest, opts ...grpc.CallOption) (*DeleteAchievementResponse, error) {
+	out := new(DeleteAchievementResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/DeleteAchievement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/ListAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest, opts ...grpc.CallOption) (*GetUserAchievementsResponse, error) {
+	out := new(GetUserAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/GetUserAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) ListFlaggedStatUpdates(ctx context.Context, in *ListFlaggedStatUpdatesRequest, opts ...grpc.CallOption) (*ListFlaggedStatUpdatesResponse, error) {
+	out := new(ListFlaggedStatUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/ListFlaggedStatUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) ClearFlaggedStatUpdate(ctx context.Context, in *ClearFlaggedStatUpdateRequest, opts ...grpc.CallOption) (*ClearFlaggedStatUpdateResponse, error) {
+	out := new(ClearFlaggedStatUpdateResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/ClearFlaggedStatUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersStatsClient) RollbackFlaggedStatUpdate(ctx context.Context, in *RollbackFlaggedStatUpdateRequest, opts ...grpc.CallOption) (*RollbackFlaggedStatUpdateResponse, error) {
+	out := new(RollbackFlaggedStatUpdateResponse)
+	err := c.cc.Invoke(ctx, "/service.UsersStats/RollbackFlaggedStatUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersStatsServer is the server API for UsersStats service.
+type UsersStatsServer interface {
+	GetStats(context.Context, *ReadUserStatsRequest) (*ReadUserStatsResponse, error)
+	UpdateStats(context.Context, *UpdateUserStatsRequest) (*UpdateUserStatsResponse, error)
+	BatchUpdateStats(context.Context, *BatchUpdateStatsRequest) (*BatchUpdateStatsResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	RecordMatch(context.Context, *RecordMatchRequest) (*RecordMatchResponse, error)
+	ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error)
+	RebuildStats(context.Context, *RebuildStatsRequest) (*RebuildStatsResponse, error)
+	CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error)
+	ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error)
+	GetRating(context.Context, *GetRatingRequest) (*GetRatingResponse, error)
+	GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error)
+	CreateAchievement(context.Context, *CreateAchievementRequest) (*CreateAchievementResponse, error)
+	DeleteAchievement(context.Context, *DeleteAchievementRequest) (*DeleteAchievementResponse, error)
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	GetUserAchievements(context.Context, *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error)
+	ListFlaggedStatUpdates(context.Context, *ListFlaggedStatUpdatesRequest) (*ListFlaggedStatUpdatesResponse, error)
+	ClearFlaggedStatUpdate(context.Context, *ClearFlaggedStatUpdateRequest) (*ClearFlaggedStatUpdateResponse, error)
+	RollbackFlaggedStatUpdate(context.Context, *RollbackFlaggedStatUpdateRequest) (*RollbackFlaggedStatUpdateResponse, error)
+}
+
+func RegisterUsersStatsServer(s *grpc.Server, srv UsersStatsServer) {
+	s.RegisterService(&_UsersStats_serviceDesc, srv)
+}
+
+func _UsersStats_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).GetStats(ctx, req.(*ReadUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_UpdateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).UpdateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/UpdateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).UpdateStats(ctx, req.(*UpdateUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_BatchUpdateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).BatchUpdateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/BatchUpdateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).BatchUpdateStats(ctx, req.(*BatchUpdateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_RecordMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).RecordMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.UsersService/GetVersion",
+		FullMethod: "/service.UsersStats/RecordMatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).GetVersion(ctx, req.(*empty.Empty))
+		return srv.(UsersStatsServer).RecordMatch(ctx, req.(*RecordMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _UsersService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "service.UsersService",
-	HandlerType: (*UsersServiceServer)(nil),
+func _UsersStats_ListMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).ListMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/ListMatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).ListMatchHistory(ctx, req.(*ListMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_RebuildStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).RebuildStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/RebuildStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).RebuildStats(ctx, req.(*RebuildStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/CreateSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).CreateSeason(ctx, req.(*CreateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/ListSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).ListSeasons(ctx, req.(*ListSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_GetRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).GetRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/GetRating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).GetRating(ctx, req.(*GetRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_GetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).GetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/GetRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).GetRatings(ctx, req.(*GetRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_CreateAchievement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAchievementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).CreateAchievement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/CreateAchievement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).CreateAchievement(ctx, req.(*CreateAchievementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_DeleteAchievement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAchievementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).DeleteAchievement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/DeleteAchievement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).DeleteAchievement(ctx, req.(*DeleteAchievementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/ListAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_GetUserAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).GetUserAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/GetUserAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).GetUserAchievements(ctx, req.(*GetUserAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_ListFlaggedStatUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedStatUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).ListFlaggedStatUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/ListFlaggedStatUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).ListFlaggedStatUpdates(ctx, req.(*ListFlaggedStatUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_ClearFlaggedStatUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFlaggedStatUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).ClearFlaggedStatUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/ClearFlaggedStatUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).ClearFlaggedStatUpdate(ctx, req.(*ClearFlaggedStatUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersStats_RollbackFlaggedStatUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackFlaggedStatUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersStatsServer).RollbackFlaggedStatUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.UsersStats/RollbackFlaggedStatUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersStatsServer).RollbackFlaggedStatUpdate(ctx, req.(*RollbackFlaggedStatUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UsersStats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.UsersStats",
+	HandlerType: (*UsersStatsServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetVersion",
-			Handler:    _UsersService_GetVersion_Handler,
+			MethodName: "GetStats",
+			Handler:    _UsersStats_GetStats_Handler,
+		},
+		{
+			MethodName: "UpdateStats",
+			Handler:    _UsersStats_UpdateStats_Handler,
+		},
+		{
+			MethodName: "BatchUpdateStats",
+			Handler:    _UsersStats_BatchUpdateStats_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _UsersStats_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "RecordMatch",
+			Handler:    _UsersStats_RecordMatch_Handler,
+		},
+		{
+			MethodName: "ListMatchHistory",
+			Handler:    _UsersStats_ListMatchHistory_Handler,
+		},
+		{
+			MethodName: "RebuildStats",
+			Handler:    _UsersStats_RebuildStats_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _UsersStats_CreateSeason_Handler,
+		},
+		{
+			MethodName: "ListSeasons",
+			Handler:    _UsersStats_ListSeasons_Handler,
+		},
+		{
+			MethodName: "GetRating",
+			Handler:    _UsersStats_GetRating_Handler,
+		},
+		{
+			MethodName: "GetRatings",
+			Handler:    _UsersStats_GetRatings_Handler,
+		},
+		{
+			MethodName: "CreateAchievement",
+			Handler:    _UsersStats_CreateAchievement_Handler,
+		},
+		{
+			MethodName: "DeleteAchievement",
+			Handler:    _UsersStats_DeleteAchievement_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _UsersStats_ListAchievements_Handler,
+		},
+		{
+			MethodName: "GetUserAchievements",
+			Handler:    _UsersStats_GetUserAchievements_Handler,
+		},
+		{
+			MethodName: "ListFlaggedStatUpdates",
+			Handler:    _UsersStats_ListFlaggedStatUpdates_Handler,
+		},
+		{
+			MethodName: "ClearFlaggedStatUpdate",
+			Handler:    _UsersStats_ClearFlaggedStatUpdate_Handler,
+		},
+		{
+			MethodName: "RollbackFlaggedStatUpdate",
+			Handler:    _UsersStats_RollbackFlaggedStatUpdate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
 }
 
-// UsersClient is the client API for Users service.
+// NewsServiceClient is the client API for NewsService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UsersClient interface {
-	Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	Read(ctx context.Context, in *ReadUserRequest, opts ...grpc.CallOption) (*ReadUserResponse, error)
-	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
-	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
-	List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	GrantCurrencies(ctx context.Context, in *GrantCurrenciesRequest, opts ...grpc.CallOption) (*GrantCurrenciesResponse, error)
-	GetUserCurrencies(ctx context.Context, in *GetUserCurrenciesRequest, opts ...grpc.CallOption) (*GetUserCurrenciesResponse, error)
+type NewsServiceClient interface {
+	Create(ctx context.Context, in *CreateNewsRequest, opts ...grpc.CallOption) (*CreateNewsResponse, error)
+	Read(ctx context.Context, in *ReadNewsRequest, opts ...grpc.CallOption) (*ReadNewsResponse, error)
+	Update(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error)
+	List(ctx context.Context, in *ListNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
+	Delete(ctx context.Context, in *DeleteNewsRequest, opts ...grpc.CallOption) (*DeleteNewsResponse, error)
+	Publish(ctx context.Context, in *PublishNewsRequest, opts ...grpc.CallOption) (*PublishNewsResponse, error)
+	Unpublish(ctx context.Context, in *UnpublishNewsRequest, opts ...grpc.CallOption) (*UnpublishNewsResponse, error)
+	SetNewsTranslation(ctx context.Context, in *SetNewsTranslationRequest, opts ...grpc.CallOption) (*SetNewsTranslationResponse, error)
+	DeleteNewsTranslation(ctx context.Context, in *DeleteNewsTranslationRequest, opts ...grpc.CallOption) (*DeleteNewsTranslationResponse, error)
 }
 
-type usersClient struct {
+type newsServiceClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewUsersClient(cc *grpc.ClientConn) UsersClient {
-	return &usersClient{cc}
+func NewNewsServiceClient(cc *grpc.ClientConn) NewsServiceClient {
+	return &newsServiceClient{cc}
 }
 
-func (c *usersClient) Create(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/Create", in, out, opts...)
+func (c *newsServiceClient) Create(ctx context.Context, in *CreateNewsRequest, opts ...grpc.CallOption) (*CreateNewsResponse, error) {
+	out := new(CreateNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) Read(ctx context.Context, in *ReadNewsRequest, opts ...grpc.CallOption) (*ReadNewsResponse, error) {
+	out := new(ReadNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Read", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) Read(ctx context.Context, in *ReadUserRequest, opts ...grpc.CallOption) (*ReadUserResponse, error) {
-	out := new(ReadUserResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/Read", in, out, opts...)
+func (c *newsServiceClient) Update(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error) {
+	out := new(UpdateNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
-	out := new(UpdateUserResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/Update", in, out, opts...)
+func (c *newsServiceClient) List(ctx context.Context, in *ListNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error) {
+	out := new(ListNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
-	out := new(DeleteUserResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/Delete", in, out, opts...)
+func (c *newsServiceClient) Delete(ctx context.Context, in *DeleteNewsRequest, opts ...grpc.CallOption) (*DeleteNewsResponse, error) {
+	out := new(DeleteNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Delete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) List(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
-	out := new(ListUsersResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/List", in, out, opts...)
+func (c *newsServiceClient) Publish(ctx context.Context, in *PublishNewsRequest, opts ...grpc.CallOption) (*PublishNewsResponse, error) {
+	out := new(PublishNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Publish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/Login", in, out, opts...)
+func (c *newsServiceClient) Unpublish(ctx context.Context, in *UnpublishNewsRequest, opts ...grpc.CallOption) (*UnpublishNewsResponse, error) {
+	out := new(UnpublishNewsResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/Unpublish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) GrantCurrencies(ctx context.Context, in *GrantCurrenciesRequest, opts ...grpc.CallOption) (*GrantCurrenciesResponse, error) {
-	out := new(GrantCurrenciesResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/GrantCurrencies", in, out, opts...)
+func (c *newsServiceClient) SetNewsTranslation(ctx context.Context, in *SetNewsTranslationRequest, opts ...grpc.CallOption) (*SetNewsTranslationResponse, error) {
+	out := new(SetNewsTranslationResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/SetNewsTranslation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersClient) GetUserCurrencies(ctx context.Context, in *GetUserCurrenciesRequest, opts ...grpc.CallOption) (*GetUserCurrenciesResponse, error) {
-	out := new(GetUserCurrenciesResponse)
-	err := c.cc.Invoke(ctx, "/service.Users/GetUserCurrencies", in, out, opts...)
+func (c *newsServiceClient) DeleteNewsTranslation(ctx context.Context, in *DeleteNewsTranslationRequest, opts ...grpc.CallOption) (*DeleteNewsTranslationResponse, error) {
+	out := new(DeleteNewsTranslationResponse)
+	err := c.cc.Invoke(ctx, "/service.NewsService/DeleteNewsTranslation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UsersServer is the server API for Users service.
-type UsersServer interface {
-	Create(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	Read(context.Context, *ReadUserRequest) (*ReadUserResponse, error)
-	Update(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	List(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	GrantCurrencies(context.Context, *GrantCurrenciesRequest) (*GrantCurrenciesResponse, error)
-	GetUserCurrencies(context.Context, *GetUserCurrenciesRequest) (*GetUserCurrenciesResponse, error)
+// NewsServiceServer is the server API for NewsService service.
+type NewsServiceServer interface {
+	Create(context.Context, *CreateNewsRequest) (*CreateNewsResponse, error)
+	Read(context.Context, *ReadNewsRequest) (*ReadNewsResponse, error)
+	Update(context.Context, *UpdateNewsRequest) (*UpdateNewsResponse, error)
+	List(context.Context, *ListNewsRequest) (*ListNewsResponse, error)
+	Delete(context.Context, *DeleteNewsRequest) (*DeleteNewsResponse, error)
+	Publish(context.Context, *PublishNewsRequest) (*PublishNewsResponse, error)
+	Unpublish(context.Context, *UnpublishNewsRequest) (*UnpublishNewsResponse, error)
+	SetNewsTranslation(context.Context, *SetNewsTranslationRequest) (*SetNewsTranslationResponse, error)
+	DeleteNewsTranslation(context.Context, *DeleteNewsTranslationRequest) (*DeleteNewsTranslationResponse, error)
 }
 
-func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
-	s.RegisterService(&_Users_serviceDesc, srv)
+func RegisterNewsServiceServer(s *grpc.Server, srv NewsServiceServer) {
+	s.RegisterService(&_NewsService_serviceDesc, srv)
 }
 
-func _Users_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _NewsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Create(ctx, in)
+		return srv.(NewsServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/Create",
+		FullMethod: "/service.NewsService/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Create(ctx, req.(*CreateUserRequest))
+		return srv.(NewsServiceServer).Create(ctx, req.(*CreateNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadUserRequest)
+func _NewsService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Read(ctx, in)
+		return srv.(NewsServiceServer).Read(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/Read",
+		FullMethod: "/service.NewsService/Read",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Read(ctx, req.(*ReadUserRequest))
+		return srv.(NewsServiceServer).Read(ctx, req.(*ReadNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _NewsService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Update(ctx, in)
+		return srv.(NewsServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/Update",
+		FullMethod: "/service.NewsService/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Update(ctx, req.(*UpdateUserRequest))
+		return srv.(NewsServiceServer).Update(ctx, req.(*UpdateNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _NewsService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Delete(ctx, in)
+		return srv.(NewsServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/Delete",
+		FullMethod: "/service.NewsService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Delete(ctx, req.(*DeleteUserRequest))
+		return srv.(NewsServiceServer).List(ctx, req.(*ListNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _NewsService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).List(ctx, in)
+		return srv.(NewsServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/List",
+		FullMethod: "/service.NewsService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).List(ctx, req.(*ListUsersRequest))
+		return srv.(NewsServiceServer).Delete(ctx, req.(*DeleteNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _NewsService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Login(ctx, in)
+		return srv.(NewsServiceServer).Publish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/Login",
+		FullMethod: "/service.NewsService/Publish",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+		return srv.(NewsServiceServer).Publish(ctx, req.(*PublishNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_GrantCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GrantCurrenciesRequest)
+func _NewsService_Unpublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishNewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).GrantCurrencies(ctx, in)
+		return srv.(NewsServiceServer).Unpublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/GrantCurrencies",
+		FullMethod: "/service.NewsService/Unpublish",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).GrantCurrencies(ctx, req.(*GrantCurrenciesRequest))
+		return srv.(NewsServiceServer).Unpublish(ctx, req.(*UnpublishNewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_GetUserCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCurrenciesRequest)
+func _NewsService_SetNewsTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNewsTranslationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).GetUserCurrencies(ctx, in)
+		return srv.(NewsServiceServer).SetNewsTranslation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.Users/GetUserCurrencies",
+		FullMethod: "/service.NewsService/SetNewsTranslation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).GetUserCurrencies(ctx, req.(*GetUserCurrenciesRequest))
+		return srv.(NewsServiceServer).SetNewsTranslation(ctx, req.(*SetNewsTranslationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Users_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "service.Users",
-	HandlerType: (*UsersServer)(nil),
+func _NewsService_DeleteNewsTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNewsTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).DeleteNewsTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.NewsService/DeleteNewsTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).DeleteNewsTranslation(ctx, req.(*DeleteNewsTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NewsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.NewsService",
+	HandlerType: (*NewsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _Users_Create_Handler,
+			Handler:    _NewsService_Create_Handler,
 		},
 		{
 			MethodName: "Read",
-			Handler:    _Users_Read_Handler,
+			Handler:    _NewsService_Read_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Users_Update_Handler,
+			Handler:    _NewsService_Update_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NewsService_List_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Users_Delete_Handler,
+			Handler:    _NewsService_Delete_Handler,
 		},
 		{
-			MethodName: "List",
-			Handler:    _Users_List_Handler,
+			MethodName: "Publish",
+			Handler:    _NewsService_Publish_Handler,
 		},
 		{
-			MethodName: "Login",
-			Handler:    _Users_Login_Handler,
+			MethodName: "Unpublish",
+			Handler:    _NewsService_Unpublish_Handler,
 		},
 		{
-			MethodName: "GrantCurrencies",
-			Handler:    _Users_GrantCurrencies_Handler,
+			MethodName: "SetNewsTranslation",
+			Handler:    _NewsService_SetNewsTranslation_Handler,
 		},
 		{
-			MethodName: "GetUserCurrencies",
-			Handler:    _Users_GetUserCurrencies_Handler,
+			MethodName: "DeleteNewsTranslation",
+			Handler:    _NewsService_DeleteNewsTranslation_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
 }
 
-// StoreItemsClient is the client API for StoreItems service.
+// StorefrontClient is the client API for Storefront service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StoreItemsClient interface {
-	Create(ctx context.Context, in *CreateStoreItemRequest, opts ...grpc.CallOption) (*CreateStoreItemResponse, error)
-	Read(ctx context.Context, in *ReadStoreItemRequest, opts ...grpc.CallOption) (*ReadStoreItemResponse, error)
-	Update(ctx context.Context, in *UpdateStoreItemRequest, opts ...grpc.CallOption) (*UpdateStoreItemResponse, error)
-	Delete(ctx context.Context, in *DeleteStoreItemRequest, opts ...grpc.CallOption) (*DeleteStoreItemResponse, error)
-	List(ctx context.Context, in *ListStoreItemsRequest, opts ...grpc.CallOption) (*ListStoreItemsResponse, error)
-	BuyByUser(ctx context.Context, in *BuyByUserRequest, opts ...grpc.CallOption) (*BuyByUserResponse, error)
-	GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest, opts ...grpc.CallOption) (*GetUserItemsIdsResponse, error)
-	GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest, opts ...grpc.CallOption) (*GetEquippedUserItemsIdsResponse, error)
-	EquipByUser(ctx context.Context, in *EquipByUserRequest, opts ...grpc.CallOption) (*EquipByUserResponse, error)
-	ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest, opts ...grpc.CallOption) (*ThrowAwayByUserResponse, error)
-}
-
-type storeItemsClient struct {
+type StorefrontClient interface {
+	GetStorefront(ctx context.Context, in *GetStorefrontRequest, opts ...grpc.CallOption) (*GetStorefrontResponse, error)
+	PreviewStorefront(ctx context.Context, in *PreviewStorefrontRequest, opts ...grpc.CallOption) (*PreviewStorefrontResponse, error)
+	CreateStorefrontSlot(ctx context.Context, in *CreateStorefrontSlotRequest, opts ...grpc.CallOption) (*CreateStorefrontSlotResponse, error)
+	UpdateStorefrontSlot(ctx context.Context, in *UpdateStorefrontSlotRequest, opts ...grpc.CallOption) (*UpdateStorefrontSlotResponse, error)
+	DeleteStorefrontSlot(ctx context.Context, in *DeleteStorefrontSlotRequest, opts ...grpc.CallOption) (*DeleteStorefrontSlotResponse, error)
+	ListStorefrontSlots(ctx context.Context, in *ListStorefrontSlotsRequest, opts ...grpc.CallOption) (*ListStorefrontSlotsResponse, error)
+	PinStorefrontItem(ctx context.Context, in *PinStorefrontItemRequest, opts ...grpc.CallOption) (*PinStorefrontItemResponse, error)
+	UnpinStorefrontItem(ctx context.Context, in *UnpinStorefrontItemRequest, opts ...grpc.CallOption) (*UnpinStorefrontItemResponse, error)
+}
+
+type storefrontClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewStoreItemsClient(cc *grpc.ClientConn) StoreItemsClient {
-	return &storeItemsClient{cc}
-}
-
-func (c *storeItemsClient) Create(ctx context.Context, in *CreateStoreItemRequest, opts ...grpc.CallOption) (*CreateStoreItemResponse, error) {
-	out := new(CreateStoreItemResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *storeItemsClient) Read(ctx context.Context, in *ReadStoreItemRequest, opts ...grpc.CallOption) (*ReadStoreItemResponse, error) {
-	out := new(ReadStoreItemResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/Read", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *storeItemsClient) Update(ctx context.Context, in *UpdateStoreItemRequest, opts ...grpc.CallOption) (*UpdateStoreItemResponse, error) {
-	out := new(UpdateStoreItemResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *storeItemsClient) Delete(ctx context.Context, in *DeleteStoreItemRequest, opts ...grpc.CallOption) (*DeleteStoreItemResponse, error) {
-	out := new(DeleteStoreItemResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewStorefrontClient(cc *grpc.ClientConn) StorefrontClient {
+	return &storefrontClient{cc}
 }
 
-func (c *storeItemsClient) List(ctx context.Context, in *ListStoreItemsRequest, opts ...grpc.CallOption) (*ListStoreItemsResponse, error) {
-	out := new(ListStoreItemsResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/List", in, out, opts...)
+func (c *storefrontClient) GetStorefront(ctx context.Context, in *GetStorefrontRequest, opts ...grpc.CallOption) (*GetStorefrontResponse, error) {
+	out := new(GetStorefrontResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/GetStorefront", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storeItemsClient) BuyByUser(ctx context.Context, in *BuyByUserRequest, opts ...grpc.CallOption) (*BuyByUserResponse, error) {
-	out := new(BuyByUserResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/BuyByUser", in, out, opts...)
+func (c *storefrontClient) PreviewStorefront(ctx context.Context, in *PreviewStorefrontRequest, opts ...grpc.CallOption) (*PreviewStorefrontResponse, error) {
+	out := new(PreviewStorefrontResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/PreviewStorefront", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storeItemsClient) GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest, opts ...grpc.CallOption) (*GetUserItemsIdsResponse, error) {
-	out := new(GetUserItemsIdsResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/GetUserItemsIds", in, out, opts...)
+func (c *storefrontClient) CreateStorefrontSlot(ctx context.Context, in *CreateStorefrontSlotRequest, opts ...grpc.CallOption) (*CreateStorefrontSlotResponse, error) {
+	out := new(CreateStorefrontSlotResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/CreateStorefrontSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storeItemsClient) GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest, opts ...grpc.CallOption) (*GetEquippedUserItemsIdsResponse, error) {
-	out := new(GetEquippedUserItemsIdsResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/GetEquippedUserItemsIds", in, out, opts...)
+func (c *storefrontClient) UpdateStorefrontSlot(ctx context.Context, in *UpdateStorefrontSlotRequest, opts ...grpc.CallOption) (*UpdateStorefrontSlotResponse, error) {
+	out := new(UpdateStorefrontSlotResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/UpdateStorefrontSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storeItemsClient) EquipByUser(ctx context.Context, in *EquipByUserRequest, opts ...grpc.CallOption) (*EquipByUserResponse, error) {
-	out := new(EquipByUserResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/EquipByUser", in, out, opts...)
+func (c *storefrontClient) DeleteStorefrontSlot(ctx context.Context, in *DeleteStorefrontSlotRequest, opts ...grpc.CallOption) (*DeleteStorefrontSlotResponse, error) {
+	out := new(DeleteStorefrontSlotResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/DeleteStorefrontSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storeItemsClient) ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest, opts ...grpc.CallOption) (*ThrowAwayByUserResponse, error) {
-	out := new(ThrowAwayByUserResponse)
-	err := c.cc.Invoke(ctx, "/service.StoreItems/ThrowAwayByUser", in, out, opts...)
+func (c *storefrontClient) ListStorefrontSlots(ctx context.Context, in *ListStorefrontSlotsRequest, opts ...grpc.CallOption) (*ListStorefrontSlotsResponse, error) {
+	out := new(ListStorefrontSlotsResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/ListStorefrontSlots", in, out, opts...)
 	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// StoreItemsServer is the server API for StoreItems service.
-type StoreItemsServer interface {
-	Create(context.Context, *CreateStoreItemRequest) (*CreateStoreItemResponse, error)
-	Read(context.Context, *ReadStoreItemRequest) (*ReadStoreItemResponse, error)
-	Update(context.Context, *UpdateStoreItemRequest) (*UpdateStoreItemResponse, error)
-	Delete(context.Context, *DeleteStoreItemRequest) (*DeleteStoreItemResponse, error)
-	List(context.Context, *ListStoreItemsRequest) (*ListStoreItemsResponse, error)
-	BuyByUser(context.Context, *BuyByUserRequest) (*BuyByUserResponse, error)
-	GetUserItemsIds(context.Context, *GetUserItemsIdsRequest) (*GetUserItemsIdsResponse, error)
-	GetEquippedUserItemsIds(context.Context, *GetEquippedUserItemsIdsRequest) (*GetEquippedUserItemsIdsResponse, error)
-	EquipByUser(context.Context, *EquipByUserRequest) (*EquipByUserResponse, error)
-	ThrowAwayByUser(context.Context, *ThrowAwayByUserRequest) (*ThrowAwayByUserResponse, error)
-}
-
-func RegisterStoreItemsServer(s *grpc.Server, srv StoreItemsServer) {
-	s.RegisterService(&_StoreItems_serviceDesc, srv)
-}
-
-func _StoreItems_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStoreItemRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StoreItemsServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.StoreItems/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).Create(ctx, req.(*CreateStoreItemRequest))
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _StoreItems_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadStoreItemRequest)
-	if err := dec(in); err != nil {
+func (c *storefrontClient) PinStorefrontItem(ctx context.Context, in *PinStorefrontItemRequest, opts ...grpc.CallOption) (*PinStorefrontItemResponse, error) {
+	out := new(PinStorefrontItemResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/PinStorefrontItem", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StoreItemsServer).Read(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.StoreItems/Read",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).Read(ctx, req.(*ReadStoreItemRequest))
+	return out, nil
+}
+
+func (c *storefrontClient) UnpinStorefrontItem(ctx context.Context, in *UnpinStorefrontItemRequest, opts ...grpc.CallOption) (*UnpinStorefrontItemResponse, error) {
+	out := new(UnpinStorefrontItemResponse)
+	err := c.cc.Invoke(ctx, "/service.Storefront/UnpinStorefrontItem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _StoreItems_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStoreItemRequest)
+// StorefrontServer is the server API for Storefront service.
+type StorefrontServer interface {
+	GetStorefront(context.Context, *GetStorefrontRequest) (*GetStorefrontResponse, error)
+	PreviewStorefront(context.Context, *PreviewStorefrontRequest) (*PreviewStorefrontResponse, error)
+	CreateStorefrontSlot(context.Context, *CreateStorefrontSlotRequest) (*CreateStorefrontSlotResponse, error)
+	UpdateStorefrontSlot(context.Context, *UpdateStorefrontSlotRequest) (*UpdateStorefrontSlotResponse, error)
+	DeleteStorefrontSlot(context.Context, *DeleteStorefrontSlotRequest) (*DeleteStorefrontSlotResponse, error)
+	ListStorefrontSlots(context.Context, *ListStorefrontSlotsRequest) (*ListStorefrontSlotsResponse, error)
+	PinStorefrontItem(context.Context, *PinStorefrontItemRequest) (*PinStorefrontItemResponse, error)
+	UnpinStorefrontItem(context.Context, *UnpinStorefrontItemRequest) (*UnpinStorefrontItemResponse, error)
+}
+
+func RegisterStorefrontServer(s *grpc.Server, srv StorefrontServer) {
+	s.RegisterService(&_Storefront_serviceDesc, srv)
+}
+
+func _Storefront_GetStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).Update(ctx, in)
+		return srv.(StorefrontServer).GetStorefront(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/Update",
+		FullMethod: "/service.Storefront/GetStorefront",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).Update(ctx, req.(*UpdateStoreItemRequest))
+		return srv.(StorefrontServer).GetStorefront(ctx, req.(*GetStorefrontRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStoreItemRequest)
+func _Storefront_PreviewStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewStorefrontRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).Delete(ctx, in)
+		return srv.(StorefrontServer).PreviewStorefront(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/Delete",
+		FullMethod: "/service.Storefront/PreviewStorefront",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).Delete(ctx, req.(*DeleteStoreItemRequest))
+		return srv.(StorefrontServer).PreviewStorefront(ctx, req.(*PreviewStorefrontRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStoreItemsRequest)
+func _Storefront_CreateStorefrontSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorefrontSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).List(ctx, in)
+		return srv.(StorefrontServer).CreateStorefrontSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/List",
+		FullMethod: "/service.Storefront/CreateStorefrontSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).List(ctx, req.(*ListStoreItemsRequest))
+		return srv.(StorefrontServer).CreateStorefrontSlot(ctx, req.(*CreateStorefrontSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_BuyByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuyByUserRequest)
+func _Storefront_UpdateStorefrontSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStorefrontSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).BuyByUser(ctx, in)
+		return srv.(StorefrontServer).UpdateStorefrontSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/BuyByUser",
+		FullMethod: "/service.Storefront/UpdateStorefrontSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).BuyByUser(ctx, req.(*BuyByUserRequest))
+		return srv.(StorefrontServer).UpdateStorefrontSlot(ctx, req.(*UpdateStorefrontSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_GetUserItemsIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserItemsIdsRequest)
+func _Storefront_DeleteStorefrontSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStorefrontSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).GetUserItemsIds(ctx, in)
+		return srv.(StorefrontServer).DeleteStorefrontSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/GetUserItemsIds",
+		FullMethod: "/service.Storefront/DeleteStorefrontSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).GetUserItemsIds(ctx, req.(*GetUserItemsIdsRequest))
+		return srv.(StorefrontServer).DeleteStorefrontSlot(ctx, req.(*DeleteStorefrontSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_GetEquippedUserItemsIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEquippedUserItemsIdsRequest)
+func _Storefront_ListStorefrontSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorefrontSlotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).GetEquippedUserItemsIds(ctx, in)
+		return srv.(StorefrontServer).ListStorefrontSlots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/GetEquippedUserItemsIds",
+		FullMethod: "/service.Storefront/ListStorefrontSlots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).GetEquippedUserItemsIds(ctx, req.(*GetEquippedUserItemsIdsRequest))
+		return srv.(StorefrontServer).ListStorefrontSlots(ctx, req.(*ListStorefrontSlotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_EquipByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EquipByUserRequest)
+func _Storefront_PinStorefrontItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinStorefrontItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).EquipByUser(ctx, in)
+		return srv.(StorefrontServer).PinStorefrontItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/EquipByUser",
+		FullMethod: "/service.Storefront/PinStorefrontItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).EquipByUser(ctx, req.(*EquipByUserRequest))
+		return srv.(StorefrontServer).PinStorefrontItem(ctx, req.(*PinStorefrontItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StoreItems_ThrowAwayByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ThrowAwayByUserRequest)
+func _Storefront_UnpinStorefrontItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinStorefrontItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StoreItemsServer).ThrowAwayByUser(ctx, in)
+		return srv.(StorefrontServer).UnpinStorefrontItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.StoreItems/ThrowAwayByUser",
+		FullMethod: "/service.Storefront/UnpinStorefrontItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StoreItemsServer).ThrowAwayByUser(ctx, req.(*ThrowAwayByUserRequest))
+		return srv.(StorefrontServer).UnpinStorefrontItem(ctx, req.(*UnpinStorefrontItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _StoreItems_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "service.StoreItems",
-	HandlerType: (*StoreItemsServer)(nil),
+var _Storefront_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.Storefront",
+	HandlerType: (*StorefrontServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Create",
-			Handler:    _StoreItems_Create_Handler,
-		},
-		{
-			MethodName: "Read",
-			Handler:    _StoreItems_Read_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _StoreItems_Update_Handler,
+			MethodName: "GetStorefront",
+			Handler:    _Storefront_GetStorefront_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _StoreItems_Delete_Handler,
+			MethodName: "PreviewStorefront",
+			Handler:    _Storefront_PreviewStorefront_Handler,
 		},
 		{
-			MethodName: "List",
-			Handler:    _StoreItems_List_Handler,
+			MethodName: "CreateStorefrontSlot",
+			Handler:    _Storefront_CreateStorefrontSlot_Handler,
 		},
 		{
-			MethodName: "BuyByUser",
-			Handler:    _StoreItems_BuyByUser_Handler,
+			MethodName: "UpdateStorefrontSlot",
+			Handler:    _Storefront_UpdateStorefrontSlot_Handler,
 		},
 		{
-			MethodName: "GetUserItemsIds",
-			Handler:    _StoreItems_GetUserItemsIds_Handler,
+			MethodName: "DeleteStorefrontSlot",
+			Handler:    _Storefront_DeleteStorefrontSlot_Handler,
 		},
 		{
-			MethodName: "GetEquippedUserItemsIds",
-			Handler:    _StoreItems_GetEquippedUserItemsIds_Handler,
+			MethodName: "ListStorefrontSlots",
+			Handler:    _Storefront_ListStorefrontSlots_Handler,
 		},
 		{
-			MethodName: "EquipByUser",
-			Handler:    _StoreItems_EquipByUser_Handler,
+			MethodName: "PinStorefrontItem",
+			Handler:    _Storefront_PinStorefrontItem_Handler,
 		},
 		{
-			MethodName: "ThrowAwayByUser",
-			Handler:    _StoreItems_ThrowAwayByUser_Handler,
+			MethodName: "UnpinStorefrontItem",
+			Handler:    _Storefront_UnpinStorefrontItem_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
 }
 
-// UsersStatsClient is the client API for UsersStats service.
+// PaymentsClient is the client API for Payments service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UsersStatsClient interface {
-	GetStats(ctx context.Context, in *ReadUserStatsRequest, opts ...grpc.CallOption) (*ReadUserStatsResponse, error)
-	UpdateStats(ctx context.Context, in *UpdateUserStatsRequest, opts ...grpc.CallOption) (*UpdateUserStatsResponse, error)
+type PaymentsClient interface {
+	CreateGemPack(ctx context.Context, in *CreateGemPackRequest, opts ...grpc.CallOption) (*CreateGemPackResponse, error)
+	DeleteGemPack(ctx context.Context, in *DeleteGemPackRequest, opts ...grpc.CallOption) (*DeleteGemPackResponse, error)
+	ListGemPacks(ctx context.Context, in *ListGemPacksRequest, opts ...grpc.CallOption) (*ListGemPacksResponse, error)
+	CreatePurchase(ctx context.Context, in *CreatePurchaseRequest, opts ...grpc.CallOption) (*CreatePurchaseResponse, error)
+	ValidateReceipt(ctx context.Context, in *ValidateReceiptRequest, opts ...grpc.CallOption) (*ValidateReceiptResponse, error)
 }
 
-type usersStatsClient struct {
+type paymentsClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewUsersStatsClient(cc *grpc.ClientConn) UsersStatsClient {
-	return &usersStatsClient{cc}
+func NewPaymentsClient(cc *grpc.ClientConn) PaymentsClient {
+	return &paymentsClient{cc}
 }
 
-func (c *usersStatsClient) GetStats(ctx context.Context, in *ReadUserStatsRequest, opts ...grpc.CallOption) (*ReadUserStatsResponse, error) {
-	out := new(ReadUserStatsResponse)
-	err := c.cc.Invoke(ctx, "/service.UsersStats/GetStats", in, out, opts...)
+func (c *paymentsClient) CreateGemPack(ctx context.Context, in *CreateGemPackRequest, opts ...grpc.CallOption) (*CreateGemPackResponse, error) {
+	out := new(CreateGemPackResponse)
+	err := c.cc.Invoke(ctx, "/service.Payments/CreateGemPack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *usersStatsClient) UpdateStats(ctx context.Context, in *UpdateUserStatsRequest, opts ...grpc.CallOption) (*UpdateUserStatsResponse, error) {
-	out := new(UpdateUserStatsResponse)
-	err := c.cc.Invoke(ctx, "/service.UsersStats/UpdateStats", in, out, opts...)
+func (c *paymentsClient) DeleteGemPack(ctx context.Context, in *DeleteGemPackRequest, opts ...grpc.CallOption) (*DeleteGemPackResponse, error) {
+	out := new(DeleteGemPackResponse)
+	err := c.cc.Invoke(ctx, "/service.Payments/DeleteGemPack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UsersStatsServer is the server API for UsersStats service.
-type UsersStatsServer interface {
-	GetStats(context.Context, *ReadUserStatsRequest) (*ReadUserStatsResponse, error)
-	UpdateStats(context.Context, *UpdateUserStatsRequest) (*UpdateUserStatsResponse, error)
-}
-
-func RegisterUsersStatsServer(s *grpc.Server, srv UsersStatsServer) {
-	s.RegisterService(&_UsersStats_serviceDesc, srv)
-}
-
-func _UsersStats_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadUserStatsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UsersStatsServer).GetStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.UsersStats/GetStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersStatsServer).GetStats(ctx, req.(*ReadUserStatsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _UsersStats_UpdateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserStatsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UsersStatsServer).UpdateStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.UsersStats/UpdateStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersStatsServer).UpdateStats(ctx, req.(*UpdateUserStatsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _UsersStats_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "service.UsersStats",
-	HandlerType: (*UsersStatsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetStats",
-			Handler:    _UsersStats_GetStats_Handler,
-		},
-		{
-			MethodName: "UpdateStats",
-			Handler:    _UsersStats_UpdateStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/amikhailau/users-service/pkg/pb/service.proto",
-}
-
-// NewsServiceClient is the client API for NewsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NewsServiceClient interface {
-	Create(ctx context.Context, in *CreateNewsRequest, opts ...grpc.CallOption) (*CreateNewsResponse, error)
-	Read(ctx context.Context, in *ReadNewsRequest, opts ...grpc.CallOption) (*ReadNewsResponse, error)
-	Update(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error)
-	List(ctx context.Context, in *ListNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
-}
-
-type newsServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewNewsServiceClient(cc *grpc.ClientConn) NewsServiceClient {
-	return &newsServiceClient{cc}
-}
-
-func (c *newsServiceClient) Create(ctx context.Context, in *CreateNewsRequest, opts ...grpc.CallOption) (*CreateNewsResponse, error) {
-	out := new(CreateNewsResponse)
-	err := c.cc.Invoke(ctx, "/service.NewsService/Create", in, out, opts...)
+func (c *paymentsClient) ListGemPacks(ctx context.Context, in *ListGemPacksRequest, opts ...grpc.CallOption) (*ListGemPacksResponse, error) {
+	out := new(ListGemPacksResponse)
+	err := c.cc.Invoke(ctx, "/service.Payments/ListGemPacks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *newsServiceClient) Read(ctx context.Context, in *ReadNewsRequest, opts ...grpc.CallOption) (*ReadNewsResponse, error) {
-	out := new(ReadNewsResponse)
-	err := c.cc.Invoke(ctx, "/service.NewsService/Read", in, out, opts...)
+func (c *paymentsClient) CreatePurchase(ctx context.Context, in *CreatePurchaseRequest, opts ...grpc.CallOption) (*CreatePurchaseResponse, error) {
+	out := new(CreatePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/service.Payments/CreatePurchase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *newsServiceClient) Update(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error) {
-	out := new(UpdateNewsResponse)
-	err := c.cc.Invoke(ctx, "/service.NewsService/Update", in, out, opts...)
+func (c *paymentsClient) ValidateReceipt(ctx context.Context, in *ValidateReceiptRequest, opts ...grpc.CallOption) (*ValidateReceiptResponse, error) {
+	out := new(ValidateReceiptResponse)
+	err := c.cc.Invoke(ctx, "/service.Payments/ValidateReceipt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *newsServiceClient) List(ctx context.Context, in *ListNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error) {
-	out := new(ListNewsResponse)
-	err := c.cc.Invoke(ctx, "/service.NewsService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PaymentsServer is the server API for Payments service.
+type PaymentsServer interface {
+	CreateGemPack(context.Context, *CreateGemPackRequest) (*CreateGemPackResponse, error)
+	DeleteGemPack(context.Context, *DeleteGemPackRequest) (*DeleteGemPackResponse, error)
+	ListGemPacks(context.Context, *ListGemPacksRequest) (*ListGemPacksResponse, error)
+	CreatePurchase(context.Context, *CreatePurchaseRequest) (*CreatePurchaseResponse, error)
+	ValidateReceipt(context.Context, *ValidateReceiptRequest) (*ValidateReceiptResponse, error)
 }
 
-// NewsServiceServer is the server API for NewsService service.
-type NewsServiceServer interface {
-	Create(context.Context, *CreateNewsRequest) (*CreateNewsResponse, error)
-	Read(context.Context, *ReadNewsRequest) (*ReadNewsResponse, error)
-	Update(context.Context, *UpdateNewsRequest) (*UpdateNewsResponse, error)
-	List(context.Context, *ListNewsRequest) (*ListNewsResponse, error)
+func RegisterPaymentsServer(s *grpc.Server, srv PaymentsServer) {
+	s.RegisterService(&_Payments_serviceDesc, srv)
 }
 
-func RegisterNewsServiceServer(s *grpc.Server, srv NewsServiceServer) {
-	s.RegisterService(&_NewsService_serviceDesc, srv)
+func _Payments_CreateGemPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGemPackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServer).CreateGemPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Payments/CreateGemPack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServer).CreateGemPack(ctx, req.(*CreateGemPackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _NewsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNewsRequest)
+func _Payments_DeleteGemPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGemPackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NewsServiceServer).Create(ctx, in)
+		return srv.(PaymentsServer).DeleteGemPack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.NewsService/Create",
+		FullMethod: "/service.Payments/DeleteGemPack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NewsServiceServer).Create(ctx, req.(*CreateNewsRequest))
+		return srv.(PaymentsServer).DeleteGemPack(ctx, req.(*DeleteGemPackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NewsService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadNewsRequest)
+func _Payments_ListGemPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGemPacksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NewsServiceServer).Read(ctx, in)
+		return srv.(PaymentsServer).ListGemPacks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.NewsService/Read",
+		FullMethod: "/service.Payments/ListGemPacks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NewsServiceServer).Read(ctx, req.(*ReadNewsRequest))
+		return srv.(PaymentsServer).ListGemPacks(ctx, req.(*ListGemPacksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NewsService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateNewsRequest)
+func _Payments_CreatePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NewsServiceServer).Update(ctx, in)
+		return srv.(PaymentsServer).CreatePurchase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.NewsService/Update",
+		FullMethod: "/service.Payments/CreatePurchase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NewsServiceServer).Update(ctx, req.(*UpdateNewsRequest))
+		return srv.(PaymentsServer).CreatePurchase(ctx, req.(*CreatePurchaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NewsService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNewsRequest)
+func _Payments_ValidateReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateReceiptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NewsServiceServer).List(ctx, in)
+		return srv.(PaymentsServer).ValidateReceipt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.NewsService/List",
+		FullMethod: "/service.Payments/ValidateReceipt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NewsServiceServer).List(ctx, req.(*ListNewsRequest))
+		return srv.(PaymentsServer).ValidateReceipt(ctx, req.(*ValidateReceiptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _NewsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "service.NewsService",
-	HandlerType: (*NewsServiceServer)(nil),
+var _Payments_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.Payments",
+	HandlerType: (*PaymentsServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Create",
-			Handler:    _NewsService_Create_Handler,
+			MethodName: "CreateGemPack",
+			Handler:    _Payments_CreateGemPack_Handler,
 		},
 		{
-			MethodName: "Read",
-			Handler:    _NewsService_Read_Handler,
+			MethodName: "DeleteGemPack",
+			Handler:    _Payments_DeleteGemPack_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _NewsService_Update_Handler,
+			MethodName: "ListGemPacks",
+			Handler:    _Payments_ListGemPacks_Handler,
 		},
 		{
-			MethodName: "List",
-			Handler:    _NewsService_List_Handler,
+			MethodName: "CreatePurchase",
+			Handler:    _Payments_CreatePurchase_Handler,
+		},
+		{
+			MethodName: "ValidateReceipt",
+			Handler:    _Payments_ValidateReceipt_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
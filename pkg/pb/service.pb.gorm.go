@@ -29,6 +29,16 @@ It has these top-level messages:
 	GrantCurrenciesResponse
 	GetUserCurrenciesRequest
 	GetUserCurrenciesResponse
+	ExchangeRate
+	SetExchangeRateRequest
+	SetExchangeRateResponse
+	DeleteExchangeRateRequest
+	DeleteExchangeRateResponse
+	ExchangeQuote
+	GetExchangeRatesRequest
+	GetExchangeRatesResponse
+	ExchangeCurrencyRequest
+	ExchangeCurrencyResponse
 	StoreItem
 	CreateStoreItemRequest
 	CreateStoreItemResponse
@@ -40,6 +50,18 @@ It has these top-level messages:
 	DeleteStoreItemResponse
 	ListStoreItemsRequest
 	ListStoreItemsResponse
+	CatalogRowError
+	ImportCatalogRequest
+	ImportCatalogResponse
+	ExportCatalogRequest
+	ExportCatalogResponse
+	PurgeItemRequest
+	PurgeItemResponse
+	StoreItemTranslation
+	SetItemTranslationRequest
+	SetItemTranslationResponse
+	DeleteItemTranslationRequest
+	DeleteItemTranslationResponse
 	BuyByUserRequest
 	BuyByUserResponse
 	ThrowAwayByUserRequest
@@ -51,11 +73,91 @@ It has these top-level messages:
 	GetUserItemsIdsResponse
 	GetEquippedUserItemsIdsRequest
 	GetEquippedUserItemsIdsResponse
+	GrantItemRequest
+	GrantItemResponse
+	ConsumeItemRequest
+	ConsumeItemResponse
+	Gift
+	GiftItemRequest
+	GiftItemResponse
+	ListPendingGiftsRequest
+	ListPendingGiftsResponse
+	AcceptGiftRequest
+	AcceptGiftResponse
+	DeclineGiftRequest
+	DeclineGiftResponse
+	ItemType
+	SetItemTypeRequest
+	SetItemTypeResponse
+	DeleteItemTypeRequest
+	DeleteItemTypeResponse
+	ListItemTypesRequest
+	ListItemTypesResponse
+	Loadout
+	CreateLoadoutRequest
+	CreateLoadoutResponse
+	UpdateLoadoutRequest
+	UpdateLoadoutResponse
+	DeleteLoadoutRequest
+	DeleteLoadoutResponse
+	ActivateLoadoutRequest
+	ActivateLoadoutResponse
+	ListLoadoutsRequest
+	ListLoadoutsResponse
+	InventoryEntry
+	InventoryItem
+	ListUserInventoryRequest
+	ListUserInventoryResponse
 	UserStats
+	GetLeaderboardRequest
+	LeaderboardEntry
+	GetLeaderboardResponse
 	ReadUserStatsRequest
+	DerivedStats
+	WindowedStats
 	ReadUserStatsResponse
 	UpdateUserStatsRequest
 	UpdateUserStatsResponse
+	BatchUpdateStatsRequest
+	BatchStatsResult
+	BatchUpdateStatsResponse
+	FlaggedStatUpdate
+	ListFlaggedStatUpdatesRequest
+	ListFlaggedStatUpdatesResponse
+	ClearFlaggedStatUpdateRequest
+	ClearFlaggedStatUpdateResponse
+	RollbackFlaggedStatUpdateRequest
+	RollbackFlaggedStatUpdateResponse
+	MatchParticipant
+	RecordMatchRequest
+	MatchReward
+	RecordMatchResponse
+	MatchHistoryEntry
+	ListMatchHistoryRequest
+	ListMatchHistoryResponse
+	RebuildStatsRequest
+	RebuildStatsResponse
+	Season
+	CreateSeasonRequest
+	CreateSeasonResponse
+	ListSeasonsRequest
+	ListSeasonsResponse
+	Rating
+	RatingHistoryEntry
+	GetRatingRequest
+	GetRatingResponse
+	GetRatingsRequest
+	GetRatingsResponse
+	Achievement
+	CreateAchievementRequest
+	CreateAchievementResponse
+	DeleteAchievementRequest
+	DeleteAchievementResponse
+	ListAchievementsRequest
+	ListAchievementsResponse
+	UserAchievement
+	GetUserAchievementsRequest
+	GetUserAchievementsResponse
 	News
 	CreateNewsRequest
 	CreateNewsResponse
@@ -63,8 +165,49 @@ It has these top-level messages:
 	ReadNewsResponse
 	UpdateNewsRequest
 	UpdateNewsResponse
+	DeleteNewsRequest
+	DeleteNewsResponse
+	PublishNewsRequest
+	PublishNewsResponse
+	UnpublishNewsRequest
+	UnpublishNewsResponse
 	ListNewsRequest
 	ListNewsResponse
+	NewsTranslation
+	SetNewsTranslationRequest
+	SetNewsTranslationResponse
+	DeleteNewsTranslationRequest
+	DeleteNewsTranslationResponse
+	StorefrontSlot
+	StorefrontPoolEntry
+	StorefrontRotationSlot
+	GetStorefrontRequest
+	GetStorefrontResponse
+	PreviewStorefrontRequest
+	PreviewStorefrontResponse
+	CreateStorefrontSlotRequest
+	CreateStorefrontSlotResponse
+	UpdateStorefrontSlotRequest
+	UpdateStorefrontSlotResponse
+	DeleteStorefrontSlotRequest
+	DeleteStorefrontSlotResponse
+	ListStorefrontSlotsRequest
+	ListStorefrontSlotsResponse
+	PinStorefrontItemRequest
+	PinStorefrontItemResponse
+	UnpinStorefrontItemRequest
+	UnpinStorefrontItemResponse
+	GemPack
+	CreateGemPackRequest
+	CreateGemPackResponse
+	DeleteGemPackRequest
+	DeleteGemPackResponse
+	ListGemPacksRequest
+	ListGemPacksResponse
+	CreatePurchaseRequest
+	CreatePurchaseResponse
+	ValidateReceiptRequest
+	ValidateReceiptResponse
 */
 package pb
 
@@ -214,17 +357,111 @@ type UserWithAfterToPB interface {
 	AfterToPB(context.Context, *User) error
 }
 
+type ExchangeRateORM struct {
+	DailyCap     int32
+	FromCurrency string
+	Id           int32 `gorm:"type:serial;primary_key"`
+	MaxAmount    int32
+	MinAmount    int32
+	RateCoins    int32
+	RateGems     int32
+	ToCurrency   string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ExchangeRateORM) TableName() string {
+	return "exchange_rates"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ExchangeRate) ToORM(ctx context.Context) (ExchangeRateORM, error) {
+	to := ExchangeRateORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ExchangeRateWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.FromCurrency = m.FromCurrency
+	to.ToCurrency = m.ToCurrency
+	to.RateGems = m.RateGems
+	to.MinAmount = m.MinAmount
+	to.MaxAmount = m.MaxAmount
+	to.DailyCap = m.DailyCap
+	to.RateCoins = m.RateCoins
+	if posthook, ok := interface{}(m).(ExchangeRateWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ExchangeRateORM) ToPB(ctx context.Context) (ExchangeRate, error) {
+	to := ExchangeRate{}
+	var err error
+	if prehook, ok := interface{}(m).(ExchangeRateWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.FromCurrency = m.FromCurrency
+	to.ToCurrency = m.ToCurrency
+	to.RateGems = m.RateGems
+	to.MinAmount = m.MinAmount
+	to.MaxAmount = m.MaxAmount
+	to.DailyCap = m.DailyCap
+	to.RateCoins = m.RateCoins
+	if posthook, ok := interface{}(m).(ExchangeRateWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ExchangeRate the arg will be the target, the caller the one being converted from
+
+// ExchangeRateBeforeToORM called before default ToORM code
+type ExchangeRateWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ExchangeRateORM) error
+}
+
+// ExchangeRateAfterToORM called after default ToORM code
+type ExchangeRateWithAfterToORM interface {
+	AfterToORM(context.Context, *ExchangeRateORM) error
+}
+
+// ExchangeRateBeforeToPB called before default ToPB code
+type ExchangeRateWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ExchangeRate) error
+}
+
+// ExchangeRateAfterToPB called after default ToPB code
+type ExchangeRateWithAfterToPB interface {
+	AfterToPB(context.Context, *ExchangeRate) error
+}
+
 type StoreItemORM struct {
-	CoinsPrice     int32
-	Description    string
-	GemsPrice      int32
-	Id             string `gorm:"type:UUID;primary_key"`
-	ImageId        string
-	Name           string
-	OnSale         bool
-	SaleCoinsPrice int32
-	SaleGemsPrice  int32
-	Type           int32
+	CoinsPrice       int32
+	Consumable       bool
+	Description      string
+	GemsPrice        int32
+	Id               string `gorm:"type:UUID;primary_key"`
+	ImageId          string
+	MaxStack         int32
+	Name             string
+	OnSale           bool
+	RentalCoinsPrice int32
+	RentalDays       int32
+	RentalGemsPrice  int32
+	Retired          bool
+	SaleCoinsPrice   int32
+	SaleGemsPrice    int32
+	Sku              string
+	Type             int32
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -252,6 +489,13 @@ func (m *StoreItem) ToORM(ctx context.Context) (StoreItemORM, error) {
 	to.OnSale = m.OnSale
 	to.SaleCoinsPrice = m.SaleCoinsPrice
 	to.SaleGemsPrice = m.SaleGemsPrice
+	to.Consumable = m.Consumable
+	to.MaxStack = m.MaxStack
+	to.RentalDays = m.RentalDays
+	to.RentalCoinsPrice = m.RentalCoinsPrice
+	to.RentalGemsPrice = m.RentalGemsPrice
+	to.Sku = m.Sku
+	to.Retired = m.Retired
 	if posthook, ok := interface{}(m).(StoreItemWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -278,6 +522,13 @@ func (m *StoreItemORM) ToPB(ctx context.Context) (StoreItem, error) {
 	to.OnSale = m.OnSale
 	to.SaleCoinsPrice = m.SaleCoinsPrice
 	to.SaleGemsPrice = m.SaleGemsPrice
+	to.Consumable = m.Consumable
+	to.MaxStack = m.MaxStack
+	to.RentalDays = m.RentalDays
+	to.RentalCoinsPrice = m.RentalCoinsPrice
+	to.RentalGemsPrice = m.RentalGemsPrice
+	to.Sku = m.Sku
+	to.Retired = m.Retired
 	if posthook, ok := interface{}(m).(StoreItemWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -307,6 +558,236 @@ type StoreItemWithAfterToPB interface {
 	AfterToPB(context.Context, *StoreItem) error
 }
 
+type ItemTypeORM struct {
+	DisplayName  string
+	Id           int32 `gorm:"primary_key"`
+	Name         string
+	Slot         string
+	SlotCapacity int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ItemTypeORM) TableName() string {
+	return "item_types"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ItemType) ToORM(ctx context.Context) (ItemTypeORM, error) {
+	to := ItemTypeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ItemTypeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.DisplayName = m.DisplayName
+	to.Slot = m.Slot
+	to.SlotCapacity = m.SlotCapacity
+	if posthook, ok := interface{}(m).(ItemTypeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ItemTypeORM) ToPB(ctx context.Context) (ItemType, error) {
+	to := ItemType{}
+	var err error
+	if prehook, ok := interface{}(m).(ItemTypeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.DisplayName = m.DisplayName
+	to.Slot = m.Slot
+	to.SlotCapacity = m.SlotCapacity
+	if posthook, ok := interface{}(m).(ItemTypeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ItemType the arg will be the target, the caller the one being converted from
+
+// ItemTypeBeforeToORM called before default ToORM code
+type ItemTypeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ItemTypeORM) error
+}
+
+// ItemTypeAfterToORM called after default ToORM code
+type ItemTypeWithAfterToORM interface {
+	AfterToORM(context.Context, *ItemTypeORM) error
+}
+
+// ItemTypeBeforeToPB called before default ToPB code
+type ItemTypeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ItemType) error
+}
+
+// ItemTypeAfterToPB called after default ToPB code
+type ItemTypeWithAfterToPB interface {
+	AfterToPB(context.Context, *ItemType) error
+}
+
+type InventoryEntryORM struct {
+	AcquiredAt       *time.Time
+	CoinsPrice       int32
+	Consumable       bool
+	Description      string
+	Equipped         bool
+	ExpiresAt        *time.Time
+	GemsPrice        int32
+	ImageId          string
+	ItemId           string
+	MaxStack         int32
+	Name             string
+	OnSale           bool
+	Quantity         int32
+	RentalCoinsPrice int32
+	RentalDays       int32
+	RentalGemsPrice  int32
+	Retired          bool
+	SaleCoinsPrice   int32
+	SaleGemsPrice    int32
+	Sku              string
+	Source           string
+	Type             int32
+	UserId           string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (InventoryEntryORM) TableName() string {
+	return "inventory_entries"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *InventoryEntry) ToORM(ctx context.Context) (InventoryEntryORM, error) {
+	to := InventoryEntryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(InventoryEntryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.UserId = m.UserId
+	to.ItemId = m.ItemId
+	to.Name = m.Name
+	to.Description = m.Description
+	to.Type = m.Type
+	to.CoinsPrice = m.CoinsPrice
+	to.GemsPrice = m.GemsPrice
+	to.ImageId = m.ImageId
+	to.OnSale = m.OnSale
+	to.SaleCoinsPrice = m.SaleCoinsPrice
+	to.SaleGemsPrice = m.SaleGemsPrice
+	to.Consumable = m.Consumable
+	to.MaxStack = m.MaxStack
+	to.Equipped = m.Equipped
+	to.Quantity = m.Quantity
+	if m.AcquiredAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.AcquiredAt); err != nil {
+			return to, err
+		}
+		to.AcquiredAt = &t
+	}
+	to.Source = m.Source
+	if m.ExpiresAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.ExpiresAt); err != nil {
+			return to, err
+		}
+		to.ExpiresAt = &t
+	}
+	to.RentalDays = m.RentalDays
+	to.RentalCoinsPrice = m.RentalCoinsPrice
+	to.RentalGemsPrice = m.RentalGemsPrice
+	to.Sku = m.Sku
+	to.Retired = m.Retired
+	if posthook, ok := interface{}(m).(InventoryEntryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *InventoryEntryORM) ToPB(ctx context.Context) (InventoryEntry, error) {
+	to := InventoryEntry{}
+	var err error
+	if prehook, ok := interface{}(m).(InventoryEntryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.UserId = m.UserId
+	to.ItemId = m.ItemId
+	to.Name = m.Name
+	to.Description = m.Description
+	to.Type = m.Type
+	to.CoinsPrice = m.CoinsPrice
+	to.GemsPrice = m.GemsPrice
+	to.ImageId = m.ImageId
+	to.OnSale = m.OnSale
+	to.SaleCoinsPrice = m.SaleCoinsPrice
+	to.SaleGemsPrice = m.SaleGemsPrice
+	to.Consumable = m.Consumable
+	to.MaxStack = m.MaxStack
+	to.Equipped = m.Equipped
+	to.Quantity = m.Quantity
+	if m.AcquiredAt != nil {
+		if to.AcquiredAt, err = ptypes1.TimestampProto(*m.AcquiredAt); err != nil {
+			return to, err
+		}
+	}
+	to.Source = m.Source
+	if m.ExpiresAt != nil {
+		if to.ExpiresAt, err = ptypes1.TimestampProto(*m.ExpiresAt); err != nil {
+			return to, err
+		}
+	}
+	to.RentalDays = m.RentalDays
+	to.RentalCoinsPrice = m.RentalCoinsPrice
+	to.RentalGemsPrice = m.RentalGemsPrice
+	to.Sku = m.Sku
+	to.Retired = m.Retired
+	if posthook, ok := interface{}(m).(InventoryEntryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type InventoryEntry the arg will be the target, the caller the one being converted from
+
+// InventoryEntryBeforeToORM called before default ToORM code
+type InventoryEntryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *InventoryEntryORM) error
+}
+
+// InventoryEntryAfterToORM called after default ToORM code
+type InventoryEntryWithAfterToORM interface {
+	AfterToORM(context.Context, *InventoryEntryORM) error
+}
+
+// InventoryEntryBeforeToPB called before default ToPB code
+type InventoryEntryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *InventoryEntry) error
+}
+
+// InventoryEntryAfterToPB called after default ToPB code
+type InventoryEntryWithAfterToPB interface {
+	AfterToPB(context.Context, *InventoryEntry) error
+}
+
 type UserStatsORM struct {
 	Games  int32
 	Id     int32 `gorm:"type:serial;primary_key"`
@@ -391,6 +872,9 @@ type NewsORM struct {
 	Description string
 	Id          string `gorm:"type:UUID;primary_key"`
 	ImageLink   string
+	PublishAt   *time.Time
+	PublishedAt *time.Time
+	Status      int32
 	Title       string
 }
 
@@ -420,6 +904,21 @@ func (m *News) ToORM(ctx context.Context) (NewsORM, error) {
 	to.Title = m.Title
 	to.Description = m.Description
 	to.ImageLink = m.ImageLink
+	to.Status = int32(m.Status)
+	if m.PublishAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.PublishAt); err != nil {
+			return to, err
+		}
+		to.PublishAt = &t
+	}
+	if m.PublishedAt != nil {
+		var t time.Time
+		if t, err = ptypes1.Timestamp(m.PublishedAt); err != nil {
+			return to, err
+		}
+		to.PublishedAt = &t
+	}
 	if posthook, ok := interface{}(m).(NewsWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -445,6 +944,17 @@ func (m *NewsORM) ToPB(ctx context.Context) (News, error) {
 	to.Title = m.Title
 	to.Description = m.Description
 	to.ImageLink = m.ImageLink
+	to.Status = NewsStatus(m.Status)
+	if m.PublishAt != nil {
+		if to.PublishAt, err = ptypes1.TimestampProto(*m.PublishAt); err != nil {
+			return to, err
+		}
+	}
+	if m.PublishedAt != nil {
+		if to.PublishedAt, err = ptypes1.TimestampProto(*m.PublishedAt); err != nil {
+			return to, err
+		}
+	}
 	if posthook, ok := interface{}(m).(NewsWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -474,82 +984,328 @@ type NewsWithAfterToPB interface {
 	AfterToPB(context.Context, *News) error
 }
 
-// DefaultCreateUser executes a basic gorm create call
-func DefaultCreateUser(ctx context.Context, in *User, db *gorm1.DB) (*User, error) {
-	if in == nil {
-		return nil, errors1.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+type StorefrontSlotORM struct {
+	Id         int32 `gorm:"type:serial;primary_key"`
+	ItemsCount int32
+	Name       string
+	Pool       []*StorefrontPoolEntryORM `gorm:"foreignkey:StorefrontSlotId;association_foreignkey:Id"`
 }
 
-type UserORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
-}
-type UserORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm1.DB) error
+// TableName overrides the default tablename generated by GORM
+func (StorefrontSlotORM) TableName() string {
+	return "storefront_slots"
 }
 
-// DefaultReadUser executes a basic gorm read call
-func DefaultReadUser(ctx context.Context, in *User, db *gorm1.DB) (*User, error) {
-	if in == nil {
-		return nil, errors1.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == "" {
-		return nil, errors1.EmptyIdError
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *StorefrontSlot) ToORM(ctx context.Context) (StorefrontSlotORM, error) {
+	to := StorefrontSlotORM{}
+	var err error
+	if prehook, ok := interface{}(m).(StorefrontSlotWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	to.ItemsCount = m.ItemsCount
+	for _, v := range m.Pool {
+		if v != nil {
+			if tempPool, cErr := v.ToORM(ctx); cErr == nil {
+				to.Pool = append(to.Pool, &tempPool)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Pool = append(to.Pool, nil)
 		}
 	}
-	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
-		return nil, err
+	if posthook, ok := interface{}(m).(StorefrontSlotWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *StorefrontSlotORM) ToPB(ctx context.Context) (StorefrontSlot, error) {
+	to := StorefrontSlot{}
+	var err error
+	if prehook, ok := interface{}(m).(StorefrontSlotWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	ormResponse := UserORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	to.ItemsCount = m.ItemsCount
+	for _, v := range m.Pool {
+		if v != nil {
+			if tempPool, cErr := v.ToPB(ctx); cErr == nil {
+				to.Pool = append(to.Pool, &tempPool)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Pool = append(to.Pool, nil)
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	if posthook, ok := interface{}(m).(StorefrontSlotWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type UserORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type StorefrontSlot the arg will be the target, the caller the one being converted from
+
+// StorefrontSlotBeforeToORM called before default ToORM code
+type StorefrontSlotWithBeforeToORM interface {
+	BeforeToORM(context.Context, *StorefrontSlotORM) error
 }
-type UserORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+
+// StorefrontSlotAfterToORM called after default ToORM code
+type StorefrontSlotWithAfterToORM interface {
+	AfterToORM(context.Context, *StorefrontSlotORM) error
+}
+
+// StorefrontSlotBeforeToPB called before default ToPB code
+type StorefrontSlotWithBeforeToPB interface {
+	BeforeToPB(context.Context, *StorefrontSlot) error
+}
+
+// StorefrontSlotAfterToPB called after default ToPB code
+type StorefrontSlotWithAfterToPB interface {
+	AfterToPB(context.Context, *StorefrontSlot) error
+}
+
+type StorefrontPoolEntryORM struct {
+	Id               int32 `gorm:"type:serial;primary_key"`
+	ItemId           string
+	StorefrontSlotId *int32
+	Weight           int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (StorefrontPoolEntryORM) TableName() string {
+	return "storefront_pool_entries"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *StorefrontPoolEntry) ToORM(ctx context.Context) (StorefrontPoolEntryORM, error) {
+	to := StorefrontPoolEntryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(StorefrontPoolEntryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ItemId = m.ItemId
+	to.Weight = m.Weight
+	if posthook, ok := interface{}(m).(StorefrontPoolEntryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *StorefrontPoolEntryORM) ToPB(ctx context.Context) (StorefrontPoolEntry, error) {
+	to := StorefrontPoolEntry{}
+	var err error
+	if prehook, ok := interface{}(m).(StorefrontPoolEntryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.ItemId = m.ItemId
+	to.Weight = m.Weight
+	if posthook, ok := interface{}(m).(StorefrontPoolEntryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type StorefrontPoolEntry the arg will be the target, the caller the one being converted from
+
+// StorefrontPoolEntryBeforeToORM called before default ToORM code
+type StorefrontPoolEntryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *StorefrontPoolEntryORM) error
+}
+
+// StorefrontPoolEntryAfterToORM called after default ToORM code
+type StorefrontPoolEntryWithAfterToORM interface {
+	AfterToORM(context.Context, *StorefrontPoolEntryORM) error
+}
+
+// StorefrontPoolEntryBeforeToPB called before default ToPB code
+type StorefrontPoolEntryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *StorefrontPoolEntry) error
+}
+
+// StorefrontPoolEntryAfterToPB called after default ToPB code
+type StorefrontPoolEntryWithAfterToPB interface {
+	AfterToPB(context.Context, *StorefrontPoolEntry) error
+}
+
+type GemPackORM struct {
+	Currency   string
+	Gems       int32
+	Id         string `gorm:"type:UUID;primary_key"`
+	Name       string
+	PriceCents int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (GemPackORM) TableName() string {
+	return "gem_packs"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *GemPack) ToORM(ctx context.Context) (GemPackORM, error) {
+	to := GemPackORM{}
+	var err error
+	if prehook, ok := interface{}(m).(GemPackWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Gems = m.Gems
+	to.PriceCents = m.PriceCents
+	to.Currency = m.Currency
+	if posthook, ok := interface{}(m).(GemPackWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *GemPackORM) ToPB(ctx context.Context) (GemPack, error) {
+	to := GemPack{}
+	var err error
+	if prehook, ok := interface{}(m).(GemPackWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Gems = m.Gems
+	to.PriceCents = m.PriceCents
+	to.Currency = m.Currency
+	if posthook, ok := interface{}(m).(GemPackWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type GemPack the arg will be the target, the caller the one being converted from
+
+// GemPackBeforeToORM called before default ToORM code
+type GemPackWithBeforeToORM interface {
+	BeforeToORM(context.Context, *GemPackORM) error
+}
+
+// GemPackAfterToORM called after default ToORM code
+type GemPackWithAfterToORM interface {
+	AfterToORM(context.Context, *GemPackORM) error
+}
+
+// GemPackBeforeToPB called before default ToPB code
+type GemPackWithBeforeToPB interface {
+	BeforeToPB(context.Context, *GemPack) error
+}
+
+// GemPackAfterToPB called after default ToPB code
+type GemPackWithAfterToPB interface {
+	AfterToPB(context.Context, *GemPack) error
+}
+
+// DefaultCreateUser executes a basic gorm create call
+func DefaultCreateUser(ctx context.Context, in *User, db *gorm1.DB) (*User, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadUser executes a basic gorm read call
+func DefaultReadUser(ctx context.Context, in *User, db *gorm1.DB) (*User, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := UserORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
 type UserORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm1.DB) error
@@ -878,8 +1634,8 @@ type UserORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm1.DB, *[]UserORM, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) error
 }
 
-// DefaultCreateStoreItem executes a basic gorm create call
-func DefaultCreateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
+// DefaultCreateExchangeRate executes a basic gorm create call
+func DefaultCreateExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm1.DB) (*ExchangeRate, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -887,7 +1643,7 @@ func DefaultCreateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -895,7 +1651,7 @@ func DefaultCreateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -904,15 +1660,15 @@ func DefaultCreateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*
 	return &pbResponse, err
 }
 
-type StoreItemORMWithBeforeCreate_ interface {
+type ExchangeRateORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterCreate_ interface {
+type ExchangeRateORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm1.DB) error
 }
 
-// DefaultReadStoreItem executes a basic gorm read call
-func DefaultReadStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
+// DefaultReadExchangeRate executes a basic gorm read call
+func DefaultReadExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm1.DB) (*ExchangeRate, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -920,27 +1676,27 @@ func DefaultReadStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*St
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &StoreItemORM{}); err != nil {
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &ExchangeRateORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := StoreItemORM{}
+	ormResponse := ExchangeRateORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(StoreItemORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ExchangeRateORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -949,17 +1705,17 @@ func DefaultReadStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*St
 	return &pbResponse, err
 }
 
-type StoreItemORMWithBeforeReadApplyQuery interface {
+type ExchangeRateORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithBeforeReadFind interface {
+type ExchangeRateORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterReadFind interface {
+type ExchangeRateORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) error {
+func DefaultDeleteExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
@@ -967,86 +1723,86 @@ func DefaultDeleteStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) er
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&StoreItemORM{}).Error
+	err = db.Where(&ormObj).Delete(&ExchangeRateORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type StoreItemORMWithBeforeDelete_ interface {
+type ExchangeRateORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterDelete_ interface {
+type ExchangeRateORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteStoreItemSet(ctx context.Context, in []*StoreItem, db *gorm1.DB) error {
+func DefaultDeleteExchangeRateSet(ctx context.Context, in []*ExchangeRate, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []int32{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors1.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&StoreItemORM{})).(StoreItemORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ExchangeRateORM{})).(ExchangeRateORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&StoreItemORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ExchangeRateORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&StoreItemORM{})).(StoreItemORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ExchangeRateORM{})).(ExchangeRateORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type StoreItemORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*StoreItem, *gorm1.DB) (*gorm1.DB, error)
+type ExchangeRateORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ExchangeRate, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*StoreItem, *gorm1.DB) error
+type ExchangeRateORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ExchangeRate, *gorm1.DB) error
 }
 
-// DefaultStrictUpdateStoreItem clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
+// DefaultStrictUpdateExchangeRate clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateExchangeRate(ctx context.Context, in *ExchangeRate, db *gorm1.DB) (*ExchangeRate, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateStoreItem")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExchangeRate")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &StoreItemORM{}
+	lockedRow := &ExchangeRateORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1054,7 +1810,7 @@ func DefaultStrictUpdateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1066,51 +1822,51 @@ func DefaultStrictUpdateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.
 	return &pbResponse, err
 }
 
-type StoreItemORMWithBeforeStrictUpdateCleanup interface {
+type ExchangeRateORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithBeforeStrictUpdateSave interface {
+type ExchangeRateORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterStrictUpdateSave interface {
+type ExchangeRateORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
 }
 
-// DefaultPatchStoreItem executes a basic gorm update call with patch behavior
-func DefaultPatchStoreItem(ctx context.Context, in *StoreItem, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*StoreItem, error) {
+// DefaultPatchExchangeRate executes a basic gorm update call with patch behavior
+func DefaultPatchExchangeRate(ctx context.Context, in *ExchangeRate, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*ExchangeRate, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
-	var pbObj StoreItem
+	var pbObj ExchangeRate
 	var err error
-	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadStoreItem(ctx, &StoreItem{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadExchangeRate(ctx, &ExchangeRate{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskStoreItem(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskExchangeRate(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ExchangeRateWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateStoreItem(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateExchangeRate(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(StoreItemWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ExchangeRateWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1118,28 +1874,28 @@ func DefaultPatchStoreItem(ctx context.Context, in *StoreItem, updateMask *field
 	return pbResponse, nil
 }
 
-type StoreItemWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ExchangeRateWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ExchangeRate, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ExchangeRateWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ExchangeRate, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ExchangeRateWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ExchangeRate, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) error
+type ExchangeRateWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ExchangeRate, *field_mask1.FieldMask, *gorm1.DB) error
 }
 
-// DefaultPatchSetStoreItem executes a bulk gorm update call with patch behavior
-func DefaultPatchSetStoreItem(ctx context.Context, objects []*StoreItem, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*StoreItem, error) {
+// DefaultPatchSetExchangeRate executes a bulk gorm update call with patch behavior
+func DefaultPatchSetExchangeRate(ctx context.Context, objects []*ExchangeRate, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*ExchangeRate, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*StoreItem, 0, len(objects))
+	results := make([]*ExchangeRate, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchStoreItem(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchExchangeRate(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1150,8 +1906,8 @@ func DefaultPatchSetStoreItem(ctx context.Context, objects []*StoreItem, updateM
 	return results, nil
 }
 
-// DefaultApplyFieldMaskStoreItem patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskStoreItem(ctx context.Context, patchee *StoreItem, patcher *StoreItem, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*StoreItem, error) {
+// DefaultApplyFieldMaskExchangeRate patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskExchangeRate(ctx context.Context, patchee *ExchangeRate, patcher *ExchangeRate, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*ExchangeRate, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1163,40 +1919,32 @@ func DefaultApplyFieldMaskStoreItem(ctx context.Context, patchee *StoreItem, pat
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
+		if f == prefix+"FromCurrency" {
+			patchee.FromCurrency = patcher.FromCurrency
 			continue
 		}
-		if f == prefix+"Description" {
-			patchee.Description = patcher.Description
+		if f == prefix+"ToCurrency" {
+			patchee.ToCurrency = patcher.ToCurrency
 			continue
 		}
-		if f == prefix+"Type" {
-			patchee.Type = patcher.Type
+		if f == prefix+"RateGems" {
+			patchee.RateGems = patcher.RateGems
 			continue
 		}
-		if f == prefix+"CoinsPrice" {
-			patchee.CoinsPrice = patcher.CoinsPrice
+		if f == prefix+"MinAmount" {
+			patchee.MinAmount = patcher.MinAmount
 			continue
 		}
-		if f == prefix+"GemsPrice" {
-			patchee.GemsPrice = patcher.GemsPrice
+		if f == prefix+"MaxAmount" {
+			patchee.MaxAmount = patcher.MaxAmount
 			continue
 		}
-		if f == prefix+"ImageId" {
-			patchee.ImageId = patcher.ImageId
+		if f == prefix+"DailyCap" {
+			patchee.DailyCap = patcher.DailyCap
 			continue
 		}
-		if f == prefix+"OnSale" {
-			patchee.OnSale = patcher.OnSale
-			continue
-		}
-		if f == prefix+"SaleCoinsPrice" {
-			patchee.SaleCoinsPrice = patcher.SaleCoinsPrice
-			continue
-		}
-		if f == prefix+"SaleGemsPrice" {
-			patchee.SaleGemsPrice = patcher.SaleGemsPrice
+		if f == prefix+"RateCoins" {
+			patchee.RateCoins = patcher.RateCoins
 			continue
 		}
 	}
@@ -1206,39 +1954,39 @@ func DefaultApplyFieldMaskStoreItem(ctx context.Context, patchee *StoreItem, pat
 	return patchee, nil
 }
 
-// DefaultListStoreItem executes a gorm list call
-func DefaultListStoreItem(ctx context.Context, db *gorm1.DB, f *query1.Filtering, s *query1.Sorting, p *query1.Pagination, fs *query1.FieldSelection) ([]*StoreItem, error) {
-	in := StoreItem{}
+// DefaultListExchangeRate executes a gorm list call
+func DefaultListExchangeRate(ctx context.Context, db *gorm1.DB) ([]*ExchangeRate, error) {
+	in := ExchangeRate{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm2.ApplyCollectionOperators(ctx, db, &StoreItemORM{}, &StoreItem{}, f, s, p, fs)
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &ExchangeRateORM{}, &ExchangeRate{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []StoreItemORM{}
+	ormResponse := []ExchangeRateORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ExchangeRateORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*StoreItem{}
+	pbResponse := []*ExchangeRate{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1249,18 +1997,18 @@ func DefaultListStoreItem(ctx context.Context, db *gorm1.DB, f *query1.Filtering
 	return pbResponse, nil
 }
 
-type StoreItemORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
+type ExchangeRateORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
+type ExchangeRateORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type StoreItemORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm1.DB, *[]StoreItemORM, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) error
+type ExchangeRateORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]ExchangeRateORM) error
 }
 
-// DefaultCreateUserStats executes a basic gorm create call
-func DefaultCreateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+// DefaultCreateStoreItem executes a basic gorm create call
+func DefaultCreateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -1268,7 +2016,7 @@ func DefaultCreateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1276,7 +2024,7 @@ func DefaultCreateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1285,15 +2033,15 @@ func DefaultCreateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*
 	return &pbResponse, err
 }
 
-type UserStatsORMWithBeforeCreate_ interface {
+type StoreItemORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterCreate_ interface {
+type StoreItemORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm1.DB) error
 }
 
-// DefaultReadUserStats executes a basic gorm read call
-func DefaultReadUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+// DefaultReadStoreItem executes a basic gorm read call
+func DefaultReadStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -1301,27 +2049,27 @@ func DefaultReadUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*Us
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &UserStatsORM{}); err != nil {
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &StoreItemORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := UserStatsORM{}
+	ormResponse := StoreItemORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(UserStatsORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(StoreItemORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1330,17 +2078,17 @@ func DefaultReadUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*Us
 	return &pbResponse, err
 }
 
-type UserStatsORMWithBeforeReadApplyQuery interface {
+type StoreItemORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithBeforeReadFind interface {
+type StoreItemORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterReadFind interface {
+type StoreItemORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) error {
+func DefaultDeleteStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
@@ -1348,86 +2096,86 @@ func DefaultDeleteUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) er
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&UserStatsORM{}).Error
+	err = db.Where(&ormObj).Delete(&StoreItemORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type UserStatsORMWithBeforeDelete_ interface {
+type StoreItemORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterDelete_ interface {
+type StoreItemORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteUserStatsSet(ctx context.Context, in []*UserStats, db *gorm1.DB) error {
+func DefaultDeleteStoreItemSet(ctx context.Context, in []*StoreItem, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
 	var err error
-	keys := []int32{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors1.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&UserStatsORM{})).(UserStatsORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&StoreItemORM{})).(StoreItemORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&UserStatsORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&StoreItemORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&UserStatsORM{})).(UserStatsORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&StoreItemORM{})).(StoreItemORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type UserStatsORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*UserStats, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*StoreItem, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*UserStats, *gorm1.DB) error
+type StoreItemORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*StoreItem, *gorm1.DB) error
 }
 
-// DefaultStrictUpdateUserStats clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+// DefaultStrictUpdateStoreItem clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStoreItem(ctx context.Context, in *StoreItem, db *gorm1.DB) (*StoreItem, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUserStats")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateStoreItem")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &UserStatsORM{}
+	lockedRow := &StoreItemORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1435,7 +2183,7 @@ func DefaultStrictUpdateUserStats(ctx context.Context, in *UserStats, db *gorm1.
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1447,51 +2195,51 @@ func DefaultStrictUpdateUserStats(ctx context.Context, in *UserStats, db *gorm1.
 	return &pbResponse, err
 }
 
-type UserStatsORMWithBeforeStrictUpdateCleanup interface {
+type StoreItemORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithBeforeStrictUpdateSave interface {
+type StoreItemORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterStrictUpdateSave interface {
+type StoreItemORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
 }
 
-// DefaultPatchUserStats executes a basic gorm update call with patch behavior
-func DefaultPatchUserStats(ctx context.Context, in *UserStats, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*UserStats, error) {
+// DefaultPatchStoreItem executes a basic gorm update call with patch behavior
+func DefaultPatchStoreItem(ctx context.Context, in *StoreItem, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*StoreItem, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
-	var pbObj UserStats
+	var pbObj StoreItem
 	var err error
-	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadUserStats(ctx, &UserStats{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadStoreItem(ctx, &StoreItem{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskUserStats(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskStoreItem(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(StoreItemWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateUserStats(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateStoreItem(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(UserStatsWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(StoreItemWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1499,28 +2247,28 @@ func DefaultPatchUserStats(ctx context.Context, in *UserStats, updateMask *field
 	return pbResponse, nil
 }
 
-type UserStatsWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type UserStatsWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) error
+type StoreItemWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *StoreItem, *field_mask1.FieldMask, *gorm1.DB) error
 }
 
-// DefaultPatchSetUserStats executes a bulk gorm update call with patch behavior
-func DefaultPatchSetUserStats(ctx context.Context, objects []*UserStats, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*UserStats, error) {
+// DefaultPatchSetStoreItem executes a bulk gorm update call with patch behavior
+func DefaultPatchSetStoreItem(ctx context.Context, objects []*StoreItem, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*StoreItem, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*UserStats, 0, len(objects))
+	results := make([]*StoreItem, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUserStats(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchStoreItem(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1531,8 +2279,8 @@ func DefaultPatchSetUserStats(ctx context.Context, objects []*UserStats, updateM
 	return results, nil
 }
 
-// DefaultApplyFieldMaskUserStats patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskUserStats(ctx context.Context, patchee *UserStats, patcher *UserStats, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*UserStats, error) {
+// DefaultApplyFieldMaskStoreItem patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStoreItem(ctx context.Context, patchee *StoreItem, patcher *StoreItem, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*StoreItem, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1544,20 +2292,68 @@ func DefaultApplyFieldMaskUserStats(ctx context.Context, patchee *UserStats, pat
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Games" {
-			patchee.Games = patcher.Games
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"Wins" {
-			patchee.Wins = patcher.Wins
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
 			continue
 		}
-		if f == prefix+"Top5" {
-			patchee.Top5 = patcher.Top5
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
 			continue
 		}
-		if f == prefix+"Kills" {
-			patchee.Kills = patcher.Kills
+		if f == prefix+"CoinsPrice" {
+			patchee.CoinsPrice = patcher.CoinsPrice
+			continue
+		}
+		if f == prefix+"GemsPrice" {
+			patchee.GemsPrice = patcher.GemsPrice
+			continue
+		}
+		if f == prefix+"ImageId" {
+			patchee.ImageId = patcher.ImageId
+			continue
+		}
+		if f == prefix+"OnSale" {
+			patchee.OnSale = patcher.OnSale
+			continue
+		}
+		if f == prefix+"SaleCoinsPrice" {
+			patchee.SaleCoinsPrice = patcher.SaleCoinsPrice
+			continue
+		}
+		if f == prefix+"SaleGemsPrice" {
+			patchee.SaleGemsPrice = patcher.SaleGemsPrice
+			continue
+		}
+		if f == prefix+"Consumable" {
+			patchee.Consumable = patcher.Consumable
+			continue
+		}
+		if f == prefix+"MaxStack" {
+			patchee.MaxStack = patcher.MaxStack
+			continue
+		}
+		if f == prefix+"RentalDays" {
+			patchee.RentalDays = patcher.RentalDays
+			continue
+		}
+		if f == prefix+"RentalCoinsPrice" {
+			patchee.RentalCoinsPrice = patcher.RentalCoinsPrice
+			continue
+		}
+		if f == prefix+"RentalGemsPrice" {
+			patchee.RentalGemsPrice = patcher.RentalGemsPrice
+			continue
+		}
+		if f == prefix+"Sku" {
+			patchee.Sku = patcher.Sku
+			continue
+		}
+		if f == prefix+"Retired" {
+			patchee.Retired = patcher.Retired
 			continue
 		}
 	}
@@ -1567,39 +2363,39 @@ func DefaultApplyFieldMaskUserStats(ctx context.Context, patchee *UserStats, pat
 	return patchee, nil
 }
 
-// DefaultListUserStats executes a gorm list call
-func DefaultListUserStats(ctx context.Context, db *gorm1.DB) ([]*UserStats, error) {
-	in := UserStats{}
+// DefaultListStoreItem executes a gorm list call
+func DefaultListStoreItem(ctx context.Context, db *gorm1.DB, f *query1.Filtering, s *query1.Sorting, p *query1.Pagination, fs *query1.FieldSelection) ([]*StoreItem, error) {
+	in := StoreItem{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm2.ApplyCollectionOperators(ctx, db, &UserStatsORM{}, &UserStats{}, nil, nil, nil, nil)
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &StoreItemORM{}, &StoreItem{}, f, s, p, fs)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []UserStatsORM{}
+	ormResponse := []StoreItemORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(StoreItemORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*UserStats{}
+	pbResponse := []*StoreItem{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1610,18 +2406,18 @@ func DefaultListUserStats(ctx context.Context, db *gorm1.DB) ([]*UserStats, erro
 	return pbResponse, nil
 }
 
-type UserStatsORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
 }
-type UserStatsORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+type StoreItemORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
 }
-type UserStatsORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm1.DB, *[]UserStatsORM) error
+type StoreItemORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]StoreItemORM, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) error
 }
 
-// DefaultCreateNews executes a basic gorm create call
-func DefaultCreateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+// DefaultCreateItemType executes a basic gorm create call
+func DefaultCreateItemType(ctx context.Context, in *ItemType, db *gorm1.DB) (*ItemType, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -1629,7 +2425,7 @@ func DefaultCreateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, erro
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1637,7 +2433,7 @@ func DefaultCreateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, erro
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1646,15 +2442,15 @@ func DefaultCreateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, erro
 	return &pbResponse, err
 }
 
-type NewsORMWithBeforeCreate_ interface {
+type ItemTypeORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithAfterCreate_ interface {
+type ItemTypeORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm1.DB) error
 }
 
-// DefaultReadNews executes a basic gorm read call
-func DefaultReadNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+// DefaultReadItemType executes a basic gorm read call
+func DefaultReadItemType(ctx context.Context, in *ItemType, db *gorm1.DB) (*ItemType, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
@@ -1662,27 +2458,27 @@ func DefaultReadNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error)
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &NewsORM{}); err != nil {
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &ItemTypeORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := NewsORM{}
+	ormResponse := ItemTypeORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(NewsORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ItemTypeORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1691,17 +2487,17 @@ func DefaultReadNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error)
 	return &pbResponse, err
 }
 
-type NewsORMWithBeforeReadApplyQuery interface {
+type ItemTypeORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithBeforeReadFind interface {
+type ItemTypeORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithAfterReadFind interface {
+type ItemTypeORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteNews(ctx context.Context, in *News, db *gorm1.DB) error {
+func DefaultDeleteItemType(ctx context.Context, in *ItemType, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
@@ -1709,86 +2505,86 @@ func DefaultDeleteNews(ctx context.Context, in *News, db *gorm1.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors1.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&NewsORM{}).Error
+	err = db.Where(&ormObj).Delete(&ItemTypeORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type NewsORMWithBeforeDelete_ interface {
+type ItemTypeORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithAfterDelete_ interface {
+type ItemTypeORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm1.DB) error
 }
 
-func DefaultDeleteNewsSet(ctx context.Context, in []*News, db *gorm1.DB) error {
+func DefaultDeleteItemTypeSet(ctx context.Context, in []*ItemType, db *gorm1.DB) error {
 	if in == nil {
 		return errors1.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []int32{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors1.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&NewsORM{})).(NewsORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ItemTypeORM{})).(ItemTypeORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&NewsORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ItemTypeORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&NewsORM{})).(NewsORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ItemTypeORM{})).(ItemTypeORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type NewsORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*News, *gorm1.DB) (*gorm1.DB, error)
+type ItemTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ItemType, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*News, *gorm1.DB) error
+type ItemTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ItemType, *gorm1.DB) error
 }
 
-// DefaultStrictUpdateNews clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+// DefaultStrictUpdateItemType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateItemType(ctx context.Context, in *ItemType, db *gorm1.DB) (*ItemType, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNews")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateItemType")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &NewsORM{}
+	lockedRow := &ItemTypeORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1796,7 +2592,7 @@ func DefaultStrictUpdateNews(ctx context.Context, in *News, db *gorm1.DB) (*News
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1808,51 +2604,51 @@ func DefaultStrictUpdateNews(ctx context.Context, in *News, db *gorm1.DB) (*News
 	return &pbResponse, err
 }
 
-type NewsORMWithBeforeStrictUpdateCleanup interface {
+type ItemTypeORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithBeforeStrictUpdateSave interface {
+type ItemTypeORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsORMWithAfterStrictUpdateSave interface {
+type ItemTypeORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
 }
 
-// DefaultPatchNews executes a basic gorm update call with patch behavior
-func DefaultPatchNews(ctx context.Context, in *News, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*News, error) {
+// DefaultPatchItemType executes a basic gorm update call with patch behavior
+func DefaultPatchItemType(ctx context.Context, in *ItemType, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*ItemType, error) {
 	if in == nil {
 		return nil, errors1.NilArgumentError
 	}
-	var pbObj News
+	var pbObj ItemType
 	var err error
-	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ItemTypeWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadNews(ctx, &News{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadItemType(ctx, &ItemType{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ItemTypeWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskNews(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskItemType(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ItemTypeWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateNews(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateItemType(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(NewsWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ItemTypeWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1860,28 +2656,28 @@ func DefaultPatchNews(ctx context.Context, in *News, updateMask *field_mask1.Fie
 	return pbResponse, nil
 }
 
-type NewsWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ItemTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ItemType, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ItemTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ItemType, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+type ItemTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ItemType, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
 }
-type NewsWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) error
+type ItemTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ItemType, *field_mask1.FieldMask, *gorm1.DB) error
 }
 
-// DefaultPatchSetNews executes a bulk gorm update call with patch behavior
-func DefaultPatchSetNews(ctx context.Context, objects []*News, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*News, error) {
+// DefaultPatchSetItemType executes a bulk gorm update call with patch behavior
+func DefaultPatchSetItemType(ctx context.Context, objects []*ItemType, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*ItemType, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*News, 0, len(objects))
+	results := make([]*ItemType, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchNews(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchItemType(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1892,8 +2688,8 @@ func DefaultPatchSetNews(ctx context.Context, objects []*News, updateMasks []*fi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskNews patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskNews(ctx context.Context, patchee *News, patcher *News, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*News, error) {
+// DefaultApplyFieldMaskItemType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskItemType(ctx context.Context, patchee *ItemType, patcher *ItemType, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*ItemType, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1905,20 +2701,20 @@ func DefaultApplyFieldMaskNews(ctx context.Context, patchee *News, patcher *News
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"CreatedAt" {
-			patchee.CreatedAt = patcher.CreatedAt
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"Title" {
-			patchee.Title = patcher.Title
+		if f == prefix+"DisplayName" {
+			patchee.DisplayName = patcher.DisplayName
 			continue
 		}
-		if f == prefix+"Description" {
-			patchee.Description = patcher.Description
+		if f == prefix+"Slot" {
+			patchee.Slot = patcher.Slot
 			continue
 		}
-		if f == prefix+"ImageLink" {
-			patchee.ImageLink = patcher.ImageLink
+		if f == prefix+"SlotCapacity" {
+			patchee.SlotCapacity = patcher.SlotCapacity
 			continue
 		}
 	}
@@ -1928,39 +2724,39 @@ func DefaultApplyFieldMaskNews(ctx context.Context, patchee *News, patcher *News
 	return patchee, nil
 }
 
-// DefaultListNews executes a gorm list call
-func DefaultListNews(ctx context.Context, db *gorm1.DB, f *query1.Filtering, s *query1.Sorting, p *query1.Pagination, fs *query1.FieldSelection) ([]*News, error) {
-	in := News{}
+// DefaultListItemType executes a gorm list call
+func DefaultListItemType(ctx context.Context, db *gorm1.DB) ([]*ItemType, error) {
+	in := ItemType{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm2.ApplyCollectionOperators(ctx, db, &NewsORM{}, &News{}, f, s, p, fs)
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &ItemTypeORM{}, &ItemType{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []NewsORM{}
+	ormResponse := []ItemTypeORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(ItemTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*News{}
+	pbResponse := []*ItemType{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1971,99 +2767,2106 @@ func DefaultListNews(ctx context.Context, db *gorm1.DB, f *query1.Filtering, s *
 	return pbResponse, nil
 }
 
-type NewsORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
-}
-type NewsORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
-}
-type NewsORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm1.DB, *[]NewsORM, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) error
+type ItemTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-type UsersDefaultServer struct {
-	DB *gorm1.DB
+type ItemTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-
-// Create ...
-func (m *UsersDefaultServer) Create(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
-	out := &CreateUserResponse{}
-	return out, nil
+type ItemTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]ItemTypeORM) error
 }
 
-// Read ...
-func (m *UsersDefaultServer) Read(ctx context.Context, in *ReadUserRequest) (*ReadUserResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(UsersUserWithBeforeRead); ok {
-		var err error
-		if db, err = custom.BeforeRead(ctx, db); err != nil {
+// DefaultCreateInventoryEntry executes a basic gorm create call
+func DefaultCreateInventoryEntry(ctx context.Context, in *InventoryEntry, db *gorm1.DB) (*InventoryEntry, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InventoryEntryORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
-	if err != nil {
+	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	out := &ReadUserResponse{Result: res}
-	if custom, ok := interface{}(in).(UsersUserWithAfterRead); ok {
-		var err error
-		if err = custom.AfterRead(ctx, out, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(InventoryEntryORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	return out, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-// UsersUserWithBeforeRead called before DefaultReadUser in the default Read handler
-type UsersUserWithBeforeRead interface {
-	BeforeRead(context.Context, *gorm1.DB) (*gorm1.DB, error)
+type InventoryEntryORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
-
-// UsersUserWithAfterRead called before DefaultReadUser in the default Read handler
-type UsersUserWithAfterRead interface {
-	AfterRead(context.Context, *ReadUserResponse, *gorm1.DB) error
+type InventoryEntryORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
 }
 
-// Update ...
-func (m *UsersDefaultServer) Update(ctx context.Context, in *UpdateUserRequest) (*UpdateUserResponse, error) {
-	out := &UpdateUserResponse{}
-	return out, nil
+// DefaultApplyFieldMaskInventoryEntry patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskInventoryEntry(ctx context.Context, patchee *InventoryEntry, patcher *InventoryEntry, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*InventoryEntry, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"ItemId" {
+			patchee.ItemId = patcher.ItemId
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
+			continue
+		}
+		if f == prefix+"CoinsPrice" {
+			patchee.CoinsPrice = patcher.CoinsPrice
+			continue
+		}
+		if f == prefix+"GemsPrice" {
+			patchee.GemsPrice = patcher.GemsPrice
+			continue
+		}
+		if f == prefix+"ImageId" {
+			patchee.ImageId = patcher.ImageId
+			continue
+		}
+		if f == prefix+"OnSale" {
+			patchee.OnSale = patcher.OnSale
+			continue
+		}
+		if f == prefix+"SaleCoinsPrice" {
+			patchee.SaleCoinsPrice = patcher.SaleCoinsPrice
+			continue
+		}
+		if f == prefix+"SaleGemsPrice" {
+			patchee.SaleGemsPrice = patcher.SaleGemsPrice
+			continue
+		}
+		if f == prefix+"Consumable" {
+			patchee.Consumable = patcher.Consumable
+			continue
+		}
+		if f == prefix+"MaxStack" {
+			patchee.MaxStack = patcher.MaxStack
+			continue
+		}
+		if f == prefix+"Equipped" {
+			patchee.Equipped = patcher.Equipped
+			continue
+		}
+		if f == prefix+"Quantity" {
+			patchee.Quantity = patcher.Quantity
+			continue
+		}
+		if f == prefix+"AcquiredAt" {
+			patchee.AcquiredAt = patcher.AcquiredAt
+			continue
+		}
+		if f == prefix+"Source" {
+			patchee.Source = patcher.Source
+			continue
+		}
+		if f == prefix+"ExpiresAt" {
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if f == prefix+"RentalDays" {
+			patchee.RentalDays = patcher.RentalDays
+			continue
+		}
+		if f == prefix+"RentalCoinsPrice" {
+			patchee.RentalCoinsPrice = patcher.RentalCoinsPrice
+			continue
+		}
+		if f == prefix+"RentalGemsPrice" {
+			patchee.RentalGemsPrice = patcher.RentalGemsPrice
+			continue
+		}
+		if f == prefix+"Sku" {
+			patchee.Sku = patcher.Sku
+			continue
+		}
+		if f == prefix+"Retired" {
+			patchee.Retired = patcher.Retired
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
 }
 
-// Delete ...
-func (m *UsersDefaultServer) Delete(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(UsersUserWithBeforeDelete); ok {
-		var err error
-		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+// DefaultListInventoryEntry executes a gorm list call
+func DefaultListInventoryEntry(ctx context.Context, db *gorm1.DB) ([]*InventoryEntry, error) {
+	in := InventoryEntry{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InventoryEntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	err := DefaultDeleteUser(ctx, &User{Id: in.GetId()}, db)
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &InventoryEntryORM{}, &InventoryEntry{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &DeleteUserResponse{}
-	if custom, ok := interface{}(in).(UsersUserWithAfterDelete); ok {
-		var err error
-		if err = custom.AfterDelete(ctx, out, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(InventoryEntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	return out, nil
-}
-
-// UsersUserWithBeforeDelete called before DefaultDeleteUser in the default Delete handler
-type UsersUserWithBeforeDelete interface {
-	BeforeDelete(context.Context, *gorm1.DB) (*gorm1.DB, error)
-}
-
-// UsersUserWithAfterDelete called before DefaultDeleteUser in the default Delete handler
-type UsersUserWithAfterDelete interface {
-	AfterDelete(context.Context, *DeleteUserResponse, *gorm1.DB) error
-}
-
-// List ...
-func (m *UsersDefaultServer) List(ctx context.Context, in *ListUsersRequest) (*ListUsersResponse, error) {
+	db = db.Where(&ormObj)
+	ormResponse := []InventoryEntryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(InventoryEntryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*InventoryEntry{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type InventoryEntryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type InventoryEntryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type InventoryEntryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]InventoryEntryORM) error
+}
+
+// DefaultCreateUserStats executes a basic gorm create call
+func DefaultCreateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserStatsORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadUserStats executes a basic gorm read call
+func DefaultReadUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &UserStatsORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := UserStatsORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UserStatsORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserStatsORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&UserStatsORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type UserStatsORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteUserStatsSet(ctx context.Context, in []*UserStats, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []int32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&UserStatsORM{})).(UserStatsORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&UserStatsORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&UserStatsORM{})).(UserStatsORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type UserStatsORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*UserStats, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*UserStats, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateUserStats clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUserStats(ctx context.Context, in *UserStats, db *gorm1.DB) (*UserStats, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUserStats")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &UserStatsORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type UserStatsORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchUserStats executes a basic gorm update call with patch behavior
+func DefaultPatchUserStats(ctx context.Context, in *UserStats, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*UserStats, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj UserStats
+	var err error
+	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadUserStats(ctx, &UserStats{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskUserStats(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(UserStatsWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateUserStats(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(UserStatsWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type UserStatsWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *UserStats, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultPatchSetUserStats executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUserStats(ctx context.Context, objects []*UserStats, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*UserStats, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*UserStats, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchUserStats(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskUserStats patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUserStats(ctx context.Context, patchee *UserStats, patcher *UserStats, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*UserStats, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Games" {
+			patchee.Games = patcher.Games
+			continue
+		}
+		if f == prefix+"Wins" {
+			patchee.Wins = patcher.Wins
+			continue
+		}
+		if f == prefix+"Top5" {
+			patchee.Top5 = patcher.Top5
+			continue
+		}
+		if f == prefix+"Kills" {
+			patchee.Kills = patcher.Kills
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListUserStats executes a gorm list call
+func DefaultListUserStats(ctx context.Context, db *gorm1.DB) ([]*UserStats, error) {
+	in := UserStats{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &UserStatsORM{}, &UserStats{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []UserStatsORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserStatsORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*UserStats{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type UserStatsORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type UserStatsORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]UserStatsORM) error
+}
+
+// DefaultCreateNews executes a basic gorm create call
+func DefaultCreateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NewsORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadNews executes a basic gorm read call
+func DefaultReadNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &NewsORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NewsORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NewsORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NewsORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteNews(ctx context.Context, in *News, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&NewsORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type NewsORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteNewsSet(ctx context.Context, in []*News, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&NewsORM{})).(NewsORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&NewsORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NewsORM{})).(NewsORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type NewsORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*News, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*News, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateNews clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNews(ctx context.Context, in *News, db *gorm1.DB) (*News, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNews")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &NewsORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NewsORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchNews executes a basic gorm update call with patch behavior
+func DefaultPatchNews(ctx context.Context, in *News, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*News, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj News
+	var err error
+	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNews(ctx, &News{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNews(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NewsWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNews(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NewsWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NewsWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type NewsWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *News, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultPatchSetNews executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNews(ctx context.Context, objects []*News, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*News, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*News, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNews(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskNews patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNews(ctx context.Context, patchee *News, patcher *News, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*News, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"ImageLink" {
+			patchee.ImageLink = patcher.ImageLink
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"PublishAt" {
+			patchee.PublishAt = patcher.PublishAt
+			continue
+		}
+		if f == prefix+"PublishedAt" {
+			patchee.PublishedAt = patcher.PublishedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNews executes a gorm list call
+func DefaultListNews(ctx context.Context, db *gorm1.DB, f *query1.Filtering, s *query1.Sorting, p *query1.Pagination, fs *query1.FieldSelection) ([]*News, error) {
+	in := News{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &NewsORM{}, &News{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []NewsORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NewsORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*News{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NewsORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
+}
+type NewsORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) (*gorm1.DB, error)
+}
+type NewsORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]NewsORM, *query1.Filtering, *query1.Sorting, *query1.Pagination, *query1.FieldSelection) error
+}
+
+// DefaultCreateStorefrontSlot executes a basic gorm create call
+func DefaultCreateStorefrontSlot(ctx context.Context, in *StorefrontSlot, db *gorm1.DB) (*StorefrontSlot, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StorefrontSlotORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadStorefrontSlot executes a basic gorm read call
+func DefaultReadStorefrontSlot(ctx context.Context, in *StorefrontSlot, db *gorm1.DB) (*StorefrontSlot, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &StorefrontSlotORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := StorefrontSlotORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(StorefrontSlotORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StorefrontSlotORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteStorefrontSlot(ctx context.Context, in *StorefrontSlot, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&StorefrontSlotORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type StorefrontSlotORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteStorefrontSlotSet(ctx context.Context, in []*StorefrontSlot, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []int32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&StorefrontSlotORM{})).(StorefrontSlotORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&StorefrontSlotORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&StorefrontSlotORM{})).(StorefrontSlotORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type StorefrontSlotORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*StorefrontSlot, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*StorefrontSlot, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateStorefrontSlot clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStorefrontSlot(ctx context.Context, in *StorefrontSlot, db *gorm1.DB) (*StorefrontSlot, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateStorefrontSlot")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &StorefrontSlotORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterPool := StorefrontPoolEntryORM{}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	filterPool.StorefrontSlotId = new(int32)
+	*filterPool.StorefrontSlotId = ormObj.Id
+	if err = db.Where(filterPool).Delete(StorefrontPoolEntryORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StorefrontSlotORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchStorefrontSlot executes a basic gorm update call with patch behavior
+func DefaultPatchStorefrontSlot(ctx context.Context, in *StorefrontSlot, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*StorefrontSlot, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj StorefrontSlot
+	var err error
+	if hook, ok := interface{}(&pbObj).(StorefrontSlotWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadStorefrontSlot(ctx, &StorefrontSlot{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(StorefrontSlotWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskStorefrontSlot(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(StorefrontSlotWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateStorefrontSlot(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(StorefrontSlotWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type StorefrontSlotWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *StorefrontSlot, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *StorefrontSlot, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *StorefrontSlot, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *StorefrontSlot, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultPatchSetStorefrontSlot executes a bulk gorm update call with patch behavior
+func DefaultPatchSetStorefrontSlot(ctx context.Context, objects []*StorefrontSlot, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*StorefrontSlot, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*StorefrontSlot, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchStorefrontSlot(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskStorefrontSlot patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStorefrontSlot(ctx context.Context, patchee *StorefrontSlot, patcher *StorefrontSlot, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*StorefrontSlot, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"ItemsCount" {
+			patchee.ItemsCount = patcher.ItemsCount
+			continue
+		}
+		if f == prefix+"Pool" {
+			patchee.Pool = patcher.Pool
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListStorefrontSlot executes a gorm list call
+func DefaultListStorefrontSlot(ctx context.Context, db *gorm1.DB) ([]*StorefrontSlot, error) {
+	in := StorefrontSlot{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &StorefrontSlotORM{}, &StorefrontSlot{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []StorefrontSlotORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontSlotORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*StorefrontSlot{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type StorefrontSlotORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontSlotORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]StorefrontSlotORM) error
+}
+
+// DefaultCreateStorefrontPoolEntry executes a basic gorm create call
+func DefaultCreateStorefrontPoolEntry(ctx context.Context, in *StorefrontPoolEntry, db *gorm1.DB) (*StorefrontPoolEntry, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StorefrontPoolEntryORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadStorefrontPoolEntry executes a basic gorm read call
+func DefaultReadStorefrontPoolEntry(ctx context.Context, in *StorefrontPoolEntry, db *gorm1.DB) (*StorefrontPoolEntry, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &StorefrontPoolEntryORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := StorefrontPoolEntryORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(StorefrontPoolEntryORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StorefrontPoolEntryORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteStorefrontPoolEntry(ctx context.Context, in *StorefrontPoolEntry, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&StorefrontPoolEntryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type StorefrontPoolEntryORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteStorefrontPoolEntrySet(ctx context.Context, in []*StorefrontPoolEntry, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []int32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&StorefrontPoolEntryORM{})).(StorefrontPoolEntryORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&StorefrontPoolEntryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&StorefrontPoolEntryORM{})).(StorefrontPoolEntryORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type StorefrontPoolEntryORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*StorefrontPoolEntry, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*StorefrontPoolEntry, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateStorefrontPoolEntry clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStorefrontPoolEntry(ctx context.Context, in *StorefrontPoolEntry, db *gorm1.DB) (*StorefrontPoolEntry, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateStorefrontPoolEntry")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &StorefrontPoolEntryORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StorefrontPoolEntryORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchStorefrontPoolEntry executes a basic gorm update call with patch behavior
+func DefaultPatchStorefrontPoolEntry(ctx context.Context, in *StorefrontPoolEntry, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*StorefrontPoolEntry, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj StorefrontPoolEntry
+	var err error
+	if hook, ok := interface{}(&pbObj).(StorefrontPoolEntryWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadStorefrontPoolEntry(ctx, &StorefrontPoolEntry{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(StorefrontPoolEntryWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskStorefrontPoolEntry(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(StorefrontPoolEntryWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateStorefrontPoolEntry(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(StorefrontPoolEntryWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type StorefrontPoolEntryWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *StorefrontPoolEntry, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *StorefrontPoolEntry, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *StorefrontPoolEntry, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *StorefrontPoolEntry, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultPatchSetStorefrontPoolEntry executes a bulk gorm update call with patch behavior
+func DefaultPatchSetStorefrontPoolEntry(ctx context.Context, objects []*StorefrontPoolEntry, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*StorefrontPoolEntry, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*StorefrontPoolEntry, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchStorefrontPoolEntry(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskStorefrontPoolEntry patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStorefrontPoolEntry(ctx context.Context, patchee *StorefrontPoolEntry, patcher *StorefrontPoolEntry, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*StorefrontPoolEntry, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"ItemId" {
+			patchee.ItemId = patcher.ItemId
+			continue
+		}
+		if f == prefix+"Weight" {
+			patchee.Weight = patcher.Weight
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListStorefrontPoolEntry executes a gorm list call
+func DefaultListStorefrontPoolEntry(ctx context.Context, db *gorm1.DB) ([]*StorefrontPoolEntry, error) {
+	in := StorefrontPoolEntry{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &StorefrontPoolEntryORM{}, &StorefrontPoolEntry{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []StorefrontPoolEntryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StorefrontPoolEntryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*StorefrontPoolEntry{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type StorefrontPoolEntryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type StorefrontPoolEntryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]StorefrontPoolEntryORM) error
+}
+
+// DefaultCreateGemPack executes a basic gorm create call
+func DefaultCreateGemPack(ctx context.Context, in *GemPack, db *gorm1.DB) (*GemPack, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type GemPackORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm1.DB) error
+}
+
+// DefaultReadGemPack executes a basic gorm read call
+func DefaultReadGemPack(ctx context.Context, in *GemPack, db *gorm1.DB) (*GemPack, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm2.ApplyFieldSelection(ctx, db, nil, &GemPackORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := GemPackORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(GemPackORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type GemPackORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteGemPack(ctx context.Context, in *GemPack, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors1.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&GemPackORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type GemPackORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm1.DB) error
+}
+
+func DefaultDeleteGemPackSet(ctx context.Context, in []*GemPack, db *gorm1.DB) error {
+	if in == nil {
+		return errors1.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors1.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&GemPackORM{})).(GemPackORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&GemPackORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&GemPackORM{})).(GemPackORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type GemPackORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*GemPack, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*GemPack, *gorm1.DB) error
+}
+
+// DefaultStrictUpdateGemPack clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateGemPack(ctx context.Context, in *GemPack, db *gorm1.DB) (*GemPack, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateGemPack")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &GemPackORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type GemPackORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm1.DB) error
+}
+
+// DefaultPatchGemPack executes a basic gorm update call with patch behavior
+func DefaultPatchGemPack(ctx context.Context, in *GemPack, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*GemPack, error) {
+	if in == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var pbObj GemPack
+	var err error
+	if hook, ok := interface{}(&pbObj).(GemPackWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadGemPack(ctx, &GemPack{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(GemPackWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskGemPack(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(GemPackWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateGemPack(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(GemPackWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type GemPackWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *GemPack, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *GemPack, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *GemPack, *field_mask1.FieldMask, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *GemPack, *field_mask1.FieldMask, *gorm1.DB) error
+}
+
+// DefaultPatchSetGemPack executes a bulk gorm update call with patch behavior
+func DefaultPatchSetGemPack(ctx context.Context, objects []*GemPack, updateMasks []*field_mask1.FieldMask, db *gorm1.DB) ([]*GemPack, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors1.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*GemPack, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchGemPack(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskGemPack patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskGemPack(ctx context.Context, patchee *GemPack, patcher *GemPack, updateMask *field_mask1.FieldMask, prefix string, db *gorm1.DB) (*GemPack, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors1.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Gems" {
+			patchee.Gems = patcher.Gems
+			continue
+		}
+		if f == prefix+"PriceCents" {
+			patchee.PriceCents = patcher.PriceCents
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListGemPack executes a gorm list call
+func DefaultListGemPack(ctx context.Context, db *gorm1.DB) ([]*GemPack, error) {
+	in := GemPack{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm2.ApplyCollectionOperators(ctx, db, &GemPackORM{}, &GemPack{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []GemPackORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(GemPackORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*GemPack{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type GemPackORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+type GemPackORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm1.DB, *[]GemPackORM) error
+}
+type UsersDefaultServer struct {
+	DB *gorm1.DB
+}
+
+// Create ...
+func (m *UsersDefaultServer) Create(ctx context.Context, in *CreateUserRequest) (*CreateUserResponse, error) {
+	out := &CreateUserResponse{}
+	return out, nil
+}
+
+// Read ...
+func (m *UsersDefaultServer) Read(ctx context.Context, in *ReadUserRequest) (*ReadUserResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(UsersUserWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ReadUserResponse{Result: res}
+	if custom, ok := interface{}(in).(UsersUserWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// UsersUserWithBeforeRead called before DefaultReadUser in the default Read handler
+type UsersUserWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// UsersUserWithAfterRead called before DefaultReadUser in the default Read handler
+type UsersUserWithAfterRead interface {
+	AfterRead(context.Context, *ReadUserResponse, *gorm1.DB) error
+}
+
+// Update ...
+func (m *UsersDefaultServer) Update(ctx context.Context, in *UpdateUserRequest) (*UpdateUserResponse, error) {
+	out := &UpdateUserResponse{}
+	return out, nil
+}
+
+// Delete ...
+func (m *UsersDefaultServer) Delete(ctx context.Context, in *DeleteUserRequest) (*DeleteUserResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(UsersUserWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteUser(ctx, &User{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteUserResponse{}
+	if custom, ok := interface{}(in).(UsersUserWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// UsersUserWithBeforeDelete called before DefaultDeleteUser in the default Delete handler
+type UsersUserWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// UsersUserWithAfterDelete called before DefaultDeleteUser in the default Delete handler
+type UsersUserWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteUserResponse, *gorm1.DB) error
+}
+
+// List ...
+func (m *UsersDefaultServer) List(ctx context.Context, in *ListUsersRequest) (*ListUsersResponse, error) {
 	db := m.DB
 	if custom, ok := interface{}(in).(UsersUserWithBeforeList); ok {
 		var err error
@@ -2129,6 +4932,57 @@ func (m *UsersDefaultServer) GetUserCurrencies(ctx context.Context, in *GetUserC
 	return out, nil
 }
 
+// SetExchangeRate ...
+func (m *UsersDefaultServer) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest) (*SetExchangeRateResponse, error) {
+	out := &SetExchangeRateResponse{}
+	return out, nil
+}
+
+// DeleteExchangeRate ...
+func (m *UsersDefaultServer) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(UsersExchangeRateWithBeforeDeleteExchangeRate); ok {
+		var err error
+		if db, err = custom.BeforeDeleteExchangeRate(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteExchangeRate(ctx, &ExchangeRate{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteExchangeRateResponse{}
+	if custom, ok := interface{}(in).(UsersExchangeRateWithAfterDeleteExchangeRate); ok {
+		var err error
+		if err = custom.AfterDeleteExchangeRate(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// UsersExchangeRateWithBeforeDeleteExchangeRate called before DefaultDeleteExchangeRateExchangeRate in the default DeleteExchangeRate handler
+type UsersExchangeRateWithBeforeDeleteExchangeRate interface {
+	BeforeDeleteExchangeRate(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// UsersExchangeRateWithAfterDeleteExchangeRate called before DefaultDeleteExchangeRateExchangeRate in the default DeleteExchangeRate handler
+type UsersExchangeRateWithAfterDeleteExchangeRate interface {
+	AfterDeleteExchangeRate(context.Context, *DeleteExchangeRateResponse, *gorm1.DB) error
+}
+
+// GetExchangeRates ...
+func (m *UsersDefaultServer) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error) {
+	out := &GetExchangeRatesResponse{}
+	return out, nil
+}
+
+// ExchangeCurrency ...
+func (m *UsersDefaultServer) ExchangeCurrency(ctx context.Context, in *ExchangeCurrencyRequest) (*ExchangeCurrencyResponse, error) {
+	out := &ExchangeCurrencyResponse{}
+	return out, nil
+}
+
 type StoreItemsDefaultServer struct {
 	DB *gorm1.DB
 }
@@ -2201,125 +5055,299 @@ func (m *StoreItemsDefaultServer) Update(ctx context.Context, in *UpdateStoreIte
 	return out, nil
 }
 
-// StoreItemsStoreItemWithBeforeUpdate called before DefaultUpdateStoreItem in the default Update handler
-type StoreItemsStoreItemWithBeforeUpdate interface {
-	BeforeUpdate(context.Context, *gorm1.DB) (*gorm1.DB, error)
+// StoreItemsStoreItemWithBeforeUpdate called before DefaultUpdateStoreItem in the default Update handler
+type StoreItemsStoreItemWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// StoreItemsStoreItemWithAfterUpdate called before DefaultUpdateStoreItem in the default Update handler
+type StoreItemsStoreItemWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateStoreItemResponse, *gorm1.DB) error
+}
+
+// Delete ...
+func (m *StoreItemsDefaultServer) Delete(ctx context.Context, in *DeleteStoreItemRequest) (*DeleteStoreItemResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(StoreItemsStoreItemWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteStoreItem(ctx, &StoreItem{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteStoreItemResponse{}
+	if custom, ok := interface{}(in).(StoreItemsStoreItemWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// StoreItemsStoreItemWithBeforeDelete called before DefaultDeleteStoreItem in the default Delete handler
+type StoreItemsStoreItemWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// StoreItemsStoreItemWithAfterDelete called before DefaultDeleteStoreItem in the default Delete handler
+type StoreItemsStoreItemWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteStoreItemResponse, *gorm1.DB) error
+}
+
+// List ...
+func (m *StoreItemsDefaultServer) List(ctx context.Context, in *ListStoreItemsRequest) (*ListStoreItemsResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(StoreItemsStoreItemWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListStoreItem(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var resPaging *query1.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query1.PageInfo{Offset: offset}
+	}
+	out := &ListStoreItemsResponse{Results: res, Page: resPaging}
+	if custom, ok := interface{}(in).(StoreItemsStoreItemWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// StoreItemsStoreItemWithBeforeList called before DefaultListStoreItem in the default List handler
+type StoreItemsStoreItemWithBeforeList interface {
+	BeforeList(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// StoreItemsStoreItemWithAfterList called before DefaultListStoreItem in the default List handler
+type StoreItemsStoreItemWithAfterList interface {
+	AfterList(context.Context, *ListStoreItemsResponse, *gorm1.DB) error
+}
+
+// BuyByUser ...
+func (m *StoreItemsDefaultServer) BuyByUser(ctx context.Context, in *BuyByUserRequest) (*BuyByUserResponse, error) {
+	out := &BuyByUserResponse{}
+	return out, nil
+}
+
+// GetUserItemsIds ...
+func (m *StoreItemsDefaultServer) GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest) (*GetUserItemsIdsResponse, error) {
+	out := &GetUserItemsIdsResponse{}
+	return out, nil
+}
+
+// GetEquippedUserItemsIds ...
+func (m *StoreItemsDefaultServer) GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest) (*GetEquippedUserItemsIdsResponse, error) {
+	out := &GetEquippedUserItemsIdsResponse{}
+	return out, nil
+}
+
+// EquipByUser ...
+func (m *StoreItemsDefaultServer) EquipByUser(ctx context.Context, in *EquipByUserRequest) (*EquipByUserResponse, error) {
+	out := &EquipByUserResponse{}
+	return out, nil
+}
+
+// ThrowAwayByUser ...
+func (m *StoreItemsDefaultServer) ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest) (*ThrowAwayByUserResponse, error) {
+	out := &ThrowAwayByUserResponse{}
+	return out, nil
+}
+
+// GrantItem ...
+func (m *StoreItemsDefaultServer) GrantItem(ctx context.Context, in *GrantItemRequest) (*GrantItemResponse, error) {
+	out := &GrantItemResponse{}
+	return out, nil
+}
+
+// ConsumeItem ...
+func (m *StoreItemsDefaultServer) ConsumeItem(ctx context.Context, in *ConsumeItemRequest) (*ConsumeItemResponse, error) {
+	out := &ConsumeItemResponse{}
+	return out, nil
+}
+
+// GiftItem ...
+func (m *StoreItemsDefaultServer) GiftItem(ctx context.Context, in *GiftItemRequest) (*GiftItemResponse, error) {
+	out := &GiftItemResponse{}
+	return out, nil
+}
+
+// ListPendingGifts ...
+func (m *StoreItemsDefaultServer) ListPendingGifts(ctx context.Context, in *ListPendingGiftsRequest) (*ListPendingGiftsResponse, error) {
+	out := &ListPendingGiftsResponse{}
+	return out, nil
+}
+
+// AcceptGift ...
+func (m *StoreItemsDefaultServer) AcceptGift(ctx context.Context, in *AcceptGiftRequest) (*AcceptGiftResponse, error) {
+	out := &AcceptGiftResponse{}
+	return out, nil
+}
+
+// DeclineGift ...
+func (m *StoreItemsDefaultServer) DeclineGift(ctx context.Context, in *DeclineGiftRequest) (*DeclineGiftResponse, error) {
+	out := &DeclineGiftResponse{}
+	return out, nil
+}
+
+// ImportCatalog ...
+func (m *StoreItemsDefaultServer) ImportCatalog(ctx context.Context, in *ImportCatalogRequest) (*ImportCatalogResponse, error) {
+	out := &ImportCatalogResponse{}
+	return out, nil
 }
 
-// StoreItemsStoreItemWithAfterUpdate called before DefaultUpdateStoreItem in the default Update handler
-type StoreItemsStoreItemWithAfterUpdate interface {
-	AfterUpdate(context.Context, *UpdateStoreItemResponse, *gorm1.DB) error
+// ExportCatalog ...
+func (m *StoreItemsDefaultServer) ExportCatalog(ctx context.Context, in *ExportCatalogRequest) (*ExportCatalogResponse, error) {
+	out := &ExportCatalogResponse{}
+	return out, nil
 }
 
-// Delete ...
-func (m *StoreItemsDefaultServer) Delete(ctx context.Context, in *DeleteStoreItemRequest) (*DeleteStoreItemResponse, error) {
+// PurgeItem ...
+func (m *StoreItemsDefaultServer) PurgeItem(ctx context.Context, in *PurgeItemRequest) (*PurgeItemResponse, error) {
+	out := &PurgeItemResponse{}
+	return out, nil
+}
+
+// SetItemTranslation ...
+func (m *StoreItemsDefaultServer) SetItemTranslation(ctx context.Context, in *SetItemTranslationRequest) (*SetItemTranslationResponse, error) {
+	out := &SetItemTranslationResponse{}
+	return out, nil
+}
+
+// DeleteItemTranslation ...
+func (m *StoreItemsDefaultServer) DeleteItemTranslation(ctx context.Context, in *DeleteItemTranslationRequest) (*DeleteItemTranslationResponse, error) {
+	out := &DeleteItemTranslationResponse{}
+	return out, nil
+}
+
+// SetItemType ...
+func (m *StoreItemsDefaultServer) SetItemType(ctx context.Context, in *SetItemTypeRequest) (*SetItemTypeResponse, error) {
+	out := &SetItemTypeResponse{}
+	return out, nil
+}
+
+// DeleteItemType ...
+func (m *StoreItemsDefaultServer) DeleteItemType(ctx context.Context, in *DeleteItemTypeRequest) (*DeleteItemTypeResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(StoreItemsStoreItemWithBeforeDelete); ok {
+	if custom, ok := interface{}(in).(StoreItemsItemTypeWithBeforeDeleteItemType); ok {
 		var err error
-		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+		if db, err = custom.BeforeDeleteItemType(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	err := DefaultDeleteStoreItem(ctx, &StoreItem{Id: in.GetId()}, db)
+	err := DefaultDeleteItemType(ctx, &ItemType{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	out := &DeleteStoreItemResponse{}
-	if custom, ok := interface{}(in).(StoreItemsStoreItemWithAfterDelete); ok {
+	out := &DeleteItemTypeResponse{}
+	if custom, ok := interface{}(in).(StoreItemsItemTypeWithAfterDeleteItemType); ok {
 		var err error
-		if err = custom.AfterDelete(ctx, out, db); err != nil {
+		if err = custom.AfterDeleteItemType(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// StoreItemsStoreItemWithBeforeDelete called before DefaultDeleteStoreItem in the default Delete handler
-type StoreItemsStoreItemWithBeforeDelete interface {
-	BeforeDelete(context.Context, *gorm1.DB) (*gorm1.DB, error)
+// StoreItemsItemTypeWithBeforeDeleteItemType called before DefaultDeleteItemTypeItemType in the default DeleteItemType handler
+type StoreItemsItemTypeWithBeforeDeleteItemType interface {
+	BeforeDeleteItemType(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
 
-// StoreItemsStoreItemWithAfterDelete called before DefaultDeleteStoreItem in the default Delete handler
-type StoreItemsStoreItemWithAfterDelete interface {
-	AfterDelete(context.Context, *DeleteStoreItemResponse, *gorm1.DB) error
+// StoreItemsItemTypeWithAfterDeleteItemType called before DefaultDeleteItemTypeItemType in the default DeleteItemType handler
+type StoreItemsItemTypeWithAfterDeleteItemType interface {
+	AfterDeleteItemType(context.Context, *DeleteItemTypeResponse, *gorm1.DB) error
 }
 
-// List ...
-func (m *StoreItemsDefaultServer) List(ctx context.Context, in *ListStoreItemsRequest) (*ListStoreItemsResponse, error) {
+// ListItemTypes ...
+func (m *StoreItemsDefaultServer) ListItemTypes(ctx context.Context, in *ListItemTypesRequest) (*ListItemTypesResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(StoreItemsStoreItemWithBeforeList); ok {
+	if custom, ok := interface{}(in).(StoreItemsItemTypeWithBeforeListItemTypes); ok {
 		var err error
-		if db, err = custom.BeforeList(ctx, db); err != nil {
+		if db, err = custom.BeforeListItemTypes(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
-		pagedRequest = true
-	}
-	res, err := DefaultListStoreItem(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := DefaultListItemType(ctx, db)
 	if err != nil {
 		return nil, err
 	}
-	var resPaging *query1.PageInfo
-	if pagedRequest {
-		var offset int32
-		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
-			size--
-			res = res[:size]
-			offset = in.GetPaging().GetOffset() + size
-		}
-		resPaging = &query1.PageInfo{Offset: offset}
-	}
-	out := &ListStoreItemsResponse{Results: res, Page: resPaging}
-	if custom, ok := interface{}(in).(StoreItemsStoreItemWithAfterList); ok {
+	out := &ListItemTypesResponse{Results: res}
+	if custom, ok := interface{}(in).(StoreItemsItemTypeWithAfterListItemTypes); ok {
 		var err error
-		if err = custom.AfterList(ctx, out, db); err != nil {
+		if err = custom.AfterListItemTypes(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// StoreItemsStoreItemWithBeforeList called before DefaultListStoreItem in the default List handler
-type StoreItemsStoreItemWithBeforeList interface {
-	BeforeList(context.Context, *gorm1.DB) (*gorm1.DB, error)
+// StoreItemsItemTypeWithBeforeListItemTypes called before DefaultListItemTypesItemType in the default ListItemTypes handler
+type StoreItemsItemTypeWithBeforeListItemTypes interface {
+	BeforeListItemTypes(context.Context, *gorm1.DB) (*gorm1.DB, error)
 }
 
-// StoreItemsStoreItemWithAfterList called before DefaultListStoreItem in the default List handler
-type StoreItemsStoreItemWithAfterList interface {
-	AfterList(context.Context, *ListStoreItemsResponse, *gorm1.DB) error
+// StoreItemsItemTypeWithAfterListItemTypes called before DefaultListItemTypesItemType in the default ListItemTypes handler
+type StoreItemsItemTypeWithAfterListItemTypes interface {
+	AfterListItemTypes(context.Context, *ListItemTypesResponse, *gorm1.DB) error
 }
 
-// BuyByUser ...
-func (m *StoreItemsDefaultServer) BuyByUser(ctx context.Context, in *BuyByUserRequest) (*BuyByUserResponse, error) {
-	out := &BuyByUserResponse{}
+// CreateLoadout ...
+func (m *StoreItemsDefaultServer) CreateLoadout(ctx context.Context, in *CreateLoadoutRequest) (*CreateLoadoutResponse, error) {
+	out := &CreateLoadoutResponse{}
 	return out, nil
 }
 
-// GetUserItemsIds ...
-func (m *StoreItemsDefaultServer) GetUserItemsIds(ctx context.Context, in *GetUserItemsIdsRequest) (*GetUserItemsIdsResponse, error) {
-	out := &GetUserItemsIdsResponse{}
+// UpdateLoadout ...
+func (m *StoreItemsDefaultServer) UpdateLoadout(ctx context.Context, in *UpdateLoadoutRequest) (*UpdateLoadoutResponse, error) {
+	out := &UpdateLoadoutResponse{}
 	return out, nil
 }
 
-// GetEquippedUserItemsIds ...
-func (m *StoreItemsDefaultServer) GetEquippedUserItemsIds(ctx context.Context, in *GetEquippedUserItemsIdsRequest) (*GetEquippedUserItemsIdsResponse, error) {
-	out := &GetEquippedUserItemsIdsResponse{}
+// DeleteLoadout ...
+func (m *StoreItemsDefaultServer) DeleteLoadout(ctx context.Context, in *DeleteLoadoutRequest) (*DeleteLoadoutResponse, error) {
+	out := &DeleteLoadoutResponse{}
 	return out, nil
 }
 
-// EquipByUser ...
-func (m *StoreItemsDefaultServer) EquipByUser(ctx context.Context, in *EquipByUserRequest) (*EquipByUserResponse, error) {
-	out := &EquipByUserResponse{}
+// ActivateLoadout ...
+func (m *StoreItemsDefaultServer) ActivateLoadout(ctx context.Context, in *ActivateLoadoutRequest) (*ActivateLoadoutResponse, error) {
+	out := &ActivateLoadoutResponse{}
 	return out, nil
 }
 
-// ThrowAwayByUser ...
-func (m *StoreItemsDefaultServer) ThrowAwayByUser(ctx context.Context, in *ThrowAwayByUserRequest) (*ThrowAwayByUserResponse, error) {
-	out := &ThrowAwayByUserResponse{}
+// ListLoadouts ...
+func (m *StoreItemsDefaultServer) ListLoadouts(ctx context.Context, in *ListLoadoutsRequest) (*ListLoadoutsResponse, error) {
+	out := &ListLoadoutsResponse{}
+	return out, nil
+}
+
+// ListUserInventory ...
+func (m *StoreItemsDefaultServer) ListUserInventory(ctx context.Context, in *ListUserInventoryRequest) (*ListUserInventoryResponse, error) {
+	out := &ListUserInventoryResponse{}
 	return out, nil
 }
 
@@ -2339,6 +5367,102 @@ func (m *UsersStatsDefaultServer) UpdateStats(ctx context.Context, in *UpdateUse
 	return out, nil
 }
 
+// BatchUpdateStats ...
+func (m *UsersStatsDefaultServer) BatchUpdateStats(ctx context.Context, in *BatchUpdateStatsRequest) (*BatchUpdateStatsResponse, error) {
+	out := &BatchUpdateStatsResponse{}
+	return out, nil
+}
+
+// GetLeaderboard ...
+func (m *UsersStatsDefaultServer) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	out := &GetLeaderboardResponse{}
+	return out, nil
+}
+
+// RecordMatch ...
+func (m *UsersStatsDefaultServer) RecordMatch(ctx context.Context, in *RecordMatchRequest) (*RecordMatchResponse, error) {
+	out := &RecordMatchResponse{}
+	return out, nil
+}
+
+// ListMatchHistory ...
+func (m *UsersStatsDefaultServer) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error) {
+	out := &ListMatchHistoryResponse{}
+	return out, nil
+}
+
+// RebuildStats ...
+func (m *UsersStatsDefaultServer) RebuildStats(ctx context.Context, in *RebuildStatsRequest) (*RebuildStatsResponse, error) {
+	out := &RebuildStatsResponse{}
+	return out, nil
+}
+
+// CreateSeason ...
+func (m *UsersStatsDefaultServer) CreateSeason(ctx context.Context, in *CreateSeasonRequest) (*CreateSeasonResponse, error) {
+	out := &CreateSeasonResponse{}
+	return out, nil
+}
+
+// ListSeasons ...
+func (m *UsersStatsDefaultServer) ListSeasons(ctx context.Context, in *ListSeasonsRequest) (*ListSeasonsResponse, error) {
+	out := &ListSeasonsResponse{}
+	return out, nil
+}
+
+// GetRating ...
+func (m *UsersStatsDefaultServer) GetRating(ctx context.Context, in *GetRatingRequest) (*GetRatingResponse, error) {
+	out := &GetRatingResponse{}
+	return out, nil
+}
+
+// GetRatings ...
+func (m *UsersStatsDefaultServer) GetRatings(ctx context.Context, in *GetRatingsRequest) (*GetRatingsResponse, error) {
+	out := &GetRatingsResponse{}
+	return out, nil
+}
+
+// CreateAchievement ...
+func (m *UsersStatsDefaultServer) CreateAchievement(ctx context.Context, in *CreateAchievementRequest) (*CreateAchievementResponse, error) {
+	out := &CreateAchievementResponse{}
+	return out, nil
+}
+
+// DeleteAchievement ...
+func (m *UsersStatsDefaultServer) DeleteAchievement(ctx context.Context, in *DeleteAchievementRequest) (*DeleteAchievementResponse, error) {
+	out := &DeleteAchievementResponse{}
+	return out, nil
+}
+
+// ListAchievements ...
+func (m *UsersStatsDefaultServer) ListAchievements(ctx context.Context, in *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	out := &ListAchievementsResponse{}
+	return out, nil
+}
+
+// GetUserAchievements ...
+func (m *UsersStatsDefaultServer) GetUserAchievements(ctx context.Context, in *GetUserAchievementsRequest) (*GetUserAchievementsResponse, error) {
+	out := &GetUserAchievementsResponse{}
+	return out, nil
+}
+
+// ListFlaggedStatUpdates ...
+func (m *UsersStatsDefaultServer) ListFlaggedStatUpdates(ctx context.Context, in *ListFlaggedStatUpdatesRequest) (*ListFlaggedStatUpdatesResponse, error) {
+	out := &ListFlaggedStatUpdatesResponse{}
+	return out, nil
+}
+
+// ClearFlaggedStatUpdate ...
+func (m *UsersStatsDefaultServer) ClearFlaggedStatUpdate(ctx context.Context, in *ClearFlaggedStatUpdateRequest) (*ClearFlaggedStatUpdateResponse, error) {
+	out := &ClearFlaggedStatUpdateResponse{}
+	return out, nil
+}
+
+// RollbackFlaggedStatUpdate ...
+func (m *UsersStatsDefaultServer) RollbackFlaggedStatUpdate(ctx context.Context, in *RollbackFlaggedStatUpdateRequest) (*RollbackFlaggedStatUpdateResponse, error) {
+	out := &RollbackFlaggedStatUpdateResponse{}
+	return out, nil
+}
+
 type NewsServiceDefaultServer struct {
 	DB *gorm1.DB
 }
@@ -2436,3 +5560,227 @@ type NewsServiceNewsWithBeforeList interface {
 type NewsServiceNewsWithAfterList interface {
 	AfterList(context.Context, *ListNewsResponse, *gorm1.DB) error
 }
+
+// Delete ...
+func (m *NewsServiceDefaultServer) Delete(ctx context.Context, in *DeleteNewsRequest) (*DeleteNewsResponse, error) {
+	out := &DeleteNewsResponse{}
+	return out, nil
+}
+
+// Publish ...
+func (m *NewsServiceDefaultServer) Publish(ctx context.Context, in *PublishNewsRequest) (*PublishNewsResponse, error) {
+	out := &PublishNewsResponse{}
+	return out, nil
+}
+
+// Unpublish ...
+func (m *NewsServiceDefaultServer) Unpublish(ctx context.Context, in *UnpublishNewsRequest) (*UnpublishNewsResponse, error) {
+	out := &UnpublishNewsResponse{}
+	return out, nil
+}
+
+// SetNewsTranslation ...
+func (m *NewsServiceDefaultServer) SetNewsTranslation(ctx context.Context, in *SetNewsTranslationRequest) (*SetNewsTranslationResponse, error) {
+	out := &SetNewsTranslationResponse{}
+	return out, nil
+}
+
+// DeleteNewsTranslation ...
+func (m *NewsServiceDefaultServer) DeleteNewsTranslation(ctx context.Context, in *DeleteNewsTranslationRequest) (*DeleteNewsTranslationResponse, error) {
+	out := &DeleteNewsTranslationResponse{}
+	return out, nil
+}
+
+type StorefrontDefaultServer struct {
+	DB *gorm1.DB
+}
+
+// GetStorefront ...
+func (m *StorefrontDefaultServer) GetStorefront(ctx context.Context, in *GetStorefrontRequest) (*GetStorefrontResponse, error) {
+	out := &GetStorefrontResponse{}
+	return out, nil
+}
+
+// PreviewStorefront ...
+func (m *StorefrontDefaultServer) PreviewStorefront(ctx context.Context, in *PreviewStorefrontRequest) (*PreviewStorefrontResponse, error) {
+	out := &PreviewStorefrontResponse{}
+	return out, nil
+}
+
+// CreateStorefrontSlot ...
+func (m *StorefrontDefaultServer) CreateStorefrontSlot(ctx context.Context, in *CreateStorefrontSlotRequest) (*CreateStorefrontSlotResponse, error) {
+	out := &CreateStorefrontSlotResponse{}
+	return out, nil
+}
+
+// UpdateStorefrontSlot ...
+func (m *StorefrontDefaultServer) UpdateStorefrontSlot(ctx context.Context, in *UpdateStorefrontSlotRequest) (*UpdateStorefrontSlotResponse, error) {
+	out := &UpdateStorefrontSlotResponse{}
+	return out, nil
+}
+
+// DeleteStorefrontSlot ...
+func (m *StorefrontDefaultServer) DeleteStorefrontSlot(ctx context.Context, in *DeleteStorefrontSlotRequest) (*DeleteStorefrontSlotResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(StorefrontStorefrontSlotWithBeforeDeleteStorefrontSlot); ok {
+		var err error
+		if db, err = custom.BeforeDeleteStorefrontSlot(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteStorefrontSlot(ctx, &StorefrontSlot{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteStorefrontSlotResponse{}
+	if custom, ok := interface{}(in).(StorefrontStorefrontSlotWithAfterDeleteStorefrontSlot); ok {
+		var err error
+		if err = custom.AfterDeleteStorefrontSlot(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// StorefrontStorefrontSlotWithBeforeDeleteStorefrontSlot called before DefaultDeleteStorefrontSlotStorefrontSlot in the default DeleteStorefrontSlot handler
+type StorefrontStorefrontSlotWithBeforeDeleteStorefrontSlot interface {
+	BeforeDeleteStorefrontSlot(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// StorefrontStorefrontSlotWithAfterDeleteStorefrontSlot called before DefaultDeleteStorefrontSlotStorefrontSlot in the default DeleteStorefrontSlot handler
+type StorefrontStorefrontSlotWithAfterDeleteStorefrontSlot interface {
+	AfterDeleteStorefrontSlot(context.Context, *DeleteStorefrontSlotResponse, *gorm1.DB) error
+}
+
+// ListStorefrontSlots ...
+func (m *StorefrontDefaultServer) ListStorefrontSlots(ctx context.Context, in *ListStorefrontSlotsRequest) (*ListStorefrontSlotsResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(StorefrontStorefrontSlotWithBeforeListStorefrontSlots); ok {
+		var err error
+		if db, err = custom.BeforeListStorefrontSlots(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListStorefrontSlot(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListStorefrontSlotsResponse{Results: res}
+	if custom, ok := interface{}(in).(StorefrontStorefrontSlotWithAfterListStorefrontSlots); ok {
+		var err error
+		if err = custom.AfterListStorefrontSlots(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// StorefrontStorefrontSlotWithBeforeListStorefrontSlots called before DefaultListStorefrontSlotsStorefrontSlot in the default ListStorefrontSlots handler
+type StorefrontStorefrontSlotWithBeforeListStorefrontSlots interface {
+	BeforeListStorefrontSlots(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// StorefrontStorefrontSlotWithAfterListStorefrontSlots called before DefaultListStorefrontSlotsStorefrontSlot in the default ListStorefrontSlots handler
+type StorefrontStorefrontSlotWithAfterListStorefrontSlots interface {
+	AfterListStorefrontSlots(context.Context, *ListStorefrontSlotsResponse, *gorm1.DB) error
+}
+
+// PinStorefrontItem ...
+func (m *StorefrontDefaultServer) PinStorefrontItem(ctx context.Context, in *PinStorefrontItemRequest) (*PinStorefrontItemResponse, error) {
+	out := &PinStorefrontItemResponse{}
+	return out, nil
+}
+
+// UnpinStorefrontItem ...
+func (m *StorefrontDefaultServer) UnpinStorefrontItem(ctx context.Context, in *UnpinStorefrontItemRequest) (*UnpinStorefrontItemResponse, error) {
+	out := &UnpinStorefrontItemResponse{}
+	return out, nil
+}
+
+type PaymentsDefaultServer struct {
+	DB *gorm1.DB
+}
+
+// CreateGemPack ...
+func (m *PaymentsDefaultServer) CreateGemPack(ctx context.Context, in *CreateGemPackRequest) (*CreateGemPackResponse, error) {
+	out := &CreateGemPackResponse{}
+	return out, nil
+}
+
+// DeleteGemPack ...
+func (m *PaymentsDefaultServer) DeleteGemPack(ctx context.Context, in *DeleteGemPackRequest) (*DeleteGemPackResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(PaymentsGemPackWithBeforeDeleteGemPack); ok {
+		var err error
+		if db, err = custom.BeforeDeleteGemPack(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteGemPack(ctx, &GemPack{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteGemPackResponse{}
+	if custom, ok := interface{}(in).(PaymentsGemPackWithAfterDeleteGemPack); ok {
+		var err error
+		if err = custom.AfterDeleteGemPack(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// PaymentsGemPackWithBeforeDeleteGemPack called before DefaultDeleteGemPackGemPack in the default DeleteGemPack handler
+type PaymentsGemPackWithBeforeDeleteGemPack interface {
+	BeforeDeleteGemPack(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// PaymentsGemPackWithAfterDeleteGemPack called before DefaultDeleteGemPackGemPack in the default DeleteGemPack handler
+type PaymentsGemPackWithAfterDeleteGemPack interface {
+	AfterDeleteGemPack(context.Context, *DeleteGemPackResponse, *gorm1.DB) error
+}
+
+// ListGemPacks ...
+func (m *PaymentsDefaultServer) ListGemPacks(ctx context.Context, in *ListGemPacksRequest) (*ListGemPacksResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(PaymentsGemPackWithBeforeListGemPacks); ok {
+		var err error
+		if db, err = custom.BeforeListGemPacks(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListGemPack(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListGemPacksResponse{Results: res}
+	if custom, ok := interface{}(in).(PaymentsGemPackWithAfterListGemPacks); ok {
+		var err error
+		if err = custom.AfterListGemPacks(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// PaymentsGemPackWithBeforeListGemPacks called before DefaultListGemPacksGemPack in the default ListGemPacks handler
+type PaymentsGemPackWithBeforeListGemPacks interface {
+	BeforeListGemPacks(context.Context, *gorm1.DB) (*gorm1.DB, error)
+}
+
+// PaymentsGemPackWithAfterListGemPacks called before DefaultListGemPacksGemPack in the default ListGemPacks handler
+type PaymentsGemPackWithAfterListGemPacks interface {
+	AfterListGemPacks(context.Context, *ListGemPacksResponse, *gorm1.DB) error
+}
+
+// CreatePurchase ...
+func (m *PaymentsDefaultServer) CreatePurchase(ctx context.Context, in *CreatePurchaseRequest) (*CreatePurchaseResponse, error) {
+	out := &CreatePurchaseResponse{}
+	return out, nil
+}
+
+// ValidateReceipt ...
+func (m *PaymentsDefaultServer) ValidateReceipt(ctx context.Context, in *ValidateReceiptRequest) (*ValidateReceiptResponse, error) {
+	out := &ValidateReceiptResponse{}
+	return out, nil
+}
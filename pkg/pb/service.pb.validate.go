@@ -1399,39 +1399,36 @@ var _ interface {
 	ErrorName() string
 } = GetUserCurrenciesResponseValidationError{}
 
-// Validate checks the field values on StoreItem with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *StoreItem) Validate() error {
+// Validate checks the field values on ExchangeRate with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ExchangeRate) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for Type
+	// no validation rules for FromCurrency
 
-	// no validation rules for CoinsPrice
+	// no validation rules for ToCurrency
 
-	// no validation rules for GemsPrice
+	// no validation rules for RateGems
 
-	// no validation rules for ImageId
+	// no validation rules for MinAmount
 
-	// no validation rules for OnSale
+	// no validation rules for MaxAmount
 
-	// no validation rules for SaleCoinsPrice
+	// no validation rules for DailyCap
 
-	// no validation rules for SaleGemsPrice
+	// no validation rules for RateCoins
 
 	return nil
 }
 
-// StoreItemValidationError is the validation error returned by
-// StoreItem.Validate if the designated constraints aren't met.
-type StoreItemValidationError struct {
+// ExchangeRateValidationError is the validation error returned by
+// ExchangeRate.Validate if the designated constraints aren't met.
+type ExchangeRateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1439,22 +1436,22 @@ type StoreItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e StoreItemValidationError) Field() string { return e.field }
+func (e ExchangeRateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StoreItemValidationError) Reason() string { return e.reason }
+func (e ExchangeRateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StoreItemValidationError) Cause() error { return e.cause }
+func (e ExchangeRateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StoreItemValidationError) Key() bool { return e.key }
+func (e ExchangeRateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StoreItemValidationError) ErrorName() string { return "StoreItemValidationError" }
+func (e ExchangeRateValidationError) ErrorName() string { return "ExchangeRateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StoreItemValidationError) Error() string {
+func (e ExchangeRateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1466,14 +1463,14 @@ func (e StoreItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStoreItem.%s: %s%s",
+		"invalid %sExchangeRate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StoreItemValidationError{}
+var _ error = ExchangeRateValidationError{}
 
 var _ interface {
 	Field() string
@@ -1481,40 +1478,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StoreItemValidationError{}
+} = ExchangeRateValidationError{}
 
-// Validate checks the field values on CreateStoreItemRequest with the rules
+// Validate checks the field values on SetExchangeRateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateStoreItemRequest) Validate() error {
+func (m *SetExchangeRateRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for Type
+	// no validation rules for FromCurrency
 
-	// no validation rules for CoinsPrice
+	// no validation rules for ToCurrency
 
-	// no validation rules for GemsPrice
+	// no validation rules for RateGems
 
-	// no validation rules for ImageId
+	// no validation rules for MinAmount
 
-	// no validation rules for OnSale
+	// no validation rules for MaxAmount
 
-	// no validation rules for SaleCoinsPrice
+	// no validation rules for DailyCap
 
-	// no validation rules for SaleGemsPrice
+	// no validation rules for RateCoins
 
 	return nil
 }
 
-// CreateStoreItemRequestValidationError is the validation error returned by
-// CreateStoreItemRequest.Validate if the designated constraints aren't met.
-type CreateStoreItemRequestValidationError struct {
+// SetExchangeRateRequestValidationError is the validation error returned by
+// SetExchangeRateRequest.Validate if the designated constraints aren't met.
+type SetExchangeRateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1522,24 +1515,24 @@ type CreateStoreItemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStoreItemRequestValidationError) Field() string { return e.field }
+func (e SetExchangeRateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStoreItemRequestValidationError) Reason() string { return e.reason }
+func (e SetExchangeRateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStoreItemRequestValidationError) Cause() error { return e.cause }
+func (e SetExchangeRateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStoreItemRequestValidationError) Key() bool { return e.key }
+func (e SetExchangeRateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStoreItemRequestValidationError) ErrorName() string {
-	return "CreateStoreItemRequestValidationError"
+func (e SetExchangeRateRequestValidationError) ErrorName() string {
+	return "SetExchangeRateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStoreItemRequestValidationError) Error() string {
+func (e SetExchangeRateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1551,14 +1544,14 @@ func (e CreateStoreItemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStoreItemRequest.%s: %s%s",
+		"invalid %sSetExchangeRateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStoreItemRequestValidationError{}
+var _ error = SetExchangeRateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1566,19 +1559,19 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStoreItemRequestValidationError{}
+} = SetExchangeRateRequestValidationError{}
 
-// Validate checks the field values on CreateStoreItemResponse with the rules
+// Validate checks the field values on SetExchangeRateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateStoreItemResponse) Validate() error {
+func (m *SetExchangeRateResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateStoreItemResponseValidationError{
+			return SetExchangeRateResponseValidationError{
 				field:  "Result",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1589,9 +1582,9 @@ func (m *CreateStoreItemResponse) Validate() error {
 	return nil
 }
 
-// CreateStoreItemResponseValidationError is the validation error returned by
-// CreateStoreItemResponse.Validate if the designated constraints aren't met.
-type CreateStoreItemResponseValidationError struct {
+// SetExchangeRateResponseValidationError is the validation error returned by
+// SetExchangeRateResponse.Validate if the designated constraints aren't met.
+type SetExchangeRateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1599,24 +1592,24 @@ type CreateStoreItemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateStoreItemResponseValidationError) Field() string { return e.field }
+func (e SetExchangeRateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateStoreItemResponseValidationError) Reason() string { return e.reason }
+func (e SetExchangeRateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateStoreItemResponseValidationError) Cause() error { return e.cause }
+func (e SetExchangeRateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateStoreItemResponseValidationError) Key() bool { return e.key }
+func (e SetExchangeRateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateStoreItemResponseValidationError) ErrorName() string {
-	return "CreateStoreItemResponseValidationError"
+func (e SetExchangeRateResponseValidationError) ErrorName() string {
+	return "SetExchangeRateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateStoreItemResponseValidationError) Error() string {
+func (e SetExchangeRateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1628,14 +1621,14 @@ func (e CreateStoreItemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateStoreItemResponse.%s: %s%s",
+		"invalid %sSetExchangeRateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateStoreItemResponseValidationError{}
+var _ error = SetExchangeRateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1643,12 +1636,12 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateStoreItemResponseValidationError{}
+} = SetExchangeRateResponseValidationError{}
 
-// Validate checks the field values on ReadStoreItemRequest with the rules
+// Validate checks the field values on DeleteExchangeRateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadStoreItemRequest) Validate() error {
+func (m *DeleteExchangeRateRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -1658,9 +1651,9 @@ func (m *ReadStoreItemRequest) Validate() error {
 	return nil
 }
 
-// ReadStoreItemRequestValidationError is the validation error returned by
-// ReadStoreItemRequest.Validate if the designated constraints aren't met.
-type ReadStoreItemRequestValidationError struct {
+// DeleteExchangeRateRequestValidationError is the validation error returned by
+// DeleteExchangeRateRequest.Validate if the designated constraints aren't met.
+type DeleteExchangeRateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1668,24 +1661,24 @@ type ReadStoreItemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadStoreItemRequestValidationError) Field() string { return e.field }
+func (e DeleteExchangeRateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadStoreItemRequestValidationError) Reason() string { return e.reason }
+func (e DeleteExchangeRateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadStoreItemRequestValidationError) Cause() error { return e.cause }
+func (e DeleteExchangeRateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadStoreItemRequestValidationError) Key() bool { return e.key }
+func (e DeleteExchangeRateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadStoreItemRequestValidationError) ErrorName() string {
-	return "ReadStoreItemRequestValidationError"
+func (e DeleteExchangeRateRequestValidationError) ErrorName() string {
+	return "DeleteExchangeRateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadStoreItemRequestValidationError) Error() string {
+func (e DeleteExchangeRateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1697,14 +1690,14 @@ func (e ReadStoreItemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadStoreItemRequest.%s: %s%s",
+		"invalid %sDeleteExchangeRateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadStoreItemRequestValidationError{}
+var _ error = DeleteExchangeRateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1712,32 +1705,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadStoreItemRequestValidationError{}
+} = DeleteExchangeRateRequestValidationError{}
 
-// Validate checks the field values on ReadStoreItemResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadStoreItemResponse) Validate() error {
+func (m *DeleteExchangeRateResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReadStoreItemResponseValidationError{
-				field:  "Result",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// ReadStoreItemResponseValidationError is the validation error returned by
-// ReadStoreItemResponse.Validate if the designated constraints aren't met.
-type ReadStoreItemResponseValidationError struct {
+// DeleteExchangeRateResponseValidationError is the validation error returned
+// by DeleteExchangeRateResponse.Validate if the designated constraints aren't met.
+type DeleteExchangeRateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1745,24 +1728,24 @@ type ReadStoreItemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadStoreItemResponseValidationError) Field() string { return e.field }
+func (e DeleteExchangeRateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadStoreItemResponseValidationError) Reason() string { return e.reason }
+func (e DeleteExchangeRateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadStoreItemResponseValidationError) Cause() error { return e.cause }
+func (e DeleteExchangeRateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadStoreItemResponseValidationError) Key() bool { return e.key }
+func (e DeleteExchangeRateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadStoreItemResponseValidationError) ErrorName() string {
-	return "ReadStoreItemResponseValidationError"
+func (e DeleteExchangeRateResponseValidationError) ErrorName() string {
+	return "DeleteExchangeRateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadStoreItemResponseValidationError) Error() string {
+func (e DeleteExchangeRateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1774,14 +1757,14 @@ func (e ReadStoreItemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadStoreItemResponse.%s: %s%s",
+		"invalid %sDeleteExchangeRateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadStoreItemResponseValidationError{}
+var _ error = DeleteExchangeRateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1789,42 +1772,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadStoreItemResponseValidationError{}
+} = DeleteExchangeRateResponseValidationError{}
 
-// Validate checks the field values on UpdateStoreItemRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateStoreItemRequest) Validate() error {
+// Validate checks the field values on ExchangeQuote with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ExchangeQuote) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetRate()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateStoreItemRequestValidationError{
-				field:  "Payload",
+			return ExchangeQuoteValidationError{
+				field:  "Rate",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateStoreItemRequestValidationError{
-				field:  "Fields",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Amount
+
+	// no validation rules for Receive
+
+	// no validation rules for RemainingToday
 
 	return nil
 }
 
-// UpdateStoreItemRequestValidationError is the validation error returned by
-// UpdateStoreItemRequest.Validate if the designated constraints aren't met.
-type UpdateStoreItemRequestValidationError struct {
+// ExchangeQuoteValidationError is the validation error returned by
+// ExchangeQuote.Validate if the designated constraints aren't met.
+type ExchangeQuoteValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1832,24 +1811,22 @@ type UpdateStoreItemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStoreItemRequestValidationError) Field() string { return e.field }
+func (e ExchangeQuoteValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStoreItemRequestValidationError) Reason() string { return e.reason }
+func (e ExchangeQuoteValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStoreItemRequestValidationError) Cause() error { return e.cause }
+func (e ExchangeQuoteValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStoreItemRequestValidationError) Key() bool { return e.key }
+func (e ExchangeQuoteValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStoreItemRequestValidationError) ErrorName() string {
-	return "UpdateStoreItemRequestValidationError"
-}
+func (e ExchangeQuoteValidationError) ErrorName() string { return "ExchangeQuoteValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateStoreItemRequestValidationError) Error() string {
+func (e ExchangeQuoteValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1861,14 +1838,14 @@ func (e UpdateStoreItemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStoreItemRequest.%s: %s%s",
+		"invalid %sExchangeQuote.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStoreItemRequestValidationError{}
+var _ error = ExchangeQuoteValidationError{}
 
 var _ interface {
 	Field() string
@@ -1876,32 +1853,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStoreItemRequestValidationError{}
+} = ExchangeQuoteValidationError{}
 
-// Validate checks the field values on UpdateStoreItemResponse with the rules
+// Validate checks the field values on GetExchangeRatesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateStoreItemResponse) Validate() error {
+func (m *GetExchangeRatesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateStoreItemResponseValidationError{
-				field:  "Result",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Amount
 
 	return nil
 }
 
-// UpdateStoreItemResponseValidationError is the validation error returned by
-// UpdateStoreItemResponse.Validate if the designated constraints aren't met.
-type UpdateStoreItemResponseValidationError struct {
+// GetExchangeRatesRequestValidationError is the validation error returned by
+// GetExchangeRatesRequest.Validate if the designated constraints aren't met.
+type GetExchangeRatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1909,24 +1880,24 @@ type UpdateStoreItemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStoreItemResponseValidationError) Field() string { return e.field }
+func (e GetExchangeRatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStoreItemResponseValidationError) Reason() string { return e.reason }
+func (e GetExchangeRatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStoreItemResponseValidationError) Cause() error { return e.cause }
+func (e GetExchangeRatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStoreItemResponseValidationError) Key() bool { return e.key }
+func (e GetExchangeRatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStoreItemResponseValidationError) ErrorName() string {
-	return "UpdateStoreItemResponseValidationError"
+func (e GetExchangeRatesRequestValidationError) ErrorName() string {
+	return "GetExchangeRatesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStoreItemResponseValidationError) Error() string {
+func (e GetExchangeRatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1938,14 +1909,14 @@ func (e UpdateStoreItemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStoreItemResponse.%s: %s%s",
+		"invalid %sGetExchangeRatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStoreItemResponseValidationError{}
+var _ error = GetExchangeRatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1953,24 +1924,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStoreItemResponseValidationError{}
+} = GetExchangeRatesRequestValidationError{}
 
-// Validate checks the field values on DeleteStoreItemRequest with the rules
+// Validate checks the field values on GetExchangeRatesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteStoreItemRequest) Validate() error {
+func (m *GetExchangeRatesResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	for idx, item := range m.GetQuotes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExchangeRatesResponseValidationError{
+					field:  fmt.Sprintf("Quotes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// DeleteStoreItemRequestValidationError is the validation error returned by
-// DeleteStoreItemRequest.Validate if the designated constraints aren't met.
-type DeleteStoreItemRequestValidationError struct {
+// GetExchangeRatesResponseValidationError is the validation error returned by
+// GetExchangeRatesResponse.Validate if the designated constraints aren't met.
+type GetExchangeRatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1978,24 +1962,24 @@ type DeleteStoreItemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStoreItemRequestValidationError) Field() string { return e.field }
+func (e GetExchangeRatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStoreItemRequestValidationError) Reason() string { return e.reason }
+func (e GetExchangeRatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStoreItemRequestValidationError) Cause() error { return e.cause }
+func (e GetExchangeRatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStoreItemRequestValidationError) Key() bool { return e.key }
+func (e GetExchangeRatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStoreItemRequestValidationError) ErrorName() string {
-	return "DeleteStoreItemRequestValidationError"
+func (e GetExchangeRatesResponseValidationError) ErrorName() string {
+	return "GetExchangeRatesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteStoreItemRequestValidationError) Error() string {
+func (e GetExchangeRatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2007,14 +1991,14 @@ func (e DeleteStoreItemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStoreItemRequest.%s: %s%s",
+		"invalid %sGetExchangeRatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStoreItemRequestValidationError{}
+var _ error = GetExchangeRatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2022,22 +2006,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStoreItemRequestValidationError{}
+} = GetExchangeRatesResponseValidationError{}
 
-// Validate checks the field values on DeleteStoreItemResponse with the rules
+// Validate checks the field values on ExchangeCurrencyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteStoreItemResponse) Validate() error {
+func (m *ExchangeCurrencyRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for Id
+
+	// no validation rules for FromCurrency
+
+	// no validation rules for ToCurrency
+
+	// no validation rules for Amount
+
 	return nil
 }
 
-// DeleteStoreItemResponseValidationError is the validation error returned by
-// DeleteStoreItemResponse.Validate if the designated constraints aren't met.
-type DeleteStoreItemResponseValidationError struct {
+// ExchangeCurrencyRequestValidationError is the validation error returned by
+// ExchangeCurrencyRequest.Validate if the designated constraints aren't met.
+type ExchangeCurrencyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2045,24 +2037,24 @@ type DeleteStoreItemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStoreItemResponseValidationError) Field() string { return e.field }
+func (e ExchangeCurrencyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStoreItemResponseValidationError) Reason() string { return e.reason }
+func (e ExchangeCurrencyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStoreItemResponseValidationError) Cause() error { return e.cause }
+func (e ExchangeCurrencyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStoreItemResponseValidationError) Key() bool { return e.key }
+func (e ExchangeCurrencyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStoreItemResponseValidationError) ErrorName() string {
-	return "DeleteStoreItemResponseValidationError"
+func (e ExchangeCurrencyRequestValidationError) ErrorName() string {
+	return "ExchangeCurrencyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteStoreItemResponseValidationError) Error() string {
+func (e ExchangeCurrencyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2074,14 +2066,14 @@ func (e DeleteStoreItemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStoreItemResponse.%s: %s%s",
+		"invalid %sExchangeCurrencyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStoreItemResponseValidationError{}
+var _ error = ExchangeCurrencyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2089,62 +2081,11225 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStoreItemResponseValidationError{}
+} = ExchangeCurrencyRequestValidationError{}
 
-// Validate checks the field values on ListStoreItemsRequest with the rules
+// Validate checks the field values on ExchangeCurrencyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListStoreItemsRequest) Validate() error {
+func (m *ExchangeCurrencyResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListStoreItemsRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Spent
 
-	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListStoreItemsRequestValidationError{
-				field:  "OrderBy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Received
 
-	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListStoreItemsRequestValidationError{
-				field:  "Fields",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Coins
 
-	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListStoreItemsRequestValidationError{
-				field:  "Paging",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	// no validation rules for Gems
+
+	return nil
+}
+
+// ExchangeCurrencyResponseValidationError is the validation error returned by
+// ExchangeCurrencyResponse.Validate if the designated constraints aren't met.
+type ExchangeCurrencyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeCurrencyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeCurrencyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeCurrencyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeCurrencyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeCurrencyResponseValidationError) ErrorName() string {
+	return "ExchangeCurrencyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeCurrencyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeCurrencyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeCurrencyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeCurrencyResponseValidationError{}
+
+// Validate checks the field values on StoreItem with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *StoreItem) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for CoinsPrice
+
+	// no validation rules for GemsPrice
+
+	// no validation rules for ImageId
+
+	// no validation rules for OnSale
+
+	// no validation rules for SaleCoinsPrice
+
+	// no validation rules for SaleGemsPrice
+
+	// no validation rules for Consumable
+
+	// no validation rules for MaxStack
+
+	// no validation rules for RentalDays
+
+	// no validation rules for RentalCoinsPrice
+
+	// no validation rules for RentalGemsPrice
+
+	// no validation rules for Sku
+
+	// no validation rules for Retired
+
+	return nil
+}
+
+// StoreItemValidationError is the validation error returned by
+// StoreItem.Validate if the designated constraints aren't met.
+type StoreItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreItemValidationError) ErrorName() string { return "StoreItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StoreItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreItemValidationError{}
+
+// Validate checks the field values on CreateStoreItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateStoreItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for CoinsPrice
+
+	// no validation rules for GemsPrice
+
+	// no validation rules for ImageId
+
+	// no validation rules for OnSale
+
+	// no validation rules for SaleCoinsPrice
+
+	// no validation rules for SaleGemsPrice
+
+	// no validation rules for Consumable
+
+	// no validation rules for MaxStack
+
+	// no validation rules for RentalDays
+
+	// no validation rules for RentalCoinsPrice
+
+	// no validation rules for RentalGemsPrice
+
+	// no validation rules for Sku
+
+	return nil
+}
+
+// CreateStoreItemRequestValidationError is the validation error returned by
+// CreateStoreItemRequest.Validate if the designated constraints aren't met.
+type CreateStoreItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStoreItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStoreItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStoreItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStoreItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStoreItemRequestValidationError) ErrorName() string {
+	return "CreateStoreItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStoreItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStoreItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStoreItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStoreItemRequestValidationError{}
+
+// Validate checks the field values on CreateStoreItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateStoreItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateStoreItemResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateStoreItemResponseValidationError is the validation error returned by
+// CreateStoreItemResponse.Validate if the designated constraints aren't met.
+type CreateStoreItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateStoreItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateStoreItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateStoreItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateStoreItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateStoreItemResponseValidationError) ErrorName() string {
+	return "CreateStoreItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateStoreItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateStoreItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateStoreItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateStoreItemResponseValidationError{}
+
+// Validate checks the field values on ReadStoreItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadStoreItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// ReadStoreItemRequestValidationError is the validation error returned by
+// ReadStoreItemRequest.Validate if the designated constraints aren't met.
+type ReadStoreItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadStoreItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadStoreItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadStoreItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadStoreItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadStoreItemRequestValidationError) ErrorName() string {
+	return "ReadStoreItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadStoreItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadStoreItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadStoreItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadStoreItemRequestValidationError{}
+
+// Validate checks the field values on ReadStoreItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadStoreItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadStoreItemResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadStoreItemResponseValidationError is the validation error returned by
+// ReadStoreItemResponse.Validate if the designated constraints aren't met.
+type ReadStoreItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadStoreItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadStoreItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadStoreItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadStoreItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadStoreItemResponseValidationError) ErrorName() string {
+	return "ReadStoreItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadStoreItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadStoreItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadStoreItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadStoreItemResponseValidationError{}
+
+// Validate checks the field values on UpdateStoreItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateStoreItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreItemRequestValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreItemRequestValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateStoreItemRequestValidationError is the validation error returned by
+// UpdateStoreItemRequest.Validate if the designated constraints aren't met.
+type UpdateStoreItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStoreItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStoreItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStoreItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStoreItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStoreItemRequestValidationError) ErrorName() string {
+	return "UpdateStoreItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStoreItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStoreItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStoreItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStoreItemRequestValidationError{}
+
+// Validate checks the field values on UpdateStoreItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateStoreItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStoreItemResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateStoreItemResponseValidationError is the validation error returned by
+// UpdateStoreItemResponse.Validate if the designated constraints aren't met.
+type UpdateStoreItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateStoreItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateStoreItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateStoreItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateStoreItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateStoreItemResponseValidationError) ErrorName() string {
+	return "UpdateStoreItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateStoreItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateStoreItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateStoreItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateStoreItemResponseValidationError{}
+
+// Validate checks the field values on DeleteStoreItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteStoreItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteStoreItemRequestValidationError is the validation error returned by
+// DeleteStoreItemRequest.Validate if the designated constraints aren't met.
+type DeleteStoreItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStoreItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStoreItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStoreItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStoreItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStoreItemRequestValidationError) ErrorName() string {
+	return "DeleteStoreItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStoreItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStoreItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStoreItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStoreItemRequestValidationError{}
+
+// Validate checks the field values on DeleteStoreItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteStoreItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteStoreItemResponseValidationError is the validation error returned by
+// DeleteStoreItemResponse.Validate if the designated constraints aren't met.
+type DeleteStoreItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteStoreItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteStoreItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteStoreItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteStoreItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteStoreItemResponseValidationError) ErrorName() string {
+	return "DeleteStoreItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteStoreItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteStoreItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteStoreItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteStoreItemResponseValidationError{}
+
+// Validate checks the field values on ListStoreItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListStoreItemsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreItemsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreItemsRequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreItemsRequestValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreItemsRequestValidationError{
+				field:  "Paging",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	// no validation rules for IncludeRetired
+
+	return nil
+}
+
+// ListStoreItemsRequestValidationError is the validation error returned by
+// ListStoreItemsRequest.Validate if the designated constraints aren't met.
+type ListStoreItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreItemsRequestValidationError) ErrorName() string {
+	return "ListStoreItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreItemsRequestValidationError{}
+
+// Validate checks the field values on ListStoreItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListStoreItemsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreItemsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStoreItemsResponseValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	return nil
+}
+
+// ListStoreItemsResponseValidationError is the validation error returned by
+// ListStoreItemsResponse.Validate if the designated constraints aren't met.
+type ListStoreItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreItemsResponseValidationError) ErrorName() string {
+	return "ListStoreItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreItemsResponseValidationError{}
+
+// Validate checks the field values on CatalogRowError with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *CatalogRowError) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Row
+
+	// no validation rules for Sku
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// CatalogRowErrorValidationError is the validation error returned by
+// CatalogRowError.Validate if the designated constraints aren't met.
+type CatalogRowErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogRowErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogRowErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogRowErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogRowErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogRowErrorValidationError) ErrorName() string { return "CatalogRowErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogRowErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogRowError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogRowErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogRowErrorValidationError{}
+
+// Validate checks the field values on ImportCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportCatalogRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Format
+
+	// no validation rules for Data
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// ImportCatalogRequestValidationError is the validation error returned by
+// ImportCatalogRequest.Validate if the designated constraints aren't met.
+type ImportCatalogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCatalogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCatalogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCatalogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCatalogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCatalogRequestValidationError) ErrorName() string {
+	return "ImportCatalogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCatalogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCatalogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCatalogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCatalogRequestValidationError{}
+
+// Validate checks the field values on ImportCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportCatalogResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Applied
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportCatalogResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportCatalogResponseValidationError is the validation error returned by
+// ImportCatalogResponse.Validate if the designated constraints aren't met.
+type ImportCatalogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCatalogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCatalogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCatalogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCatalogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCatalogResponseValidationError) ErrorName() string {
+	return "ImportCatalogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCatalogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCatalogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCatalogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCatalogResponseValidationError{}
+
+// Validate checks the field values on ExportCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportCatalogRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Format
+
+	return nil
+}
+
+// ExportCatalogRequestValidationError is the validation error returned by
+// ExportCatalogRequest.Validate if the designated constraints aren't met.
+type ExportCatalogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCatalogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCatalogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCatalogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCatalogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCatalogRequestValidationError) ErrorName() string {
+	return "ExportCatalogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCatalogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCatalogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCatalogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCatalogRequestValidationError{}
+
+// Validate checks the field values on ExportCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportCatalogResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Format
+
+	// no validation rules for Data
+
+	return nil
+}
+
+// ExportCatalogResponseValidationError is the validation error returned by
+// ExportCatalogResponse.Validate if the designated constraints aren't met.
+type ExportCatalogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCatalogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCatalogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCatalogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCatalogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCatalogResponseValidationError) ErrorName() string {
+	return "ExportCatalogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCatalogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCatalogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCatalogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCatalogResponseValidationError{}
+
+// Validate checks the field values on PurgeItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *PurgeItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Refund
+
+	// no validation rules for CompensationCoins
+
+	// no validation rules for CompensationGems
+
+	return nil
+}
+
+// PurgeItemRequestValidationError is the validation error returned by
+// PurgeItemRequest.Validate if the designated constraints aren't met.
+type PurgeItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeItemRequestValidationError) ErrorName() string { return "PurgeItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeItemRequestValidationError{}
+
+// Validate checks the field values on PurgeItemResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *PurgeItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for AffectedOwners
+
+	// no validation rules for RefundedGiftSenders
+
+	return nil
+}
+
+// PurgeItemResponseValidationError is the validation error returned by
+// PurgeItemResponse.Validate if the designated constraints aren't met.
+type PurgeItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeItemResponseValidationError) ErrorName() string {
+	return "PurgeItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeItemResponseValidationError{}
+
+// Validate checks the field values on StoreItemTranslation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StoreItemTranslation) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ItemId
+
+	// no validation rules for Locale
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	return nil
+}
+
+// StoreItemTranslationValidationError is the validation error returned by
+// StoreItemTranslation.Validate if the designated constraints aren't met.
+type StoreItemTranslationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreItemTranslationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreItemTranslationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreItemTranslationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreItemTranslationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreItemTranslationValidationError) ErrorName() string {
+	return "StoreItemTranslationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoreItemTranslationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreItemTranslation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreItemTranslationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreItemTranslationValidationError{}
+
+// Validate checks the field values on SetItemTranslationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetItemTranslationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ItemId
+
+	// no validation rules for Locale
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	return nil
+}
+
+// SetItemTranslationRequestValidationError is the validation error returned by
+// SetItemTranslationRequest.Validate if the designated constraints aren't met.
+type SetItemTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetItemTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetItemTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetItemTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetItemTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetItemTranslationRequestValidationError) ErrorName() string {
+	return "SetItemTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetItemTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetItemTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetItemTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetItemTranslationRequestValidationError{}
+
+// Validate checks the field values on SetItemTranslationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetItemTranslationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetItemTranslationResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetItemTranslationResponseValidationError is the validation error returned
+// by SetItemTranslationResponse.Validate if the designated constraints aren't met.
+type SetItemTranslationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetItemTranslationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetItemTranslationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetItemTranslationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetItemTranslationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetItemTranslationResponseValidationError) ErrorName() string {
+	return "SetItemTranslationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetItemTranslationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetItemTranslationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetItemTranslationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetItemTranslationResponseValidationError{}
+
+// Validate checks the field values on DeleteItemTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteItemTranslationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ItemId
+
+	// no validation rules for Locale
+
+	return nil
+}
+
+// DeleteItemTranslationRequestValidationError is the validation error returned
+// by DeleteItemTranslationRequest.Validate if the designated constraints
+// aren't met.
+type DeleteItemTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemTranslationRequestValidationError) ErrorName() string {
+	return "DeleteItemTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemTranslationRequestValidationError{}
+
+// Validate checks the field values on DeleteItemTranslationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteItemTranslationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteItemTranslationResponseValidationError is the validation error
+// returned by DeleteItemTranslationResponse.Validate if the designated
+// constraints aren't met.
+type DeleteItemTranslationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemTranslationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemTranslationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemTranslationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemTranslationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemTranslationResponseValidationError) ErrorName() string {
+	return "DeleteItemTranslationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemTranslationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemTranslationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemTranslationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemTranslationResponseValidationError{}
+
+// Validate checks the field values on BuyByUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *BuyByUserRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Quantity
+
+	// no validation rules for Rent
+
+	return nil
+}
+
+// BuyByUserRequestValidationError is the validation error returned by
+// BuyByUserRequest.Validate if the designated constraints aren't met.
+type BuyByUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuyByUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuyByUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuyByUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuyByUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuyByUserRequestValidationError) ErrorName() string { return "BuyByUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BuyByUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuyByUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuyByUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuyByUserRequestValidationError{}
+
+// Validate checks the field values on BuyByUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *BuyByUserResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// BuyByUserResponseValidationError is the validation error returned by
+// BuyByUserResponse.Validate if the designated constraints aren't met.
+type BuyByUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuyByUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuyByUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuyByUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuyByUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuyByUserResponseValidationError) ErrorName() string {
+	return "BuyByUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BuyByUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuyByUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuyByUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuyByUserResponseValidationError{}
+
+// Validate checks the field values on ThrowAwayByUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ThrowAwayByUserRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	return nil
+}
+
+// ThrowAwayByUserRequestValidationError is the validation error returned by
+// ThrowAwayByUserRequest.Validate if the designated constraints aren't met.
+type ThrowAwayByUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThrowAwayByUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThrowAwayByUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThrowAwayByUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThrowAwayByUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThrowAwayByUserRequestValidationError) ErrorName() string {
+	return "ThrowAwayByUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ThrowAwayByUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThrowAwayByUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThrowAwayByUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThrowAwayByUserRequestValidationError{}
+
+// Validate checks the field values on ThrowAwayByUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ThrowAwayByUserResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ThrowAwayByUserResponseValidationError is the validation error returned by
+// ThrowAwayByUserResponse.Validate if the designated constraints aren't met.
+type ThrowAwayByUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThrowAwayByUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThrowAwayByUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThrowAwayByUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThrowAwayByUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThrowAwayByUserResponseValidationError) ErrorName() string {
+	return "ThrowAwayByUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ThrowAwayByUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThrowAwayByUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThrowAwayByUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThrowAwayByUserResponseValidationError{}
+
+// Validate checks the field values on EquipByUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EquipByUserRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	return nil
+}
+
+// EquipByUserRequestValidationError is the validation error returned by
+// EquipByUserRequest.Validate if the designated constraints aren't met.
+type EquipByUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipByUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipByUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipByUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipByUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipByUserRequestValidationError) ErrorName() string {
+	return "EquipByUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EquipByUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipByUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipByUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipByUserRequestValidationError{}
+
+// Validate checks the field values on EquipByUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EquipByUserResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// EquipByUserResponseValidationError is the validation error returned by
+// EquipByUserResponse.Validate if the designated constraints aren't met.
+type EquipByUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipByUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipByUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipByUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipByUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipByUserResponseValidationError) ErrorName() string {
+	return "EquipByUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EquipByUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipByUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipByUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipByUserResponseValidationError{}
+
+// Validate checks the field values on GetUserItemsIdsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetUserItemsIdsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// GetUserItemsIdsRequestValidationError is the validation error returned by
+// GetUserItemsIdsRequest.Validate if the designated constraints aren't met.
+type GetUserItemsIdsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserItemsIdsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserItemsIdsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserItemsIdsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserItemsIdsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserItemsIdsRequestValidationError) ErrorName() string {
+	return "GetUserItemsIdsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserItemsIdsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserItemsIdsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserItemsIdsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserItemsIdsRequestValidationError{}
+
+// Validate checks the field values on UserItemInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *UserItemInfo) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ItemId
+
+	// no validation rules for Equipped
+
+	// no validation rules for Quantity
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserItemInfoValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RemainingSeconds
+
+	return nil
+}
+
+// UserItemInfoValidationError is the validation error returned by
+// UserItemInfo.Validate if the designated constraints aren't met.
+type UserItemInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserItemInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserItemInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserItemInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserItemInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserItemInfoValidationError) ErrorName() string { return "UserItemInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserItemInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserItemInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserItemInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserItemInfoValidationError{}
+
+// Validate checks the field values on GetUserItemsIdsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetUserItemsIdsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserItemsIdsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetUserItemsIdsResponseValidationError is the validation error returned by
+// GetUserItemsIdsResponse.Validate if the designated constraints aren't met.
+type GetUserItemsIdsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserItemsIdsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserItemsIdsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserItemsIdsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserItemsIdsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserItemsIdsResponseValidationError) ErrorName() string {
+	return "GetUserItemsIdsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserItemsIdsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserItemsIdsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserItemsIdsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserItemsIdsResponseValidationError{}
+
+// Validate checks the field values on GetEquippedUserItemsIdsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetEquippedUserItemsIdsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// GetEquippedUserItemsIdsRequestValidationError is the validation error
+// returned by GetEquippedUserItemsIdsRequest.Validate if the designated
+// constraints aren't met.
+type GetEquippedUserItemsIdsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEquippedUserItemsIdsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEquippedUserItemsIdsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEquippedUserItemsIdsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEquippedUserItemsIdsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEquippedUserItemsIdsRequestValidationError) ErrorName() string {
+	return "GetEquippedUserItemsIdsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEquippedUserItemsIdsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEquippedUserItemsIdsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEquippedUserItemsIdsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEquippedUserItemsIdsRequestValidationError{}
+
+// Validate checks the field values on GetEquippedUserItemsIdsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetEquippedUserItemsIdsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetEquippedUserItemsIdsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetEquippedUserItemsIdsResponseValidationError is the validation error
+// returned by GetEquippedUserItemsIdsResponse.Validate if the designated
+// constraints aren't met.
+type GetEquippedUserItemsIdsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEquippedUserItemsIdsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEquippedUserItemsIdsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEquippedUserItemsIdsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEquippedUserItemsIdsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEquippedUserItemsIdsResponseValidationError) ErrorName() string {
+	return "GetEquippedUserItemsIdsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEquippedUserItemsIdsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEquippedUserItemsIdsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEquippedUserItemsIdsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEquippedUserItemsIdsResponseValidationError{}
+
+// Validate checks the field values on GrantItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GrantItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantItemRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GrantItemRequestValidationError is the validation error returned by
+// GrantItemRequest.Validate if the designated constraints aren't met.
+type GrantItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantItemRequestValidationError) ErrorName() string { return "GrantItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GrantItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantItemRequestValidationError{}
+
+// Validate checks the field values on GrantItemResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GrantItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GrantItemResponseValidationError is the validation error returned by
+// GrantItemResponse.Validate if the designated constraints aren't met.
+type GrantItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantItemResponseValidationError) ErrorName() string {
+	return "GrantItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantItemResponseValidationError{}
+
+// Validate checks the field values on ConsumeItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ConsumeItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Quantity
+
+	return nil
+}
+
+// ConsumeItemRequestValidationError is the validation error returned by
+// ConsumeItemRequest.Validate if the designated constraints aren't met.
+type ConsumeItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeItemRequestValidationError) ErrorName() string {
+	return "ConsumeItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeItemRequestValidationError{}
+
+// Validate checks the field values on ConsumeItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ConsumeItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Remaining
+
+	return nil
+}
+
+// ConsumeItemResponseValidationError is the validation error returned by
+// ConsumeItemResponse.Validate if the designated constraints aren't met.
+type ConsumeItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumeItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumeItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumeItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumeItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumeItemResponseValidationError) ErrorName() string {
+	return "ConsumeItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsumeItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumeItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumeItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumeItemResponseValidationError{}
+
+// Validate checks the field values on Gift with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Gift) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for SenderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Message
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GiftValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GiftValidationError is the validation error returned by Gift.Validate if the
+// designated constraints aren't met.
+type GiftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiftValidationError) ErrorName() string { return "GiftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GiftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiftValidationError{}
+
+// Validate checks the field values on GiftItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GiftItemRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SenderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// GiftItemRequestValidationError is the validation error returned by
+// GiftItemRequest.Validate if the designated constraints aren't met.
+type GiftItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiftItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiftItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiftItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiftItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiftItemRequestValidationError) ErrorName() string { return "GiftItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GiftItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGiftItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiftItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiftItemRequestValidationError{}
+
+// Validate checks the field values on GiftItemResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GiftItemResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for GiftId
+
+	return nil
+}
+
+// GiftItemResponseValidationError is the validation error returned by
+// GiftItemResponse.Validate if the designated constraints aren't met.
+type GiftItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiftItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiftItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiftItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiftItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiftItemResponseValidationError) ErrorName() string { return "GiftItemResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GiftItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGiftItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiftItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiftItemResponseValidationError{}
+
+// Validate checks the field values on ListPendingGiftsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListPendingGiftsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// ListPendingGiftsRequestValidationError is the validation error returned by
+// ListPendingGiftsRequest.Validate if the designated constraints aren't met.
+type ListPendingGiftsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingGiftsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingGiftsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingGiftsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingGiftsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingGiftsRequestValidationError) ErrorName() string {
+	return "ListPendingGiftsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingGiftsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingGiftsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingGiftsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingGiftsRequestValidationError{}
+
+// Validate checks the field values on ListPendingGiftsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListPendingGiftsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetGifts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingGiftsResponseValidationError{
+					field:  fmt.Sprintf("Gifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListPendingGiftsResponseValidationError is the validation error returned by
+// ListPendingGiftsResponse.Validate if the designated constraints aren't met.
+type ListPendingGiftsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingGiftsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingGiftsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingGiftsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingGiftsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingGiftsResponseValidationError) ErrorName() string {
+	return "ListPendingGiftsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingGiftsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingGiftsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingGiftsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingGiftsResponseValidationError{}
+
+// Validate checks the field values on AcceptGiftRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *AcceptGiftRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for GiftId
+
+	return nil
+}
+
+// AcceptGiftRequestValidationError is the validation error returned by
+// AcceptGiftRequest.Validate if the designated constraints aren't met.
+type AcceptGiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptGiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptGiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptGiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptGiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptGiftRequestValidationError) ErrorName() string {
+	return "AcceptGiftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptGiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptGiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptGiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptGiftRequestValidationError{}
+
+// Validate checks the field values on AcceptGiftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AcceptGiftResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AcceptGiftResponseValidationError is the validation error returned by
+// AcceptGiftResponse.Validate if the designated constraints aren't met.
+type AcceptGiftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptGiftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptGiftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptGiftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptGiftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptGiftResponseValidationError) ErrorName() string {
+	return "AcceptGiftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptGiftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptGiftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptGiftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptGiftResponseValidationError{}
+
+// Validate checks the field values on DeclineGiftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeclineGiftRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for GiftId
+
+	return nil
+}
+
+// DeclineGiftRequestValidationError is the validation error returned by
+// DeclineGiftRequest.Validate if the designated constraints aren't met.
+type DeclineGiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineGiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineGiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineGiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineGiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineGiftRequestValidationError) ErrorName() string {
+	return "DeclineGiftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineGiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineGiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineGiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineGiftRequestValidationError{}
+
+// Validate checks the field values on DeclineGiftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeclineGiftResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeclineGiftResponseValidationError is the validation error returned by
+// DeclineGiftResponse.Validate if the designated constraints aren't met.
+type DeclineGiftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineGiftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineGiftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineGiftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineGiftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineGiftResponseValidationError) ErrorName() string {
+	return "DeclineGiftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineGiftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineGiftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineGiftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineGiftResponseValidationError{}
+
+// Validate checks the field values on ItemType with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ItemType) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Slot
+
+	// no validation rules for SlotCapacity
+
+	return nil
+}
+
+// ItemTypeValidationError is the validation error returned by
+// ItemType.Validate if the designated constraints aren't met.
+type ItemTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemTypeValidationError) ErrorName() string { return "ItemTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItemType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemTypeValidationError{}
+
+// Validate checks the field values on SetItemTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetItemTypeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Slot
+
+	// no validation rules for SlotCapacity
+
+	return nil
+}
+
+// SetItemTypeRequestValidationError is the validation error returned by
+// SetItemTypeRequest.Validate if the designated constraints aren't met.
+type SetItemTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetItemTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetItemTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetItemTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetItemTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetItemTypeRequestValidationError) ErrorName() string {
+	return "SetItemTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetItemTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetItemTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetItemTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetItemTypeRequestValidationError{}
+
+// Validate checks the field values on SetItemTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetItemTypeResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetItemTypeResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetItemTypeResponseValidationError is the validation error returned by
+// SetItemTypeResponse.Validate if the designated constraints aren't met.
+type SetItemTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetItemTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetItemTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetItemTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetItemTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetItemTypeResponseValidationError) ErrorName() string {
+	return "SetItemTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetItemTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetItemTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetItemTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetItemTypeResponseValidationError{}
+
+// Validate checks the field values on DeleteItemTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteItemTypeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteItemTypeRequestValidationError is the validation error returned by
+// DeleteItemTypeRequest.Validate if the designated constraints aren't met.
+type DeleteItemTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemTypeRequestValidationError) ErrorName() string {
+	return "DeleteItemTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemTypeRequestValidationError{}
+
+// Validate checks the field values on DeleteItemTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteItemTypeResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteItemTypeResponseValidationError is the validation error returned by
+// DeleteItemTypeResponse.Validate if the designated constraints aren't met.
+type DeleteItemTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemTypeResponseValidationError) ErrorName() string {
+	return "DeleteItemTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemTypeResponseValidationError{}
+
+// Validate checks the field values on ListItemTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListItemTypesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListItemTypesRequestValidationError is the validation error returned by
+// ListItemTypesRequest.Validate if the designated constraints aren't met.
+type ListItemTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemTypesRequestValidationError) ErrorName() string {
+	return "ListItemTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListItemTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemTypesRequestValidationError{}
+
+// Validate checks the field values on ListItemTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListItemTypesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListItemTypesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListItemTypesResponseValidationError is the validation error returned by
+// ListItemTypesResponse.Validate if the designated constraints aren't met.
+type ListItemTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemTypesResponseValidationError) ErrorName() string {
+	return "ListItemTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListItemTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemTypesResponseValidationError{}
+
+// Validate checks the field values on Loadout with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Loadout) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// LoadoutValidationError is the validation error returned by Loadout.Validate
+// if the designated constraints aren't met.
+type LoadoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoadoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoadoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoadoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoadoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoadoutValidationError) ErrorName() string { return "LoadoutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoadoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoadout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoadoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoadoutValidationError{}
+
+// Validate checks the field values on CreateLoadoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateLoadoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// CreateLoadoutRequestValidationError is the validation error returned by
+// CreateLoadoutRequest.Validate if the designated constraints aren't met.
+type CreateLoadoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLoadoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLoadoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLoadoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLoadoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLoadoutRequestValidationError) ErrorName() string {
+	return "CreateLoadoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLoadoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLoadoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLoadoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLoadoutRequestValidationError{}
+
+// Validate checks the field values on CreateLoadoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateLoadoutResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLoadoutResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateLoadoutResponseValidationError is the validation error returned by
+// CreateLoadoutResponse.Validate if the designated constraints aren't met.
+type CreateLoadoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateLoadoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateLoadoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateLoadoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateLoadoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateLoadoutResponseValidationError) ErrorName() string {
+	return "CreateLoadoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateLoadoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateLoadoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateLoadoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateLoadoutResponseValidationError{}
+
+// Validate checks the field values on UpdateLoadoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateLoadoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// UpdateLoadoutRequestValidationError is the validation error returned by
+// UpdateLoadoutRequest.Validate if the designated constraints aren't met.
+type UpdateLoadoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLoadoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLoadoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLoadoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLoadoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLoadoutRequestValidationError) ErrorName() string {
+	return "UpdateLoadoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLoadoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLoadoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLoadoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLoadoutRequestValidationError{}
+
+// Validate checks the field values on UpdateLoadoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateLoadoutResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLoadoutResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateLoadoutResponseValidationError is the validation error returned by
+// UpdateLoadoutResponse.Validate if the designated constraints aren't met.
+type UpdateLoadoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLoadoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLoadoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLoadoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLoadoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLoadoutResponseValidationError) ErrorName() string {
+	return "UpdateLoadoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLoadoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLoadoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLoadoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLoadoutResponseValidationError{}
+
+// Validate checks the field values on DeleteLoadoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteLoadoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteLoadoutRequestValidationError is the validation error returned by
+// DeleteLoadoutRequest.Validate if the designated constraints aren't met.
+type DeleteLoadoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLoadoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLoadoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLoadoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLoadoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLoadoutRequestValidationError) ErrorName() string {
+	return "DeleteLoadoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLoadoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLoadoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLoadoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLoadoutRequestValidationError{}
+
+// Validate checks the field values on DeleteLoadoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteLoadoutResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteLoadoutResponseValidationError is the validation error returned by
+// DeleteLoadoutResponse.Validate if the designated constraints aren't met.
+type DeleteLoadoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLoadoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLoadoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLoadoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLoadoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLoadoutResponseValidationError) ErrorName() string {
+	return "DeleteLoadoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLoadoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLoadoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLoadoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLoadoutResponseValidationError{}
+
+// Validate checks the field values on ActivateLoadoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ActivateLoadoutRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// ActivateLoadoutRequestValidationError is the validation error returned by
+// ActivateLoadoutRequest.Validate if the designated constraints aren't met.
+type ActivateLoadoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateLoadoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateLoadoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateLoadoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateLoadoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateLoadoutRequestValidationError) ErrorName() string {
+	return "ActivateLoadoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateLoadoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateLoadoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateLoadoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateLoadoutRequestValidationError{}
+
+// Validate checks the field values on ActivateLoadoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ActivateLoadoutResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ActivateLoadoutResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ActivateLoadoutResponseValidationError is the validation error returned by
+// ActivateLoadoutResponse.Validate if the designated constraints aren't met.
+type ActivateLoadoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateLoadoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateLoadoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateLoadoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateLoadoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateLoadoutResponseValidationError) ErrorName() string {
+	return "ActivateLoadoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateLoadoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateLoadoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateLoadoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateLoadoutResponseValidationError{}
+
+// Validate checks the field values on ListLoadoutsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListLoadoutsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// ListLoadoutsRequestValidationError is the validation error returned by
+// ListLoadoutsRequest.Validate if the designated constraints aren't met.
+type ListLoadoutsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoadoutsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoadoutsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoadoutsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoadoutsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoadoutsRequestValidationError) ErrorName() string {
+	return "ListLoadoutsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLoadoutsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoadoutsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoadoutsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoadoutsRequestValidationError{}
+
+// Validate checks the field values on ListLoadoutsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListLoadoutsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLoadoutsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListLoadoutsResponseValidationError is the validation error returned by
+// ListLoadoutsResponse.Validate if the designated constraints aren't met.
+type ListLoadoutsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoadoutsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoadoutsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoadoutsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoadoutsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoadoutsResponseValidationError) ErrorName() string {
+	return "ListLoadoutsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLoadoutsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoadoutsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoadoutsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoadoutsResponseValidationError{}
+
+// Validate checks the field values on InventoryEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *InventoryEntry) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for CoinsPrice
+
+	// no validation rules for GemsPrice
+
+	// no validation rules for ImageId
+
+	// no validation rules for OnSale
+
+	// no validation rules for SaleCoinsPrice
+
+	// no validation rules for SaleGemsPrice
+
+	// no validation rules for Consumable
+
+	// no validation rules for MaxStack
+
+	// no validation rules for Equipped
+
+	// no validation rules for Quantity
+
+	if v, ok := interface{}(m.GetAcquiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryEntryValidationError{
+				field:  "AcquiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryEntryValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RentalDays
+
+	// no validation rules for RentalCoinsPrice
+
+	// no validation rules for RentalGemsPrice
+
+	// no validation rules for Sku
+
+	// no validation rules for Retired
+
+	return nil
+}
+
+// InventoryEntryValidationError is the validation error returned by
+// InventoryEntry.Validate if the designated constraints aren't met.
+type InventoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InventoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InventoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InventoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InventoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InventoryEntryValidationError) ErrorName() string { return "InventoryEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InventoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInventoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InventoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InventoryEntryValidationError{}
+
+// Validate checks the field values on InventoryItem with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *InventoryItem) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryItemValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Equipped
+
+	// no validation rules for Quantity
+
+	if v, ok := interface{}(m.GetAcquiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryItemValidationError{
+				field:  "AcquiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryItemValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// InventoryItemValidationError is the validation error returned by
+// InventoryItem.Validate if the designated constraints aren't met.
+type InventoryItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InventoryItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InventoryItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InventoryItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InventoryItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InventoryItemValidationError) ErrorName() string { return "InventoryItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InventoryItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInventoryItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InventoryItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InventoryItemValidationError{}
+
+// Validate checks the field values on ListUserInventoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUserInventoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserInventoryRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserInventoryRequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserInventoryRequestValidationError{
+				field:  "Paging",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListUserInventoryRequestValidationError is the validation error returned by
+// ListUserInventoryRequest.Validate if the designated constraints aren't met.
+type ListUserInventoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserInventoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserInventoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserInventoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserInventoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserInventoryRequestValidationError) ErrorName() string {
+	return "ListUserInventoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserInventoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserInventoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserInventoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserInventoryRequestValidationError{}
+
+// Validate checks the field values on ListUserInventoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUserInventoryResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserInventoryResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserInventoryResponseValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListUserInventoryResponseValidationError is the validation error returned by
+// ListUserInventoryResponse.Validate if the designated constraints aren't met.
+type ListUserInventoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserInventoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserInventoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserInventoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserInventoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserInventoryResponseValidationError) ErrorName() string {
+	return "ListUserInventoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserInventoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserInventoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserInventoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserInventoryResponseValidationError{}
+
+// Validate checks the field values on UserStats with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *UserStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Games
+
+	// no validation rules for Wins
+
+	// no validation rules for Top5
+
+	// no validation rules for Kills
+
+	return nil
+}
+
+// UserStatsValidationError is the validation error returned by
+// UserStats.Validate if the designated constraints aren't met.
+type UserStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserStatsValidationError) ErrorName() string { return "UserStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserStatsValidationError{}
+
+// Validate checks the field values on GetLeaderboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetLeaderboardRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Metric
+
+	// no validation rules for Ascending
+
+	// no validation rules for Limit
+
+	// no validation rules for Cursor
+
+	// no validation rules for AroundUser
+
+	// no validation rules for SeasonId
+
+	return nil
+}
+
+// GetLeaderboardRequestValidationError is the validation error returned by
+// GetLeaderboardRequest.Validate if the designated constraints aren't met.
+type GetLeaderboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderboardRequestValidationError) ErrorName() string {
+	return "GetLeaderboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaderboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderboardRequestValidationError{}
+
+// Validate checks the field values on LeaderboardEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *LeaderboardEntry) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Value
+
+	return nil
+}
+
+// LeaderboardEntryValidationError is the validation error returned by
+// LeaderboardEntry.Validate if the designated constraints aren't met.
+type LeaderboardEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaderboardEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaderboardEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaderboardEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaderboardEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaderboardEntryValidationError) ErrorName() string { return "LeaderboardEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaderboardEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaderboardEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaderboardEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaderboardEntryValidationError{}
+
+// Validate checks the field values on GetLeaderboardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetLeaderboardResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Metric
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLeaderboardResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	return nil
+}
+
+// GetLeaderboardResponseValidationError is the validation error returned by
+// GetLeaderboardResponse.Validate if the designated constraints aren't met.
+type GetLeaderboardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderboardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderboardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderboardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderboardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderboardResponseValidationError) ErrorName() string {
+	return "GetLeaderboardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaderboardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderboardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderboardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderboardResponseValidationError{}
+
+// Validate checks the field values on ReadUserStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadUserStatsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for SeasonId
+
+	// no validation rules for LastWeek
+
+	// no validation rules for LastMonth
+
+	// no validation rules for LastMatches
+
+	return nil
+}
+
+// ReadUserStatsRequestValidationError is the validation error returned by
+// ReadUserStatsRequest.Validate if the designated constraints aren't met.
+type ReadUserStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadUserStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadUserStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadUserStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadUserStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadUserStatsRequestValidationError) ErrorName() string {
+	return "ReadUserStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadUserStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadUserStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadUserStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadUserStatsRequestValidationError{}
+
+// Validate checks the field values on DerivedStats with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DerivedStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for KdRatio
+
+	// no validation rules for WinRate
+
+	// no validation rules for Top5Rate
+
+	// no validation rules for AvgKills
+
+	return nil
+}
+
+// DerivedStatsValidationError is the validation error returned by
+// DerivedStats.Validate if the designated constraints aren't met.
+type DerivedStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DerivedStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DerivedStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DerivedStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DerivedStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DerivedStatsValidationError) ErrorName() string { return "DerivedStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DerivedStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDerivedStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DerivedStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DerivedStatsValidationError{}
+
+// Validate checks the field values on WindowedStats with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *WindowedStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetTotals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WindowedStatsValidationError{
+				field:  "Totals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDerived()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WindowedStatsValidationError{
+				field:  "Derived",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// WindowedStatsValidationError is the validation error returned by
+// WindowedStats.Validate if the designated constraints aren't met.
+type WindowedStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WindowedStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WindowedStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WindowedStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WindowedStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WindowedStatsValidationError) ErrorName() string { return "WindowedStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WindowedStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWindowedStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WindowedStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WindowedStatsValidationError{}
+
+// Validate checks the field values on ReadUserStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadUserStatsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadUserStatsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDerived()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadUserStatsResponseValidationError{
+				field:  "Derived",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastWeek()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadUserStatsResponseValidationError{
+				field:  "LastWeek",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastMonth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadUserStatsResponseValidationError{
+				field:  "LastMonth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastMatches()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadUserStatsResponseValidationError{
+				field:  "LastMatches",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadUserStatsResponseValidationError is the validation error returned by
+// ReadUserStatsResponse.Validate if the designated constraints aren't met.
+type ReadUserStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadUserStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadUserStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadUserStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadUserStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadUserStatsResponseValidationError) ErrorName() string {
+	return "ReadUserStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadUserStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadUserStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadUserStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadUserStatsResponseValidationError{}
+
+// Validate checks the field values on UpdateUserStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateUserStatsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for AddGames
+
+	// no validation rules for AddWins
+
+	// no validation rules for AddTop5
+
+	// no validation rules for AddKills
+
+	return nil
+}
+
+// UpdateUserStatsRequestValidationError is the validation error returned by
+// UpdateUserStatsRequest.Validate if the designated constraints aren't met.
+type UpdateUserStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserStatsRequestValidationError) ErrorName() string {
+	return "UpdateUserStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserStatsRequestValidationError{}
+
+// Validate checks the field values on UpdateUserStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateUserStatsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUnlocked() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateUserStatsResponseValidationError{
+					field:  fmt.Sprintf("Unlocked[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Flagged
+
+	return nil
+}
+
+// UpdateUserStatsResponseValidationError is the validation error returned by
+// UpdateUserStatsResponse.Validate if the designated constraints aren't met.
+type UpdateUserStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserStatsResponseValidationError) ErrorName() string {
+	return "UpdateUserStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserStatsResponseValidationError{}
+
+// Validate checks the field values on BatchUpdateStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchUpdateStatsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MatchId
+
+	for idx, item := range m.GetUpdates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateStatsRequestValidationError{
+					field:  fmt.Sprintf("Updates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchUpdateStatsRequestValidationError is the validation error returned by
+// BatchUpdateStatsRequest.Validate if the designated constraints aren't met.
+type BatchUpdateStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateStatsRequestValidationError) ErrorName() string {
+	return "BatchUpdateStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateStatsRequestValidationError{}
+
+// Validate checks the field values on BatchStatsResult with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *BatchStatsResult) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for UserId
+
+	// no validation rules for Applied
+
+	// no validation rules for Flagged
+
+	for idx, item := range m.GetUnlocked() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchStatsResultValidationError{
+					field:  fmt.Sprintf("Unlocked[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchStatsResultValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// BatchStatsResultValidationError is the validation error returned by
+// BatchStatsResult.Validate if the designated constraints aren't met.
+type BatchStatsResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchStatsResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchStatsResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchStatsResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchStatsResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchStatsResultValidationError) ErrorName() string { return "BatchStatsResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchStatsResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchStatsResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchStatsResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchStatsResultValidationError{}
+
+// Validate checks the field values on BatchUpdateStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchUpdateStatsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateStatsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Replayed
+
+	return nil
+}
+
+// BatchUpdateStatsResponseValidationError is the validation error returned by
+// BatchUpdateStatsResponse.Validate if the designated constraints aren't met.
+type BatchUpdateStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateStatsResponseValidationError) ErrorName() string {
+	return "BatchUpdateStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateStatsResponseValidationError{}
+
+// Validate checks the field values on FlaggedStatUpdate with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FlaggedStatUpdate) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for AddGames
+
+	// no validation rules for AddWins
+
+	// no validation rules for AddTop5
+
+	// no validation rules for AddKills
+
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlaggedStatUpdateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// FlaggedStatUpdateValidationError is the validation error returned by
+// FlaggedStatUpdate.Validate if the designated constraints aren't met.
+type FlaggedStatUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlaggedStatUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlaggedStatUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlaggedStatUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlaggedStatUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlaggedStatUpdateValidationError) ErrorName() string {
+	return "FlaggedStatUpdateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlaggedStatUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlaggedStatUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlaggedStatUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlaggedStatUpdateValidationError{}
+
+// Validate checks the field values on ListFlaggedStatUpdatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListFlaggedStatUpdatesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for IncludeResolved
+
+	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFlaggedStatUpdatesRequestValidationError{
+				field:  "Paging",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListFlaggedStatUpdatesRequestValidationError is the validation error
+// returned by ListFlaggedStatUpdatesRequest.Validate if the designated
+// constraints aren't met.
+type ListFlaggedStatUpdatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlaggedStatUpdatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlaggedStatUpdatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlaggedStatUpdatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlaggedStatUpdatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlaggedStatUpdatesRequestValidationError) ErrorName() string {
+	return "ListFlaggedStatUpdatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlaggedStatUpdatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlaggedStatUpdatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlaggedStatUpdatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlaggedStatUpdatesRequestValidationError{}
+
+// Validate checks the field values on ListFlaggedStatUpdatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListFlaggedStatUpdatesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFlaggedStatUpdatesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFlaggedStatUpdatesResponseValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListFlaggedStatUpdatesResponseValidationError is the validation error
+// returned by ListFlaggedStatUpdatesResponse.Validate if the designated
+// constraints aren't met.
+type ListFlaggedStatUpdatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlaggedStatUpdatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlaggedStatUpdatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlaggedStatUpdatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlaggedStatUpdatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlaggedStatUpdatesResponseValidationError) ErrorName() string {
+	return "ListFlaggedStatUpdatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlaggedStatUpdatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlaggedStatUpdatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlaggedStatUpdatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlaggedStatUpdatesResponseValidationError{}
+
+// Validate checks the field values on ClearFlaggedStatUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ClearFlaggedStatUpdateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// ClearFlaggedStatUpdateRequestValidationError is the validation error
+// returned by ClearFlaggedStatUpdateRequest.Validate if the designated
+// constraints aren't met.
+type ClearFlaggedStatUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearFlaggedStatUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearFlaggedStatUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearFlaggedStatUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearFlaggedStatUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearFlaggedStatUpdateRequestValidationError) ErrorName() string {
+	return "ClearFlaggedStatUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearFlaggedStatUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearFlaggedStatUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearFlaggedStatUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearFlaggedStatUpdateRequestValidationError{}
+
+// Validate checks the field values on ClearFlaggedStatUpdateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ClearFlaggedStatUpdateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ClearFlaggedStatUpdateResponseValidationError is the validation error
+// returned by ClearFlaggedStatUpdateResponse.Validate if the designated
+// constraints aren't met.
+type ClearFlaggedStatUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearFlaggedStatUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearFlaggedStatUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearFlaggedStatUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearFlaggedStatUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearFlaggedStatUpdateResponseValidationError) ErrorName() string {
+	return "ClearFlaggedStatUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearFlaggedStatUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearFlaggedStatUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearFlaggedStatUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearFlaggedStatUpdateResponseValidationError{}
+
+// Validate checks the field values on RollbackFlaggedStatUpdateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RollbackFlaggedStatUpdateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// RollbackFlaggedStatUpdateRequestValidationError is the validation error
+// returned by RollbackFlaggedStatUpdateRequest.Validate if the designated
+// constraints aren't met.
+type RollbackFlaggedStatUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackFlaggedStatUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackFlaggedStatUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackFlaggedStatUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackFlaggedStatUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackFlaggedStatUpdateRequestValidationError) ErrorName() string {
+	return "RollbackFlaggedStatUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackFlaggedStatUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackFlaggedStatUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackFlaggedStatUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackFlaggedStatUpdateRequestValidationError{}
+
+// Validate checks the field values on RollbackFlaggedStatUpdateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RollbackFlaggedStatUpdateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackFlaggedStatUpdateResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRevoked() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RollbackFlaggedStatUpdateResponseValidationError{
+					field:  fmt.Sprintf("Revoked[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RollbackFlaggedStatUpdateResponseValidationError is the validation error
+// returned by RollbackFlaggedStatUpdateResponse.Validate if the designated
+// constraints aren't met.
+type RollbackFlaggedStatUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackFlaggedStatUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackFlaggedStatUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackFlaggedStatUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackFlaggedStatUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackFlaggedStatUpdateResponseValidationError) ErrorName() string {
+	return "RollbackFlaggedStatUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackFlaggedStatUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackFlaggedStatUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackFlaggedStatUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackFlaggedStatUpdateResponseValidationError{}
+
+// Validate checks the field values on MatchParticipant with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MatchParticipant) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Placement
+
+	// no validation rules for Kills
+
+	return nil
+}
+
+// MatchParticipantValidationError is the validation error returned by
+// MatchParticipant.Validate if the designated constraints aren't met.
+type MatchParticipantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchParticipantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchParticipantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchParticipantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchParticipantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchParticipantValidationError) ErrorName() string { return "MatchParticipantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchParticipantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchParticipant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchParticipantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchParticipantValidationError{}
+
+// Validate checks the field values on RecordMatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RecordMatchRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MatchId
+
+	// no validation rules for Mode
+
+	// no validation rules for DurationSeconds
+
+	if v, ok := interface{}(m.GetPlayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordMatchRequestValidationError{
+				field:  "PlayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParticipants() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordMatchRequestValidationError{
+					field:  fmt.Sprintf("Participants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RecordMatchRequestValidationError is the validation error returned by
+// RecordMatchRequest.Validate if the designated constraints aren't met.
+type RecordMatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordMatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordMatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordMatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordMatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordMatchRequestValidationError) ErrorName() string {
+	return "RecordMatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordMatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordMatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordMatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordMatchRequestValidationError{}
+
+// Validate checks the field values on MatchReward with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MatchReward) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for PlacementCoins
+
+	// no validation rules for KillCoins
+
+	// no validation rules for FirstWinCoins
+
+	// no validation rules for TotalCoins
+
+	// no validation rules for Flagged
+
+	return nil
+}
+
+// MatchRewardValidationError is the validation error returned by
+// MatchReward.Validate if the designated constraints aren't met.
+type MatchRewardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchRewardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchRewardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchRewardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchRewardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchRewardValidationError) ErrorName() string { return "MatchRewardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchRewardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchReward.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchRewardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchRewardValidationError{}
+
+// Validate checks the field values on RecordMatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RecordMatchResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MatchId
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordMatchResponseValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RecordMatchResponseValidationError is the validation error returned by
+// RecordMatchResponse.Validate if the designated constraints aren't met.
+type RecordMatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordMatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordMatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordMatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordMatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordMatchResponseValidationError) ErrorName() string {
+	return "RecordMatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordMatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordMatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordMatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordMatchResponseValidationError{}
+
+// Validate checks the field values on MatchHistoryEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MatchHistoryEntry) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MatchId
+
+	// no validation rules for Mode
+
+	// no validation rules for DurationSeconds
+
+	if v, ok := interface{}(m.GetPlayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchHistoryEntryValidationError{
+				field:  "PlayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Players
+
+	// no validation rules for Placement
+
+	// no validation rules for Kills
+
+	// no validation rules for RewardCoins
+
+	return nil
+}
+
+// MatchHistoryEntryValidationError is the validation error returned by
+// MatchHistoryEntry.Validate if the designated constraints aren't met.
+type MatchHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchHistoryEntryValidationError) ErrorName() string {
+	return "MatchHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchHistoryEntryValidationError{}
+
+// Validate checks the field values on ListMatchHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListMatchHistoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListMatchHistoryRequestValidationError{
+				field:  "Paging",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListMatchHistoryRequestValidationError is the validation error returned by
+// ListMatchHistoryRequest.Validate if the designated constraints aren't met.
+type ListMatchHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMatchHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMatchHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMatchHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMatchHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMatchHistoryRequestValidationError) ErrorName() string {
+	return "ListMatchHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMatchHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMatchHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMatchHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMatchHistoryRequestValidationError{}
+
+// Validate checks the field values on ListMatchHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListMatchHistoryResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMatchHistoryResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListMatchHistoryResponseValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListMatchHistoryResponseValidationError is the validation error returned by
+// ListMatchHistoryResponse.Validate if the designated constraints aren't met.
+type ListMatchHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMatchHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMatchHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMatchHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMatchHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMatchHistoryResponseValidationError) ErrorName() string {
+	return "ListMatchHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMatchHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMatchHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMatchHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMatchHistoryResponseValidationError{}
+
+// Validate checks the field values on RebuildStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RebuildStatsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	return nil
+}
+
+// RebuildStatsRequestValidationError is the validation error returned by
+// RebuildStatsRequest.Validate if the designated constraints aren't met.
+type RebuildStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildStatsRequestValidationError) ErrorName() string {
+	return "RebuildStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildStatsRequestValidationError{}
+
+// Validate checks the field values on RebuildStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RebuildStatsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RebuiltUsers
+
+	return nil
+}
+
+// RebuildStatsResponseValidationError is the validation error returned by
+// RebuildStatsResponse.Validate if the designated constraints aren't met.
+type RebuildStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildStatsResponseValidationError) ErrorName() string {
+	return "RebuildStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildStatsResponseValidationError{}
+
+// Validate checks the field values on Season with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Season) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Archived
+
+	return nil
+}
+
+// SeasonValidationError is the validation error returned by Season.Validate if
+// the designated constraints aren't met.
+type SeasonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeasonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeasonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeasonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeasonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeasonValidationError) ErrorName() string { return "SeasonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeasonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeason.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeasonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeasonValidationError{}
+
+// Validate checks the field values on CreateSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateSeasonRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateSeasonRequestValidationError is the validation error returned by
+// CreateSeasonRequest.Validate if the designated constraints aren't met.
+type CreateSeasonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSeasonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSeasonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSeasonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSeasonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSeasonRequestValidationError) ErrorName() string {
+	return "CreateSeasonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSeasonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSeasonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSeasonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSeasonRequestValidationError{}
+
+// Validate checks the field values on CreateSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateSeasonResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSeasonResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateSeasonResponseValidationError is the validation error returned by
+// CreateSeasonResponse.Validate if the designated constraints aren't met.
+type CreateSeasonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSeasonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSeasonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSeasonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSeasonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSeasonResponseValidationError) ErrorName() string {
+	return "CreateSeasonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSeasonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSeasonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSeasonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSeasonResponseValidationError{}
+
+// Validate checks the field values on ListSeasonsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSeasonsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListSeasonsRequestValidationError is the validation error returned by
+// ListSeasonsRequest.Validate if the designated constraints aren't met.
+type ListSeasonsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonsRequestValidationError) ErrorName() string {
+	return "ListSeasonsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonsRequestValidationError{}
+
+// Validate checks the field values on ListSeasonsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSeasonsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSeasonsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListSeasonsResponseValidationError is the validation error returned by
+// ListSeasonsResponse.Validate if the designated constraints aren't met.
+type ListSeasonsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSeasonsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSeasonsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSeasonsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSeasonsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSeasonsResponseValidationError) ErrorName() string {
+	return "ListSeasonsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSeasonsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSeasonsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSeasonsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSeasonsResponseValidationError{}
+
+// Validate checks the field values on Rating with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Rating) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Rating
+
+	// no validation rules for Deviation
+
+	// no validation rules for Volatility
+
+	// no validation rules for Games
+
+	if v, ok := interface{}(m.GetLastPlayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RatingValidationError{
+				field:  "LastPlayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RatingValidationError is the validation error returned by Rating.Validate if
+// the designated constraints aren't met.
+type RatingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingValidationError) ErrorName() string { return "RatingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRating.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingValidationError{}
+
+// Validate checks the field values on RatingHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RatingHistoryEntry) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MatchId
+
+	// no validation rules for Rating
+
+	// no validation rules for Deviation
+
+	// no validation rules for Volatility
+
+	if v, ok := interface{}(m.GetPlayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RatingHistoryEntryValidationError{
+				field:  "PlayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RatingHistoryEntryValidationError is the validation error returned by
+// RatingHistoryEntry.Validate if the designated constraints aren't met.
+type RatingHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingHistoryEntryValidationError) ErrorName() string {
+	return "RatingHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RatingHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRatingHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingHistoryEntryValidationError{}
+
+// Validate checks the field values on GetRatingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetRatingRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for HistoryLimit
+
+	return nil
+}
+
+// GetRatingRequestValidationError is the validation error returned by
+// GetRatingRequest.Validate if the designated constraints aren't met.
+type GetRatingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingRequestValidationError) ErrorName() string { return "GetRatingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRatingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingRequestValidationError{}
+
+// Validate checks the field values on GetRatingResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetRatingResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRatingResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRatingResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetRatingResponseValidationError is the validation error returned by
+// GetRatingResponse.Validate if the designated constraints aren't met.
+type GetRatingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingResponseValidationError) ErrorName() string {
+	return "GetRatingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRatingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingResponseValidationError{}
+
+// Validate checks the field values on GetRatingsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetRatingsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetRatingsRequestValidationError is the validation error returned by
+// GetRatingsRequest.Validate if the designated constraints aren't met.
+type GetRatingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingsRequestValidationError) ErrorName() string {
+	return "GetRatingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRatingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingsRequestValidationError{}
+
+// Validate checks the field values on GetRatingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRatingsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRatingsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetRatingsResponseValidationError is the validation error returned by
+// GetRatingsResponse.Validate if the designated constraints aren't met.
+type GetRatingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingsResponseValidationError) ErrorName() string {
+	return "GetRatingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRatingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingsResponseValidationError{}
+
+// Validate checks the field values on Achievement with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Achievement) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Metric
+
+	// no validation rules for Scope
+
+	// no validation rules for Threshold
+
+	// no validation rules for RewardCoins
+
+	// no validation rules for RewardGems
+
+	// no validation rules for RewardItemId
+
+	return nil
+}
+
+// AchievementValidationError is the validation error returned by
+// Achievement.Validate if the designated constraints aren't met.
+type AchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AchievementValidationError) ErrorName() string { return "AchievementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAchievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AchievementValidationError{}
+
+// Validate checks the field values on CreateAchievementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAchievementRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Metric
+
+	// no validation rules for Scope
+
+	// no validation rules for Threshold
+
+	// no validation rules for RewardCoins
+
+	// no validation rules for RewardGems
+
+	// no validation rules for RewardItemId
+
+	return nil
+}
+
+// CreateAchievementRequestValidationError is the validation error returned by
+// CreateAchievementRequest.Validate if the designated constraints aren't met.
+type CreateAchievementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAchievementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAchievementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAchievementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAchievementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAchievementRequestValidationError) ErrorName() string {
+	return "CreateAchievementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAchievementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAchievementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAchievementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAchievementRequestValidationError{}
+
+// Validate checks the field values on CreateAchievementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAchievementResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAchievementResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateAchievementResponseValidationError is the validation error returned by
+// CreateAchievementResponse.Validate if the designated constraints aren't met.
+type CreateAchievementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAchievementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAchievementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAchievementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAchievementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAchievementResponseValidationError) ErrorName() string {
+	return "CreateAchievementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAchievementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAchievementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAchievementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAchievementResponseValidationError{}
+
+// Validate checks the field values on DeleteAchievementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteAchievementRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteAchievementRequestValidationError is the validation error returned by
+// DeleteAchievementRequest.Validate if the designated constraints aren't met.
+type DeleteAchievementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAchievementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAchievementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAchievementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAchievementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAchievementRequestValidationError) ErrorName() string {
+	return "DeleteAchievementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAchievementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAchievementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAchievementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAchievementRequestValidationError{}
+
+// Validate checks the field values on DeleteAchievementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteAchievementResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteAchievementResponseValidationError is the validation error returned by
+// DeleteAchievementResponse.Validate if the designated constraints aren't met.
+type DeleteAchievementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAchievementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAchievementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAchievementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAchievementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAchievementResponseValidationError) ErrorName() string {
+	return "DeleteAchievementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAchievementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAchievementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAchievementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAchievementResponseValidationError{}
+
+// Validate checks the field values on ListAchievementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAchievementsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListAchievementsRequestValidationError is the validation error returned by
+// ListAchievementsRequest.Validate if the designated constraints aren't met.
+type ListAchievementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAchievementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAchievementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAchievementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAchievementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAchievementsRequestValidationError) ErrorName() string {
+	return "ListAchievementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAchievementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAchievementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAchievementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAchievementsRequestValidationError{}
+
+// Validate checks the field values on ListAchievementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAchievementsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAchievementsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListAchievementsResponseValidationError is the validation error returned by
+// ListAchievementsResponse.Validate if the designated constraints aren't met.
+type ListAchievementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAchievementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAchievementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAchievementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAchievementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAchievementsResponseValidationError) ErrorName() string {
+	return "ListAchievementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAchievementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAchievementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAchievementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAchievementsResponseValidationError{}
+
+// Validate checks the field values on UserAchievement with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UserAchievement) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetAchievement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserAchievementValidationError{
+				field:  "Achievement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Progress
+
+	// no validation rules for Unlocked
+
+	if v, ok := interface{}(m.GetUnlockedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserAchievementValidationError{
+				field:  "UnlockedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UserAchievementValidationError is the validation error returned by
+// UserAchievement.Validate if the designated constraints aren't met.
+type UserAchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAchievementValidationError) ErrorName() string { return "UserAchievementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserAchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAchievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAchievementValidationError{}
+
+// Validate checks the field values on GetUserAchievementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetUserAchievementsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Username
+
+	return nil
+}
+
+// GetUserAchievementsRequestValidationError is the validation error returned
+// by GetUserAchievementsRequest.Validate if the designated constraints aren't met.
+type GetUserAchievementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserAchievementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserAchievementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserAchievementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserAchievementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserAchievementsRequestValidationError) ErrorName() string {
+	return "GetUserAchievementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserAchievementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserAchievementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserAchievementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserAchievementsRequestValidationError{}
+
+// Validate checks the field values on GetUserAchievementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetUserAchievementsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserAchievementsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetUserAchievementsResponseValidationError is the validation error returned
+// by GetUserAchievementsResponse.Validate if the designated constraints
+// aren't met.
+type GetUserAchievementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserAchievementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserAchievementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserAchievementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserAchievementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserAchievementsResponseValidationError) ErrorName() string {
+	return "GetUserAchievementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserAchievementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserAchievementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserAchievementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserAchievementsResponseValidationError{}
+
+// Validate checks the field values on News with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *News) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NewsValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ImageLink
+
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NewsValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPublishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NewsValidationError{
+				field:  "PublishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewsValidationError is the validation error returned by News.Validate if the
+// designated constraints aren't met.
+type NewsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsValidationError) ErrorName() string { return "NewsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNews.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsValidationError{}
+
+// Validate checks the field values on CreateNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *CreateNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ImageLink
+
+	if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNewsRequestValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Publish
+
+	return nil
+}
+
+// CreateNewsRequestValidationError is the validation error returned by
+// CreateNewsRequest.Validate if the designated constraints aren't met.
+type CreateNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNewsRequestValidationError) ErrorName() string {
+	return "CreateNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNewsRequestValidationError{}
+
+// Validate checks the field values on CreateNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNewsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateNewsResponseValidationError is the validation error returned by
+// CreateNewsResponse.Validate if the designated constraints aren't met.
+type CreateNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNewsResponseValidationError) ErrorName() string {
+	return "CreateNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNewsResponseValidationError{}
+
+// Validate checks the field values on ReadNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// ReadNewsRequestValidationError is the validation error returned by
+// ReadNewsRequest.Validate if the designated constraints aren't met.
+type ReadNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadNewsRequestValidationError) ErrorName() string { return "ReadNewsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadNewsRequestValidationError{}
+
+// Validate checks the field values on ReadNewsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadNewsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadNewsResponseValidationError is the validation error returned by
+// ReadNewsResponse.Validate if the designated constraints aren't met.
+type ReadNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadNewsResponseValidationError) ErrorName() string { return "ReadNewsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadNewsResponseValidationError{}
+
+// Validate checks the field values on UpdateNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UpdateNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ImageLink
+
+	return nil
+}
+
+// UpdateNewsRequestValidationError is the validation error returned by
+// UpdateNewsRequest.Validate if the designated constraints aren't met.
+type UpdateNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNewsRequestValidationError) ErrorName() string {
+	return "UpdateNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNewsRequestValidationError{}
+
+// Validate checks the field values on UpdateNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// UpdateNewsResponseValidationError is the validation error returned by
+// UpdateNewsResponse.Validate if the designated constraints aren't met.
+type UpdateNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNewsResponseValidationError) ErrorName() string {
+	return "UpdateNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNewsResponseValidationError{}
+
+// Validate checks the field values on DeleteNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeleteNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteNewsRequestValidationError is the validation error returned by
+// DeleteNewsRequest.Validate if the designated constraints aren't met.
+type DeleteNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNewsRequestValidationError) ErrorName() string {
+	return "DeleteNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNewsRequestValidationError{}
+
+// Validate checks the field values on DeleteNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteNewsResponseValidationError is the validation error returned by
+// DeleteNewsResponse.Validate if the designated constraints aren't met.
+type DeleteNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNewsResponseValidationError) ErrorName() string {
+	return "DeleteNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNewsResponseValidationError{}
+
+// Validate checks the field values on PublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PublishNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishNewsRequestValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PublishNewsRequestValidationError is the validation error returned by
+// PublishNewsRequest.Validate if the designated constraints aren't met.
+type PublishNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishNewsRequestValidationError) ErrorName() string {
+	return "PublishNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishNewsRequestValidationError{}
+
+// Validate checks the field values on PublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PublishNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishNewsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PublishNewsResponseValidationError is the validation error returned by
+// PublishNewsResponse.Validate if the designated constraints aren't met.
+type PublishNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishNewsResponseValidationError) ErrorName() string {
+	return "PublishNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishNewsResponseValidationError{}
+
+// Validate checks the field values on UnpublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UnpublishNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Archive
+
+	return nil
+}
+
+// UnpublishNewsRequestValidationError is the validation error returned by
+// UnpublishNewsRequest.Validate if the designated constraints aren't met.
+type UnpublishNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishNewsRequestValidationError) ErrorName() string {
+	return "UnpublishNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishNewsRequestValidationError{}
+
+// Validate checks the field values on UnpublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UnpublishNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnpublishNewsResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnpublishNewsResponseValidationError is the validation error returned by
+// UnpublishNewsResponse.Validate if the designated constraints aren't met.
+type UnpublishNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishNewsResponseValidationError) ErrorName() string {
+	return "UnpublishNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishNewsResponseValidationError{}
+
+// Validate checks the field values on ListNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListNewsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNewsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNewsRequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNewsRequestValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNewsRequestValidationError{
+				field:  "Paging",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	return nil
+}
+
+// ListNewsRequestValidationError is the validation error returned by
+// ListNewsRequest.Validate if the designated constraints aren't met.
+type ListNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNewsRequestValidationError) ErrorName() string { return "ListNewsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNewsRequestValidationError{}
+
+// Validate checks the field values on ListNewsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListNewsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNewsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListNewsResponseValidationError{
+				field:  "Page",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	return nil
+}
+
+// ListNewsResponseValidationError is the validation error returned by
+// ListNewsResponse.Validate if the designated constraints aren't met.
+type ListNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNewsResponseValidationError) ErrorName() string { return "ListNewsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNewsResponseValidationError{}
+
+// Validate checks the field values on NewsTranslation with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *NewsTranslation) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NewsId
+
+	// no validation rules for Locale
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	return nil
+}
+
+// NewsTranslationValidationError is the validation error returned by
+// NewsTranslation.Validate if the designated constraints aren't met.
+type NewsTranslationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsTranslationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsTranslationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsTranslationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsTranslationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsTranslationValidationError) ErrorName() string { return "NewsTranslationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsTranslationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewsTranslation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsTranslationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsTranslationValidationError{}
+
+// Validate checks the field values on SetNewsTranslationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetNewsTranslationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NewsId
+
+	// no validation rules for Locale
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	return nil
+}
+
+// SetNewsTranslationRequestValidationError is the validation error returned by
+// SetNewsTranslationRequest.Validate if the designated constraints aren't met.
+type SetNewsTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNewsTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNewsTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNewsTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNewsTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNewsTranslationRequestValidationError) ErrorName() string {
+	return "SetNewsTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNewsTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNewsTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNewsTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNewsTranslationRequestValidationError{}
+
+// Validate checks the field values on SetNewsTranslationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetNewsTranslationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetNewsTranslationResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetNewsTranslationResponseValidationError is the validation error returned
+// by SetNewsTranslationResponse.Validate if the designated constraints aren't met.
+type SetNewsTranslationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNewsTranslationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNewsTranslationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNewsTranslationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNewsTranslationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNewsTranslationResponseValidationError) ErrorName() string {
+	return "SetNewsTranslationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNewsTranslationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNewsTranslationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNewsTranslationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNewsTranslationResponseValidationError{}
+
+// Validate checks the field values on DeleteNewsTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteNewsTranslationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NewsId
+
+	// no validation rules for Locale
+
+	return nil
+}
+
+// DeleteNewsTranslationRequestValidationError is the validation error returned
+// by DeleteNewsTranslationRequest.Validate if the designated constraints
+// aren't met.
+type DeleteNewsTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNewsTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNewsTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNewsTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNewsTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNewsTranslationRequestValidationError) ErrorName() string {
+	return "DeleteNewsTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNewsTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNewsTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNewsTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNewsTranslationRequestValidationError{}
+
+// Validate checks the field values on DeleteNewsTranslationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteNewsTranslationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteNewsTranslationResponseValidationError is the validation error
+// returned by DeleteNewsTranslationResponse.Validate if the designated
+// constraints aren't met.
+type DeleteNewsTranslationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNewsTranslationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNewsTranslationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNewsTranslationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNewsTranslationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNewsTranslationResponseValidationError) ErrorName() string {
+	return "DeleteNewsTranslationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNewsTranslationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNewsTranslationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNewsTranslationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNewsTranslationResponseValidationError{}
+
+// Validate checks the field values on StorefrontSlot with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StorefrontSlot) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ItemsCount
+
+	for idx, item := range m.GetPool() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorefrontSlotValidationError{
+					field:  fmt.Sprintf("Pool[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// StorefrontSlotValidationError is the validation error returned by
+// StorefrontSlot.Validate if the designated constraints aren't met.
+type StorefrontSlotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorefrontSlotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorefrontSlotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorefrontSlotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorefrontSlotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorefrontSlotValidationError) ErrorName() string { return "StorefrontSlotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorefrontSlotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorefrontSlot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorefrontSlotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorefrontSlotValidationError{}
+
+// Validate checks the field values on StorefrontPoolEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StorefrontPoolEntry) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for ItemId
+
+	// no validation rules for Weight
+
+	return nil
+}
+
+// StorefrontPoolEntryValidationError is the validation error returned by
+// StorefrontPoolEntry.Validate if the designated constraints aren't met.
+type StorefrontPoolEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorefrontPoolEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorefrontPoolEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorefrontPoolEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorefrontPoolEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorefrontPoolEntryValidationError) ErrorName() string {
+	return "StorefrontPoolEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorefrontPoolEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorefrontPoolEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorefrontPoolEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorefrontPoolEntryValidationError{}
+
+// Validate checks the field values on StorefrontRotationSlot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StorefrontRotationSlot) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SlotId
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StorefrontRotationSlotValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
+	}
+
+	return nil
+}
+
+// StorefrontRotationSlotValidationError is the validation error returned by
+// StorefrontRotationSlot.Validate if the designated constraints aren't met.
+type StorefrontRotationSlotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorefrontRotationSlotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorefrontRotationSlotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorefrontRotationSlotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorefrontRotationSlotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorefrontRotationSlotValidationError) ErrorName() string {
+	return "StorefrontRotationSlotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorefrontRotationSlotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorefrontRotationSlot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorefrontRotationSlotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorefrontRotationSlotValidationError{}
+
+// Validate checks the field values on GetStorefrontRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetStorefrontRequest) Validate() error {
+	if m == nil {
+		return nil
 	}
 
 	return nil
 }
 
-// ListStoreItemsRequestValidationError is the validation error returned by
-// ListStoreItemsRequest.Validate if the designated constraints aren't met.
-type ListStoreItemsRequestValidationError struct {
+// GetStorefrontRequestValidationError is the validation error returned by
+// GetStorefrontRequest.Validate if the designated constraints aren't met.
+type GetStorefrontRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2152,24 +13307,24 @@ type ListStoreItemsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStoreItemsRequestValidationError) Field() string { return e.field }
+func (e GetStorefrontRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStoreItemsRequestValidationError) Reason() string { return e.reason }
+func (e GetStorefrontRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStoreItemsRequestValidationError) Cause() error { return e.cause }
+func (e GetStorefrontRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStoreItemsRequestValidationError) Key() bool { return e.key }
+func (e GetStorefrontRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStoreItemsRequestValidationError) ErrorName() string {
-	return "ListStoreItemsRequestValidationError"
+func (e GetStorefrontRequestValidationError) ErrorName() string {
+	return "GetStorefrontRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStoreItemsRequestValidationError) Error() string {
+func (e GetStorefrontRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2181,14 +13336,14 @@ func (e ListStoreItemsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStoreItemsRequest.%s: %s%s",
+		"invalid %sGetStorefrontRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStoreItemsRequestValidationError{}
+var _ error = GetStorefrontRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2196,23 +13351,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStoreItemsRequestValidationError{}
+} = GetStorefrontRequestValidationError{}
 
-// Validate checks the field values on ListStoreItemsResponse with the rules
+// Validate checks the field values on GetStorefrontResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListStoreItemsResponse) Validate() error {
+func (m *GetStorefrontResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetResults() {
+	// no validation rules for Day
+
+	for idx, item := range m.GetSlots() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListStoreItemsResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+				return GetStorefrontResponseValidationError{
+					field:  fmt.Sprintf("Slots[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2221,22 +13378,24 @@ func (m *ListStoreItemsResponse) Validate() error {
 
 	}
 
-	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetNextResetAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListStoreItemsResponseValidationError{
-				field:  "Page",
+			return GetStorefrontResponseValidationError{
+				field:  "NextResetAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for SecondsUntilReset
+
 	return nil
 }
 
-// ListStoreItemsResponseValidationError is the validation error returned by
-// ListStoreItemsResponse.Validate if the designated constraints aren't met.
-type ListStoreItemsResponseValidationError struct {
+// GetStorefrontResponseValidationError is the validation error returned by
+// GetStorefrontResponse.Validate if the designated constraints aren't met.
+type GetStorefrontResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2244,24 +13403,24 @@ type ListStoreItemsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStoreItemsResponseValidationError) Field() string { return e.field }
+func (e GetStorefrontResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStoreItemsResponseValidationError) Reason() string { return e.reason }
+func (e GetStorefrontResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStoreItemsResponseValidationError) Cause() error { return e.cause }
+func (e GetStorefrontResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStoreItemsResponseValidationError) Key() bool { return e.key }
+func (e GetStorefrontResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStoreItemsResponseValidationError) ErrorName() string {
-	return "ListStoreItemsResponseValidationError"
+func (e GetStorefrontResponseValidationError) ErrorName() string {
+	return "GetStorefrontResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStoreItemsResponseValidationError) Error() string {
+func (e GetStorefrontResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2273,14 +13432,14 @@ func (e ListStoreItemsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStoreItemsResponse.%s: %s%s",
+		"invalid %sGetStorefrontResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStoreItemsResponseValidationError{}
+var _ error = GetStorefrontResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2288,26 +13447,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStoreItemsResponseValidationError{}
+} = GetStorefrontResponseValidationError{}
 
-// Validate checks the field values on BuyByUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *BuyByUserRequest) Validate() error {
+// Validate checks the field values on PreviewStorefrontRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PreviewStorefrontRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
-
-	// no validation rules for ItemId
+	// no validation rules for Day
 
 	return nil
 }
 
-// BuyByUserRequestValidationError is the validation error returned by
-// BuyByUserRequest.Validate if the designated constraints aren't met.
-type BuyByUserRequestValidationError struct {
+// PreviewStorefrontRequestValidationError is the validation error returned by
+// PreviewStorefrontRequest.Validate if the designated constraints aren't met.
+type PreviewStorefrontRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2315,22 +13472,24 @@ type BuyByUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BuyByUserRequestValidationError) Field() string { return e.field }
+func (e PreviewStorefrontRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BuyByUserRequestValidationError) Reason() string { return e.reason }
+func (e PreviewStorefrontRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BuyByUserRequestValidationError) Cause() error { return e.cause }
+func (e PreviewStorefrontRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BuyByUserRequestValidationError) Key() bool { return e.key }
+func (e PreviewStorefrontRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BuyByUserRequestValidationError) ErrorName() string { return "BuyByUserRequestValidationError" }
+func (e PreviewStorefrontRequestValidationError) ErrorName() string {
+	return "PreviewStorefrontRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BuyByUserRequestValidationError) Error() string {
+func (e PreviewStorefrontRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2342,14 +13501,14 @@ func (e BuyByUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBuyByUserRequest.%s: %s%s",
+		"invalid %sPreviewStorefrontRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BuyByUserRequestValidationError{}
+var _ error = PreviewStorefrontRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2357,22 +13516,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BuyByUserRequestValidationError{}
+} = PreviewStorefrontRequestValidationError{}
 
-// Validate checks the field values on BuyByUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *BuyByUserResponse) Validate() error {
+// Validate checks the field values on PreviewStorefrontResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PreviewStorefrontResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for Day
+
+	for idx, item := range m.GetSlots() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewStorefrontResponseValidationError{
+					field:  fmt.Sprintf("Slots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// BuyByUserResponseValidationError is the validation error returned by
-// BuyByUserResponse.Validate if the designated constraints aren't met.
-type BuyByUserResponseValidationError struct {
+// PreviewStorefrontResponseValidationError is the validation error returned by
+// PreviewStorefrontResponse.Validate if the designated constraints aren't met.
+type PreviewStorefrontResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2380,24 +13556,24 @@ type BuyByUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BuyByUserResponseValidationError) Field() string { return e.field }
+func (e PreviewStorefrontResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BuyByUserResponseValidationError) Reason() string { return e.reason }
+func (e PreviewStorefrontResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BuyByUserResponseValidationError) Cause() error { return e.cause }
+func (e PreviewStorefrontResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BuyByUserResponseValidationError) Key() bool { return e.key }
+func (e PreviewStorefrontResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BuyByUserResponseValidationError) ErrorName() string {
-	return "BuyByUserResponseValidationError"
+func (e PreviewStorefrontResponseValidationError) ErrorName() string {
+	return "PreviewStorefrontResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BuyByUserResponseValidationError) Error() string {
+func (e PreviewStorefrontResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2409,14 +13585,14 @@ func (e BuyByUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBuyByUserResponse.%s: %s%s",
+		"invalid %sPreviewStorefrontResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BuyByUserResponseValidationError{}
+var _ error = PreviewStorefrontResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2424,26 +13600,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BuyByUserResponseValidationError{}
+} = PreviewStorefrontResponseValidationError{}
 
-// Validate checks the field values on ThrowAwayByUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateStorefrontSlotRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ThrowAwayByUserRequest) Validate() error {
+func (m *CreateStorefrontSlotRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	// no validation rules for Name
 
-	// no validation rules for ItemId
+	// no validation rules for ItemsCount
+
+	for idx, item := range m.GetPool() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateStorefrontSlotRequestValidationError{
+					field:  fmt.Sprintf("Pool[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// ThrowAwayByUserRequestValidationError is the validation error returned by
-// ThrowAwayByUserRequest.Validate if the designated constraints aren't met.
-type ThrowAwayByUserRequestValidationError struct {
+// CreateStorefrontSlotRequestValidationError is the validation error returned
+// by CreateStorefrontSlotRequest.Validate if the designated constraints
+// aren't met.
+type CreateStorefrontSlotRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2451,24 +13643,24 @@ type ThrowAwayByUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ThrowAwayByUserRequestValidationError) Field() string { return e.field }
+func (e CreateStorefrontSlotRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ThrowAwayByUserRequestValidationError) Reason() string { return e.reason }
+func (e CreateStorefrontSlotRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ThrowAwayByUserRequestValidationError) Cause() error { return e.cause }
+func (e CreateStorefrontSlotRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ThrowAwayByUserRequestValidationError) Key() bool { return e.key }
+func (e CreateStorefrontSlotRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ThrowAwayByUserRequestValidationError) ErrorName() string {
-	return "ThrowAwayByUserRequestValidationError"
+func (e CreateStorefrontSlotRequestValidationError) ErrorName() string {
+	return "CreateStorefrontSlotRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ThrowAwayByUserRequestValidationError) Error() string {
+func (e CreateStorefrontSlotRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2480,14 +13672,14 @@ func (e ThrowAwayByUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sThrowAwayByUserRequest.%s: %s%s",
+		"invalid %sCreateStorefrontSlotRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ThrowAwayByUserRequestValidationError{}
+var _ error = CreateStorefrontSlotRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2495,22 +13687,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ThrowAwayByUserRequestValidationError{}
+} = CreateStorefrontSlotRequestValidationError{}
 
-// Validate checks the field values on ThrowAwayByUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateStorefrontSlotResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ThrowAwayByUserResponse) Validate() error {
+func (m *CreateStorefrontSlotResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateStorefrontSlotResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// ThrowAwayByUserResponseValidationError is the validation error returned by
-// ThrowAwayByUserResponse.Validate if the designated constraints aren't met.
-type ThrowAwayByUserResponseValidationError struct {
+// CreateStorefrontSlotResponseValidationError is the validation error returned
+// by CreateStorefrontSlotResponse.Validate if the designated constraints
+// aren't met.
+type CreateStorefrontSlotResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2518,24 +13721,24 @@ type ThrowAwayByUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ThrowAwayByUserResponseValidationError) Field() string { return e.field }
+func (e CreateStorefrontSlotResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ThrowAwayByUserResponseValidationError) Reason() string { return e.reason }
+func (e CreateStorefrontSlotResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ThrowAwayByUserResponseValidationError) Cause() error { return e.cause }
+func (e CreateStorefrontSlotResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ThrowAwayByUserResponseValidationError) Key() bool { return e.key }
+func (e CreateStorefrontSlotResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ThrowAwayByUserResponseValidationError) ErrorName() string {
-	return "ThrowAwayByUserResponseValidationError"
+func (e CreateStorefrontSlotResponseValidationError) ErrorName() string {
+	return "CreateStorefrontSlotResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ThrowAwayByUserResponseValidationError) Error() string {
+func (e CreateStorefrontSlotResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2547,14 +13750,14 @@ func (e ThrowAwayByUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sThrowAwayByUserResponse.%s: %s%s",
+		"invalid %sCreateStorefrontSlotResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ThrowAwayByUserResponseValidationError{}
+var _ error = CreateStorefrontSlotResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2562,26 +13765,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ThrowAwayByUserResponseValidationError{}
+} = CreateStorefrontSlotResponseValidationError{}
 
-// Validate checks the field values on EquipByUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateStorefrontSlotRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EquipByUserRequest) Validate() error {
+func (m *UpdateStorefrontSlotRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	// no validation rules for Id
 
-	// no validation rules for ItemId
+	// no validation rules for Name
+
+	// no validation rules for ItemsCount
+
+	for idx, item := range m.GetPool() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateStorefrontSlotRequestValidationError{
+					field:  fmt.Sprintf("Pool[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// EquipByUserRequestValidationError is the validation error returned by
-// EquipByUserRequest.Validate if the designated constraints aren't met.
-type EquipByUserRequestValidationError struct {
+// UpdateStorefrontSlotRequestValidationError is the validation error returned
+// by UpdateStorefrontSlotRequest.Validate if the designated constraints
+// aren't met.
+type UpdateStorefrontSlotRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2589,24 +13810,24 @@ type EquipByUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EquipByUserRequestValidationError) Field() string { return e.field }
+func (e UpdateStorefrontSlotRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EquipByUserRequestValidationError) Reason() string { return e.reason }
+func (e UpdateStorefrontSlotRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EquipByUserRequestValidationError) Cause() error { return e.cause }
+func (e UpdateStorefrontSlotRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EquipByUserRequestValidationError) Key() bool { return e.key }
+func (e UpdateStorefrontSlotRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EquipByUserRequestValidationError) ErrorName() string {
-	return "EquipByUserRequestValidationError"
+func (e UpdateStorefrontSlotRequestValidationError) ErrorName() string {
+	return "UpdateStorefrontSlotRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EquipByUserRequestValidationError) Error() string {
+func (e UpdateStorefrontSlotRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2618,14 +13839,14 @@ func (e EquipByUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEquipByUserRequest.%s: %s%s",
+		"invalid %sUpdateStorefrontSlotRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EquipByUserRequestValidationError{}
+var _ error = UpdateStorefrontSlotRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2633,12 +13854,12 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EquipByUserRequestValidationError{}
+} = UpdateStorefrontSlotRequestValidationError{}
 
-// Validate checks the field values on EquipByUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateStorefrontSlotResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EquipByUserResponse) Validate() error {
+func (m *UpdateStorefrontSlotResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -2646,9 +13867,10 @@ func (m *EquipByUserResponse) Validate() error {
 	return nil
 }
 
-// EquipByUserResponseValidationError is the validation error returned by
-// EquipByUserResponse.Validate if the designated constraints aren't met.
-type EquipByUserResponseValidationError struct {
+// UpdateStorefrontSlotResponseValidationError is the validation error returned
+// by UpdateStorefrontSlotResponse.Validate if the designated constraints
+// aren't met.
+type UpdateStorefrontSlotResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2656,24 +13878,24 @@ type EquipByUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EquipByUserResponseValidationError) Field() string { return e.field }
+func (e UpdateStorefrontSlotResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EquipByUserResponseValidationError) Reason() string { return e.reason }
+func (e UpdateStorefrontSlotResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EquipByUserResponseValidationError) Cause() error { return e.cause }
+func (e UpdateStorefrontSlotResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EquipByUserResponseValidationError) Key() bool { return e.key }
+func (e UpdateStorefrontSlotResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EquipByUserResponseValidationError) ErrorName() string {
-	return "EquipByUserResponseValidationError"
+func (e UpdateStorefrontSlotResponseValidationError) ErrorName() string {
+	return "UpdateStorefrontSlotResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EquipByUserResponseValidationError) Error() string {
+func (e UpdateStorefrontSlotResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2685,14 +13907,14 @@ func (e EquipByUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEquipByUserResponse.%s: %s%s",
+		"invalid %sUpdateStorefrontSlotResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EquipByUserResponseValidationError{}
+var _ error = UpdateStorefrontSlotResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2700,24 +13922,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EquipByUserResponseValidationError{}
+} = UpdateStorefrontSlotResponseValidationError{}
 
-// Validate checks the field values on GetUserItemsIdsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteStorefrontSlotRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetUserItemsIdsRequest) Validate() error {
+func (m *DeleteStorefrontSlotRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	// no validation rules for Id
 
 	return nil
 }
 
-// GetUserItemsIdsRequestValidationError is the validation error returned by
-// GetUserItemsIdsRequest.Validate if the designated constraints aren't met.
-type GetUserItemsIdsRequestValidationError struct {
+// DeleteStorefrontSlotRequestValidationError is the validation error returned
+// by DeleteStorefrontSlotRequest.Validate if the designated constraints
+// aren't met.
+type DeleteStorefrontSlotRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2725,24 +13948,24 @@ type GetUserItemsIdsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserItemsIdsRequestValidationError) Field() string { return e.field }
+func (e DeleteStorefrontSlotRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserItemsIdsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteStorefrontSlotRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserItemsIdsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteStorefrontSlotRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserItemsIdsRequestValidationError) Key() bool { return e.key }
+func (e DeleteStorefrontSlotRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserItemsIdsRequestValidationError) ErrorName() string {
-	return "GetUserItemsIdsRequestValidationError"
+func (e DeleteStorefrontSlotRequestValidationError) ErrorName() string {
+	return "DeleteStorefrontSlotRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserItemsIdsRequestValidationError) Error() string {
+func (e DeleteStorefrontSlotRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2754,14 +13977,14 @@ func (e GetUserItemsIdsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserItemsIdsRequest.%s: %s%s",
+		"invalid %sDeleteStorefrontSlotRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserItemsIdsRequestValidationError{}
+var _ error = DeleteStorefrontSlotRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2769,26 +13992,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserItemsIdsRequestValidationError{}
+} = DeleteStorefrontSlotRequestValidationError{}
 
-// Validate checks the field values on UserItemInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *UserItemInfo) Validate() error {
+// Validate checks the field values on DeleteStorefrontSlotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteStorefrontSlotResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for ItemId
-
-	// no validation rules for Equipped
-
 	return nil
-}
-
-// UserItemInfoValidationError is the validation error returned by
-// UserItemInfo.Validate if the designated constraints aren't met.
-type UserItemInfoValidationError struct {
+}
+
+// DeleteStorefrontSlotResponseValidationError is the validation error returned
+// by DeleteStorefrontSlotResponse.Validate if the designated constraints
+// aren't met.
+type DeleteStorefrontSlotResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2796,22 +14016,24 @@ type UserItemInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserItemInfoValidationError) Field() string { return e.field }
+func (e DeleteStorefrontSlotResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserItemInfoValidationError) Reason() string { return e.reason }
+func (e DeleteStorefrontSlotResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserItemInfoValidationError) Cause() error { return e.cause }
+func (e DeleteStorefrontSlotResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserItemInfoValidationError) Key() bool { return e.key }
+func (e DeleteStorefrontSlotResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserItemInfoValidationError) ErrorName() string { return "UserItemInfoValidationError" }
+func (e DeleteStorefrontSlotResponseValidationError) ErrorName() string {
+	return "DeleteStorefrontSlotResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserItemInfoValidationError) Error() string {
+func (e DeleteStorefrontSlotResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2823,14 +14045,14 @@ func (e UserItemInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserItemInfo.%s: %s%s",
+		"invalid %sDeleteStorefrontSlotResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserItemInfoValidationError{}
+var _ error = DeleteStorefrontSlotResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2838,37 +14060,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserItemInfoValidationError{}
+} = DeleteStorefrontSlotResponseValidationError{}
 
-// Validate checks the field values on GetUserItemsIdsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListStorefrontSlotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetUserItemsIdsResponse) Validate() error {
+func (m *ListStorefrontSlotsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetUserItemsIdsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// GetUserItemsIdsResponseValidationError is the validation error returned by
-// GetUserItemsIdsResponse.Validate if the designated constraints aren't met.
-type GetUserItemsIdsResponseValidationError struct {
+// ListStorefrontSlotsRequestValidationError is the validation error returned
+// by ListStorefrontSlotsRequest.Validate if the designated constraints aren't met.
+type ListStorefrontSlotsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2876,24 +14083,24 @@ type GetUserItemsIdsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserItemsIdsResponseValidationError) Field() string { return e.field }
+func (e ListStorefrontSlotsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserItemsIdsResponseValidationError) Reason() string { return e.reason }
+func (e ListStorefrontSlotsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserItemsIdsResponseValidationError) Cause() error { return e.cause }
+func (e ListStorefrontSlotsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserItemsIdsResponseValidationError) Key() bool { return e.key }
+func (e ListStorefrontSlotsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserItemsIdsResponseValidationError) ErrorName() string {
-	return "GetUserItemsIdsResponseValidationError"
+func (e ListStorefrontSlotsRequestValidationError) ErrorName() string {
+	return "ListStorefrontSlotsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserItemsIdsResponseValidationError) Error() string {
+func (e ListStorefrontSlotsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2905,14 +14112,14 @@ func (e GetUserItemsIdsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserItemsIdsResponse.%s: %s%s",
+		"invalid %sListStorefrontSlotsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserItemsIdsResponseValidationError{}
+var _ error = ListStorefrontSlotsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2920,25 +14127,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserItemsIdsResponseValidationError{}
+} = ListStorefrontSlotsRequestValidationError{}
 
-// Validate checks the field values on GetEquippedUserItemsIdsRequest with the
+// Validate checks the field values on ListStorefrontSlotsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetEquippedUserItemsIdsRequest) Validate() error {
+func (m *ListStorefrontSlotsResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStorefrontSlotsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// GetEquippedUserItemsIdsRequestValidationError is the validation error
-// returned by GetEquippedUserItemsIdsRequest.Validate if the designated
-// constraints aren't met.
-type GetEquippedUserItemsIdsRequestValidationError struct {
+// ListStorefrontSlotsResponseValidationError is the validation error returned
+// by ListStorefrontSlotsResponse.Validate if the designated constraints
+// aren't met.
+type ListStorefrontSlotsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2946,24 +14166,24 @@ type GetEquippedUserItemsIdsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEquippedUserItemsIdsRequestValidationError) Field() string { return e.field }
+func (e ListStorefrontSlotsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEquippedUserItemsIdsRequestValidationError) Reason() string { return e.reason }
+func (e ListStorefrontSlotsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEquippedUserItemsIdsRequestValidationError) Cause() error { return e.cause }
+func (e ListStorefrontSlotsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEquippedUserItemsIdsRequestValidationError) Key() bool { return e.key }
+func (e ListStorefrontSlotsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEquippedUserItemsIdsRequestValidationError) ErrorName() string {
-	return "GetEquippedUserItemsIdsRequestValidationError"
+func (e ListStorefrontSlotsResponseValidationError) ErrorName() string {
+	return "ListStorefrontSlotsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEquippedUserItemsIdsRequestValidationError) Error() string {
+func (e ListStorefrontSlotsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2975,14 +14195,14 @@ func (e GetEquippedUserItemsIdsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEquippedUserItemsIdsRequest.%s: %s%s",
+		"invalid %sListStorefrontSlotsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEquippedUserItemsIdsRequestValidationError{}
+var _ error = ListStorefrontSlotsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2990,38 +14210,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEquippedUserItemsIdsRequestValidationError{}
+} = ListStorefrontSlotsResponseValidationError{}
 
-// Validate checks the field values on GetEquippedUserItemsIdsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PinStorefrontItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetEquippedUserItemsIdsResponse) Validate() error {
+func (m *PinStorefrontItemRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	// no validation rules for SlotId
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetEquippedUserItemsIdsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Day
 
-	}
+	// no validation rules for ItemId
 
 	return nil
 }
 
-// GetEquippedUserItemsIdsResponseValidationError is the validation error
-// returned by GetEquippedUserItemsIdsResponse.Validate if the designated
-// constraints aren't met.
-type GetEquippedUserItemsIdsResponseValidationError struct {
+// PinStorefrontItemRequestValidationError is the validation error returned by
+// PinStorefrontItemRequest.Validate if the designated constraints aren't met.
+type PinStorefrontItemRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3029,24 +14239,24 @@ type GetEquippedUserItemsIdsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEquippedUserItemsIdsResponseValidationError) Field() string { return e.field }
+func (e PinStorefrontItemRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEquippedUserItemsIdsResponseValidationError) Reason() string { return e.reason }
+func (e PinStorefrontItemRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEquippedUserItemsIdsResponseValidationError) Cause() error { return e.cause }
+func (e PinStorefrontItemRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEquippedUserItemsIdsResponseValidationError) Key() bool { return e.key }
+func (e PinStorefrontItemRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEquippedUserItemsIdsResponseValidationError) ErrorName() string {
-	return "GetEquippedUserItemsIdsResponseValidationError"
+func (e PinStorefrontItemRequestValidationError) ErrorName() string {
+	return "PinStorefrontItemRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetEquippedUserItemsIdsResponseValidationError) Error() string {
+func (e PinStorefrontItemRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3058,14 +14268,14 @@ func (e GetEquippedUserItemsIdsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEquippedUserItemsIdsResponse.%s: %s%s",
+		"invalid %sPinStorefrontItemRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEquippedUserItemsIdsResponseValidationError{}
+var _ error = PinStorefrontItemRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3073,31 +14283,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEquippedUserItemsIdsResponseValidationError{}
+} = PinStorefrontItemRequestValidationError{}
 
-// Validate checks the field values on UserStats with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *UserStats) Validate() error {
+// Validate checks the field values on PinStorefrontItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PinStorefrontItemResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	// no validation rules for Games
-
-	// no validation rules for Wins
-
-	// no validation rules for Top5
-
-	// no validation rules for Kills
-
 	return nil
 }
 
-// UserStatsValidationError is the validation error returned by
-// UserStats.Validate if the designated constraints aren't met.
-type UserStatsValidationError struct {
+// PinStorefrontItemResponseValidationError is the validation error returned by
+// PinStorefrontItemResponse.Validate if the designated constraints aren't met.
+type PinStorefrontItemResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3105,22 +14306,24 @@ type UserStatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserStatsValidationError) Field() string { return e.field }
+func (e PinStorefrontItemResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserStatsValidationError) Reason() string { return e.reason }
+func (e PinStorefrontItemResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserStatsValidationError) Cause() error { return e.cause }
+func (e PinStorefrontItemResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserStatsValidationError) Key() bool { return e.key }
+func (e PinStorefrontItemResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserStatsValidationError) ErrorName() string { return "UserStatsValidationError" }
+func (e PinStorefrontItemResponseValidationError) ErrorName() string {
+	return "PinStorefrontItemResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserStatsValidationError) Error() string {
+func (e PinStorefrontItemResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3132,14 +14335,14 @@ func (e UserStatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserStats.%s: %s%s",
+		"invalid %sPinStorefrontItemResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserStatsValidationError{}
+var _ error = PinStorefrontItemResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3147,24 +14350,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserStatsValidationError{}
+} = PinStorefrontItemResponseValidationError{}
 
-// Validate checks the field values on ReadUserStatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UnpinStorefrontItemRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadUserStatsRequest) Validate() error {
+func (m *UnpinStorefrontItemRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Username
+	// no validation rules for SlotId
+
+	// no validation rules for Day
+
+	// no validation rules for ItemId
 
 	return nil
 }
 
-// ReadUserStatsRequestValidationError is the validation error returned by
-// ReadUserStatsRequest.Validate if the designated constraints aren't met.
-type ReadUserStatsRequestValidationError struct {
+// UnpinStorefrontItemRequestValidationError is the validation error returned
+// by UnpinStorefrontItemRequest.Validate if the designated constraints aren't met.
+type UnpinStorefrontItemRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3172,24 +14379,24 @@ type ReadUserStatsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadUserStatsRequestValidationError) Field() string { return e.field }
+func (e UnpinStorefrontItemRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadUserStatsRequestValidationError) Reason() string { return e.reason }
+func (e UnpinStorefrontItemRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadUserStatsRequestValidationError) Cause() error { return e.cause }
+func (e UnpinStorefrontItemRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadUserStatsRequestValidationError) Key() bool { return e.key }
+func (e UnpinStorefrontItemRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadUserStatsRequestValidationError) ErrorName() string {
-	return "ReadUserStatsRequestValidationError"
+func (e UnpinStorefrontItemRequestValidationError) ErrorName() string {
+	return "UnpinStorefrontItemRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadUserStatsRequestValidationError) Error() string {
+func (e UnpinStorefrontItemRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3201,14 +14408,14 @@ func (e ReadUserStatsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadUserStatsRequest.%s: %s%s",
+		"invalid %sUnpinStorefrontItemRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadUserStatsRequestValidationError{}
+var _ error = UnpinStorefrontItemRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3216,32 +14423,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadUserStatsRequestValidationError{}
+} = UnpinStorefrontItemRequestValidationError{}
 
-// Validate checks the field values on ReadUserStatsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UnpinStorefrontItemResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadUserStatsResponse) Validate() error {
+func (m *UnpinStorefrontItemResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReadUserStatsResponseValidationError{
-				field:  "Result",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// ReadUserStatsResponseValidationError is the validation error returned by
-// ReadUserStatsResponse.Validate if the designated constraints aren't met.
-type ReadUserStatsResponseValidationError struct {
+// UnpinStorefrontItemResponseValidationError is the validation error returned
+// by UnpinStorefrontItemResponse.Validate if the designated constraints
+// aren't met.
+type UnpinStorefrontItemResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3249,24 +14447,24 @@ type ReadUserStatsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadUserStatsResponseValidationError) Field() string { return e.field }
+func (e UnpinStorefrontItemResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadUserStatsResponseValidationError) Reason() string { return e.reason }
+func (e UnpinStorefrontItemResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadUserStatsResponseValidationError) Cause() error { return e.cause }
+func (e UnpinStorefrontItemResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadUserStatsResponseValidationError) Key() bool { return e.key }
+func (e UnpinStorefrontItemResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadUserStatsResponseValidationError) ErrorName() string {
-	return "ReadUserStatsResponseValidationError"
+func (e UnpinStorefrontItemResponseValidationError) ErrorName() string {
+	return "UnpinStorefrontItemResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadUserStatsResponseValidationError) Error() string {
+func (e UnpinStorefrontItemResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3278,14 +14476,14 @@ func (e ReadUserStatsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadUserStatsResponse.%s: %s%s",
+		"invalid %sUnpinStorefrontItemResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadUserStatsResponseValidationError{}
+var _ error = UnpinStorefrontItemResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3293,32 +14491,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadUserStatsResponseValidationError{}
+} = UnpinStorefrontItemResponseValidationError{}
 
-// Validate checks the field values on UpdateUserStatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateUserStatsRequest) Validate() error {
+// Validate checks the field values on GemPack with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *GemPack) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Username
+	// no validation rules for Id
 
-	// no validation rules for AddGames
+	// no validation rules for Name
 
-	// no validation rules for AddWins
+	// no validation rules for Gems
 
-	// no validation rules for AddTop5
+	// no validation rules for PriceCents
 
-	// no validation rules for AddKills
+	// no validation rules for Currency
 
 	return nil
 }
 
-// UpdateUserStatsRequestValidationError is the validation error returned by
-// UpdateUserStatsRequest.Validate if the designated constraints aren't met.
-type UpdateUserStatsRequestValidationError struct {
+// GemPackValidationError is the validation error returned by GemPack.Validate
+// if the designated constraints aren't met.
+type GemPackValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3326,24 +14523,22 @@ type UpdateUserStatsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserStatsRequestValidationError) Field() string { return e.field }
+func (e GemPackValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserStatsRequestValidationError) Reason() string { return e.reason }
+func (e GemPackValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserStatsRequestValidationError) Cause() error { return e.cause }
+func (e GemPackValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserStatsRequestValidationError) Key() bool { return e.key }
+func (e GemPackValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserStatsRequestValidationError) ErrorName() string {
-	return "UpdateUserStatsRequestValidationError"
-}
+func (e GemPackValidationError) ErrorName() string { return "GemPackValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserStatsRequestValidationError) Error() string {
+func (e GemPackValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3355,14 +14550,14 @@ func (e UpdateUserStatsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserStatsRequest.%s: %s%s",
+		"invalid %sGemPack.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserStatsRequestValidationError{}
+var _ error = GemPackValidationError{}
 
 var _ interface {
 	Field() string
@@ -3370,22 +14565,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserStatsRequestValidationError{}
+} = GemPackValidationError{}
 
-// Validate checks the field values on UpdateUserStatsResponse with the rules
+// Validate checks the field values on CreateGemPackRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateUserStatsResponse) Validate() error {
+func (m *CreateGemPackRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for Name
+
+	// no validation rules for Gems
+
+	// no validation rules for PriceCents
+
+	// no validation rules for Currency
+
 	return nil
 }
 
-// UpdateUserStatsResponseValidationError is the validation error returned by
-// UpdateUserStatsResponse.Validate if the designated constraints aren't met.
-type UpdateUserStatsResponseValidationError struct {
+// CreateGemPackRequestValidationError is the validation error returned by
+// CreateGemPackRequest.Validate if the designated constraints aren't met.
+type CreateGemPackRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3393,24 +14596,24 @@ type UpdateUserStatsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserStatsResponseValidationError) Field() string { return e.field }
+func (e CreateGemPackRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserStatsResponseValidationError) Reason() string { return e.reason }
+func (e CreateGemPackRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserStatsResponseValidationError) Cause() error { return e.cause }
+func (e CreateGemPackRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserStatsResponseValidationError) Key() bool { return e.key }
+func (e CreateGemPackRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserStatsResponseValidationError) ErrorName() string {
-	return "UpdateUserStatsResponseValidationError"
+func (e CreateGemPackRequestValidationError) ErrorName() string {
+	return "CreateGemPackRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserStatsResponseValidationError) Error() string {
+func (e CreateGemPackRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3422,14 +14625,14 @@ func (e UpdateUserStatsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserStatsResponse.%s: %s%s",
+		"invalid %sCreateGemPackRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserStatsResponseValidationError{}
+var _ error = CreateGemPackRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3437,39 +14640,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserStatsResponseValidationError{}
+} = CreateGemPackRequestValidationError{}
 
-// Validate checks the field values on News with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *News) Validate() error {
+// Validate checks the field values on CreateGemPackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateGemPackResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NewsValidationError{
-				field:  "CreatedAt",
+			return CreateGemPackResponseValidationError{
+				field:  "Result",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Title
-
-	// no validation rules for Description
-
-	// no validation rules for ImageLink
-
 	return nil
 }
 
-// NewsValidationError is the validation error returned by News.Validate if the
-// designated constraints aren't met.
-type NewsValidationError struct {
+// CreateGemPackResponseValidationError is the validation error returned by
+// CreateGemPackResponse.Validate if the designated constraints aren't met.
+type CreateGemPackResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3477,22 +14673,24 @@ type NewsValidationError struct {
 }
 
 // Field function returns field value.
-func (e NewsValidationError) Field() string { return e.field }
+func (e CreateGemPackResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NewsValidationError) Reason() string { return e.reason }
+func (e CreateGemPackResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NewsValidationError) Cause() error { return e.cause }
+func (e CreateGemPackResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NewsValidationError) Key() bool { return e.key }
+func (e CreateGemPackResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NewsValidationError) ErrorName() string { return "NewsValidationError" }
+func (e CreateGemPackResponseValidationError) ErrorName() string {
+	return "CreateGemPackResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NewsValidationError) Error() string {
+func (e CreateGemPackResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3504,14 +14702,14 @@ func (e NewsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNews.%s: %s%s",
+		"invalid %sCreateGemPackResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NewsValidationError{}
+var _ error = CreateGemPackResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3519,28 +14717,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NewsValidationError{}
+} = CreateGemPackResponseValidationError{}
 
-// Validate checks the field values on CreateNewsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *CreateNewsRequest) Validate() error {
+// Validate checks the field values on DeleteGemPackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteGemPackRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Title
-
-	// no validation rules for Description
-
-	// no validation rules for ImageLink
+	// no validation rules for Id
 
 	return nil
 }
 
-// CreateNewsRequestValidationError is the validation error returned by
-// CreateNewsRequest.Validate if the designated constraints aren't met.
-type CreateNewsRequestValidationError struct {
+// DeleteGemPackRequestValidationError is the validation error returned by
+// DeleteGemPackRequest.Validate if the designated constraints aren't met.
+type DeleteGemPackRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3548,24 +14742,24 @@ type CreateNewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateNewsRequestValidationError) Field() string { return e.field }
+func (e DeleteGemPackRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateNewsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteGemPackRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateNewsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteGemPackRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateNewsRequestValidationError) Key() bool { return e.key }
+func (e DeleteGemPackRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateNewsRequestValidationError) ErrorName() string {
-	return "CreateNewsRequestValidationError"
+func (e DeleteGemPackRequestValidationError) ErrorName() string {
+	return "DeleteGemPackRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateNewsRequestValidationError) Error() string {
+func (e DeleteGemPackRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3577,14 +14771,14 @@ func (e CreateNewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateNewsRequest.%s: %s%s",
+		"invalid %sDeleteGemPackRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateNewsRequestValidationError{}
+var _ error = DeleteGemPackRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3592,32 +14786,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateNewsRequestValidationError{}
+} = DeleteGemPackRequestValidationError{}
 
-// Validate checks the field values on CreateNewsResponse with the rules
+// Validate checks the field values on DeleteGemPackResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateNewsResponse) Validate() error {
+func (m *DeleteGemPackResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateNewsResponseValidationError{
-				field:  "Result",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// CreateNewsResponseValidationError is the validation error returned by
-// CreateNewsResponse.Validate if the designated constraints aren't met.
-type CreateNewsResponseValidationError struct {
+// DeleteGemPackResponseValidationError is the validation error returned by
+// DeleteGemPackResponse.Validate if the designated constraints aren't met.
+type DeleteGemPackResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3625,24 +14809,24 @@ type CreateNewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateNewsResponseValidationError) Field() string { return e.field }
+func (e DeleteGemPackResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateNewsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteGemPackResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateNewsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteGemPackResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateNewsResponseValidationError) Key() bool { return e.key }
+func (e DeleteGemPackResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateNewsResponseValidationError) ErrorName() string {
-	return "CreateNewsResponseValidationError"
+func (e DeleteGemPackResponseValidationError) ErrorName() string {
+	return "DeleteGemPackResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateNewsResponseValidationError) Error() string {
+func (e DeleteGemPackResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3654,14 +14838,14 @@ func (e CreateNewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateNewsResponse.%s: %s%s",
+		"invalid %sDeleteGemPackResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateNewsResponseValidationError{}
+var _ error = DeleteGemPackResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3669,24 +14853,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateNewsResponseValidationError{}
+} = DeleteGemPackResponseValidationError{}
 
-// Validate checks the field values on ReadNewsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ReadNewsRequest) Validate() error {
+// Validate checks the field values on ListGemPacksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGemPacksRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
 	return nil
 }
 
-// ReadNewsRequestValidationError is the validation error returned by
-// ReadNewsRequest.Validate if the designated constraints aren't met.
-type ReadNewsRequestValidationError struct {
+// ListGemPacksRequestValidationError is the validation error returned by
+// ListGemPacksRequest.Validate if the designated constraints aren't met.
+type ListGemPacksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3694,22 +14876,24 @@ type ReadNewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadNewsRequestValidationError) Field() string { return e.field }
+func (e ListGemPacksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadNewsRequestValidationError) Reason() string { return e.reason }
+func (e ListGemPacksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadNewsRequestValidationError) Cause() error { return e.cause }
+func (e ListGemPacksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadNewsRequestValidationError) Key() bool { return e.key }
+func (e ListGemPacksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadNewsRequestValidationError) ErrorName() string { return "ReadNewsRequestValidationError" }
+func (e ListGemPacksRequestValidationError) ErrorName() string {
+	return "ListGemPacksRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReadNewsRequestValidationError) Error() string {
+func (e ListGemPacksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3721,14 +14905,14 @@ func (e ReadNewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadNewsRequest.%s: %s%s",
+		"invalid %sListGemPacksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadNewsRequestValidationError{}
+var _ error = ListGemPacksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3736,32 +14920,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadNewsRequestValidationError{}
+} = ListGemPacksRequestValidationError{}
 
-// Validate checks the field values on ReadNewsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ReadNewsResponse) Validate() error {
+// Validate checks the field values on ListGemPacksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGemPacksResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReadNewsResponseValidationError{
-				field:  "Result",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListGemPacksResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	return nil
 }
 
-// ReadNewsResponseValidationError is the validation error returned by
-// ReadNewsResponse.Validate if the designated constraints aren't met.
-type ReadNewsResponseValidationError struct {
+// ListGemPacksResponseValidationError is the validation error returned by
+// ListGemPacksResponse.Validate if the designated constraints aren't met.
+type ListGemPacksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3769,22 +14958,24 @@ type ReadNewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadNewsResponseValidationError) Field() string { return e.field }
+func (e ListGemPacksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadNewsResponseValidationError) Reason() string { return e.reason }
+func (e ListGemPacksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadNewsResponseValidationError) Cause() error { return e.cause }
+func (e ListGemPacksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadNewsResponseValidationError) Key() bool { return e.key }
+func (e ListGemPacksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadNewsResponseValidationError) ErrorName() string { return "ReadNewsResponseValidationError" }
+func (e ListGemPacksResponseValidationError) ErrorName() string {
+	return "ListGemPacksResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReadNewsResponseValidationError) Error() string {
+func (e ListGemPacksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3796,14 +14987,14 @@ func (e ReadNewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadNewsResponse.%s: %s%s",
+		"invalid %sListGemPacksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadNewsResponseValidationError{}
+var _ error = ListGemPacksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3811,30 +15002,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadNewsResponseValidationError{}
+} = ListGemPacksResponseValidationError{}
 
-// Validate checks the field values on UpdateNewsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *UpdateNewsRequest) Validate() error {
+// Validate checks the field values on CreatePurchaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreatePurchaseRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	// no validation rules for Title
-
-	// no validation rules for Description
+	// no validation rules for UserId
 
-	// no validation rules for ImageLink
+	// no validation rules for PackId
 
 	return nil
 }
 
-// UpdateNewsRequestValidationError is the validation error returned by
-// UpdateNewsRequest.Validate if the designated constraints aren't met.
-type UpdateNewsRequestValidationError struct {
+// CreatePurchaseRequestValidationError is the validation error returned by
+// CreatePurchaseRequest.Validate if the designated constraints aren't met.
+type CreatePurchaseRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3842,24 +15029,24 @@ type UpdateNewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNewsRequestValidationError) Field() string { return e.field }
+func (e CreatePurchaseRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNewsRequestValidationError) Reason() string { return e.reason }
+func (e CreatePurchaseRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNewsRequestValidationError) Cause() error { return e.cause }
+func (e CreatePurchaseRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNewsRequestValidationError) Key() bool { return e.key }
+func (e CreatePurchaseRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNewsRequestValidationError) ErrorName() string {
-	return "UpdateNewsRequestValidationError"
+func (e CreatePurchaseRequestValidationError) ErrorName() string {
+	return "CreatePurchaseRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNewsRequestValidationError) Error() string {
+func (e CreatePurchaseRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3871,14 +15058,14 @@ func (e UpdateNewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNewsRequest.%s: %s%s",
+		"invalid %sCreatePurchaseRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNewsRequestValidationError{}
+var _ error = CreatePurchaseRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3886,22 +15073,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNewsRequestValidationError{}
+} = CreatePurchaseRequestValidationError{}
 
-// Validate checks the field values on UpdateNewsResponse with the rules
+// Validate checks the field values on CreatePurchaseResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateNewsResponse) Validate() error {
+func (m *CreatePurchaseResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for IntentId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for PriceCents
+
+	// no validation rules for Currency
+
 	return nil
 }
 
-// UpdateNewsResponseValidationError is the validation error returned by
-// UpdateNewsResponse.Validate if the designated constraints aren't met.
-type UpdateNewsResponseValidationError struct {
+// CreatePurchaseResponseValidationError is the validation error returned by
+// CreatePurchaseResponse.Validate if the designated constraints aren't met.
+type CreatePurchaseResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3909,24 +15104,24 @@ type UpdateNewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNewsResponseValidationError) Field() string { return e.field }
+func (e CreatePurchaseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNewsResponseValidationError) Reason() string { return e.reason }
+func (e CreatePurchaseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNewsResponseValidationError) Cause() error { return e.cause }
+func (e CreatePurchaseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNewsResponseValidationError) Key() bool { return e.key }
+func (e CreatePurchaseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNewsResponseValidationError) ErrorName() string {
-	return "UpdateNewsResponseValidationError"
+func (e CreatePurchaseResponseValidationError) ErrorName() string {
+	return "CreatePurchaseResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNewsResponseValidationError) Error() string {
+func (e CreatePurchaseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3938,14 +15133,14 @@ func (e UpdateNewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNewsResponse.%s: %s%s",
+		"invalid %sCreatePurchaseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNewsResponseValidationError{}
+var _ error = CreatePurchaseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3953,62 +15148,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNewsResponseValidationError{}
+} = CreatePurchaseResponseValidationError{}
 
-// Validate checks the field values on ListNewsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListNewsRequest) Validate() error {
+// Validate checks the field values on ValidateReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ValidateReceiptRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNewsRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNewsRequestValidationError{
-				field:  "OrderBy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNewsRequestValidationError{
-				field:  "Fields",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for UserId
 
-	if v, ok := interface{}(m.GetPaging()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNewsRequestValidationError{
-				field:  "Paging",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Receipt
 
 	return nil
 }
 
-// ListNewsRequestValidationError is the validation error returned by
-// ListNewsRequest.Validate if the designated constraints aren't met.
-type ListNewsRequestValidationError struct {
+// ValidateReceiptRequestValidationError is the validation error returned by
+// ValidateReceiptRequest.Validate if the designated constraints aren't met.
+type ValidateReceiptRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4016,22 +15175,24 @@ type ListNewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNewsRequestValidationError) Field() string { return e.field }
+func (e ValidateReceiptRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNewsRequestValidationError) Reason() string { return e.reason }
+func (e ValidateReceiptRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNewsRequestValidationError) Cause() error { return e.cause }
+func (e ValidateReceiptRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNewsRequestValidationError) Key() bool { return e.key }
+func (e ValidateReceiptRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNewsRequestValidationError) ErrorName() string { return "ListNewsRequestValidationError" }
+func (e ValidateReceiptRequestValidationError) ErrorName() string {
+	return "ValidateReceiptRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListNewsRequestValidationError) Error() string {
+func (e ValidateReceiptRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4043,14 +15204,14 @@ func (e ListNewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNewsRequest.%s: %s%s",
+		"invalid %sValidateReceiptRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNewsRequestValidationError{}
+var _ error = ValidateReceiptRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4058,47 +15219,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNewsRequestValidationError{}
+} = ValidateReceiptRequestValidationError{}
 
-// Validate checks the field values on ListNewsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListNewsResponse) Validate() error {
+// Validate checks the field values on ValidateReceiptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ValidateReceiptResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListNewsResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if v, ok := interface{}(m.GetPage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNewsResponseValidationError{
-				field:  "Page",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for GemsCredited
 
 	return nil
 }
 
-// ListNewsResponseValidationError is the validation error returned by
-// ListNewsResponse.Validate if the designated constraints aren't met.
-type ListNewsResponseValidationError struct {
+// ValidateReceiptResponseValidationError is the validation error returned by
+// ValidateReceiptResponse.Validate if the designated constraints aren't met.
+type ValidateReceiptResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4106,22 +15244,24 @@ type ListNewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNewsResponseValidationError) Field() string { return e.field }
+func (e ValidateReceiptResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNewsResponseValidationError) Reason() string { return e.reason }
+func (e ValidateReceiptResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNewsResponseValidationError) Cause() error { return e.cause }
+func (e ValidateReceiptResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNewsResponseValidationError) Key() bool { return e.key }
+func (e ValidateReceiptResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNewsResponseValidationError) ErrorName() string { return "ListNewsResponseValidationError" }
+func (e ValidateReceiptResponseValidationError) ErrorName() string {
+	return "ValidateReceiptResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListNewsResponseValidationError) Error() string {
+func (e ValidateReceiptResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4133,14 +15273,14 @@ func (e ListNewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNewsResponse.%s: %s%s",
+		"invalid %sValidateReceiptResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNewsResponseValidationError{}
+var _ error = ValidateReceiptResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4148,4 +15288,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNewsResponseValidationError{}
+} = ValidateReceiptResponseValidationError{}
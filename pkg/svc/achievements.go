@@ -0,0 +1,334 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	insertAchievementQuery = "INSERT INTO achievements (name, description, metric, scope, threshold, reward_coins, reward_gems, reward_item_id) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, '')) RETURNING id"
+	deleteAchievementQuery = "DELETE FROM achievements WHERE id = $1"
+	listAchievementsQuery  = "SELECT id, name, description, metric, scope, threshold, reward_coins, reward_gems, COALESCE(reward_item_id, '') " +
+		"FROM achievements ORDER BY id"
+	userAchievementsQuery = "SELECT a.id, a.name, a.description, a.metric, a.scope, a.threshold, a.reward_coins, a.reward_gems, COALESCE(a.reward_item_id, ''), " +
+		"COALESCE(ua.progress, 0), ua.unlocked_at FROM achievements a LEFT JOIN user_achievements ua ON ua.achievement_id = a.id AND ua.user_id = $1 ORDER BY a.id"
+	// lifetime achievements track the totals in user_stats, match achievements keep the best of the deltas in $2-$5
+	// of updates that add a single game, progress of unlocked achievements is frozen
+	achievementProgressQuery = "INSERT INTO user_achievements (user_id, achievement_id, progress) " +
+		"SELECT us.user_id, a.id, CASE WHEN a.scope = 'match' THEN (CASE WHEN $2::int <> 1 THEN 0 ELSE " +
+		"(CASE a.metric WHEN 'games' THEN $2::int WHEN 'wins' THEN $3::int WHEN 'top5' THEN $4::int ELSE $5::int END) END) " +
+		"ELSE (CASE a.metric WHEN 'games' THEN us.games WHEN 'wins' THEN us.wins WHEN 'top5' THEN us.top5 ELSE us.kills END) END " +
+		"FROM achievements a JOIN user_stats us ON us.user_id = $1::varchar " +
+		"ON CONFLICT (user_id, achievement_id) DO UPDATE SET progress = GREATEST(user_achievements.progress, EXCLUDED.progress) " +
+		"WHERE user_achievements.unlocked_at IS NULL"
+	unlockAchievementsQuery = "UPDATE user_achievements ua SET unlocked_at = now() FROM achievements a " +
+		"WHERE a.id = ua.achievement_id AND ua.user_id = $1 AND ua.unlocked_at IS NULL AND ua.progress >= a.threshold " +
+		"RETURNING a.id, a.name, a.description, a.metric, a.scope, a.threshold, a.reward_coins, a.reward_gems, COALESCE(a.reward_item_id, '')"
+	// recomputedProgress is the progress the stats of $1 add up to, match achievements take their best logged single game
+	recomputedProgressFrom = "achievements a JOIN user_stats us ON us.user_id = $1::varchar CROSS JOIN (" +
+		"SELECT COALESCE(max(games), 0) AS games, COALESCE(max(wins), 0) AS wins, COALESCE(max(top5), 0) AS top5, COALESCE(max(kills), 0) AS kills " +
+		"FROM stat_updates WHERE user_id = $1::varchar AND games = 1) best"
+	recomputedProgress = "(CASE WHEN a.scope = 'match' THEN " +
+		"(CASE a.metric WHEN 'games' THEN best.games WHEN 'wins' THEN best.wins WHEN 'top5' THEN best.top5 ELSE best.kills END) " +
+		"ELSE (CASE a.metric WHEN 'games' THEN us.games WHEN 'wins' THEN us.wins WHEN 'top5' THEN us.top5 ELSE us.kills END) END)"
+	// achievements unlocked before $2 were earned without the reverted update and are kept
+	relockAchievementsQuery = "UPDATE user_achievements ua SET unlocked_at = NULL FROM " + recomputedProgressFrom + " " +
+		"WHERE a.id = ua.achievement_id AND ua.user_id = $1 AND ua.unlocked_at >= $2 AND " + recomputedProgress + " < a.threshold " +
+		"RETURNING a.id, a.name, a.description, a.metric, a.scope, a.threshold, a.reward_coins, a.reward_gems, COALESCE(a.reward_item_id, '')"
+	resetAchievementProgressQuery = "UPDATE user_achievements ua SET progress = " + recomputedProgress + " FROM " + recomputedProgressFrom + " " +
+		"WHERE a.id = ua.achievement_id AND ua.user_id = $1 AND ua.unlocked_at IS NULL"
+	revokeAchievementItemQuery = "DELETE FROM users_store_items WHERE user_id = $1 AND store_item_id = $2 AND source = 'achievement'"
+	// currencies already spent can't be taken back, balances stop at 0
+	revokeCurrenciesQuery = "UPDATE users SET coins = GREATEST(coins - $1, 0), gems = GREATEST(gems - $2, 0) WHERE id = $3"
+
+	grantAchievementItemQuery = "INSERT INTO users_store_items (user_id, store_item_id, source) VALUES ($1, $2, 'achievement') " +
+		"ON CONFLICT (user_id, store_item_id) DO UPDATE SET expires_at = NULL, source = 'achievement' WHERE users_store_items.expires_at IS NOT NULL"
+)
+
+// achievementScopes maps the scopes to the values stored in achievements.scope
+var achievementScopes = map[pb.AchievementScope]string{
+	pb.AchievementScope_LIFETIME: "lifetime",
+	pb.AchievementScope_MATCH:    "match",
+}
+
+func (s *UsersStatsServer) CreateAchievement(ctx context.Context, req *pb.CreateAchievementRequest) (*pb.CreateAchievementResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name":   req.GetName(),
+		"metric": req.GetMetric().String(),
+	})
+	logger.Debug("Create achievement")
+
+	if req.GetName() == "" {
+		logger.Error("Empty achievement name")
+		return nil, status.Error(codes.InvalidArgument, "Name should be set")
+	}
+	metric, ok := leaderboardColumns[req.GetMetric()]
+	if !ok {
+		logger.Error("Unknown achievement metric")
+		return nil, status.Error(codes.InvalidArgument, "Unknown achievement metric")
+	}
+	scope, ok := achievementScopes[req.GetScope()]
+	if !ok {
+		logger.Error("Unknown achievement scope")
+		return nil, status.Error(codes.InvalidArgument, "Unknown achievement scope")
+	}
+	if req.GetThreshold() <= 0 || req.GetRewardCoins() < 0 || req.GetRewardGems() < 0 {
+		logger.Error("Invalid achievement threshold or rewards")
+		return nil, status.Error(codes.InvalidArgument, "Threshold should be positive and rewards can't be negative")
+	}
+
+	if req.GetRewardItemId() != "" {
+		var item pb.StoreItemORM
+		if err := s.cfg.Database.Where("id = ?", req.GetRewardItemId()).First(&item).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				logger.Error("Item not found")
+				return nil, status.Error(codes.NotFound, "Item not found")
+			}
+			logger.WithError(err).Error("Could not find item")
+			return nil, status.Error(codes.Internal, "Could not find item")
+		}
+		if item.Retired {
+			logger.Error(itemRetiredErrorMsg)
+			return nil, status.Error(codes.FailedPrecondition, itemRetiredErrorMsg)
+		}
+	}
+
+	achievement := &pb.Achievement{
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		Metric:       req.GetMetric(),
+		Scope:        req.GetScope(),
+		Threshold:    req.GetThreshold(),
+		RewardCoins:  req.GetRewardCoins(),
+		RewardGems:   req.GetRewardGems(),
+		RewardItemId: req.GetRewardItemId(),
+	}
+	if err := s.cfg.Database.DB().QueryRow(insertAchievementQuery, req.GetName(), req.GetDescription(), metric, scope, req.GetThreshold(),
+		req.GetRewardCoins(), req.GetRewardGems(), req.GetRewardItemId()).Scan(&achievement.Id); err != nil {
+		logger.WithError(err).Error("Could not create achievement")
+		return nil, status.Error(codes.Internal, "Could not create achievement")
+	}
+
+	return &pb.CreateAchievementResponse{Result: achievement}, nil
+}
+
+func (s *UsersStatsServer) DeleteAchievement(ctx context.Context, req *pb.DeleteAchievementRequest) (*pb.DeleteAchievementResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id": req.GetId(),
+	})
+	logger.Debug("Delete achievement")
+
+	res, err := s.cfg.Database.DB().Exec(deleteAchievementQuery, req.GetId())
+	if err != nil {
+		logger.WithError(err).Error("Could not delete achievement")
+		return nil, status.Error(codes.Internal, "Could not delete achievement")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		logger.Error("Achievement not found")
+		return nil, status.Error(codes.NotFound, "Achievement not found")
+	}
+
+	return &pb.DeleteAchievementResponse{}, nil
+}
+
+func (s *UsersStatsServer) ListAchievements(ctx context.Context, req *pb.ListAchievementsRequest) (*pb.ListAchievementsResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("List achievements")
+
+	rows, err := s.cfg.Database.DB().Query(listAchievementsQuery)
+	if err != nil {
+		logger.WithError(err).Error("Could not list achievements")
+		return nil, status.Error(codes.Internal, "Could not list achievements")
+	}
+	defer rows.Close()
+
+	achievements, err := scanAchievements(rows)
+	if err != nil {
+		logger.WithError(err).Error("Could not list achievements")
+		return nil, status.Error(codes.Internal, "Could not list achievements")
+	}
+
+	return &pb.ListAchievementsResponse{Results: achievements}, nil
+}
+
+func (s *UsersStatsServer) GetUserAchievements(ctx context.Context, req *pb.GetUserAchievementsRequest) (*pb.GetUserAchievementsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name": req.GetUsername(),
+	})
+	logger.Debug("Get user achievements")
+
+	user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.cfg.Database.DB().Query(userAchievementsQuery, user.GetId())
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch user achievements")
+		return nil, status.Error(codes.Internal, "Could not fetch user achievements")
+	}
+	defer rows.Close()
+
+	results := []*pb.UserAchievement{}
+	for rows.Next() {
+		var achievement pb.Achievement
+		var metric, scope string
+		var progress int32
+		var unlockedAt *time.Time
+		if err := rows.Scan(&achievement.Id, &achievement.Name, &achievement.Description, &metric, &scope, &achievement.Threshold,
+			&achievement.RewardCoins, &achievement.RewardGems, &achievement.RewardItemId, &progress, &unlockedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch user achievements")
+			return nil, status.Error(codes.Internal, "Could not fetch user achievements")
+		}
+		achievement.Metric, achievement.Scope = achievementEnums(metric, scope)
+
+		result := &pb.UserAchievement{Achievement: &achievement, Progress: progress, Unlocked: unlockedAt != nil}
+		if unlockedAt != nil {
+			if result.UnlockedAt, err = ptypes.TimestampProto(*unlockedAt); err != nil {
+				logger.WithError(err).Error("Could not fetch user achievements")
+				return nil, status.Error(codes.Internal, "Could not fetch user achievements")
+			}
+		}
+		results = append(results, result)
+	}
+
+	return &pb.GetUserAchievementsResponse{Results: results}, nil
+}
+
+// applyAchievements tracks the achievements progress of a user whose stats were just updated by the given deltas,
+// unlocks the completed achievements and grants their rewards in the same transaction
+func applyAchievements(logger *logrus.Entry, txnDB gorm.SQLCommon, userID string, games, wins, top5, kills int32) ([]*pb.Achievement, error) {
+	logger = logger.WithField("user_id", userID)
+
+	if _, err := txnDB.Exec(achievementProgressQuery, userID, games, wins, top5, kills); err != nil {
+		logger.WithError(err).Error("Could not update achievements progress")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+
+	rows, err := txnDB.Query(unlockAchievementsQuery, userID)
+	if err != nil {
+		logger.WithError(err).Error("Could not unlock achievements")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+	unlocked, err := scanAchievements(rows)
+	rows.Close()
+	if err != nil {
+		logger.WithError(err).Error("Could not unlock achievements")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+
+	var coins, gems int32
+	for _, achievement := range unlocked {
+		coins += achievement.GetRewardCoins()
+		gems += achievement.GetRewardGems()
+		if achievement.GetRewardItemId() == "" {
+			continue
+		}
+		if _, err := txnDB.Exec(grantAchievementItemQuery, userID, achievement.GetRewardItemId()); err != nil {
+			logger.WithError(err).WithField("achievement_id", achievement.GetId()).Error("Could not grant achievement item")
+			return nil, status.Error(codes.Internal, "Could not update achievements")
+		}
+	}
+	if coins > 0 || gems > 0 {
+		if _, err := txnDB.Exec(refundCurrenciesQuery, coins, gems, userID); err != nil {
+			logger.WithError(err).Error("Could not grant achievement currencies")
+			return nil, status.Error(codes.Internal, "Could not update achievements")
+		}
+	}
+
+	for _, achievement := range unlocked {
+		logger.WithField("achievement_id", achievement.GetId()).Info("Achievement unlocked")
+	}
+
+	return unlocked, nil
+}
+
+// revokeAchievements relocks the achievements unlocked since a reverted stats update that the user's stats no longer
+// reach and takes their rewards back, progress of locked achievements is lowered to the reverted stats
+func revokeAchievements(logger *logrus.Entry, txnDB gorm.SQLCommon, userID string, since time.Time) ([]*pb.Achievement, error) {
+	logger = logger.WithField("user_id", userID)
+
+	rows, err := txnDB.Query(relockAchievementsQuery, userID, since)
+	if err != nil {
+		logger.WithError(err).Error("Could not relock achievements")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+	revoked, err := scanAchievements(rows)
+	rows.Close()
+	if err != nil {
+		logger.WithError(err).Error("Could not relock achievements")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+
+	if _, err := txnDB.Exec(resetAchievementProgressQuery, userID); err != nil {
+		logger.WithError(err).Error("Could not reset achievements progress")
+		return nil, status.Error(codes.Internal, "Could not update achievements")
+	}
+
+	var coins, gems int32
+	for _, achievement := range revoked {
+		coins += achievement.GetRewardCoins()
+		gems += achievement.GetRewardGems()
+		if achievement.GetRewardItemId() == "" {
+			continue
+		}
+		if _, err := txnDB.Exec(revokeAchievementItemQuery, userID, achievement.GetRewardItemId()); err != nil {
+			logger.WithError(err).WithField("achievement_id", achievement.GetId()).Error("Could not revoke achievement item")
+			return nil, status.Error(codes.Internal, "Could not update achievements")
+		}
+	}
+	if coins > 0 || gems > 0 {
+		if _, err := txnDB.Exec(revokeCurrenciesQuery, coins, gems, userID); err != nil {
+			logger.WithError(err).Error("Could not revoke achievement currencies")
+			return nil, status.Error(codes.Internal, "Could not update achievements")
+		}
+	}
+
+	for _, achievement := range revoked {
+		logger.WithField("achievement_id", achievement.GetId()).Info("Achievement revoked")
+	}
+
+	return revoked, nil
+}
+
+func scanAchievements(rows *sql.Rows) ([]*pb.Achievement, error) {
+	achievements := []*pb.Achievement{}
+	for rows.Next() {
+		var achievement pb.Achievement
+		var metric, scope string
+		if err := rows.Scan(&achievement.Id, &achievement.Name, &achievement.Description, &metric, &scope, &achievement.Threshold,
+			&achievement.RewardCoins, &achievement.RewardGems, &achievement.RewardItemId); err != nil {
+			return nil, err
+		}
+		achievement.Metric, achievement.Scope = achievementEnums(metric, scope)
+		achievements = append(achievements, &achievement)
+	}
+	return achievements, rows.Err()
+}
+
+// achievementEnums converts the stored metric and scope back to their enums
+func achievementEnums(metric, scope string) (pb.LeaderboardMetric, pb.AchievementScope) {
+	var m pb.LeaderboardMetric
+	for value, column := range leaderboardColumns {
+		if column == metric {
+			m = value
+		}
+	}
+	var sc pb.AchievementScope
+	for value, stored := range achievementScopes {
+		if stored == scope {
+			sc = value
+		}
+	}
+	return m, sc
+}
@@ -0,0 +1,227 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAchievements(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlSearchItem := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlSearchStats := `SELECT * FROM "user_stats"  WHERE ("user_id" = $1)`
+	sqlUpdateStats := `UPDATE "user_stats" SET "games" = $1, "kills" = $2, "top5" = $3, "user_id" = $4, "wins" = $5  WHERE "user_stats"."id" = $6`
+	achievementColumns := []string{"id", "name", "description", "metric", "scope", "threshold", "reward_coins", "reward_gems", "reward_item_id"}
+
+	t.Run("Create Achievement - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "retired"}).AddRow("item-id", "Skull banner", false))
+		mock.ExpectQuery(regexp.QuoteMeta(insertAchievementQuery)).
+			WithArgs("Centurion", "Get 100 kills", "kills", "lifetime", 100, 50, 0, "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		res, err := stClient.CreateAchievement(ctx, &pb.CreateAchievementRequest{
+			Name:         "Centurion",
+			Description:  "Get 100 kills",
+			Metric:       pb.LeaderboardMetric_KILLS,
+			Threshold:    100,
+			RewardCoins:  50,
+			RewardItemId: "item-id",
+		})
+		if err != nil {
+			t.Fatalf("error creating achievement: %v", err)
+		}
+		if res.GetResult().GetId() != 1 || res.GetResult().GetScope() != pb.AchievementScope_LIFETIME {
+			t.Fatalf("unexpected achievement: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create Achievement - retired reward item", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "retired"}).AddRow("item-id", "Skull banner", true))
+
+		_, err := stClient.CreateAchievement(ctx, &pb.CreateAchievementRequest{Name: "Centurion", Threshold: 100, RewardItemId: "item-id"})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create Achievement - invalid", func(t *testing.T) {
+		requests := []*pb.CreateAchievementRequest{
+			{Threshold: 10},
+			{Name: "Winner", Threshold: 0},
+			{Name: "Winner", Threshold: 10, Metric: pb.LeaderboardMetric(42)},
+			{Name: "Winner", Threshold: 10, Scope: pb.AchievementScope(42)},
+			{Name: "Winner", Threshold: 10, RewardGems: -1},
+		}
+		for _, req := range requests {
+			_, err := stClient.CreateAchievement(ctx, req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument for %v, got: %v", req, err)
+			}
+		}
+	})
+
+	t.Run("Delete Achievement - not found", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(deleteAchievementQuery)).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := stClient.DeleteAchievement(ctx, &pb.DeleteAchievementRequest{Id: 7})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List Achievements", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(listAchievementsQuery)).
+			WillReturnRows(sqlmock.NewRows(achievementColumns).
+				AddRow(1, "Centurion", "Get 100 kills", "kills", "lifetime", 100, 50, 0, "item-id").
+				AddRow(2, "Rampage", "Get 10 kills in a match", "kills", "match", 10, 0, 5, ""))
+
+		res, err := stClient.ListAchievements(ctx, &pb.ListAchievementsRequest{})
+		if err != nil {
+			t.Fatalf("error listing achievements: %v", err)
+		}
+		if len(res.GetResults()) != 2 || res.GetResults()[1].GetScope() != pb.AchievementScope_MATCH || res.GetResults()[1].GetMetric() != pb.LeaderboardMetric_KILLS {
+			t.Fatalf("unexpected achievements: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get User Achievements", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-id", "some-name"))
+		mock.ExpectQuery(regexp.QuoteMeta(userAchievementsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(append(achievementColumns, "progress", "unlocked_at")).
+				AddRow(1, "Centurion", "Get 100 kills", "kills", "lifetime", 100, 50, 0, "item-id", 100, time.Now()).
+				AddRow(2, "Rampage", "Get 10 kills in a match", "kills", "match", 10, 0, 5, "", 6, nil))
+
+		res, err := stClient.GetUserAchievements(ctx, &pb.GetUserAchievementsRequest{Username: "some-id"})
+		if err != nil {
+			t.Fatalf("error getting user achievements: %v", err)
+		}
+		if len(res.GetResults()) != 2 || !res.GetResults()[0].GetUnlocked() || res.GetResults()[0].GetUnlockedAt() == nil ||
+			res.GetResults()[1].GetUnlocked() || res.GetResults()[1].GetProgress() != 6 {
+			t.Fatalf("unexpected user achievements: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Update Stats - unlocks with rewards", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-id", "some-name"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchStats)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "wins", "top5", "kills", "games"}).AddRow(1, 3, 8, 95, 20))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateStats)).WithArgs(21, 106, 8, nil, 3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("some-id", 1, 0, 0, 11).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs("some-id", 1, 0, 0, 11, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("some-id", 1, 0, 0, 11).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(achievementColumns).
+				AddRow(1, "Centurion", "Get 100 kills", "kills", "lifetime", 100, 50, 0, "item-id").
+				AddRow(2, "Rampage", "Get 10 kills in a match", "kills", "match", 10, 0, 5, ""))
+		mock.ExpectExec(regexp.QuoteMeta(grantAchievementItemQuery)).WithArgs("some-id", "item-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(refundCurrenciesQuery)).WithArgs(50, 5, "some-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stClient.UpdateStats(ctx, &pb.UpdateUserStatsRequest{Username: "some-id", AddGames: 1, AddKills: 11})
+		if err != nil {
+			t.Fatalf("error updating user stats: %v", err)
+		}
+		if len(res.GetUnlocked()) != 2 || res.GetUnlocked()[0].GetName() != "Centurion" {
+			t.Fatalf("unexpected unlocked achievements: %v", res.GetUnlocked())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Update Stats - reward fails", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-id", "some-name"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchStats)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "wins", "top5", "kills", "games"}).AddRow(1, 3, 8, 95, 20))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateStats)).WithArgs(21, 106, 8, nil, 3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("some-id", 1, 0, 0, 11).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs("some-id", 1, 0, 0, 11, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("some-id", 1, 0, 0, 11).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(achievementColumns).AddRow(2, "Rampage", "Get 10 kills in a match", "kills", "match", 10, 0, 5, ""))
+		mock.ExpectExec(regexp.QuoteMeta(refundCurrenciesQuery)).WithArgs(0, 5, "some-id").WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		_, err := stClient.UpdateStats(ctx, &pb.UpdateUserStatsRequest{Username: "some-id", AddGames: 1, AddKills: 11})
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("expected Internal, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
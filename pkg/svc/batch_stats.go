@@ -0,0 +1,255 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBatchStatsUpdates = 200
+
+	// resolveUsersQuery matches usernames the way findUserByProvidedID does, by id, name or email
+	resolveUsersQuery   = "SELECT id, name, email FROM users WHERE id IN (?) OR name IN (?) OR email IN (?)"
+	statBatchQuery      = "SELECT results FROM stat_batches WHERE match_id = $1"
+	claimStatBatchQuery = "INSERT INTO stat_batches (match_id, results) VALUES ($1, '') ON CONFLICT (match_id) DO NOTHING"
+	saveStatBatchQuery  = "UPDATE stat_batches SET results = $2 WHERE match_id = $1"
+	addUserStatsQuery   = "UPDATE user_stats SET games = games + $2, wins = wins + $3, top5 = top5 + $4, kills = kills + $5 " +
+		"WHERE user_id = $1 RETURNING games, wins, top5, kills"
+)
+
+// BatchUpdateStats applies the stats updates of a whole match in one transaction, updates of unknown users
+// are skipped and reported in their result
+func (s *UsersStatsServer) BatchUpdateStats(ctx context.Context, req *pb.BatchUpdateStatsRequest) (*pb.BatchUpdateStatsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"match_id": req.GetMatchId(),
+		"updates":  len(req.GetUpdates()),
+	})
+	logger.Debug("Batch update user stats")
+
+	if err := validateStatsBatch(req); err != nil {
+		logger.WithError(err).Error("Invalid stats batch")
+		return nil, err
+	}
+
+	if res, err := s.statBatch(logger, req.GetMatchId()); err != nil || res != nil {
+		return res, err
+	}
+
+	violations := make([][]string, len(req.GetUpdates()))
+	for i, update := range req.GetUpdates() {
+		violations[i] = s.cfg.Plausibility.violations(update)
+		if len(violations[i]) > 0 && !s.cfg.Plausibility.Flag {
+			logger.WithField("violations", violations[i]).Error("Implausible stats update")
+			return nil, status.Errorf(codes.InvalidArgument, "Implausible stats update for %s: %s",
+				update.GetUsername(), strings.Join(violations[i], violationsSeparator))
+		}
+	}
+
+	userIDs, err := s.resolveUsers(logger, req.GetUpdates())
+	if err != nil {
+		return nil, err
+	}
+
+	// updates are applied in user id order so concurrent batches lock user_stats rows in the same order
+	order := make([]int, 0, len(req.GetUpdates()))
+	results := make([]*pb.BatchStatsResult, len(req.GetUpdates()))
+	resolved := make(map[string]bool, len(req.GetUpdates()))
+	for i, update := range req.GetUpdates() {
+		results[i] = &pb.BatchStatsResult{Username: update.GetUsername(), UserId: userIDs[i]}
+		if userIDs[i] == "" {
+			continue
+		}
+		if resolved[userIDs[i]] {
+			logger.WithField("user_id", userIDs[i]).Error("Stats batch updates a user twice")
+			return nil, status.Errorf(codes.InvalidArgument, "User %s is updated twice", update.GetUsername())
+		}
+		resolved[userIDs[i]] = true
+		order = append(order, i)
+	}
+	sort.Slice(order, func(i, j int) bool { return userIDs[order[i]] < userIDs[order[j]] })
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	// claiming the match id waits for a concurrent submission of the same match, which is then replayed
+	res, err := txnDB.Exec(claimStatBatchQuery, req.GetMatchId())
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not claim stats batch")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		txnDB.Rollback()
+		replayed, err := s.statBatch(logger, req.GetMatchId())
+		if err == nil && replayed == nil {
+			logger.Error("Stats batch vanished while it was claimed")
+			return nil, status.Error(codes.Aborted, "Stats batch is being applied, retry later")
+		}
+		return replayed, err
+	}
+
+	now := time.Now()
+	for _, i := range order {
+		update, result := req.GetUpdates()[i], results[i]
+		logger := logger.WithField("user_id", result.GetUserId())
+
+		result.Stats = &pb.UserStats{}
+		err := txnDB.QueryRow(addUserStatsQuery, result.GetUserId(), update.GetAddGames(), update.GetAddWins(), update.GetAddTop5(), update.GetAddKills()).
+			Scan(&result.Stats.Games, &result.Stats.Wins, &result.Stats.Top5, &result.Stats.Kills)
+		if err == sql.ErrNoRows {
+			txnDB.Rollback()
+			logger.Error("Corrupted user - doesn't have 1 stats object")
+			return nil, status.Error(codes.Internal, "Profile is corrupted. Contact support.")
+		}
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not update user stats")
+			return nil, status.Error(codes.Internal, "Could not update user stats")
+		}
+
+		if _, err := txnDB.Exec(applySeasonStatsQuery, result.GetUserId(), update.GetAddGames(), update.GetAddWins(), update.GetAddTop5(), update.GetAddKills()); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not update season stats")
+			return nil, status.Error(codes.Internal, "Could not update user stats")
+		}
+
+		if len(violations[i]) > 0 {
+			logger.WithField("violations", violations[i]).Warn("Implausible stats update, flagging it for review")
+			_, err = txnDB.Exec(recordFlaggedStatUpdateQuery, result.GetUserId(), update.GetAddGames(), update.GetAddWins(), update.GetAddTop5(), update.GetAddKills(), now,
+				strings.Join(violations[i], violationsSeparator))
+			result.Flagged = true
+		} else {
+			_, err = txnDB.Exec(recordStatUpdateQuery, result.GetUserId(), update.GetAddGames(), update.GetAddWins(), update.GetAddTop5(), update.GetAddKills(), now)
+		}
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not record stats update")
+			return nil, status.Error(codes.Internal, "Could not update user stats")
+		}
+
+		if result.Unlocked, err = applyAchievements(logger, txnDB, result.GetUserId(), update.GetAddGames(), update.GetAddWins(), update.GetAddTop5(), update.GetAddKills()); err != nil {
+			txnDB.Rollback()
+			return nil, err
+		}
+		result.Applied = true
+	}
+
+	response := &pb.BatchUpdateStatsResponse{Results: results}
+	encoded, err := proto.Marshal(response)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not encode stats batch results")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+	if _, err := txnDB.Exec(saveStatBatchQuery, req.GetMatchId(), encoded); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not save stats batch results")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	return response, nil
+}
+
+func validateStatsBatch(req *pb.BatchUpdateStatsRequest) error {
+	if req.GetMatchId() == "" {
+		return status.Error(codes.InvalidArgument, "Match id is required")
+	}
+	if len(req.GetUpdates()) == 0 || len(req.GetUpdates()) > maxBatchStatsUpdates {
+		return status.Errorf(codes.InvalidArgument, "A batch should have between 1 and %d updates", maxBatchStatsUpdates)
+	}
+	seen := make(map[string]bool, len(req.GetUpdates()))
+	for _, update := range req.GetUpdates() {
+		if update.GetUsername() == "" {
+			return status.Error(codes.InvalidArgument, "Username is required")
+		}
+		if seen[update.GetUsername()] {
+			return status.Errorf(codes.InvalidArgument, "User %s is updated twice", update.GetUsername())
+		}
+		seen[update.GetUsername()] = true
+	}
+	return nil
+}
+
+// statBatch returns the stored results of an already submitted match, nil when the match id is new
+func (s *UsersStatsServer) statBatch(logger *logrus.Entry, matchID string) (*pb.BatchUpdateStatsResponse, error) {
+	var encoded []byte
+	err := s.cfg.Database.DB().QueryRow(statBatchQuery, matchID).Scan(&encoded)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch stats batch")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	response := &pb.BatchUpdateStatsResponse{}
+	if err := proto.Unmarshal(encoded, response); err != nil {
+		logger.WithError(err).Error("Could not decode stats batch results")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+	response.Replayed = true
+	logger.Info("Stats batch is already applied, replaying its results")
+	return response, nil
+}
+
+// resolveUsers returns the user id of every update in a single query, empty for unknown usernames.
+// A username matching several users resolves by id first, then name, then email like findUserByProvidedID
+func (s *UsersStatsServer) resolveUsers(logger *logrus.Entry, updates []*pb.UpdateUserStatsRequest) ([]string, error) {
+	usernames := make([]string, 0, len(updates))
+	for _, update := range updates {
+		usernames = append(usernames, update.GetUsername())
+	}
+
+	rows, err := s.cfg.Database.Raw(resolveUsersQuery, usernames, usernames, usernames).Rows()
+	if err != nil {
+		logger.WithError(err).Error("Could not resolve users")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+	defer rows.Close()
+
+	byID, byName, byEmail := map[string]string{}, map[string]string{}, map[string]string{}
+	for rows.Next() {
+		var id, name, email string
+		if err := rows.Scan(&id, &name, &email); err != nil {
+			logger.WithError(err).Error("Could not resolve users")
+			return nil, status.Error(codes.Internal, "Could not update user stats")
+		}
+		byID[id], byName[name], byEmail[email] = id, id, id
+	}
+	if err := rows.Err(); err != nil {
+		logger.WithError(err).Error("Could not resolve users")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	userIDs := make([]string, len(usernames))
+	for i, username := range usernames {
+		for _, found := range []map[string]string{byID, byName, byEmail} {
+			if id, ok := found[username]; ok {
+				userIDs[i] = id
+				break
+			}
+		}
+		if userIDs[i] == "" {
+			logger.WithField("username", username).Warn("Could not find user, skipping stats update")
+		}
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,212 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchUpdateStats(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:     gdb,
+		UsersServer:  usrServer,
+		Plausibility: PlausibilityConfig{MaxKillsPerGame: 40},
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	sqlResolveUsers := "SELECT id, name, email FROM users WHERE id IN ($1,$2,$3) OR name IN ($4,$5,$6) OR email IN ($7,$8,$9)"
+	req := &pb.BatchUpdateStatsRequest{
+		MatchId: "match-1",
+		Updates: []*pb.UpdateUserStatsRequest{
+			{Username: "second-name", AddGames: 1, AddKills: 3},
+			{Username: "first-id", AddGames: 1, AddWins: 1, AddTop5: 1, AddKills: 7},
+			{Username: "ghost", AddGames: 1},
+		},
+	}
+
+	t.Run("Batch update stats - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-1").WillReturnRows(sqlmock.NewRows([]string{"results"}))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlResolveUsers)).
+			WithArgs("second-name", "first-id", "ghost", "second-name", "first-id", "ghost", "second-name", "first-id", "ghost").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
+				AddRow("first-id", "first-name", "first@email.com").
+				AddRow("second-id", "second-name", "second@email.com"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(claimStatBatchQuery)).WithArgs("match-1").WillReturnResult(sqlmock.NewResult(0, 1))
+		// updates are applied in user id order
+		mock.ExpectQuery(regexp.QuoteMeta(addUserStatsQuery)).WithArgs("first-id", 1, 1, 1, 7).
+			WillReturnRows(sqlmock.NewRows([]string{"games", "wins", "top5", "kills"}).AddRow(11, 2, 5, 40))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("first-id", 1, 1, 1, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs("first-id", 1, 1, 1, 7, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("first-id", 1, 1, 1, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("first-id").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectQuery(regexp.QuoteMeta(addUserStatsQuery)).WithArgs("second-id", 1, 0, 0, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"games", "wins", "top5", "kills"}).AddRow(3, 0, 1, 5))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("second-id", 1, 0, 0, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs("second-id", 1, 0, 0, 3, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("second-id", 1, 0, 0, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("second-id").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectExec(regexp.QuoteMeta(saveStatBatchQuery)).WithArgs("match-1", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stClient.BatchUpdateStats(ctx, req)
+		if err != nil {
+			t.Fatalf("error batch updating stats: %v", err)
+		}
+		results := res.GetResults()
+		if len(results) != 3 || res.GetReplayed() {
+			t.Fatalf("unexpected results: %v", res)
+		}
+		if results[0].GetUserId() != "second-id" || !results[0].GetApplied() || results[0].GetStats().GetKills() != 5 {
+			t.Fatalf("unexpected result of second-name: %v", results[0])
+		}
+		if results[1].GetUserId() != "first-id" || !results[1].GetApplied() || results[1].GetStats().GetWins() != 2 {
+			t.Fatalf("unexpected result of first-id: %v", results[1])
+		}
+		if results[2].GetUserId() != "" || results[2].GetApplied() {
+			t.Fatalf("unexpected result of ghost: %v", results[2])
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	stored, err := proto.Marshal(&pb.BatchUpdateStatsResponse{Results: []*pb.BatchStatsResult{
+		{Username: "second-name", UserId: "second-id", Applied: true},
+		{Username: "first-id", UserId: "first-id", Applied: true},
+		{Username: "ghost"},
+	}})
+	if err != nil {
+		t.Fatalf("Could not encode stored results: %v", err)
+	}
+
+	t.Run("Batch update stats - retried", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-1").WillReturnRows(sqlmock.NewRows([]string{"results"}).AddRow(stored))
+
+		res, err := stClient.BatchUpdateStats(ctx, req)
+		if err != nil {
+			t.Fatalf("error batch updating stats: %v", err)
+		}
+		if !res.GetReplayed() || len(res.GetResults()) != 3 || res.GetResults()[1].GetUserId() != "first-id" {
+			t.Fatalf("unexpected replayed results: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Batch update stats - submitted concurrently", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-1").WillReturnRows(sqlmock.NewRows([]string{"results"}))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlResolveUsers)).
+			WithArgs("second-name", "first-id", "ghost", "second-name", "first-id", "ghost", "second-name", "first-id", "ghost").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
+				AddRow("first-id", "first-name", "first@email.com").
+				AddRow("second-id", "second-name", "second@email.com"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(claimStatBatchQuery)).WithArgs("match-1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-1").WillReturnRows(sqlmock.NewRows([]string{"results"}).AddRow(stored))
+
+		res, err := stClient.BatchUpdateStats(ctx, req)
+		if err != nil {
+			t.Fatalf("error batch updating stats: %v", err)
+		}
+		if !res.GetReplayed() {
+			t.Fatalf("expected replayed results: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Batch update stats - invalid", func(t *testing.T) {
+		invalid := []*pb.BatchUpdateStatsRequest{
+			{Updates: req.GetUpdates()},
+			{MatchId: "match-2"},
+			{MatchId: "match-2", Updates: []*pb.UpdateUserStatsRequest{{Username: "first-id"}, {Username: "first-id"}}},
+		}
+		for _, r := range invalid {
+			if _, err := stClient.BatchUpdateStats(ctx, r); status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument for %v, got: %v", r, err)
+			}
+		}
+	})
+
+	t.Run("Batch update stats - implausible", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-3").WillReturnRows(sqlmock.NewRows([]string{"results"}))
+
+		_, err := stClient.BatchUpdateStats(ctx, &pb.BatchUpdateStatsRequest{
+			MatchId: "match-3",
+			Updates: []*pb.UpdateUserStatsRequest{{Username: "first-id", AddGames: 1, AddKills: 500}},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Batch update stats - same user twice", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(statBatchQuery)).WithArgs("match-4").WillReturnRows(sqlmock.NewRows([]string{"results"}))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email FROM users WHERE id IN ($1,$2) OR name IN ($3,$4) OR email IN ($5,$6)")).
+			WithArgs("first-id", "first-name", "first-id", "first-name", "first-id", "first-name").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).AddRow("first-id", "first-name", "first@email.com"))
+
+		_, err := stClient.BatchUpdateStats(ctx, &pb.BatchUpdateStatsRequest{
+			MatchId: "match-4",
+			Updates: []*pb.UpdateUserStatsRequest{{Username: "first-id", AddGames: 1}, {Username: "first-name", AddGames: 1}},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -0,0 +1,476 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	catalogFormatJSON = "json"
+	catalogFormatCSV  = "csv"
+)
+
+// catalogRow is a store item as it appears in imported and exported catalogs, items are matched by SKU
+type catalogRow struct {
+	Sku              string `json:"sku"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Type             int32  `json:"type"`
+	CoinsPrice       int32  `json:"coins_price"`
+	GemsPrice        int32  `json:"gems_price"`
+	ImageId          string `json:"image_id"`
+	OnSale           bool   `json:"on_sale"`
+	SaleCoinsPrice   int32  `json:"sale_coins_price"`
+	SaleGemsPrice    int32  `json:"sale_gems_price"`
+	Consumable       bool   `json:"consumable"`
+	MaxStack         int32  `json:"max_stack"`
+	RentalDays       int32  `json:"rental_days"`
+	RentalCoinsPrice int32  `json:"rental_coins_price"`
+	RentalGemsPrice  int32  `json:"rental_gems_price"`
+	Retired          bool   `json:"retired"`
+
+	// row is the position in the imported catalog and columns the indexes of the columns it sets,
+	// both kept last so they aren't columns
+	row     int32
+	columns []int
+}
+
+// catalogColumns are the CSV columns, named and ordered like the catalogRow json fields
+var catalogColumns = func() []string {
+	rowType := reflect.TypeOf(catalogRow{})
+	columns := make([]string, 0, rowType.NumField())
+	for i := 0; i < rowType.NumField(); i++ {
+		if tag := rowType.Field(i).Tag.Get("json"); tag != "" {
+			columns = append(columns, tag)
+		}
+	}
+	return columns
+}()
+
+func (s *StoreItemsServer) ImportCatalog(ctx context.Context, req *pb.ImportCatalogRequest) (*pb.ImportCatalogResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"format":  req.GetFormat(),
+		"dry_run": req.GetDryRun(),
+	})
+	logger.Debug("Import Catalog")
+
+	rows, res, err := parseCatalog(req.GetFormat(), req.GetData())
+	if err != nil {
+		logger.WithError(err).Error("Could not parse catalog")
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Could not parse catalog: %v", err))
+	}
+
+	txnDB := s.cfg.Database.Begin()
+	if txnDB.Error != nil {
+		logger.WithError(txnDB.Error).Error("Could not begin transaction")
+		return nil, status.Error(codes.Internal, "Could not import catalog")
+	}
+
+	// items are locked so the validated catalog is still valid when it gets applied
+	var items []*pb.StoreItemORM
+	if err := txnDB.Set("gorm:query_option", "FOR UPDATE").Find(&items).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not fetch items")
+		return nil, status.Error(codes.Internal, "Could not import catalog")
+	}
+	var itemTypes []*pb.ItemTypeORM
+	if err := txnDB.Find(&itemTypes).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not fetch item types")
+		return nil, status.Error(codes.Internal, "Could not import catalog")
+	}
+
+	bySku := map[string]*pb.StoreItemORM{}
+	for _, item := range items {
+		bySku[item.Sku] = item
+	}
+	changedConsumables := []string{}
+	for _, row := range rows {
+		if item, ok := bySku[row.Sku]; ok {
+			row.overlay(item)
+			if row.Consumable != item.Consumable {
+				changedConsumables = append(changedConsumables, item.Id)
+			}
+		}
+	}
+
+	owned := map[string]bool{}
+	if len(changedConsumables) > 0 {
+		ownedRows, err := txnDB.Raw(ownedItemsRawQuery, changedConsumables).Rows()
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not fetch owned items")
+			return nil, status.Error(codes.Internal, "Could not import catalog")
+		}
+		for ownedRows.Next() {
+			var itemID string
+			if err := ownedRows.Scan(&itemID); err != nil {
+				ownedRows.Close()
+				txnDB.Rollback()
+				logger.WithError(err).Error("Could not fetch owned items")
+				return nil, status.Error(codes.Internal, "Could not import catalog")
+			}
+			owned[itemID] = true
+		}
+		ownedRows.Close()
+	}
+
+	errs, err := s.validateCatalog(ctx, rows, items, itemTypes, owned)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not validate catalog")
+		return nil, status.Error(codes.Internal, "Could not import catalog")
+	}
+	res.Errors = append(res.Errors, errs...)
+
+	if len(res.Errors) > 0 || req.GetDryRun() {
+		txnDB.Rollback()
+		countCatalogChanges(res, rows, items)
+		return res, nil
+	}
+
+	for _, row := range rows {
+		item, ok := bySku[row.Sku]
+		if !ok {
+			item = &pb.StoreItemORM{Id: uuid.NewV4().String()}
+		}
+		row.apply(item)
+
+		if ok {
+			err = txnDB.Save(item).Error
+			res.Updated++
+		} else {
+			err = txnDB.Create(item).Error
+			res.Created++
+		}
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).WithField("sku", row.Sku).Error("Could not save item")
+			return nil, status.Error(codes.Internal, "Could not import catalog")
+		}
+	}
+
+	if err := txnDB.Commit().Error; err != nil {
+		logger.WithError(err).Error("Could not commit catalog")
+		return nil, status.Error(codes.Internal, "Could not import catalog")
+	}
+	res.Applied = true
+	logger.WithFields(logrus.Fields{"created": res.Created, "updated": res.Updated}).Info("Catalog imported")
+
+	return res, nil
+}
+
+func (s *StoreItemsServer) ExportCatalog(ctx context.Context, req *pb.ExportCatalogRequest) (*pb.ExportCatalogResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("format", req.GetFormat())
+	logger.Debug("Export Catalog")
+
+	format := req.GetFormat()
+	if format == "" {
+		format = catalogFormatJSON
+	}
+	if format != catalogFormatJSON && format != catalogFormatCSV {
+		logger.Error("Unknown catalog format")
+		return nil, status.Error(codes.InvalidArgument, "Format should be json or csv")
+	}
+
+	var items []*pb.StoreItemORM
+	if err := s.cfg.Database.Order("sku").Find(&items).Error; err != nil {
+		logger.WithError(err).Error("Could not fetch items")
+		return nil, status.Error(codes.Internal, "Could not export catalog")
+	}
+
+	rows := make([]*catalogRow, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, newCatalogRow(item))
+	}
+
+	var buf bytes.Buffer
+	var err error
+	if format == catalogFormatCSV {
+		err = writeCatalogCSV(&buf, rows)
+	} else {
+		err = json.NewEncoder(&buf).Encode(rows)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Could not encode catalog")
+		return nil, status.Error(codes.Internal, "Could not export catalog")
+	}
+
+	return &pb.ExportCatalogResponse{Format: format, Data: buf.String()}, nil
+}
+
+// parseCatalog decodes the rows it can, rows that can't be decoded are reported as row errors of the response.
+// The error is only returned when the catalog as a whole is unreadable.
+func parseCatalog(format, data string) ([]*catalogRow, *pb.ImportCatalogResponse, error) {
+	res := &pb.ImportCatalogResponse{}
+	rows := []*catalogRow{}
+
+	switch format {
+	case "", catalogFormatJSON:
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(data), &raw); err != nil {
+			return nil, nil, err
+		}
+		for i, message := range raw {
+			row := &catalogRow{row: int32(i + 1)}
+			decoder := json.NewDecoder(bytes.NewReader(message))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(row); err != nil {
+				res.Errors = append(res.Errors, &pb.CatalogRowError{Row: int32(i + 1), Message: err.Error()})
+				continue
+			}
+			var keys map[string]json.RawMessage
+			if err := json.Unmarshal(message, &keys); err != nil {
+				res.Errors = append(res.Errors, &pb.CatalogRowError{Row: int32(i + 1), Message: err.Error()})
+				continue
+			}
+			for key := range keys {
+				row.columns = append(row.columns, catalogColumnIndex(key))
+			}
+			rows = append(rows, row)
+		}
+	case catalogFormatCSV:
+		reader := csv.NewReader(strings.NewReader(data))
+		header, err := reader.Read()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, column := range header {
+			if catalogColumnIndex(column) < 0 {
+				return nil, nil, fmt.Errorf("unknown column %q", column)
+			}
+		}
+		for i := 1; ; i++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			row := &catalogRow{row: int32(i)}
+			if err := row.setColumns(header, record); err != nil {
+				res.Errors = append(res.Errors, &pb.CatalogRowError{Row: int32(i), Message: err.Error()})
+				continue
+			}
+			rows = append(rows, row)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown format %q", format)
+	}
+
+	return rows, res, nil
+}
+
+// validateCatalog checks every row on its own and the catalog as it would look after the import,
+// so items may swap names or images as long as the result has no duplicates. Names only clash within
+// an item type, like in Create. owned holds the ids of items players own.
+func (s *StoreItemsServer) validateCatalog(ctx context.Context, rows []*catalogRow, items []*pb.StoreItemORM, itemTypes []*pb.ItemTypeORM,
+	owned map[string]bool) ([]*pb.CatalogRowError, error) {
+	errs := []*pb.CatalogRowError{}
+	report := func(row *catalogRow, msg string) {
+		errs = append(errs, &pb.CatalogRowError{Row: row.row, Sku: row.Sku, Message: msg})
+	}
+
+	types := map[int32]bool{}
+	for _, itemType := range itemTypes {
+		types[itemType.Id] = true
+	}
+
+	type name struct {
+		name     string
+		itemType int32
+	}
+	type identity struct {
+		name    name
+		imageID string
+	}
+	final := map[string]identity{}
+	bySku := map[string]*pb.StoreItemORM{}
+	for _, item := range items {
+		final[item.Sku] = identity{name{item.Name, item.Type}, item.ImageId}
+		bySku[item.Sku] = item
+	}
+
+	seen := map[string]bool{}
+	for _, row := range rows {
+		switch {
+		case row.Sku == "" || row.Name == "" || row.ImageId == "":
+			report(row, "SKU, name and image id should be set")
+		case seen[row.Sku]:
+			report(row, "SKU is listed more than once")
+		case !types[row.Type]:
+			report(row, "Unknown item type")
+		case row.CoinsPrice < 0 || row.GemsPrice < 0 || row.SaleCoinsPrice < 0 || row.SaleGemsPrice < 0 ||
+			row.RentalCoinsPrice < 0 || row.RentalGemsPrice < 0:
+			report(row, "Prices can't be negative")
+		case row.MaxStack < 0:
+			report(row, "Max stack can't be negative")
+		case row.RentalDays < 0 || (row.RentalDays > 0 && row.Consumable):
+			report(row, "Rental days can't be negative and consumables can't be rented")
+		case bySku[row.Sku] != nil && bySku[row.Sku].Consumable != row.Consumable && owned[bySku[row.Sku].Id]:
+			report(row, consumableItemOwnedErrorMsg)
+		default:
+			exists, err := s.imageExists(ctx, row.ImageId)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				report(row, "Unknown image id")
+			}
+		}
+		seen[row.Sku] = true
+		final[row.Sku] = identity{name{row.Name, row.Type}, row.ImageId}
+	}
+
+	names, imageIDs := map[name]int{}, map[string]int{}
+	for _, id := range final {
+		names[id.name]++
+		imageIDs[id.imageID]++
+	}
+	for _, row := range rows {
+		if names[name{row.Name, row.Type}] > 1 {
+			report(row, "Name is used by another item of the same type")
+		}
+		if imageIDs[row.ImageId] > 1 {
+			report(row, "Image id is used by another item")
+		}
+	}
+
+	return errs, nil
+}
+
+// countCatalogChanges fills in what an import that wasn't applied would have done
+func countCatalogChanges(res *pb.ImportCatalogResponse, rows []*catalogRow, items []*pb.StoreItemORM) {
+	skus := map[string]bool{}
+	for _, item := range items {
+		skus[item.Sku] = true
+	}
+	for _, row := range rows {
+		if skus[row.Sku] {
+			res.Updated++
+		} else {
+			res.Created++
+		}
+	}
+}
+
+func newCatalogRow(item *pb.StoreItemORM) *catalogRow {
+	return &catalogRow{
+		Sku:              item.Sku,
+		Name:             item.Name,
+		Description:      item.Description,
+		Type:             item.Type,
+		CoinsPrice:       item.CoinsPrice,
+		GemsPrice:        item.GemsPrice,
+		ImageId:          item.ImageId,
+		OnSale:           item.OnSale,
+		SaleCoinsPrice:   item.SaleCoinsPrice,
+		SaleGemsPrice:    item.SaleGemsPrice,
+		Consumable:       item.Consumable,
+		MaxStack:         item.MaxStack,
+		RentalDays:       item.RentalDays,
+		RentalCoinsPrice: item.RentalCoinsPrice,
+		RentalGemsPrice:  item.RentalGemsPrice,
+		Retired:          item.Retired,
+	}
+}
+
+func (r *catalogRow) apply(item *pb.StoreItemORM) {
+	item.Sku = r.Sku
+	item.Name = r.Name
+	item.Description = r.Description
+	item.Type = r.Type
+	item.CoinsPrice = r.CoinsPrice
+	item.GemsPrice = r.GemsPrice
+	item.ImageId = r.ImageId
+	item.OnSale = r.OnSale
+	item.SaleCoinsPrice = r.SaleCoinsPrice
+	item.SaleGemsPrice = r.SaleGemsPrice
+	item.Consumable = r.Consumable
+	item.MaxStack = r.MaxStack
+	item.RentalDays = r.RentalDays
+	item.RentalCoinsPrice = r.RentalCoinsPrice
+	item.RentalGemsPrice = r.RentalGemsPrice
+	item.Retired = r.Retired
+}
+
+// overlay fills in the columns the row doesn't set with the values of the item it updates,
+// so partial catalogs only change the columns they list
+func (r *catalogRow) overlay(item *pb.StoreItemORM) {
+	merged := newCatalogRow(item)
+	from, to := reflect.ValueOf(r).Elem(), reflect.ValueOf(merged).Elem()
+	for _, i := range r.columns {
+		to.Field(i).Set(from.Field(i))
+	}
+	merged.row, merged.columns = r.row, r.columns
+	*r = *merged
+}
+
+// setColumns parses a CSV record, columns missing from the header keep their zero values until the row is overlaid
+func (r *catalogRow) setColumns(header, record []string) error {
+	value := reflect.ValueOf(r).Elem()
+	for i, column := range header {
+		r.columns = append(r.columns, catalogColumnIndex(column))
+		field := value.Field(catalogColumnIndex(column))
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(record[i])
+		case reflect.Int32:
+			parsed, err := strconv.ParseInt(strings.TrimSpace(record[i]), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q", column, record[i])
+			}
+			field.SetInt(parsed)
+		case reflect.Bool:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(record[i]))
+			if err != nil {
+				return fmt.Errorf("invalid %s %q", column, record[i])
+			}
+			field.SetBool(parsed)
+		}
+	}
+	return nil
+}
+
+func writeCatalogCSV(w io.Writer, rows []*catalogRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(catalogColumns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		value := reflect.ValueOf(row).Elem()
+		record := make([]string, len(catalogColumns))
+		for i := range catalogColumns {
+			record[i] = fmt.Sprint(value.Field(i).Interface())
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func catalogColumnIndex(name string) int {
+	for i, column := range catalogColumns {
+		if column == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,249 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCatalog(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlLockItems := `SELECT * FROM "store_items" FOR UPDATE`
+	sqlItemTypes := `SELECT * FROM "item_types"`
+	sqlSaveItem := `UPDATE "store_items" SET`
+	sqlUpdateItem := `UPDATE "store_items" SET "coins_price" = $1, "consumable" = $2, "description" = $3, "gems_price" = $4, "image_id" = $5, "max_stack" = $6, "name" = $7, "on_sale" = $8, "rental_coins_price" = $9, "rental_days" = $10, "rental_gems_price" = $11, "retired" = $12, "sale_coins_price" = $13, "sale_gems_price" = $14, "sku" = $15, "type" = $16  WHERE "store_items"."id" = $17`
+	sqlCreateItem := `INSERT INTO "store_items"`
+	sqlExportItems := `SELECT * FROM "store_items" ORDER BY "sku"`
+
+	itemColumns := []string{"id", "sku", "name", "type", "image_id", "coins_price"}
+	expectCatalogState := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlLockItems)).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow("sword-id", "SWORD-1", "Sword", 1, "sword-image", 100).
+				AddRow("shield-id", "SHIELD-1", "Shield", 1, "shield-image", 80))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlItemTypes)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "weapon").AddRow(2, "emote"))
+	}
+
+	jsonCatalog := `[
+		{"sku": "SWORD-1", "name": "Sword", "type": 1, "image_id": "sword-image", "coins_price": 150},
+		{"sku": "DANCE-1", "name": "Dance", "type": 2, "image_id": "dance-image", "gems_price": 5}
+	]`
+
+	t.Run("Import Catalog - dry run", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectRollback()
+
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Format: "json", Data: jsonCatalog, DryRun: true})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if res.GetApplied() || res.GetCreated() != 1 || res.GetUpdated() != 1 || len(res.GetErrors()) != 0 {
+			t.Fatalf("unexpected dry run result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - applied", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectExec(regexp.QuoteMeta(sqlSaveItem)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateItem)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("dance-id"))
+		mock.ExpectCommit()
+
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Data: jsonCatalog})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if !res.GetApplied() || res.GetCreated() != 1 || res.GetUpdated() != 1 {
+			t.Fatalf("unexpected import result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - row errors", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectRollback()
+
+		csvCatalog := "sku,name,type,image_id,coins_price\n" +
+			"AXE-1,Shield,1,axe-image,10\n" +
+			"BOW-1,Bow,7,bow-image,10\n" +
+			"SPEAR-1,Spear,1,spear-image,lots\n" +
+			"SWORD-1,Longsword,1,sword-image,100\n"
+
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Format: "csv", Data: csvCatalog})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if res.GetApplied() {
+			t.Fatalf("catalog with errors should not be applied")
+		}
+		rows := map[int32]bool{}
+		for _, rowErr := range res.GetErrors() {
+			rows[rowErr.GetRow()] = true
+		}
+		if len(rows) != 3 || !rows[1] || !rows[2] || !rows[3] {
+			t.Fatalf("unexpected row errors: %v", res.GetErrors())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - partial header", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlLockItems)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "name", "type", "image_id", "coins_price", "on_sale", "sale_coins_price", "max_stack", "consumable", "retired"}).
+				AddRow("potion-id", "POTION-1", "Potion", 1, "potion-image", 100, true, 80, 5, true, true))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlItemTypes)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "weapon"))
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateItem)).
+			WithArgs(100, true, "Heals a bit", 0, "potion-image", 5, "Small Potion", true, 0, 0, 0, true, 80, 0, "POTION-1", 1, "potion-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		csvCatalog := "sku,name,description\n" +
+			"POTION-1,Small Potion,Heals a bit\n"
+
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Format: "csv", Data: csvCatalog})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if !res.GetApplied() || res.GetUpdated() != 1 || len(res.GetErrors()) != 0 {
+			t.Fatalf("unexpected import result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - swapped names", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectRollback()
+
+		swapped := `[
+			{"sku": "SWORD-1", "name": "Shield", "type": 1, "image_id": "sword-image"},
+			{"sku": "SHIELD-1", "name": "Sword", "type": 1, "image_id": "shield-image"}
+		]`
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Data: swapped, DryRun: true})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if len(res.GetErrors()) != 0 {
+			t.Fatalf("unexpected row errors: %v", res.GetErrors())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - same name of another type", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectRollback()
+
+		catalog := `[{"sku": "SWORD-EMOTE-1", "name": "Sword", "type": 2, "image_id": "sword-emote-image"}]`
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Data: catalog, DryRun: true})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if len(res.GetErrors()) != 0 || res.GetCreated() != 1 {
+			t.Fatalf("unexpected import result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - consumable of an owned item", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectCatalogState()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT store_item_id FROM users_store_items WHERE store_item_id IN ($1)`)).WithArgs("sword-id").
+			WillReturnRows(sqlmock.NewRows([]string{"store_item_id"}).AddRow("sword-id"))
+		mock.ExpectRollback()
+
+		catalog := `[{"sku": "SWORD-1", "consumable": true, "max_stack": 10}]`
+		res, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Data: catalog})
+		if err != nil {
+			t.Fatalf("error importing catalog: %v", err)
+		}
+		if res.GetApplied() || len(res.GetErrors()) != 1 || res.GetErrors()[0].GetMessage() != consumableItemOwnedErrorMsg {
+			t.Fatalf("unexpected import result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Import Catalog - unreadable", func(t *testing.T) {
+		_, err := stiClient.ImportCatalog(ctx, &pb.ImportCatalogRequest{Format: "csv", Data: "sku,colour\nSWORD-1,red\n"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("Export Catalog - csv", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlExportItems)).
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("sword-id", "SWORD-1", "Sword, Long", 1, "sword-image", 100))
+
+		res, err := stiClient.ExportCatalog(ctx, &pb.ExportCatalogRequest{Format: "csv"})
+		if err != nil {
+			t.Fatalf("error exporting catalog: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(res.GetData()), "\n")
+		if len(lines) != 2 || lines[0] != strings.Join(catalogColumns, ",") || !strings.HasPrefix(lines[1], `SWORD-1,"Sword, Long",,1,100,`) {
+			t.Fatalf("unexpected csv export: %q", res.GetData())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
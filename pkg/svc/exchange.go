@@ -0,0 +1,268 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	exchangeCurrencyQuery = "UPDATE users SET gems = gems - $1, coins = coins + $2 WHERE id = $3 AND gems >= $1 RETURNING coins, gems"
+	exchangedSinceQuery   = "SELECT COALESCE(SUM(amount), 0) FROM currency_exchanges WHERE user_id = $1 AND from_currency = $2 AND to_currency = $3 AND created_at >= $4"
+	insertExchangeQuery   = "INSERT INTO currency_exchanges (user_id, from_currency, to_currency, amount, received) VALUES ($1, $2, $3, $4, $5)"
+)
+
+// gems can only be exchanged for coins, coins are earned in matches and must not buy paid currency
+const (
+	exchangeFromCurrency = "gems"
+	exchangeToCurrency   = "coins"
+)
+
+func (s *UsersServer) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.SetExchangeRateResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"from_currency": req.GetFromCurrency(),
+		"to_currency":   req.GetToCurrency(),
+	})
+	logger.Debug("Set Exchange Rate")
+
+	if req.GetFromCurrency() != exchangeFromCurrency || req.GetToCurrency() != exchangeToCurrency {
+		logger.Error("Unsupported currency pair")
+		return nil, status.Error(codes.InvalidArgument, "Unsupported currency pair")
+	}
+
+	rateGems := req.GetRateGems()
+	if rateGems == 0 {
+		rateGems = 1
+	}
+	minAmount := req.GetMinAmount()
+	if minAmount == 0 {
+		minAmount = 1
+	}
+	if rateGems < 0 || req.GetRateCoins() <= 0 || minAmount < 0 || req.GetDailyCap() < 0 || req.GetMaxAmount() < 0 ||
+		(req.GetMaxAmount() != 0 && req.GetMaxAmount() < minAmount) {
+		logger.Error("Exchange rate validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Rate should be positive and amount limits should be consistent")
+	}
+
+	var rate pb.ExchangeRateORM
+	err := s.cfg.Database.Where("from_currency = ? AND to_currency = ?", req.GetFromCurrency(), req.GetToCurrency()).First(&rate).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not set exchange rate")
+		return nil, status.Error(codes.Internal, "Could not set exchange rate")
+	}
+
+	rate.FromCurrency = req.GetFromCurrency()
+	rate.ToCurrency = req.GetToCurrency()
+	rate.RateGems = rateGems
+	rate.RateCoins = req.GetRateCoins()
+	rate.MinAmount = minAmount
+	rate.MaxAmount = req.GetMaxAmount()
+	rate.DailyCap = req.GetDailyCap()
+
+	if err := s.cfg.Database.Save(&rate).Error; err != nil {
+		logger.WithError(err).Error("Could not set exchange rate")
+		return nil, status.Error(codes.Internal, "Could not set exchange rate")
+	}
+
+	pbRate, err := rate.ToPB(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Could not set exchange rate")
+		return nil, status.Error(codes.Internal, "Could not set exchange rate")
+	}
+
+	return &pb.SetExchangeRateResponse{Result: &pbRate}, nil
+}
+
+func (s *UsersServer) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
+	logger.Debug("Delete Exchange Rate")
+
+	var rate pb.ExchangeRateORM
+	if err := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&rate).Error; err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not delete exchange rate")
+		return nil, status.Error(codes.Internal, "Could not delete exchange rate")
+	}
+
+	return &pb.DeleteExchangeRateResponse{}, nil
+}
+
+func (s *UsersServer) GetExchangeRates(ctx context.Context, req *pb.GetExchangeRatesRequest) (*pb.GetExchangeRatesResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("provided_id", req.GetId())
+	logger.Debug("Get Exchange Rates")
+
+	var usr *pb.User
+	if req.GetId() != "" {
+		claims, _ := auth.GetAuthorizationData(ctx)
+		if !claims.IsAdmin && claims.UserId != req.GetId() && claims.UserName != req.GetId() && claims.UserEmail != req.GetId() {
+			logger.Error("User can only use this endpoint for themselves")
+			return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+		}
+
+		var err error
+		if usr, err = s.findUserByProvidedID(ctx, logger, req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	var rates []*pb.ExchangeRateORM
+	if err := s.cfg.Database.Order("id").Find(&rates).Error; err != nil {
+		logger.WithError(err).Error("Could not fetch exchange rates")
+		return nil, status.Error(codes.Internal, "Could not fetch exchange rates")
+	}
+
+	quotes := make([]*pb.ExchangeQuote, 0, len(rates))
+	for _, rate := range rates {
+		pbRate, err := rate.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not fetch exchange rates")
+			return nil, status.Error(codes.Internal, "Could not fetch exchange rates")
+		}
+
+		quote := &pb.ExchangeQuote{Rate: &pbRate, Amount: req.GetAmount()}
+		if received := exchangedAmount(req.GetAmount(), rate.RateGems, rate.RateCoins); received > 0 {
+			quote.Receive = received
+		}
+
+		if usr != nil && rate.DailyCap > 0 {
+			var exchanged int32
+			if err := s.cfg.Database.DB().QueryRow(exchangedSinceQuery, usr.GetId(), rate.FromCurrency, rate.ToCurrency, startOfDay(time.Now())).
+				Scan(&exchanged); err != nil {
+				logger.WithError(err).Error("Could not fetch exchanged amount")
+				return nil, status.Error(codes.Internal, "Could not fetch exchange rates")
+			}
+			if exchanged < rate.DailyCap {
+				quote.RemainingToday = rate.DailyCap - exchanged
+			}
+		}
+
+		quotes = append(quotes, quote)
+	}
+
+	return &pb.GetExchangeRatesResponse{Quotes: quotes}, nil
+}
+
+func (s *UsersServer) ExchangeCurrency(ctx context.Context, req *pb.ExchangeCurrencyRequest) (*pb.ExchangeCurrencyResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"provided_id":   req.GetId(),
+		"from_currency": req.GetFromCurrency(),
+		"to_currency":   req.GetToCurrency(),
+		"amount":        req.GetAmount(),
+	})
+	logger.Debug("Exchange Currency")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetId() && claims.UserName != req.GetId() && claims.UserEmail != req.GetId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	if req.GetFromCurrency() != exchangeFromCurrency || req.GetToCurrency() != exchangeToCurrency {
+		logger.Error("Unsupported currency pair")
+		return nil, status.Error(codes.InvalidArgument, "Unsupported currency pair")
+	}
+
+	var rate pb.ExchangeRateORM
+	if err := s.cfg.Database.Where("from_currency = ? AND to_currency = ?", req.GetFromCurrency(), req.GetToCurrency()).First(&rate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Exchange rate not found")
+			return nil, status.Error(codes.NotFound, "Exchange rate not found")
+		}
+		logger.WithError(err).Error("Could not fetch exchange rate")
+		return nil, status.Error(codes.Internal, "Could not exchange currency")
+	}
+
+	if req.GetAmount() < rate.MinAmount {
+		logger.Error("Amount is below the minimum")
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Amount should be at least %d", rate.MinAmount))
+	}
+	if rate.MaxAmount > 0 && req.GetAmount() > rate.MaxAmount {
+		logger.Error("Amount is above the maximum")
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Amount should be at most %d", rate.MaxAmount))
+	}
+
+	received := exchangedAmount(req.GetAmount(), rate.RateGems, rate.RateCoins)
+	if received < 0 {
+		logger.Error("Exchanged amount overflows")
+		return nil, status.Error(codes.InvalidArgument, "Amount is too big")
+	}
+	if received == 0 {
+		logger.Error("Exchanged amount rounds down to nothing")
+		return nil, status.Error(codes.InvalidArgument, "Amount is too small for the rate")
+	}
+
+	usr, err := s.findUserByProvidedID(ctx, logger, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not exchange currency")
+	}
+
+	res := &pb.ExchangeCurrencyResponse{Spent: req.GetAmount(), Received: received}
+	err = txnDB.QueryRow(exchangeCurrencyQuery, req.GetAmount(), received, usr.GetId()).
+		Scan(&res.Coins, &res.Gems)
+	if err == sql.ErrNoRows {
+		txnDB.Rollback()
+		logger.Error("Not enough funds")
+		return nil, status.Error(codes.InvalidArgument, "Not enough gems")
+	}
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not exchange currency")
+		return nil, status.Error(codes.Internal, "Could not exchange currency")
+	}
+
+	// the users row is locked by now, so concurrent exchanges can't slip past the cap
+	if rate.DailyCap > 0 {
+		var exchanged int32
+		if err := txnDB.QueryRow(exchangedSinceQuery, usr.GetId(), rate.FromCurrency, rate.ToCurrency, startOfDay(time.Now())).
+			Scan(&exchanged); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not fetch exchanged amount")
+			return nil, status.Error(codes.Internal, "Could not exchange currency")
+		}
+		if exchanged+req.GetAmount() > rate.DailyCap {
+			txnDB.Rollback()
+			logger.Error("Daily exchange cap reached")
+			return nil, status.Error(codes.ResourceExhausted, "Daily exchange cap reached")
+		}
+	}
+
+	if _, err := txnDB.Exec(insertExchangeQuery, usr.GetId(), rate.FromCurrency, rate.ToCurrency, req.GetAmount(), received); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not record exchange")
+		return nil, status.Error(codes.Internal, "Could not exchange currency")
+	}
+
+	txnDB.Commit()
+
+	return res, nil
+}
+
+// exchangedAmount returns the coins amount gems buy at rateGems gems for rateCoins coins, rounded down,
+// or -1 if it doesn't fit into int32
+func exchangedAmount(amount, rateGems, rateCoins int32) int32 {
+	received := int64(amount) * int64(rateCoins) / int64(rateGems)
+	if received > math.MaxInt32 {
+		return -1
+	}
+	return int32(received)
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,219 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestExchange(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usersServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usersServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	usersClient := pb.NewUsersClient(conn)
+
+	sqlSearchRate := `SELECT * FROM "exchange_rates" WHERE (from_currency = $1 AND to_currency = $2) ORDER BY "exchange_rates"."id" ASC LIMIT 1`
+	sqlSearchIDUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlCreateRate := `INSERT INTO "exchange_rates" ("daily_cap","from_currency","max_amount","min_amount","rate_coins","rate_gems","to_currency") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "exchange_rates"."id"`
+	sqlListRates := `SELECT * FROM "exchange_rates" ORDER BY "id"`
+
+	rateColumns := []string{"id", "from_currency", "to_currency", "rate_gems", "rate_coins", "min_amount", "max_amount", "daily_cap"}
+
+	t.Run("Set Exchange Rate - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateRate)).WithArgs(50, "gems", 100, 1, 10, 1, "coins").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+
+		res, err := usersClient.SetExchangeRate(ctx, &pb.SetExchangeRateRequest{
+			FromCurrency: "gems",
+			ToCurrency:   "coins",
+			RateCoins:    10,
+			MaxAmount:    100,
+			DailyCap:     50,
+		})
+		if err != nil {
+			t.Fatalf("error setting exchange rate: %v", err)
+		}
+		if res.GetResult().GetId() != 1 || res.GetResult().GetMinAmount() != 1 {
+			t.Fatalf("unexpected exchange rate: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Set Exchange Rate - unsupported pair", func(t *testing.T) {
+		_, err := usersClient.SetExchangeRate(ctx, &pb.SetExchangeRateRequest{FromCurrency: "gems", ToCurrency: "gold", RateCoins: 10})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		_, err = usersClient.SetExchangeRate(ctx, &pb.SetExchangeRateRequest{FromCurrency: "coins", ToCurrency: "gems", RateCoins: 10})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument for coins to gems, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Get Exchange Rates - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListRates)).
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 1, 10, 1, 100, 50))
+		mock.ExpectQuery(regexp.QuoteMeta(exchangedSinceQuery)).WithArgs("user-id", "gems", "coins", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(20))
+
+		res, err := usersClient.GetExchangeRates(ctx, &pb.GetExchangeRatesRequest{Id: "user-id", Amount: 5})
+		if err != nil {
+			t.Fatalf("error fetching exchange rates: %v", err)
+		}
+		if len(res.GetQuotes()) != 1 || res.GetQuotes()[0].GetReceive() != 50 || res.GetQuotes()[0].GetRemainingToday() != 30 {
+			t.Fatalf("unexpected quotes: %v", res.GetQuotes())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Exchange Currency - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 1, 10, 1, 100, 50))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(exchangeCurrencyQuery)).WithArgs(5, 50, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"coins", "gems"}).AddRow(150, 15))
+		mock.ExpectQuery(regexp.QuoteMeta(exchangedSinceQuery)).WithArgs("user-id", "gems", "coins", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(20))
+		mock.ExpectExec(regexp.QuoteMeta(insertExchangeQuery)).WithArgs("user-id", "gems", "coins", 5, 50).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		res, err := usersClient.ExchangeCurrency(ctx, &pb.ExchangeCurrencyRequest{Id: "user-id", FromCurrency: "gems", ToCurrency: "coins", Amount: 5})
+		if err != nil {
+			t.Fatalf("error exchanging currency: %v", err)
+		}
+		if res.GetReceived() != 50 || res.GetCoins() != 150 || res.GetGems() != 15 {
+			t.Fatalf("unexpected exchange result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Exchange Currency - fractional rate", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 2, 3, 1, 100, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(exchangeCurrencyQuery)).WithArgs(5, 7, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"coins", "gems"}).AddRow(107, 15))
+		mock.ExpectExec(regexp.QuoteMeta(insertExchangeQuery)).WithArgs("user-id", "gems", "coins", 5, 7).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		res, err := usersClient.ExchangeCurrency(ctx, &pb.ExchangeCurrencyRequest{Id: "user-id", FromCurrency: "gems", ToCurrency: "coins", Amount: 5})
+		if err != nil {
+			t.Fatalf("error exchanging currency: %v", err)
+		}
+		if res.GetReceived() != 7 {
+			t.Fatalf("unexpected exchange result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Exchange Currency - amount too small for the rate", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 2, 1, 1, 100, 0))
+
+		_, err := usersClient.ExchangeCurrency(ctx, &pb.ExchangeCurrencyRequest{Id: "user-id", FromCurrency: "gems", ToCurrency: "coins", Amount: 1})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Exchange Currency - not enough gems", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 1, 10, 1, 100, 50))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(exchangeCurrencyQuery)).WithArgs(5, 50, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"coins", "gems"}))
+		mock.ExpectRollback()
+
+		_, err := usersClient.ExchangeCurrency(ctx, &pb.ExchangeCurrencyRequest{Id: "user-id", FromCurrency: "gems", ToCurrency: "coins", Amount: 5})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Exchange Currency - daily cap reached", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchRate)).WithArgs("gems", "coins").
+			WillReturnRows(sqlmock.NewRows(rateColumns).AddRow(1, "gems", "coins", 1, 10, 1, 100, 50))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(exchangeCurrencyQuery)).WithArgs(40, 400, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"coins", "gems"}).AddRow(500, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(exchangedSinceQuery)).WithArgs("user-id", "gems", "coins", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(20))
+		mock.ExpectRollback()
+
+		_, err := usersClient.ExchangeCurrency(ctx, &pb.ExchangeCurrencyRequest{Id: "user-id", FromCurrency: "gems", ToCurrency: "coins", Amount: 40})
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
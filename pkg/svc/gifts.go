@@ -0,0 +1,348 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxGiftMessageLength = 256
+
+	giftStatusAccepted = "accepted"
+	giftStatusDeclined = "declined"
+
+	// rented items don't count, receiving them as a gift makes them permanent
+	ownsItemQuery          = "SELECT count(*) FROM users_store_items WHERE user_id = $1 AND store_item_id = $2 AND expires_at IS NULL"
+	pendingGiftExistsQuery = "SELECT count(*) FROM gifts WHERE recipient_id = $1 AND store_item_id = $2 AND status = 'pending'"
+	giftsSentSinceQuery    = "SELECT count(*) FROM gifts WHERE sender_id = $1 AND created_at >= $2"
+	chargeGiftSenderQuery  = "UPDATE users SET coins = coins - $1, gems = gems - $2 WHERE id = $3 AND coins >= $1 AND gems >= $2"
+	insertGiftQuery        = "INSERT INTO gifts (id, sender_id, recipient_id, store_item_id, message, coins_price, gems_price, status) VALUES ($1, $2, $3, $4, $5, $6, $7, 'pending')"
+	pendingGiftsQuery      = "SELECT id, sender_id, recipient_id, store_item_id, message, created_at FROM gifts WHERE recipient_id = $1 AND status = 'pending' ORDER BY created_at"
+	pendingGiftQuery       = "SELECT sender_id, store_item_id, coins_price, gems_price FROM gifts WHERE id = $1 AND recipient_id = $2 AND status = 'pending'"
+	resolveGiftQuery       = "UPDATE gifts SET status = $1 WHERE id = $2 AND status = 'pending'"
+	grantItemQuery         = "INSERT INTO users_store_items (user_id, store_item_id, source) VALUES ($1, $2, 'gift') " +
+		"ON CONFLICT (user_id, store_item_id) DO UPDATE SET expires_at = NULL, source = 'gift' WHERE users_store_items.expires_at IS NOT NULL"
+	refundCurrenciesQuery = "UPDATE users SET coins = coins + $1, gems = gems + $2 WHERE id = $3"
+)
+
+type pendingGift struct {
+	senderID   string
+	itemID     string
+	coinsPrice int32
+	gemsPrice  int32
+}
+
+func (s *StoreItemsServer) GiftItem(ctx context.Context, req *pb.GiftItemRequest) (*pb.GiftItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"sender_id":    req.GetSenderId(),
+		"recipient_id": req.GetRecipientId(),
+		"item_id":      req.GetItemId(),
+	})
+	logger.Debug("Gifting item")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetSenderId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	if req.GetSenderId() == req.GetRecipientId() {
+		logger.Error("Sender and recipient are the same user")
+		return nil, status.Error(codes.InvalidArgument, "Can't send a gift to yourself")
+	}
+
+	if len(req.GetMessage()) > maxGiftMessageLength {
+		logger.Error("Gift message is too long")
+		return nil, status.Error(codes.InvalidArgument, "Gift message is too long")
+	}
+
+	var sender, recipient pb.UserORM
+	var item pb.StoreItemORM
+
+	if err := s.cfg.Database.Where("id = ?", req.GetSenderId()).First(&sender).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("User not found")
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		logger.WithError(err).Error("Could not find user")
+		return nil, status.Error(codes.Internal, "Could not find user")
+	}
+
+	if err := s.cfg.Database.Where("id = ?", req.GetRecipientId()).First(&recipient).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Recipient not found")
+			return nil, status.Error(codes.NotFound, "Recipient not found")
+		}
+		logger.WithError(err).Error("Could not find recipient")
+		return nil, status.Error(codes.Internal, "Could not find recipient")
+	}
+
+	if err := s.cfg.Database.Where("id = ?", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Item not found")
+			return nil, status.Error(codes.NotFound, "Item not found")
+		}
+		logger.WithError(err).Error("Could not find item")
+		return nil, status.Error(codes.Internal, "Could not find item")
+	}
+
+	if item.Retired {
+		logger.Error(itemRetiredErrorMsg)
+		return nil, status.Error(codes.FailedPrecondition, itemRetiredErrorMsg)
+	}
+
+	if err := s.checkIfRecipientCanReceive(logger, recipient.Id, item.Id); err != nil {
+		return nil, err
+	}
+
+	coinsPrice, gemsPrice := itemPrice(&item)
+	if err := chargeUser(logger, &sender, coinsPrice, gemsPrice, 1); err != nil {
+		return nil, err
+	}
+
+	giftID := uuid.NewV4().String()
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not send gift")
+	}
+
+	res, err := txnDB.Exec(chargeGiftSenderQuery, coinsPrice, gemsPrice, sender.Id)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not charge sender")
+		return nil, status.Error(codes.Internal, "Could not send gift")
+	}
+	charged, err := res.RowsAffected()
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not charge sender")
+		return nil, status.Error(codes.Internal, "Could not send gift")
+	}
+	if charged == 0 {
+		txnDB.Rollback()
+		logger.Error("Not enough funds")
+		return nil, status.Error(codes.InvalidArgument, "Not enough coins or gems")
+	}
+
+	// the sender row is locked by now, so concurrent gifts can't slip past the daily limit
+	if s.cfg.GiftsPerDay > 0 {
+		var sentToday int
+		if err := txnDB.QueryRow(giftsSentSinceQuery, sender.Id, startOfDay(time.Now())).Scan(&sentToday); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not count sent gifts")
+			return nil, status.Error(codes.Internal, "Could not send gift")
+		}
+		if sentToday >= s.cfg.GiftsPerDay {
+			txnDB.Rollback()
+			logger.Error("Daily gift limit reached")
+			return nil, status.Error(codes.ResourceExhausted, "Daily gift limit reached")
+		}
+	}
+
+	if _, err := txnDB.Exec(insertGiftQuery, giftID, sender.Id, recipient.Id, item.Id, req.GetMessage(), coinsPrice, gemsPrice); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not proceed with the operation")
+		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not send gift")
+	}
+
+	return &pb.GiftItemResponse{GiftId: giftID}, nil
+}
+
+func (s *StoreItemsServer) ListPendingGifts(ctx context.Context, req *pb.ListPendingGiftsRequest) (*pb.ListPendingGiftsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("user_id", req.GetUserId())
+	logger.Debug("Listing pending gifts")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.StandardClaims.Audience != "svc" && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	gifts := []*pb.Gift{}
+	rows, err := s.cfg.Database.DB().Query(pendingGiftsQuery, req.GetUserId())
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch pending gifts")
+		return nil, status.Error(codes.Internal, "Could not fetch pending gifts")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		gift := pb.Gift{}
+		var createdAt time.Time
+		if err := rows.Scan(&gift.Id, &gift.SenderId, &gift.RecipientId, &gift.ItemId, &gift.Message, &createdAt); err != nil {
+			logger.WithError(err).Error("Could not fetch pending gifts")
+			return nil, status.Error(codes.Internal, "Could not fetch pending gifts")
+		}
+		if gift.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+			logger.WithError(err).Error("Failed to convert unix time to proto timestamp")
+			return nil, status.Error(codes.Internal, "Could not fetch pending gifts")
+		}
+		gifts = append(gifts, &gift)
+	}
+
+	return &pb.ListPendingGiftsResponse{Gifts: gifts}, nil
+}
+
+func (s *StoreItemsServer) AcceptGift(ctx context.Context, req *pb.AcceptGiftRequest) (*pb.AcceptGiftResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"gift_id": req.GetGiftId(),
+	})
+	logger.Debug("Accepting gift")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	gift, err := s.fetchPendingGift(logger, req.GetGiftId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var owned int
+	if err := s.cfg.Database.DB().QueryRow(ownsItemQuery, req.GetUserId(), gift.itemID).Scan(&owned); err != nil {
+		logger.WithError(err).Error("Could not check owned items")
+		return nil, status.Error(codes.Internal, "Could not accept gift")
+	}
+	if owned > 0 {
+		logger.Error("User already owns this item")
+		return nil, status.Error(codes.AlreadyExists, "User already owns this item")
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not accept gift")
+	}
+
+	if err := resolveGift(txnDB, req.GetGiftId(), giftStatusAccepted); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not resolve gift")
+		return nil, status.Error(codes.Internal, "Could not accept gift")
+	}
+
+	if _, err := txnDB.Exec(grantItemQuery, req.GetUserId(), gift.itemID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not grant item")
+		return nil, status.Error(codes.Internal, "Could not accept gift")
+	}
+
+	txnDB.Commit()
+
+	return &pb.AcceptGiftResponse{}, nil
+}
+
+func (s *StoreItemsServer) DeclineGift(ctx context.Context, req *pb.DeclineGiftRequest) (*pb.DeclineGiftResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"gift_id": req.GetGiftId(),
+	})
+	logger.Debug("Declining gift")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	gift, err := s.fetchPendingGift(logger, req.GetGiftId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not decline gift")
+	}
+
+	if err := resolveGift(txnDB, req.GetGiftId(), giftStatusDeclined); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not resolve gift")
+		return nil, status.Error(codes.Internal, "Could not decline gift")
+	}
+
+	if _, err := txnDB.Exec(refundCurrenciesQuery, gift.coinsPrice, gift.gemsPrice, gift.senderID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not refund sender")
+		return nil, status.Error(codes.Internal, "Could not decline gift")
+	}
+
+	txnDB.Commit()
+
+	return &pb.DeclineGiftResponse{}, nil
+}
+
+func (s *StoreItemsServer) checkIfRecipientCanReceive(logger *logrus.Entry, recipientID, itemID string) error {
+	var owned int
+	if err := s.cfg.Database.DB().QueryRow(ownsItemQuery, recipientID, itemID).Scan(&owned); err != nil {
+		logger.WithError(err).Error("Could not check owned items")
+		return status.Error(codes.Internal, "Could not send gift")
+	}
+	if owned > 0 {
+		logger.Error("Recipient already owns this item")
+		return status.Error(codes.AlreadyExists, "Recipient already owns this item")
+	}
+
+	var pending int
+	if err := s.cfg.Database.DB().QueryRow(pendingGiftExistsQuery, recipientID, itemID).Scan(&pending); err != nil {
+		logger.WithError(err).Error("Could not check pending gifts")
+		return status.Error(codes.Internal, "Could not send gift")
+	}
+	if pending > 0 {
+		logger.Error("Recipient already has this item pending")
+		return status.Error(codes.AlreadyExists, "Recipient already has this item pending")
+	}
+
+	return nil
+}
+
+func (s *StoreItemsServer) fetchPendingGift(logger *logrus.Entry, giftID, recipientID string) (*pendingGift, error) {
+	gift := &pendingGift{}
+	err := s.cfg.Database.DB().QueryRow(pendingGiftQuery, giftID, recipientID).
+		Scan(&gift.senderID, &gift.itemID, &gift.coinsPrice, &gift.gemsPrice)
+	if err == sql.ErrNoRows {
+		logger.Error("Gift not found")
+		return nil, status.Error(codes.NotFound, "Gift not found")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch gift")
+		return nil, status.Error(codes.Internal, "Could not fetch gift")
+	}
+	return gift, nil
+}
+
+// resolveGift moves a pending gift to its final status, failing if somebody resolved it first
+func resolveGift(txnDB *sql.Tx, giftID, giftStatus string) error {
+	res, err := txnDB.Exec(resolveGiftQuery, giftStatus, giftID)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != 1 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
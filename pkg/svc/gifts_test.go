@@ -0,0 +1,230 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGifts(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	storeItemsServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database:    gdb,
+		GiftsPerDay: 1,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, storeItemsServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	storeItemsClient := pb.NewStoreItemsClient(conn)
+
+	sqlSearchIDUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlSearchIDItem := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+
+	userColumns := []string{"id", "name", "email", "password", "coins", "gems"}
+	itemColumns := []string{"id", "name", "coins_price", "gems_price", "on_sale", "sale_coins_price", "sale_gems_price"}
+
+	giftRequest := &pb.GiftItemRequest{
+		SenderId:    "sender-id",
+		RecipientId: "recipient-id",
+		ItemId:      "item-id",
+		Message:     "Happy birthday!",
+	}
+
+	expectGiftLookups := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("sender-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("sender-id", "sender", "sender@mail.com", "pwd", 100, 10))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("recipient-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("recipient-id", "recipient", "recipient@mail.com", "pwd", 0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDItem)).WithArgs("item-id").
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("item-id", "Sword", 50, 0, true, 30, 0))
+	}
+
+	t.Run("Gift Item - positive", func(t *testing.T) {
+		expectGiftLookups()
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftExistsQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(chargeGiftSenderQuery)).WithArgs(30, 0, "sender-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(giftsSentSinceQuery)).WithArgs("sender-id", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(regexp.QuoteMeta(insertGiftQuery)).
+			WithArgs(sqlmock.AnyArg(), "sender-id", "recipient-id", "item-id", "Happy birthday!", 30, 0).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		res, err := storeItemsClient.GiftItem(ctx, giftRequest)
+		if err != nil {
+			t.Fatalf("error gifting item: %v", err)
+		}
+		if res.GetGiftId() == "" {
+			t.Fatal("expected gift id to be returned")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Gift Item - recipient already owns item", func(t *testing.T) {
+		expectGiftLookups()
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		_, err := storeItemsClient.GiftItem(ctx, giftRequest)
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Gift Item - daily limit reached", func(t *testing.T) {
+		expectGiftLookups()
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftExistsQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(chargeGiftSenderQuery)).WithArgs(30, 0, "sender-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(giftsSentSinceQuery)).WithArgs("sender-id", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		_, err := storeItemsClient.GiftItem(ctx, giftRequest)
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Gift Item - balance spent concurrently", func(t *testing.T) {
+		expectGiftLookups()
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftExistsQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(chargeGiftSenderQuery)).WithArgs(30, 0, "sender-id").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := storeItemsClient.GiftItem(ctx, giftRequest)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("List Pending Gifts - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftsQuery)).WithArgs("recipient-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sender_id", "recipient_id", "store_item_id", "message", "created_at"}).
+				AddRow("gift-id", "sender-id", "recipient-id", "item-id", "Happy birthday!", time.Now()))
+
+		res, err := storeItemsClient.ListPendingGifts(ctx, &pb.ListPendingGiftsRequest{UserId: "recipient-id"})
+		if err != nil {
+			t.Fatalf("error listing pending gifts: %v", err)
+		}
+		if len(res.GetGifts()) != 1 || res.GetGifts()[0].GetMessage() != "Happy birthday!" {
+			t.Fatalf("unexpected pending gifts: %v", res.GetGifts())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Accept Gift - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftQuery)).WithArgs("gift-id", "recipient-id").
+			WillReturnRows(sqlmock.NewRows([]string{"sender_id", "store_item_id", "coins_price", "gems_price"}).
+				AddRow("sender-id", "item-id", 30, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(resolveGiftQuery)).WithArgs(giftStatusAccepted, "gift-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(grantItemQuery)).WithArgs("recipient-id", "item-id").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		_, err := storeItemsClient.AcceptGift(ctx, &pb.AcceptGiftRequest{UserId: "recipient-id", GiftId: "gift-id"})
+		if err != nil {
+			t.Fatalf("error accepting gift: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Decline Gift - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftQuery)).WithArgs("gift-id", "recipient-id").
+			WillReturnRows(sqlmock.NewRows([]string{"sender_id", "store_item_id", "coins_price", "gems_price"}).
+				AddRow("sender-id", "item-id", 30, 0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(resolveGiftQuery)).WithArgs(giftStatusDeclined, "gift-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(refundCurrenciesQuery)).WithArgs(30, 0, "sender-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := storeItemsClient.DeclineGift(ctx, &pb.DeclineGiftRequest{UserId: "recipient-id", GiftId: "gift-id"})
+		if err != nil {
+			t.Fatalf("error declining gift: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Decline Gift - not found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(pendingGiftQuery)).WithArgs("gift-id", "recipient-id").
+			WillReturnRows(sqlmock.NewRows([]string{"sender_id", "store_item_id", "coins_price", "gems_price"}))
+
+		_, err := storeItemsClient.DeclineGift(ctx, &pb.DeclineGiftRequest{UserId: "recipient-id", GiftId: "gift-id"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
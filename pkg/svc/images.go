@@ -0,0 +1,187 @@
+package svc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/images"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	imagesLogComponent = "images"
+	imagesCacheControl = "public, max-age=31536000, immutable"
+)
+
+type ImagesHandlerConfig struct {
+	Store images.BlobStore
+	// MaxSize limits the size of uploads in bytes
+	MaxSize int64
+	// Path is the prefix the handler is mounted at, e.g. /images/
+	Path string
+	// PublicKey verifies the session tokens of uploads
+	PublicKey *rsa.PublicKey
+}
+
+type uploadImageResponse struct {
+	Id       string   `json:"id"`
+	Variants []string `json:"variants"`
+}
+
+// NewImagesHandler serves uploaded images under cfg.Path and accepts admin uploads with a POST to cfg.Path itself.
+// Images never change once uploaded, so they are served with long lived cache headers.
+func NewImagesHandler(cfg *ImagesHandlerConfig, logger *logrus.Logger) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		entry := logger.WithField("component", imagesLogComponent)
+		name := strings.TrimPrefix(request.URL.Path, cfg.Path)
+
+		switch {
+		case name == "" && request.Method == http.MethodPost:
+			uploadImage(cfg, entry, writer, request)
+		case name != "" && (request.Method == http.MethodGet || request.Method == http.MethodHead):
+			serveImage(cfg, entry, writer, request, name)
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func uploadImage(cfg *ImagesHandlerConfig, logger *logrus.Entry, writer http.ResponseWriter, request *http.Request) {
+	claims, err := auth.GetHTTPAuthorizationData(request, cfg.PublicKey)
+	if err != nil || claims.ExpiresAt < time.Now().Unix() {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if !claims.IsAdmin && claims.StandardClaims.Audience != "svc" {
+		writer.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil || (contentType != "image/png" && contentType != "image/jpeg") {
+		writer.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, cfg.MaxSize))
+	if err != nil {
+		writer.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if http.DetectContentType(data) != contentType {
+		writer.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	variants, err := images.Process(data)
+	switch err {
+	case nil:
+	case images.ErrTooLarge:
+		writer.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	default:
+		writer.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	id := uuid.NewV4().String()
+	logger = logger.WithField("image_id", id)
+
+	res := uploadImageResponse{Id: id}
+	for _, v := range images.Variants {
+		if err := cfg.Store.Put(request.Context(), images.Key(id, v.Name), variants[v.Name]); err != nil {
+			logger.WithError(err).Error("Could not store image variant")
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		res.Variants = append(res.Variants, v.Name)
+	}
+	// the original goes last, an image exists only once all of its variants do
+	if err := cfg.Store.Put(request.Context(), images.Key(id, images.Original), data); err != nil {
+		logger.WithError(err).Error("Could not store image")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	res.Variants = append(res.Variants, images.Original)
+	logger.Info("Image uploaded")
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusCreated)
+	json.NewEncoder(writer).Encode(res)
+}
+
+// serveImage handles {id} and {id}/{variant}
+func serveImage(cfg *ImagesHandlerConfig, logger *logrus.Entry, writer http.ResponseWriter, request *http.Request, name string) {
+	parts := strings.SplitN(name, "/", 2)
+	id, variant := parts[0], images.Original
+	if len(parts) == 2 {
+		variant = parts[1]
+	}
+	if _, err := uuid.FromString(id); err != nil || !images.IsVariant(variant) {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	etag := `"` + images.Key(id, variant) + `"`
+	if request.Header.Get("If-None-Match") == etag {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	data, err := cfg.Store.Get(request.Context(), images.Key(id, variant))
+	if err == images.ErrNotFound {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.WithError(err).WithField("image_id", id).Error("Could not read image")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", http.DetectContentType(data))
+	writer.Header().Set("Cache-Control", imagesCacheControl)
+	writer.Header().Set("ETag", etag)
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(data)
+}
+
+// uploadedImageExists reports whether an image id in the upload format was uploaded to store. Other ids
+// point at images hosted elsewhere and aren't checked, nor is anything when no store is configured.
+func uploadedImageExists(ctx context.Context, store images.BlobStore, id string) (bool, error) {
+	if store == nil {
+		return true, nil
+	}
+	if parsed, err := uuid.FromString(id); err != nil || parsed.String() != id {
+		return true, nil
+	}
+
+	exists, err := store.Exists(ctx, images.Key(id, images.Original))
+	if err == images.ErrInvalidKey {
+		return false, nil
+	}
+	return exists, err
+}
+
+func checkImageExists(ctx context.Context, logger *logrus.Entry, store images.BlobStore, id string) error {
+	exists, err := uploadedImageExists(ctx, store, id)
+	if err != nil {
+		logger.WithError(err).Error("Could not check image")
+		return status.Error(codes.Internal, "Could not check image")
+	}
+	if !exists {
+		logger.Error("Unknown image id")
+		return status.Error(codes.InvalidArgument, "Unknown image id")
+	}
+	return nil
+}
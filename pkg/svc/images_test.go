@@ -0,0 +1,226 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/images"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestImages(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	store, err := images.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Could not create image store: %v", err)
+	}
+	publicKeyBytes, err := ioutil.ReadFile("../auth/public.pem")
+	if err != nil {
+		t.Fatalf("Could not read public key: %v", err)
+	}
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
+	if err != nil {
+		t.Fatalf("Could not parse public key: %v", err)
+	}
+	handler := NewImagesHandler(&ImagesHandlerConfig{Store: store, MaxSize: 1 << 20, Path: "/images/", PublicKey: publicKey}, logger)
+
+	src := image.NewRGBA(image.Rect(0, 0, 600, 300))
+	for x := 0; x < 600; x++ {
+		src.Set(x, x/2, color.RGBA{R: 255, A: 255})
+	}
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, src); err != nil {
+		t.Fatalf("Could not encode test image: %v", err)
+	}
+
+	upload := func(contentType string, body []byte, authorized bool) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/images/", bytes.NewReader(body))
+		request.Header.Set("Content-Type", contentType)
+		if authorized {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	var uploaded uploadImageResponse
+
+	t.Run("Upload Image - positive", func(t *testing.T) {
+		recorder := upload("image/png", pngData.Bytes(), true)
+		if recorder.Code != http.StatusCreated {
+			t.Fatalf("unexpected upload response code: %v", recorder.Code)
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&uploaded); err != nil {
+			t.Fatalf("could not decode upload response: %v", err)
+		}
+		if len(uploaded.Variants) != len(images.Variants)+1 {
+			t.Fatalf("unexpected variants: %v", uploaded.Variants)
+		}
+	})
+
+	t.Run("Upload Image - not authorized", func(t *testing.T) {
+		if code := upload("image/png", pngData.Bytes(), false).Code; code != http.StatusUnauthorized {
+			t.Fatalf("unexpected upload response code: %v", code)
+		}
+	})
+
+	t.Run("Upload Image - forged token", func(t *testing.T) {
+		forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.GameClaims{
+			IsAdmin:        true,
+			StandardClaims: jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix()},
+		}).SignedString([]byte("not-the-session-key"))
+		if err != nil {
+			t.Fatalf("Could not sign forged token: %v", err)
+		}
+		request := httptest.NewRequest(http.MethodPost, "/images/", bytes.NewReader(pngData.Bytes()))
+		request.Header.Set("Content-Type", "image/png")
+		request.Header.Set("Authorization", "Bearer "+forged)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusUnauthorized {
+			t.Fatalf("unexpected upload response code: %v", recorder.Code)
+		}
+	})
+
+	t.Run("Upload Image - wrong content type", func(t *testing.T) {
+		if code := upload("text/plain", pngData.Bytes(), true).Code; code != http.StatusUnsupportedMediaType {
+			t.Fatalf("unexpected upload response code: %v", code)
+		}
+		if code := upload("image/png", []byte("not an image"), true).Code; code != http.StatusUnsupportedMediaType {
+			t.Fatalf("unexpected upload response code: %v", code)
+		}
+	})
+
+	t.Run("Upload Image - too large", func(t *testing.T) {
+		small := NewImagesHandler(&ImagesHandlerConfig{Store: store, MaxSize: 64, Path: "/images/", PublicKey: publicKey}, logger)
+		request := httptest.NewRequest(http.MethodPost, "/images/", bytes.NewReader(pngData.Bytes()))
+		request.Header.Set("Content-Type", "image/png")
+		request.Header.Set("Authorization", "Bearer "+token)
+		recorder := httptest.NewRecorder()
+		small.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("unexpected upload response code: %v", recorder.Code)
+		}
+	})
+
+	t.Run("Get Image - resized variant", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/images/"+uploaded.Id+"/thumb", nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("unexpected get response code: %v", recorder.Code)
+		}
+		if recorder.Header().Get("Content-Type") != "image/png" || recorder.Header().Get("Cache-Control") == "" {
+			t.Fatalf("unexpected headers: %v", recorder.Header())
+		}
+		thumb, err := png.DecodeConfig(recorder.Body)
+		if err != nil {
+			t.Fatalf("could not decode thumbnail: %v", err)
+		}
+		if thumb.Width != 128 || thumb.Height != 64 {
+			t.Fatalf("unexpected thumbnail size: %dx%d", thumb.Width, thumb.Height)
+		}
+	})
+
+	t.Run("Get Image - not modified", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/images/"+uploaded.Id, nil)
+		request.Header.Set("If-None-Match", `"`+images.Key(uploaded.Id, images.Original)+`"`)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusNotModified {
+			t.Fatalf("unexpected get response code: %v", recorder.Code)
+		}
+	})
+
+	t.Run("Get Image - not found", func(t *testing.T) {
+		for _, path := range []string{"/images/6ba7b810-9dad-11d1-80b4-00c04fd430c8", "/images/" + uploaded.Id + "/huge", "/images/../secret"} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			if recorder.Code != http.StatusNotFound {
+				t.Fatalf("unexpected get response code for %s: %v", path, recorder.Code)
+			}
+		}
+	})
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+		Images:   store,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlSearchItemType := `SELECT * FROM "item_types" WHERE (id = $1) ORDER BY "item_types"."id" ASC LIMIT 1`
+	sqlSearchNameAndType := `SELECT * FROM "store_items" WHERE (name = $1 AND type = $2) ORDER BY "store_items"."id" ASC LIMIT 1`
+
+	t.Run("Create Item - unknown image", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "display_name", "slot", "slot_capacity"}).AddRow(1, "skin", "Skin", "skin", 1))
+
+		_, err := stiClient.Create(ctx, &pb.CreateStoreItemRequest{Name: "Sword", Type: 1, ImageId: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create Item - external image is not checked", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "display_name", "slot", "slot_capacity"}).AddRow(1, "skin", "Skin", "skin", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchNameAndType)).WithArgs("Sword", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "type"}).AddRow("item-id", "Sword", 1))
+
+		_, err := stiClient.Create(ctx, &pb.CreateStoreItemRequest{Name: "Sword", Type: 1, ImageId: "weapons/sword.png"})
+		if status.Convert(err).Message() != "Item with such name and type already exists" {
+			t.Fatalf("expected the image check to pass, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	atlasgorm "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *StoreItemsServer) ListUserInventory(ctx context.Context, req *pb.ListUserInventoryRequest) (*pb.ListUserInventoryResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("user_id", req.GetUserId())
+	logger.Debug("List User Inventory")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	db, err := atlasgorm.ApplyCollectionOperators(ctx, s.cfg.Database.Where("user_id = ?", req.GetUserId()),
+		&pb.InventoryEntryORM{}, &pb.InventoryEntry{}, req.GetFilter(), req.GetOrderBy(), req.GetPaging(), nil)
+	if err != nil {
+		logger.WithError(err).Error("Invalid collection operators")
+		return nil, status.Error(codes.InvalidArgument, "Invalid filtering, sorting or paging")
+	}
+	if len(req.GetOrderBy().GetCriterias()) == 0 {
+		db = db.Order("acquired_at DESC")
+	}
+	// item_id keeps pages stable when the requested ordering has ties
+	db = db.Order("item_id")
+
+	var entries []*pb.InventoryEntryORM
+	if err := db.Find(&entries).Error; err != nil {
+		logger.WithError(err).Error("Could not fetch user inventory")
+		return nil, status.Error(codes.Internal, "Could not fetch user inventory")
+	}
+
+	results := make([]*pb.InventoryItem, 0, len(entries))
+	for _, entry := range entries {
+		pbEntry, err := entry.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not fetch user inventory")
+			return nil, status.Error(codes.Internal, "Could not fetch user inventory")
+		}
+		results = append(results, inventoryItem(&pbEntry))
+	}
+
+	page := &query.PageInfo{Size: int32(len(results))}
+	if limit := req.GetPaging().GetLimit(); limit > 0 && int32(len(results)) == limit {
+		page.Offset = req.GetPaging().GetOffset() + limit
+	} else {
+		page.SetLastOffset()
+	}
+
+	return &pb.ListUserInventoryResponse{Results: results, Page: page}, nil
+}
+
+func inventoryItem(entry *pb.InventoryEntry) *pb.InventoryItem {
+	return &pb.InventoryItem{
+		Item: &pb.StoreItem{
+			Id:               entry.GetItemId(),
+			Name:             entry.GetName(),
+			Description:      entry.GetDescription(),
+			Type:             entry.GetType(),
+			CoinsPrice:       entry.GetCoinsPrice(),
+			GemsPrice:        entry.GetGemsPrice(),
+			ImageId:          entry.GetImageId(),
+			OnSale:           entry.GetOnSale(),
+			SaleCoinsPrice:   entry.GetSaleCoinsPrice(),
+			SaleGemsPrice:    entry.GetSaleGemsPrice(),
+			Consumable:       entry.GetConsumable(),
+			MaxStack:         entry.GetMaxStack(),
+			RentalDays:       entry.GetRentalDays(),
+			RentalCoinsPrice: entry.GetRentalCoinsPrice(),
+			RentalGemsPrice:  entry.GetRentalGemsPrice(),
+			Sku:              entry.GetSku(),
+			Retired:          entry.GetRetired(),
+		},
+		Equipped:   entry.GetEquipped(),
+		Quantity:   entry.GetQuantity(),
+		AcquiredAt: entry.GetAcquiredAt(),
+		Source:     entry.GetSource(),
+		ExpiresAt:  entry.GetExpiresAt(),
+	}
+}
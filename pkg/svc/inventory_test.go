@@ -0,0 +1,134 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestInventory(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlFiltered := `SELECT * FROM "inventory_entries" WHERE (user_id = $1) AND (((inventory_entries.type = $2) AND (inventory_entries.equipped = $3))) ORDER BY acquired_at DESC,item_id LIMIT 2`
+	sqlSorted := `SELECT * FROM "inventory_entries" WHERE (user_id = $1) ORDER BY inventory_entries.name,item_id`
+
+	entryColumns := []string{"user_id", "item_id", "name", "description", "type", "coins_price", "gems_price", "image_id",
+		"on_sale", "sale_coins_price", "sale_gems_price", "consumable", "max_stack", "equipped", "quantity", "acquired_at", "source"}
+
+	acquiredAt := time.Date(2020, 1, 1, 1, 5, 57, 0, time.UTC)
+
+	t.Run("List User Inventory - filtered page", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFiltered)).WithArgs("some-id", float64(2), "true").
+			WillReturnRows(sqlmock.NewRows(entryColumns).
+				AddRow("some-id", "item-1", "Wave", "desc", 2, 100, 0, "im-1", false, 0, 0, false, 0, true, 1, acquiredAt, "purchase").
+				AddRow("some-id", "item-2", "Dance", "desc", 2, 0, 10, "im-2", false, 0, 0, false, 0, true, 1, acquiredAt, "gift"))
+
+		filter, err := query.ParseFiltering("type == 2 and equipped == 'true'")
+		if err != nil {
+			t.Fatalf("error parsing filter: %v", err)
+		}
+
+		res, err := stiClient.ListUserInventory(ctx, &pb.ListUserInventoryRequest{
+			UserId: "some-id",
+			Filter: filter,
+			Paging: &query.Pagination{Limit: 2},
+		})
+		if err != nil {
+			t.Fatalf("error listing inventory: %v", err)
+		}
+		if len(res.GetResults()) != 2 || res.GetResults()[1].GetItem().GetGemsPrice() != 10 || res.GetResults()[1].GetSource() != "gift" {
+			t.Fatalf("unexpected inventory: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("List User Inventory - complete items", func(t *testing.T) {
+		columns := append(entryColumns, "expires_at", "rental_days", "rental_coins_price", "rental_gems_price", "sku", "retired")
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSorted)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("some-id", "item-3", "Cape", "desc", 1, 200, 20, "im-3", true, 150, 15, false, 0, false, 1, acquiredAt, "rental",
+					acquiredAt.Add(72*time.Hour), 3, 30, 3, "CAPE-1", true))
+
+		sorting, err := query.ParseSorting("name")
+		if err != nil {
+			t.Fatalf("error parsing sorting: %v", err)
+		}
+
+		res, err := stiClient.ListUserInventory(ctx, &pb.ListUserInventoryRequest{UserId: "some-id", OrderBy: sorting})
+		if err != nil {
+			t.Fatalf("error listing inventory: %v", err)
+		}
+		if len(res.GetResults()) != 1 {
+			t.Fatalf("unexpected inventory: %v", res.GetResults())
+		}
+		item := res.GetResults()[0].GetItem()
+		if item.GetSku() != "CAPE-1" || !item.GetRetired() || item.GetRentalDays() != 3 || item.GetRentalCoinsPrice() != 30 ||
+			item.GetRentalGemsPrice() != 3 || !item.GetOnSale() || item.GetSaleCoinsPrice() != 150 || item.GetSaleGemsPrice() != 15 {
+			t.Fatalf("unexpected inventory item: %v", item)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("List User Inventory - sorted", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSorted)).WithArgs("some-id").WillReturnRows(sqlmock.NewRows(entryColumns))
+
+		sorting, err := query.ParseSorting("name")
+		if err != nil {
+			t.Fatalf("error parsing sorting: %v", err)
+		}
+
+		res, err := stiClient.ListUserInventory(ctx, &pb.ListUserInventoryRequest{UserId: "some-id", OrderBy: sorting})
+		if err != nil {
+			t.Fatalf("error listing inventory: %v", err)
+		}
+		if len(res.GetResults()) != 0 {
+			t.Fatalf("unexpected inventory: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	upsertItemTypeQuery = "INSERT INTO item_types (id, name, display_name, slot, slot_capacity) VALUES ($1, $2, $3, $4, $5) " +
+		"ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, display_name = EXCLUDED.display_name, slot = EXCLUDED.slot, slot_capacity = EXCLUDED.slot_capacity"
+	itemsOfTypeQuery = "SELECT count(*) FROM store_items WHERE type = $1"
+)
+
+func (s *StoreItemsServer) SetItemType(ctx context.Context, req *pb.SetItemTypeRequest) (*pb.SetItemTypeResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id":   req.GetId(),
+		"name": req.GetName(),
+		"slot": req.GetSlot(),
+	})
+	logger.Debug("Set Item Type")
+
+	if req.GetName() == "" || req.GetSlotCapacity() < 0 {
+		logger.Error("Item type validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Name should be set and slot capacity can't be negative")
+	}
+
+	var existingType pb.ItemTypeORM
+	if err := s.cfg.Database.Where("name = ? AND id <> ?", req.GetName(), req.GetId()).First(&existingType).Error; err == nil {
+		logger.Error("Item type with such name already exists")
+		return nil, status.Error(codes.InvalidArgument, "Item type with such name already exists")
+	} else if err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not set item type")
+		return nil, status.Error(codes.Internal, "Could not set item type")
+	}
+
+	itemType := &pb.ItemType{
+		Id:           req.GetId(),
+		Name:         req.GetName(),
+		DisplayName:  req.GetDisplayName(),
+		Slot:         req.GetSlot(),
+		SlotCapacity: req.GetSlotCapacity(),
+	}
+	if itemType.SlotCapacity == 0 {
+		itemType.SlotCapacity = 1
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(upsertItemTypeQuery, itemType.Id, itemType.Name, itemType.DisplayName,
+		itemType.Slot, itemType.SlotCapacity); err != nil {
+		logger.WithError(err).Error("Could not set item type")
+		return nil, status.Error(codes.Internal, "Could not set item type")
+	}
+
+	return &pb.SetItemTypeResponse{Result: itemType}, nil
+}
+
+func (s *StoreItemsServer) DeleteItemType(ctx context.Context, req *pb.DeleteItemTypeRequest) (*pb.DeleteItemTypeResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
+	logger.Debug("Delete Item Type")
+
+	var itemsCount int
+	if err := s.cfg.Database.DB().QueryRow(itemsOfTypeQuery, req.GetId()).Scan(&itemsCount); err != nil {
+		logger.WithError(err).Error("Could not count items of type")
+		return nil, status.Error(codes.Internal, "Could not delete item type")
+	}
+	if itemsCount > 0 {
+		logger.Error("Item type is still in use")
+		return nil, status.Error(codes.FailedPrecondition, "Item type is still used by store items")
+	}
+
+	var itemType pb.ItemTypeORM
+	if err := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&itemType).Error; err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not delete item type")
+		return nil, status.Error(codes.Internal, "Could not delete item type")
+	}
+
+	return &pb.DeleteItemTypeResponse{}, nil
+}
+
+func (s *StoreItemsServer) ListItemTypes(ctx context.Context, req *pb.ListItemTypesRequest) (*pb.ListItemTypesResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("List Item Types")
+
+	var itemTypes []*pb.ItemTypeORM
+	if err := s.cfg.Database.Order("id").Find(&itemTypes).Error; err != nil {
+		logger.WithError(err).Error("Could not list item types")
+		return nil, status.Error(codes.Internal, "Could not list item types")
+	}
+
+	results := make([]*pb.ItemType, 0, len(itemTypes))
+	for _, itemType := range itemTypes {
+		pbType, err := itemType.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not list item types")
+			return nil, status.Error(codes.Internal, "Could not list item types")
+		}
+		results = append(results, &pbType)
+	}
+
+	return &pb.ListItemTypesResponse{Results: results}, nil
+}
+
+// findItemType returns the registered type, store items can't use unknown types
+func (s *StoreItemsServer) findItemType(logger *logrus.Entry, id int32) (*pb.ItemTypeORM, error) {
+	var itemType pb.ItemTypeORM
+	if err := s.cfg.Database.Where("id = ?", id).First(&itemType).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Unknown item type")
+			return nil, status.Error(codes.InvalidArgument, "Unknown item type")
+		}
+		logger.WithError(err).Error("Could not fetch item type")
+		return nil, status.Error(codes.Internal, "Could not fetch item type")
+	}
+	return &itemType, nil
+}
@@ -0,0 +1,113 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestItemTypes(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlSearchName := `SELECT * FROM "item_types" WHERE (name = $1 AND id <> $2) ORDER BY "item_types"."id" ASC LIMIT 1`
+	sqlListTypes := `SELECT * FROM "item_types" ORDER BY "id"`
+
+	typeColumns := []string{"id", "name", "display_name", "slot", "slot_capacity"}
+
+	t.Run("Set Item Type - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchName)).WithArgs("emote", 2).WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectExec(regexp.QuoteMeta(upsertItemTypeQuery)).WithArgs(2, "emote", "Emote", "emotes", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		res, err := stiClient.SetItemType(ctx, &pb.SetItemTypeRequest{Id: 2, Name: "emote", DisplayName: "Emote", Slot: "emotes"})
+		if err != nil {
+			t.Fatalf("error setting item type: %v", err)
+		}
+		if res.GetResult().GetSlotCapacity() != 1 {
+			t.Fatalf("unexpected item type: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Set Item Type - same name", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchName)).WithArgs("emote", 3).
+			WillReturnRows(sqlmock.NewRows(typeColumns).AddRow(2, "emote", "Emote", "emotes", 1))
+
+		_, err := stiClient.SetItemType(ctx, &pb.SetItemTypeRequest{Id: 3, Name: "emote", Slot: "emotes"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Delete Item Type - still in use", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(itemsOfTypeQuery)).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+		_, err := stiClient.DeleteItemType(ctx, &pb.DeleteItemTypeRequest{Id: 2})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("List Item Types - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListTypes)).WillReturnRows(sqlmock.NewRows(typeColumns).
+			AddRow(1, "skin", "Skin", "skin", 1).AddRow(2, "emote", "Emote", "emotes", 4))
+
+		res, err := stiClient.ListItemTypes(ctx, &pb.ListItemTypesRequest{})
+		if err != nil {
+			t.Fatalf("error listing item types: %v", err)
+		}
+		if len(res.GetResults()) != 2 || res.GetResults()[1].GetSlotCapacity() != 4 {
+			t.Fatalf("unexpected item types: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
@@ -0,0 +1,249 @@
+package svc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 100
+
+	// the leaderboard queries are formatted with a column from leaderboardColumns (%[1]s), the direction (%[2]s),
+	// the comparison that moves past the cursor in that direction (%[3]s) and a stats source from
+	// leaderboardSource (%[4]s), never with user input. Ties are broken by user id so pages don't overlap, compared
+	// bytewise with the "C" collation like the cached leaderboards do.
+	leaderboardQuery = "SELECT rank, user_id, name, value FROM (" +
+		"SELECT DENSE_RANK() OVER (ORDER BY us.%[1]s %[2]s) AS rank, u.id AS user_id, u.name, us.%[1]s AS value " +
+		"FROM users u JOIN %[4]s) lb " +
+		"WHERE $1 = '' OR value %[3]s $2 OR (value = $2 AND user_id COLLATE \"C\" > $1) " +
+		"ORDER BY value %[2]s, user_id COLLATE \"C\" LIMIT $3"
+	leaderboardAroundQuery = "WITH lb AS (" +
+		"SELECT DENSE_RANK() OVER (ORDER BY us.%[1]s %[2]s) AS rank, ROW_NUMBER() OVER (ORDER BY us.%[1]s %[2]s, u.id COLLATE \"C\") AS pos, " +
+		"u.id AS user_id, u.name, us.%[1]s AS value FROM users u JOIN %[4]s) " +
+		"SELECT rank, user_id, name, value FROM lb WHERE pos >= (SELECT pos FROM lb WHERE user_id = $1) - $2 ORDER BY pos LIMIT $3"
+)
+
+// leaderboardColumns whitelists the user_stats columns players can be ranked by
+var leaderboardColumns = map[pb.LeaderboardMetric]string{
+	pb.LeaderboardMetric_WINS:  "wins",
+	pb.LeaderboardMetric_KILLS: "kills",
+	pb.LeaderboardMetric_GAMES: "games",
+	pb.LeaderboardMetric_TOP5:  "top5",
+}
+
+// leaderboardCursor points at the last entry of a page
+type leaderboardCursor struct {
+	metric    pb.LeaderboardMetric
+	seasonID  int32
+	ascending bool
+	value     int32
+	userID    string
+}
+
+func (c *leaderboardCursor) encode() string {
+	raw := fmt.Sprintf("%d:%d:%t:%d:%s", c.metric, c.seasonID, c.ascending, c.value, c.userID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeLeaderboardCursor(cursor string) (*leaderboardCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(raw), ":", 5)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("expected 5 cursor parts, got %d", len(parts))
+	}
+	metric, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	seasonID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	ascending, err := strconv.ParseBool(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(parts[3], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &leaderboardCursor{
+		metric:    pb.LeaderboardMetric(metric),
+		seasonID:  int32(seasonID),
+		ascending: ascending,
+		value:     int32(value),
+		userID:    parts[4],
+	}, nil
+}
+
+func (s *UsersStatsServer) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"metric":      req.GetMetric().String(),
+		"ascending":   req.GetAscending(),
+		"around_user": req.GetAroundUser(),
+		"season_id":   req.GetSeasonId(),
+	})
+	logger.Debug("Get leaderboard")
+
+	column, ok := leaderboardColumns[req.GetMetric()]
+	if !ok {
+		logger.Error("Unknown leaderboard metric")
+		return nil, status.Error(codes.InvalidArgument, "Unknown leaderboard metric")
+	}
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit < 0 || limit > maxLeaderboardLimit {
+		logger.Error("Invalid leaderboard limit")
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Limit should be between 1 and %d", maxLeaderboardLimit))
+	}
+
+	direction, comparison := "DESC", "<"
+	if req.GetAscending() {
+		direction, comparison = "ASC", ">"
+	}
+
+	if req.GetAroundUser() != "" && req.GetCursor() != "" {
+		logger.Error("Both cursor and around user are set")
+		return nil, status.Error(codes.InvalidArgument, "Cursor can't be used together with around user")
+	}
+
+	source := leaderboardSource("user_stats", false)
+	var seasonArgs []interface{}
+	if req.GetSeasonId() != 0 {
+		table, err := s.seasonStatsTable(logger, req.GetSeasonId())
+		if err != nil {
+			return nil, err
+		}
+		source = leaderboardSource(table, true)
+		seasonArgs = append(seasonArgs, req.GetSeasonId())
+	}
+
+	after := &leaderboardCursor{metric: req.GetMetric(), seasonID: req.GetSeasonId(), ascending: req.GetAscending()}
+	if req.GetCursor() != "" {
+		var err error
+		after, err = decodeLeaderboardCursor(req.GetCursor())
+		if err != nil || after.metric != req.GetMetric() || after.seasonID != req.GetSeasonId() || after.ascending != req.GetAscending() {
+			logger.WithError(err).Error("Invalid leaderboard cursor")
+			return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+	}
+
+	var aroundUserID string
+	if req.GetAroundUser() != "" {
+		user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetAroundUser())
+		if err != nil {
+			return nil, err
+		}
+		aroundUserID = user.GetId()
+	}
+
+	var entries []*pb.LeaderboardEntry
+	var err error
+	if snapshot := s.cachedLeaderboard(req.GetSeasonId()); snapshot != nil {
+		ranked := snapshot.ranked[req.GetAscending()][req.GetMetric()]
+		if aroundUserID != "" {
+			pos, ok := snapshot.position(ranked, aroundUserID)
+			if !ok {
+				logger.Error("User is not ranked")
+				return nil, status.Error(codes.NotFound, "User is not ranked")
+			}
+			start := pos - int(limit/2)
+			if start < 0 {
+				start = 0
+			}
+			entries = snapshot.page(ranked, start, limit)
+		} else {
+			entries = snapshot.page(ranked, ranked.after(snapshot.users, after.value, after.userID), limit)
+		}
+	} else if aroundUserID != "" {
+		query := fmt.Sprintf(leaderboardAroundQuery, column, direction, comparison, source)
+		args := append([]interface{}{aroundUserID, limit / 2, limit}, seasonArgs...)
+		entries, err = s.fetchLeaderboard(logger, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			logger.Error("User is not ranked")
+			return nil, status.Error(codes.NotFound, "User is not ranked")
+		}
+	} else {
+		query := fmt.Sprintf(leaderboardQuery, column, direction, comparison, source)
+		args := append([]interface{}{after.userID, after.value, limit}, seasonArgs...)
+		entries, err = s.fetchLeaderboard(logger, query, args...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &pb.GetLeaderboardResponse{Metric: req.GetMetric(), Entries: entries}
+	if int32(len(entries)) == limit {
+		last := entries[len(entries)-1]
+		next := &leaderboardCursor{
+			metric:    req.GetMetric(),
+			seasonID:  req.GetSeasonId(),
+			ascending: req.GetAscending(),
+			value:     last.GetValue(),
+			userID:    last.GetUserId(),
+		}
+		res.NextCursor = next.encode()
+	}
+
+	return res, nil
+}
+
+// cachedLeaderboard returns the leaderboard snapshot when the cache is enabled and fresh, seasons are never cached
+func (s *UsersStatsServer) cachedLeaderboard(seasonID int32) *leaderboardSnapshot {
+	if s.leaderboard == nil || seasonID != 0 {
+		return nil
+	}
+	return s.leaderboard.fresh()
+}
+
+// leaderboardSource joins users to one of the stats tables, seasonal tables are filtered by the season id in $4
+func leaderboardSource(table string, seasonal bool) string {
+	if seasonal {
+		return table + " us ON us.user_id = u.id AND us.season_id = $4"
+	}
+	return table + " us ON us.user_id = u.id"
+}
+
+func (s *UsersStatsServer) fetchLeaderboard(logger *logrus.Entry, query string, args ...interface{}) ([]*pb.LeaderboardEntry, error) {
+	rows, err := s.cfg.Database.DB().Query(query, args...)
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch leaderboard")
+		return nil, status.Error(codes.Internal, "Could not fetch leaderboard")
+	}
+	defer rows.Close()
+
+	entries := []*pb.LeaderboardEntry{}
+	for rows.Next() {
+		entry := &pb.LeaderboardEntry{}
+		if err := rows.Scan(&entry.Rank, &entry.UserId, &entry.Username, &entry.Value); err != nil {
+			logger.WithError(err).Error("Could not fetch leaderboard")
+			return nil, status.Error(codes.Internal, "Could not fetch leaderboard")
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		logger.WithError(err).Error("Could not fetch leaderboard")
+		return nil, status.Error(codes.Internal, "Could not fetch leaderboard")
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,219 @@
+package svc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+const leaderboardSnapshotQuery = "SELECT u.id, u.name, us.games, us.wins, us.top5, us.kills FROM users u JOIN user_stats us ON us.user_id = u.id"
+
+// leaderboardCache keeps ranked snapshots of the lifetime leaderboards so requests don't rank every player.
+// Snapshots are rebuilt whole by RunLeaderboardRefresh, stats writes aren't applied to them, so cached
+// leaderboards lag behind user_stats by up to staleness. Snapshots older than staleness are not served.
+type leaderboardCache struct {
+	staleness time.Duration
+
+	mu       sync.RWMutex
+	snapshot *leaderboardSnapshot
+}
+
+type leaderboardUser struct {
+	id   string
+	name string
+	// stats is indexed by LeaderboardMetric
+	stats [4]int32
+}
+
+// rankedLeaderboard holds the users of a snapshot in the order of one metric and direction,
+// ties broken by user id bytewise like in leaderboardQuery
+type rankedLeaderboard struct {
+	ascending bool
+	metric    pb.LeaderboardMetric
+	// order holds indexes into leaderboardSnapshot.users
+	order []int32
+	// rank is the dense rank of order[i]
+	rank []int32
+}
+
+type leaderboardSnapshot struct {
+	builtAt time.Time
+	users   []leaderboardUser
+	index   map[string]int32
+	ranked  map[bool]map[pb.LeaderboardMetric]*rankedLeaderboard
+}
+
+func newLeaderboardCache(staleness time.Duration) *leaderboardCache {
+	return &leaderboardCache{staleness: staleness}
+}
+
+// fresh returns the current snapshot, or nil when there is none within the staleness bound
+func (c *leaderboardCache) fresh() *leaderboardSnapshot {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.snapshot == nil || time.Since(c.snapshot.builtAt) > c.staleness {
+		return nil
+	}
+	return c.snapshot
+}
+
+func (c *leaderboardCache) set(snapshot *leaderboardSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snapshot = snapshot
+}
+
+// RefreshLeaderboards rebuilds the leaderboard snapshot from user_stats
+func (s *UsersStatsServer) RefreshLeaderboards(ctx context.Context) error {
+	if s.leaderboard == nil {
+		return nil
+	}
+
+	builtAt := time.Now()
+	rows, err := s.cfg.Database.DB().QueryContext(ctx, leaderboardSnapshotQuery)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	users := []leaderboardUser{}
+	for rows.Next() {
+		var user leaderboardUser
+		if err := rows.Scan(&user.id, &user.name, &user.stats[pb.LeaderboardMetric_GAMES], &user.stats[pb.LeaderboardMetric_WINS],
+			&user.stats[pb.LeaderboardMetric_TOP5], &user.stats[pb.LeaderboardMetric_KILLS]); err != nil {
+			return err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	s.leaderboard.set(buildLeaderboardSnapshot(users, builtAt))
+	return nil
+}
+
+func buildLeaderboardSnapshot(users []leaderboardUser, builtAt time.Time) *leaderboardSnapshot {
+	snapshot := &leaderboardSnapshot{
+		builtAt: builtAt,
+		users:   users,
+		index:   make(map[string]int32, len(users)),
+		ranked:  map[bool]map[pb.LeaderboardMetric]*rankedLeaderboard{false: {}, true: {}},
+	}
+	for i, user := range users {
+		snapshot.index[user.id] = int32(i)
+	}
+	for metric := range leaderboardColumns {
+		for _, ascending := range []bool{false, true} {
+			snapshot.ranked[ascending][metric] = rankLeaderboard(users, metric, ascending)
+		}
+	}
+	return snapshot
+}
+
+func rankLeaderboard(users []leaderboardUser, metric pb.LeaderboardMetric, ascending bool) *rankedLeaderboard {
+	ranked := &rankedLeaderboard{
+		ascending: ascending,
+		metric:    metric,
+		order:     make([]int32, len(users)),
+		rank:      make([]int32, len(users)),
+	}
+	for i := range ranked.order {
+		ranked.order[i] = int32(i)
+	}
+	sort.Slice(ranked.order, func(i, j int) bool {
+		a, b := users[ranked.order[i]], users[ranked.order[j]]
+		return ranked.before(a.stats[metric], a.id, b.stats[metric], b.id)
+	})
+
+	var rank int32
+	for i, idx := range ranked.order {
+		if i == 0 || users[idx].stats[metric] != users[ranked.order[i-1]].stats[metric] {
+			rank++
+		}
+		ranked.rank[i] = rank
+	}
+	return ranked
+}
+
+// before tells if the entry with value a and user aID is ranked before the one with value b and user bID
+func (r *rankedLeaderboard) before(a int32, aID string, b int32, bID string) bool {
+	if a != b {
+		return (a > b) != r.ascending
+	}
+	return aID < bID
+}
+
+// after returns the position of the first entry ranked after the cursor in O(log n)
+func (r *rankedLeaderboard) after(users []leaderboardUser, value int32, userID string) int {
+	if userID == "" {
+		return 0
+	}
+	return sort.Search(len(r.order), func(i int) bool {
+		user := users[r.order[i]]
+		return r.before(value, userID, user.stats[r.metric], user.id)
+	})
+}
+
+// position returns the position of a user in O(log n), false when the user isn't in the snapshot
+func (s *leaderboardSnapshot) position(ranked *rankedLeaderboard, userID string) (int, bool) {
+	idx, ok := s.index[userID]
+	if !ok {
+		return 0, false
+	}
+	user := s.users[idx]
+	pos := sort.Search(len(ranked.order), func(i int) bool {
+		other := s.users[ranked.order[i]]
+		return !ranked.before(other.stats[ranked.metric], other.id, user.stats[ranked.metric], user.id)
+	})
+	return pos, true
+}
+
+// page returns up to limit entries starting at position start
+func (s *leaderboardSnapshot) page(ranked *rankedLeaderboard, start int, limit int32) []*pb.LeaderboardEntry {
+	entries := []*pb.LeaderboardEntry{}
+	for i := start; i < len(ranked.order) && int32(len(entries)) < limit; i++ {
+		user := s.users[ranked.order[i]]
+		entries = append(entries, &pb.LeaderboardEntry{
+			Rank:     ranked.rank[i],
+			UserId:   user.id,
+			Username: user.name,
+			Value:    user.stats[ranked.metric],
+		})
+	}
+	return entries
+}
+
+// RunLeaderboardRefresh rebuilds the leaderboard snapshot at half the staleness bound so it's
+// always served while the database is reachable
+func RunLeaderboardRefresh(ctx context.Context, s *UsersStatsServer, logger *logrus.Logger) {
+	if s.leaderboard == nil {
+		return
+	}
+
+	refresh := func() {
+		started := time.Now()
+		if err := s.RefreshLeaderboards(ctx); err != nil {
+			logger.WithError(err).Error("Could not refresh leaderboards")
+			return
+		}
+		logger.WithField("took", time.Since(started).String()).Debug("Refreshed leaderboards")
+	}
+
+	refresh()
+	ticker := time.NewTicker(s.leaderboard.staleness / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refresh()
+		}
+	}
+}
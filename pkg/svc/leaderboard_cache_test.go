@@ -0,0 +1,341 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLeaderboardCache(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:             gdb,
+		UsersServer:          usrServer,
+		LeaderboardStaleness: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+
+	t.Run("Refresh Leaderboards", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(leaderboardSnapshotQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "games", "wins", "top5", "kills"}).
+				AddRow("user-c", "carol", 30, 1, 9, 12).
+				AddRow("user-a", "alice", 20, 4, 10, 40).
+				AddRow("user-b", "bob", 25, 2, 11, 40).
+				AddRow("user-d", "dave", 1, 0, 0, 0))
+
+		if err := stServer.RefreshLeaderboards(context.TODO()); err != nil {
+			t.Fatalf("error refreshing leaderboards: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	var nextCursor string
+
+	t.Run("Get Leaderboard - cached first page", func(t *testing.T) {
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_KILLS, Limit: 2})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		entries := res.GetEntries()
+		if len(entries) != 2 || entries[0].GetUserId() != "user-a" || entries[1].GetUserId() != "user-b" ||
+			entries[1].GetRank() != 1 || entries[1].GetUsername() != "bob" || res.GetNextCursor() == "" {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		nextCursor = res.GetNextCursor()
+	})
+
+	t.Run("Get Leaderboard - cached next page", func(t *testing.T) {
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_KILLS, Limit: 2, Cursor: nextCursor})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		entries := res.GetEntries()
+		if len(entries) != 2 || entries[0].GetUserId() != "user-c" || entries[0].GetRank() != 2 || entries[1].GetRank() != 3 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+	})
+
+	t.Run("Get Leaderboard - cached ascending", func(t *testing.T) {
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_GAMES, Ascending: true, Limit: 1})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 1 || res.GetEntries()[0].GetUserId() != "user-d" || res.GetEntries()[0].GetValue() != 1 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+	})
+
+	t.Run("Get Leaderboard - cached around user", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-b", "bob"))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Limit: 3, AroundUser: "user-b"})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		entries := res.GetEntries()
+		if len(entries) != 3 || entries[0].GetUserId() != "user-a" || entries[1].GetUserId() != "user-b" || entries[1].GetRank() != 2 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - around user missing from the snapshot", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-e").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-e", "eve"))
+
+		_, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{AroundUser: "user-e"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - stale snapshot", func(t *testing.T) {
+		stServer.leaderboard.set(buildLeaderboardSnapshot(nil, time.Now().Add(-2*time.Minute)))
+		sqlWins := fmt.Sprintf(leaderboardQuery, "wins", "DESC", "<", leaderboardSource("user_stats", false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlWins)).WithArgs("", 0, defaultLeaderboardLimit).
+			WillReturnRows(sqlmock.NewRows([]string{"rank", "user_id", "name", "value"}).AddRow(1, "user-a", "alice", 4))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 1 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
+
+const benchmarkLeaderboardUsers = 300000
+
+func benchmarkLeaderboardUsersList() []leaderboardUser {
+	random := rand.New(rand.NewSource(1))
+	users := make([]leaderboardUser, benchmarkLeaderboardUsers)
+	for i := range users {
+		users[i] = leaderboardUser{id: fmt.Sprintf("user-%07d", i), name: fmt.Sprintf("player%d", i)}
+		users[i].stats[pb.LeaderboardMetric_GAMES] = random.Int31n(5000)
+		users[i].stats[pb.LeaderboardMetric_WINS] = random.Int31n(500)
+		users[i].stats[pb.LeaderboardMetric_TOP5] = random.Int31n(1500)
+		users[i].stats[pb.LeaderboardMetric_KILLS] = random.Int31n(20000)
+	}
+	return users
+}
+
+// BenchmarkLeaderboardRank ranks every player by one metric, the work leaderboardQuery
+// has the database do on every uncached request
+func BenchmarkLeaderboardRank(b *testing.B) {
+	users := benchmarkLeaderboardUsersList()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rankLeaderboard(users, pb.LeaderboardMetric_KILLS, false)
+	}
+}
+
+func BenchmarkLeaderboardCachedPage(b *testing.B) {
+	snapshot := buildLeaderboardSnapshot(benchmarkLeaderboardUsersList(), time.Now())
+	ranked := snapshot.ranked[false][pb.LeaderboardMetric_KILLS]
+	cursor := snapshot.users[ranked.order[len(ranked.order)/2]]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		snapshot.page(ranked, ranked.after(snapshot.users, cursor.stats[pb.LeaderboardMetric_KILLS], cursor.id), defaultLeaderboardLimit)
+	}
+}
+
+func BenchmarkLeaderboardCachedAround(b *testing.B) {
+	snapshot := buildLeaderboardSnapshot(benchmarkLeaderboardUsersList(), time.Now())
+	ranked := snapshot.ranked[false][pb.LeaderboardMetric_KILLS]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pos, _ := snapshot.position(ranked, snapshot.users[i%len(snapshot.users)].id)
+		start := pos - defaultLeaderboardLimit/2
+		if start < 0 {
+			start = 0
+		}
+		snapshot.page(ranked, start, defaultLeaderboardLimit)
+	}
+}
+
+// benchmarkLeaderboardDSNEnv names the Postgres database the SQL leaderboard benchmarks compare against,
+// they are skipped when it isn't set. The benchmark users are loaded into temporary tables shadowing
+// users and user_stats, so the database is left untouched.
+const benchmarkLeaderboardDSNEnv = "LEADERBOARD_BENCH_DSN"
+
+func benchmarkLeaderboardDB(b *testing.B, users []leaderboardUser) *sql.DB {
+	dsn := os.Getenv(benchmarkLeaderboardDSNEnv)
+	if dsn == "" {
+		b.Skipf("%s is not set", benchmarkLeaderboardDSNEnv)
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		b.Fatalf("Could not open database: %v", err)
+	}
+	b.Cleanup(func() { db.Close() })
+	// temporary tables only exist on the connection that created them
+	db.SetMaxOpenConns(1)
+
+	for _, stmt := range []string{
+		"CREATE TEMPORARY TABLE users (id varchar primary key, name varchar NOT NULL)",
+		"CREATE TEMPORARY TABLE user_stats (user_id varchar NOT NULL, games int NOT NULL, wins int NOT NULL, top5 int NOT NULL, kills int NOT NULL)",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			b.Fatalf("Could not create benchmark tables: %v", err)
+		}
+	}
+
+	txn, err := db.Begin()
+	if err != nil {
+		b.Fatalf("Could not start transaction: %v", err)
+	}
+	copyUsers, err := txn.Prepare(pq.CopyIn("users", "id", "name"))
+	if err != nil {
+		b.Fatalf("Could not copy users: %v", err)
+	}
+	for _, user := range users {
+		if _, err := copyUsers.Exec(user.id, user.name); err != nil {
+			b.Fatalf("Could not copy users: %v", err)
+		}
+	}
+	if _, err := copyUsers.Exec(); err != nil {
+		b.Fatalf("Could not copy users: %v", err)
+	}
+	copyStats, err := txn.Prepare(pq.CopyIn("user_stats", "user_id", "games", "wins", "top5", "kills"))
+	if err != nil {
+		b.Fatalf("Could not copy user stats: %v", err)
+	}
+	for _, user := range users {
+		if _, err := copyStats.Exec(user.id, user.stats[pb.LeaderboardMetric_GAMES], user.stats[pb.LeaderboardMetric_WINS],
+			user.stats[pb.LeaderboardMetric_TOP5], user.stats[pb.LeaderboardMetric_KILLS]); err != nil {
+			b.Fatalf("Could not copy user stats: %v", err)
+		}
+	}
+	if _, err := copyStats.Exec(); err != nil {
+		b.Fatalf("Could not copy user stats: %v", err)
+	}
+	if err := txn.Commit(); err != nil {
+		b.Fatalf("Could not commit benchmark data: %v", err)
+	}
+
+	// the indexes of the leaderboard_indexes migration
+	for _, stmt := range []string{
+		"CREATE INDEX ON user_stats(wins, user_id COLLATE \"C\")",
+		"CREATE INDEX ON user_stats(kills, user_id COLLATE \"C\")",
+		"CREATE INDEX ON user_stats(games, user_id COLLATE \"C\")",
+		"CREATE INDEX ON user_stats(top5, user_id COLLATE \"C\")",
+		"ANALYZE users",
+		"ANALYZE user_stats",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			b.Fatalf("Could not index benchmark tables: %v", err)
+		}
+	}
+	return db
+}
+
+func benchmarkQuery(b *testing.B, db *sql.DB, query string, args ...interface{}) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		b.Fatalf("Could not run query: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != nil {
+		b.Fatalf("Could not read rows: %v", err)
+	}
+}
+
+// BenchmarkLeaderboardSQLPage is the uncached counterpart of BenchmarkLeaderboardCachedPage
+func BenchmarkLeaderboardSQLPage(b *testing.B) {
+	users := benchmarkLeaderboardUsersList()
+	db := benchmarkLeaderboardDB(b, users)
+	snapshot := buildLeaderboardSnapshot(users, time.Now())
+	ranked := snapshot.ranked[false][pb.LeaderboardMetric_KILLS]
+	cursor := snapshot.users[ranked.order[len(ranked.order)/2]]
+	query := fmt.Sprintf(leaderboardQuery, "kills", "DESC", "<", leaderboardSource("user_stats", false))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkQuery(b, db, query, cursor.id, cursor.stats[pb.LeaderboardMetric_KILLS], defaultLeaderboardLimit)
+	}
+}
+
+// BenchmarkLeaderboardSQLAround is the uncached counterpart of BenchmarkLeaderboardCachedAround
+func BenchmarkLeaderboardSQLAround(b *testing.B) {
+	users := benchmarkLeaderboardUsersList()
+	db := benchmarkLeaderboardDB(b, users)
+	query := fmt.Sprintf(leaderboardAroundQuery, "kills", "DESC", "<", leaderboardSource("user_stats", false))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkQuery(b, db, query, users[i%len(users)].id, defaultLeaderboardLimit/2, defaultLeaderboardLimit)
+	}
+}
+
+// BenchmarkUsersByStatsSQL runs the top 100 query of the users list sorted by stats
+func BenchmarkUsersByStatsSQL(b *testing.B) {
+	db := benchmarkLeaderboardDB(b, benchmarkLeaderboardUsersList())
+	query := fetchUsersByStatsQuery + "ORDER BY us.kills DESC LIMIT 100"
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkQuery(b, db, query)
+	}
+}
@@ -0,0 +1,198 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLeaderboard(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+	usrClient := pb.NewUsersClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	lifetime := leaderboardSource("user_stats", false)
+	sqlKillsDesc := fmt.Sprintf(leaderboardQuery, "kills", "DESC", "<", lifetime)
+	sqlWinsAsc := fmt.Sprintf(leaderboardQuery, "wins", "ASC", ">", lifetime)
+	sqlAroundWins := fmt.Sprintf(leaderboardAroundQuery, "wins", "DESC", "<", lifetime)
+	entryColumns := []string{"rank", "user_id", "name", "value"}
+
+	var nextCursor string
+
+	t.Run("Get Leaderboard - first page", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlKillsDesc)).WithArgs("", 0, 2).
+			WillReturnRows(sqlmock.NewRows(entryColumns).
+				AddRow(1, "user-a", "alice", 40).
+				AddRow(1, "user-b", "bob", 40))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_KILLS, Limit: 2})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 2 || res.GetEntries()[1].GetRank() != 1 || res.GetNextCursor() == "" {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		nextCursor = res.GetNextCursor()
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - next page", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlKillsDesc)).WithArgs("user-b", 40, 2).
+			WillReturnRows(sqlmock.NewRows(entryColumns).AddRow(2, "user-c", "carol", 12))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_KILLS, Limit: 2, Cursor: nextCursor})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 1 || res.GetEntries()[0].GetRank() != 2 || res.GetNextCursor() != "" {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - cursor of another ordering", func(t *testing.T) {
+		_, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Metric: pb.LeaderboardMetric_WINS, Limit: 2, Cursor: nextCursor})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - ascending", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlWinsAsc)).WithArgs("", 0, defaultLeaderboardLimit).
+			WillReturnRows(sqlmock.NewRows(entryColumns).AddRow(1, "user-c", "carol", 0))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Ascending: true})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if res.GetMetric() != pb.LeaderboardMetric_WINS || len(res.GetEntries()) != 1 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - around user", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-b", "bob"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlAroundWins)).WithArgs("user-b", 2, 5).
+			WillReturnRows(sqlmock.NewRows(entryColumns).
+				AddRow(3, "user-a", "alice", 9).
+				AddRow(4, "user-b", "bob", 7).
+				AddRow(4, "user-c", "carol", 7))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Limit: 5, AroundUser: "user-b"})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 3 || res.GetNextCursor() != "" {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - around unranked user", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-d").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-d", "dave"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlAroundWins)).WithArgs("user-d", 25, 50).
+			WillReturnRows(sqlmock.NewRows(entryColumns))
+
+		_, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{AroundUser: "user-d"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - invalid requests", func(t *testing.T) {
+		requests := []*pb.GetLeaderboardRequest{
+			{Metric: pb.LeaderboardMetric(42)},
+			{Limit: maxLeaderboardLimit + 1},
+			{Cursor: "not a cursor"},
+			{Cursor: nextCursor, AroundUser: "user-b"},
+		}
+		for _, req := range requests {
+			_, err := stClient.GetLeaderboard(ctx, req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument for %v, got: %v", req, err)
+			}
+		}
+	})
+
+	t.Run("List Users - deprecated stats ordering", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(fetchUsersByStatsQuery + "ORDER BY us.kills DESC LIMIT 100")).
+			WillReturnRows(sqlmock.NewRows([]string{"name", "games", "wins", "top5", "kills"}).AddRow("alice", 10, 2, 5, 40))
+
+		res, err := usrClient.List(ctx, &pb.ListUsersRequest{
+			OrderBy: &query.Sorting{Criterias: []*query.SortCriteria{{Tag: "Kills", Order: query.SortCriteria_DESC}}},
+		})
+		if err != nil {
+			t.Fatalf("error listing users: %v", err)
+		}
+		if len(res.GetResults()) != 1 || res.GetResults()[0].GetStats().GetKills() != 40 {
+			t.Fatalf("unexpected users: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
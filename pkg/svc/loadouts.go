@@ -0,0 +1,350 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxLoadoutNameLength    = 64
+	loadoutNotFoundErrorMsg = "Loadout not found"
+
+	loadoutNameTakenQuery  = "SELECT count(*) FROM loadouts WHERE user_id = $1 AND name = $2 AND id <> $3"
+	insertLoadoutQuery     = "INSERT INTO loadouts (user_id, name) VALUES ($1, $2) RETURNING id"
+	renameLoadoutQuery     = "UPDATE loadouts SET name = $1 WHERE id = $2 AND user_id = $3"
+	deleteLoadoutQuery     = "DELETE FROM loadouts WHERE id = $1 AND user_id = $2"
+	lockLoadoutQuery       = "SELECT id FROM loadouts WHERE id = $1 AND user_id = $2 FOR UPDATE"
+	overfilledSlotQuery    = "SELECT it.slot, it.slot_capacity FROM loadout_items li JOIN users_store_items usi ON usi.store_item_id = li.store_item_id AND usi.user_id = $1 JOIN store_items si ON si.id = li.store_item_id JOIN item_types it ON it.id = si.type WHERE li.loadout_id = $2 AND it.slot <> '' AND (usi.expires_at IS NULL OR usi.expires_at > now()) GROUP BY it.slot, it.slot_capacity HAVING count(*) > it.slot_capacity LIMIT 1"
+	clearLoadoutItemsQuery = "DELETE FROM loadout_items WHERE loadout_id = $1"
+	insertLoadoutItemQuery = "INSERT INTO loadout_items (loadout_id, store_item_id) VALUES ($1, $2)"
+	unequipAllQuery        = "UPDATE users_store_items SET equipped = 'f' WHERE user_id = $1 AND equipped = 't'"
+	equipLoadoutQuery      = "UPDATE users_store_items SET equipped = 't' WHERE user_id = $1 AND store_item_id IN (SELECT store_item_id FROM loadout_items WHERE loadout_id = $2) AND (expires_at IS NULL OR expires_at > now())"
+	listLoadoutsQuery      = "SELECT l.id, l.name, li.store_item_id FROM loadouts l LEFT JOIN loadout_items li ON li.loadout_id = l.id WHERE l.user_id = $1 ORDER BY l.id, li.store_item_id"
+	ownedItemSlotsRawQuery = "SELECT si.id, it.slot, it.slot_capacity FROM users_store_items usi JOIN store_items si ON si.id = usi.store_item_id JOIN item_types it ON it.id = si.type WHERE usi.user_id = ? AND usi.store_item_id IN (?) AND (usi.expires_at IS NULL OR usi.expires_at > now())"
+)
+
+func (s *StoreItemsServer) CreateLoadout(ctx context.Context, req *pb.CreateLoadoutRequest) (*pb.CreateLoadoutResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"name":    req.GetName(),
+	})
+	logger.Debug("Create Loadout")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	if err := s.checkLoadout(logger, req.GetUserId(), 0, req.GetName(), req.GetItemIds()); err != nil {
+		return nil, err
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not create loadout")
+	}
+
+	loadout := &pb.Loadout{UserId: req.GetUserId(), Name: req.GetName(), ItemIds: req.GetItemIds()}
+	if err := txnDB.QueryRow(insertLoadoutQuery, req.GetUserId(), req.GetName()).Scan(&loadout.Id); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not create loadout")
+		return nil, status.Error(codes.Internal, "Could not create loadout")
+	}
+
+	if err := setLoadoutItems(txnDB, loadout.Id, req.GetItemIds()); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not save loadout items")
+		return nil, status.Error(codes.Internal, "Could not create loadout")
+	}
+
+	txnDB.Commit()
+
+	return &pb.CreateLoadoutResponse{Result: loadout}, nil
+}
+
+func (s *StoreItemsServer) UpdateLoadout(ctx context.Context, req *pb.UpdateLoadoutRequest) (*pb.UpdateLoadoutResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"id":      req.GetId(),
+	})
+	logger.Debug("Update Loadout")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	if err := s.checkLoadout(logger, req.GetUserId(), req.GetId(), req.GetName(), req.GetItemIds()); err != nil {
+		return nil, err
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not update loadout")
+	}
+
+	res, err := txnDB.Exec(renameLoadoutQuery, req.GetName(), req.GetId(), req.GetUserId())
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not update loadout")
+		return nil, status.Error(codes.Internal, "Could not update loadout")
+	}
+	if updated, err := res.RowsAffected(); err != nil || updated == 0 {
+		txnDB.Rollback()
+		logger.WithError(err).Error(loadoutNotFoundErrorMsg)
+		return nil, status.Error(codes.NotFound, loadoutNotFoundErrorMsg)
+	}
+
+	if _, err := txnDB.Exec(clearLoadoutItemsQuery, req.GetId()); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not clear loadout items")
+		return nil, status.Error(codes.Internal, "Could not update loadout")
+	}
+
+	if err := setLoadoutItems(txnDB, req.GetId(), req.GetItemIds()); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not save loadout items")
+		return nil, status.Error(codes.Internal, "Could not update loadout")
+	}
+
+	txnDB.Commit()
+
+	return &pb.UpdateLoadoutResponse{Result: &pb.Loadout{
+		Id:      req.GetId(),
+		UserId:  req.GetUserId(),
+		Name:    req.GetName(),
+		ItemIds: req.GetItemIds(),
+	}}, nil
+}
+
+func (s *StoreItemsServer) DeleteLoadout(ctx context.Context, req *pb.DeleteLoadoutRequest) (*pb.DeleteLoadoutResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"id":      req.GetId(),
+	})
+	logger.Debug("Delete Loadout")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(deleteLoadoutQuery, req.GetId(), req.GetUserId()); err != nil {
+		logger.WithError(err).Error("Could not delete loadout")
+		return nil, status.Error(codes.Internal, "Could not delete loadout")
+	}
+
+	return &pb.DeleteLoadoutResponse{}, nil
+}
+
+// ActivateLoadout replaces the whole equipped set with the loadout items the user still owns
+func (s *StoreItemsServer) ActivateLoadout(ctx context.Context, req *pb.ActivateLoadoutRequest) (*pb.ActivateLoadoutResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"id":      req.GetId(),
+	})
+	logger.Debug("Activate Loadout")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	var loadoutID int32
+	if err := txnDB.QueryRow(lockLoadoutQuery, req.GetId(), req.GetUserId()).Scan(&loadoutID); err != nil {
+		txnDB.Rollback()
+		if err == sql.ErrNoRows {
+			logger.Error(loadoutNotFoundErrorMsg)
+			return nil, status.Error(codes.NotFound, loadoutNotFoundErrorMsg)
+		}
+		logger.WithError(err).Error("Could not fetch loadout")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	// slot capacities may have shrunk since the loadout was saved
+	var slot string
+	var capacity int32
+	err = txnDB.QueryRow(overfilledSlotQuery, req.GetUserId(), loadoutID).Scan(&slot, &capacity)
+	if err == nil {
+		txnDB.Rollback()
+		logger.Error("Loadout overfills equipment slot")
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Slot %s can hold at most %d items", slot, capacity))
+	}
+	if err != sql.ErrNoRows {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not check loadout slots")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	if _, err := txnDB.Exec(unequipAllQuery, req.GetUserId()); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not deequip items")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	if _, err := txnDB.Exec(equipLoadoutQuery, req.GetUserId(), loadoutID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not equip loadout items")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	rows, err := txnDB.Query(equippedUserItemsQuery, req.GetUserId())
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not fetch equipped items")
+		return nil, status.Error(codes.Internal, "Could not activate loadout")
+	}
+
+	items := []*pb.UserItemInfo{}
+	now := time.Now()
+	for rows.Next() {
+		item, err := scanUserItem(rows, now)
+		if err != nil {
+			rows.Close()
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not fetch equipped items")
+			return nil, status.Error(codes.Internal, "Could not activate loadout")
+		}
+		items = append(items, item)
+	}
+	rows.Close()
+
+	txnDB.Commit()
+
+	return &pb.ActivateLoadoutResponse{Items: items}, nil
+}
+
+func (s *StoreItemsServer) ListLoadouts(ctx context.Context, req *pb.ListLoadoutsRequest) (*pb.ListLoadoutsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("user_id", req.GetUserId())
+	logger.Debug("List Loadouts")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	rows, err := s.cfg.Database.DB().Query(listLoadoutsQuery, req.GetUserId())
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch loadouts")
+		return nil, status.Error(codes.Internal, "Could not fetch loadouts")
+	}
+	defer rows.Close()
+
+	loadouts := []*pb.Loadout{}
+	for rows.Next() {
+		var id int32
+		var name string
+		var itemID sql.NullString
+		if err := rows.Scan(&id, &name, &itemID); err != nil {
+			logger.WithError(err).Error("Could not fetch loadouts")
+			return nil, status.Error(codes.Internal, "Could not fetch loadouts")
+		}
+
+		if len(loadouts) == 0 || loadouts[len(loadouts)-1].Id != id {
+			loadouts = append(loadouts, &pb.Loadout{Id: id, UserId: req.GetUserId(), Name: name, ItemIds: []string{}})
+		}
+		if itemID.Valid {
+			last := loadouts[len(loadouts)-1]
+			last.ItemIds = append(last.ItemIds, itemID.String)
+		}
+	}
+
+	return &pb.ListLoadoutsResponse{Results: loadouts}, nil
+}
+
+// checkLoadout validates the loadout name and checks that the items are owned and fit the equipment slots
+func (s *StoreItemsServer) checkLoadout(logger *logrus.Entry, userID string, loadoutID int32, name string, itemIds []string) error {
+	if name == "" || len(name) > maxLoadoutNameLength {
+		logger.Error("Loadout name validation failed")
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Loadout name should be from 1 to %d characters long", maxLoadoutNameLength))
+	}
+
+	var taken int
+	if err := s.cfg.Database.DB().QueryRow(loadoutNameTakenQuery, userID, name, loadoutID).Scan(&taken); err != nil {
+		logger.WithError(err).Error("Could not check loadout name")
+		return status.Error(codes.Internal, "Could not save loadout")
+	}
+	if taken > 0 {
+		logger.Error("Loadout with such name already exists")
+		return status.Error(codes.InvalidArgument, "Loadout with such name already exists")
+	}
+
+	if len(itemIds) == 0 {
+		return nil
+	}
+
+	requested := map[string]bool{}
+	for _, itemID := range itemIds {
+		if requested[itemID] {
+			logger.Error("Duplicate loadout item")
+			return status.Error(codes.InvalidArgument, "Loadout items should be unique")
+		}
+		requested[itemID] = true
+	}
+
+	rows, err := s.cfg.Database.Raw(ownedItemSlotsRawQuery, userID, itemIds).Rows()
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch loadout items")
+		return status.Error(codes.Internal, "Could not save loadout")
+	}
+	defer rows.Close()
+
+	owned := 0
+	slotItems := map[string]int32{}
+	for rows.Next() {
+		var itemID, slot string
+		var capacity int32
+		if err := rows.Scan(&itemID, &slot, &capacity); err != nil {
+			logger.WithError(err).Error("Could not fetch loadout items")
+			return status.Error(codes.Internal, "Could not save loadout")
+		}
+		if slot == "" {
+			logger.Error("Loadout item can't be equipped")
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("Item %s can't be equipped", itemID))
+		}
+		slotItems[slot]++
+		if slotItems[slot] > capacity {
+			logger.Error("Loadout overfills equipment slot")
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("Slot %s can hold at most %d items", slot, capacity))
+		}
+		owned++
+	}
+
+	if owned != len(itemIds) {
+		logger.Error("Loadout contains items the user doesn't own")
+		return status.Error(codes.InvalidArgument, "Loadout can only contain owned items")
+	}
+
+	return nil
+}
+
+func setLoadoutItems(txnDB *sql.Tx, loadoutID int32, itemIds []string) error {
+	for _, itemID := range itemIds {
+		if _, err := txnDB.Exec(insertLoadoutItemQuery, loadoutID, itemID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadouts(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlOwnedItemSlots := `SELECT si.id, it.slot, it.slot_capacity FROM users_store_items usi JOIN store_items si ON si.id = usi.store_item_id JOIN item_types it ON it.id = si.type WHERE usi.user_id = $1 AND usi.store_item_id IN ($2,$3)`
+
+	slotColumns := []string{"id", "slot", "slot_capacity"}
+
+	t.Run("Create Loadout - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(loadoutNameTakenQuery)).WithArgs("some-id", "ranked", 0).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlOwnedItemSlots)).WithArgs("some-id", "skin-id", "emote-id").
+			WillReturnRows(sqlmock.NewRows(slotColumns).AddRow("skin-id", "skin", 1).AddRow("emote-id", "emotes", 4))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(insertLoadoutQuery)).WithArgs("some-id", "ranked").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectExec(regexp.QuoteMeta(insertLoadoutItemQuery)).WithArgs(7, "skin-id").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(insertLoadoutItemQuery)).WithArgs(7, "emote-id").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		res, err := stiClient.CreateLoadout(ctx, &pb.CreateLoadoutRequest{UserId: "some-id", Name: "ranked", ItemIds: []string{"skin-id", "emote-id"}})
+		if err != nil {
+			t.Fatalf("error creating loadout: %v", err)
+		}
+		if res.GetResult().GetId() != 7 {
+			t.Fatalf("unexpected loadout: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Create Loadout - not owned item", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(loadoutNameTakenQuery)).WithArgs("some-id", "casual", 0).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlOwnedItemSlots)).WithArgs("some-id", "skin-id", "emote-id").
+			WillReturnRows(sqlmock.NewRows(slotColumns).AddRow("skin-id", "skin", 1))
+
+		_, err := stiClient.CreateLoadout(ctx, &pb.CreateLoadoutRequest{UserId: "some-id", Name: "casual", ItemIds: []string{"skin-id", "emote-id"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Update Loadout - slot overfilled", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(loadoutNameTakenQuery)).WithArgs("some-id", "ranked", 7).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlOwnedItemSlots)).WithArgs("some-id", "skin-id", "other-skin-id").
+			WillReturnRows(sqlmock.NewRows(slotColumns).AddRow("skin-id", "skin", 1).AddRow("other-skin-id", "skin", 1))
+
+		_, err := stiClient.UpdateLoadout(ctx, &pb.UpdateLoadoutRequest{UserId: "some-id", Id: 7, Name: "ranked", ItemIds: []string{"skin-id", "other-skin-id"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Activate Loadout - positive", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockLoadoutQuery)).WithArgs(7, "some-id").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectQuery(regexp.QuoteMeta(overfilledSlotQuery)).WithArgs("some-id", 7).WillReturnRows(sqlmock.NewRows([]string{"slot", "slot_capacity"}))
+		mock.ExpectExec(regexp.QuoteMeta(unequipAllQuery)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(equipLoadoutQuery)).WithArgs("some-id", 7).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(equippedUserItemsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"store_item_id", "equipped", "quantity", "expires_at"}).AddRow("skin-id", true, 1, nil).AddRow("emote-id", true, 1, nil))
+		mock.ExpectCommit()
+
+		res, err := stiClient.ActivateLoadout(ctx, &pb.ActivateLoadoutRequest{UserId: "some-id", Id: 7})
+		if err != nil {
+			t.Fatalf("error activating loadout: %v", err)
+		}
+		if len(res.GetItems()) != 2 {
+			t.Fatalf("unexpected equipped items: %v", res.GetItems())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Activate Loadout - slot shrunk", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockLoadoutQuery)).WithArgs(7, "some-id").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectQuery(regexp.QuoteMeta(overfilledSlotQuery)).WithArgs("some-id", 7).
+			WillReturnRows(sqlmock.NewRows([]string{"slot", "slot_capacity"}).AddRow("emotes", 2))
+		mock.ExpectRollback()
+
+		_, err := stiClient.ActivateLoadout(ctx, &pb.ActivateLoadoutRequest{UserId: "some-id", Id: 7})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Activate Loadout - not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockLoadoutQuery)).WithArgs(8, "some-id").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := stiClient.ActivateLoadout(ctx, &pb.ActivateLoadoutRequest{UserId: "some-id", Id: 8})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("List Loadouts - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(listLoadoutsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "store_item_id"}).
+				AddRow(7, "ranked", "emote-id").AddRow(7, "ranked", "skin-id").AddRow(9, "empty", nil))
+
+		res, err := stiClient.ListLoadouts(ctx, &pb.ListLoadoutsRequest{UserId: "some-id"})
+		if err != nil {
+			t.Fatalf("error listing loadouts: %v", err)
+		}
+		if len(res.GetResults()) != 2 || len(res.GetResults()[0].GetItemIds()) != 2 || len(res.GetResults()[1].GetItemIds()) != 0 {
+			t.Fatalf("unexpected loadouts: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
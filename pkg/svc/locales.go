@@ -0,0 +1,98 @@
+package svc
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// LocaleConfig lists the locales content can be translated to, the content itself is written in Default
+type LocaleConfig struct {
+	Default   string
+	Supported []string
+}
+
+// Resolve picks the locale content is returned in. The locale request field wins over the
+// Accept-Language header, anything that isn't supported falls back to Default.
+func (c LocaleConfig) Resolve(ctx context.Context, requested string) string {
+	candidates := []string{}
+	if requested != "" {
+		candidates = append(candidates, requested)
+	}
+	candidates = append(candidates, acceptedLanguages(ctx)...)
+
+	for _, candidate := range candidates {
+		if locale, ok := c.match(candidate); ok {
+			return locale
+		}
+	}
+	return c.Default
+}
+
+// IsTranslation reports whether locale is a supported locale other than Default
+func (c LocaleConfig) IsTranslation(locale string) bool {
+	if locale == c.Default {
+		return false
+	}
+	for _, supported := range c.Supported {
+		if supported == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// match finds a supported locale for a language tag, "de-AT" matches "de-AT" first and "de" after that
+func (c LocaleConfig) match(tag string) (string, bool) {
+	tag = strings.Replace(strings.TrimSpace(tag), "_", "-", -1)
+	base := strings.SplitN(tag, "-", 2)[0]
+	locales := append([]string{c.Default}, c.Supported...)
+
+	for _, candidate := range []string{tag, base} {
+		for _, locale := range locales {
+			if locale != "" && strings.EqualFold(locale, candidate) {
+				return locale, true
+			}
+		}
+	}
+	return "", false
+}
+
+// acceptedLanguages returns the languages of the Accept-Language header ordered by preference
+func acceptedLanguages(ctx context.Context) []string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := append(md.Get(runtime.MetadataPrefix+"accept-language"), md.Get("accept-language")...)
+
+	type language struct {
+		tag     string
+		quality float64
+	}
+	languages := []language{}
+	for _, header := range headers {
+		for _, part := range strings.Split(header, ",") {
+			params := strings.Split(part, ";")
+			lang := language{tag: strings.TrimSpace(params[0]), quality: 1}
+			for _, param := range params[1:] {
+				if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") {
+					if quality, err := strconv.ParseFloat(q[2:], 64); err == nil {
+						lang.quality = quality
+					}
+				}
+			}
+			if lang.tag != "" && lang.tag != "*" && lang.quality > 0 {
+				languages = append(languages, lang)
+			}
+		}
+	}
+	sort.SliceStable(languages, func(i, j int) bool { return languages[i].quality > languages[j].quality })
+
+	tags := make([]string, 0, len(languages))
+	for _, lang := range languages {
+		tags = append(tags, lang.tag)
+	}
+	return tags
+}
@@ -0,0 +1,351 @@
+package svc
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// top5Placement is the worst placement that still counts as a top 5 finish
+	top5Placement = 5
+
+	defaultMatchHistoryLimit = 20
+	maxMatchHistoryLimit     = 100
+
+	insertMatchQuery = "INSERT INTO matches (id, mode, duration_seconds, players, played_at) VALUES ($1, $2, $3, $4, $5) " +
+		"ON CONFLICT (id) DO NOTHING"
+	insertMatchParticipantQuery = "INSERT INTO match_participants (match_id, user_id, placement, kills, reward_coins) VALUES ($1, $2, $3, $4, $5)"
+	applyMatchStatsQuery        = "UPDATE user_stats SET games = games + 1, wins = wins + $2, top5 = top5 + $3, kills = kills + $4 WHERE user_id = $1"
+	matchHistoryQuery           = "SELECT m.id, m.mode, m.duration_seconds, m.played_at, m.players, mp.placement, mp.kills, mp.reward_coins " +
+		"FROM match_participants mp JOIN matches m ON m.id = mp.match_id WHERE mp.user_id = $1 " +
+		"ORDER BY m.played_at DESC, m.id LIMIT $2 OFFSET $3"
+	// rebuildStatsQuery adds up the stats update log on top of the stats counted before it, an empty $1 rebuilds every user
+	rebuildStatsQuery = "UPDATE user_stats us SET (games, wins, top5, kills) = (" +
+		"SELECT COALESCE(sum(games), 0), COALESCE(sum(wins), 0), COALESCE(sum(top5), 0), COALESCE(sum(kills), 0) FROM (" +
+		"SELECT games, wins, top5, kills FROM stat_baselines WHERE user_id = us.user_id UNION ALL " +
+		"SELECT games, wins, top5, kills FROM stat_updates WHERE user_id = us.user_id) logged) " +
+		"WHERE $1 = '' OR us.user_id = $1"
+	// rebuildSeasonStatsQuery adds up the updates played during the running season, seasons came after the log
+	rebuildSeasonStatsQuery = "UPDATE season_stats ss SET (games, wins, top5, kills) = (" +
+		"SELECT COALESCE(sum(games), 0), COALESCE(sum(wins), 0), COALESCE(sum(top5), 0), COALESCE(sum(kills), 0) " +
+		"FROM stat_updates WHERE user_id = ss.user_id AND played_at >= s.starts_at AND played_at < s.ends_at) " +
+		"FROM seasons s WHERE s.id = ss.season_id AND s.starts_at <= now() AND s.ends_at > now() AND s.archived_at IS NULL " +
+		"AND ($1 = '' OR ss.user_id = $1)"
+)
+
+func (s *UsersStatsServer) RecordMatch(ctx context.Context, req *pb.RecordMatchRequest) (*pb.RecordMatchResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"match_id":     req.GetMatchId(),
+		"mode":         req.GetMode(),
+		"participants": len(req.GetParticipants()),
+	})
+	logger.Debug("Record match")
+
+	if err := validateMatch(req); err != nil {
+		logger.WithError(err).Error("Invalid match")
+		return nil, err
+	}
+
+	violations := make([][]string, len(req.GetParticipants()))
+	for i, participant := range req.GetParticipants() {
+		violations[i] = s.cfg.Plausibility.violations(participantStats(participant))
+		if len(violations[i]) > 0 && !s.cfg.Plausibility.Flag {
+			logger.WithField("violations", violations[i]).Error("Implausible match participant")
+			return nil, status.Errorf(codes.InvalidArgument, "Implausible stats for %s: %s",
+				participant.GetUserId(), strings.Join(violations[i], violationsSeparator))
+		}
+	}
+
+	playedAt := time.Now()
+	if req.GetPlayedAt() != nil {
+		t, err := ptypes.Timestamp(req.GetPlayedAt())
+		if err != nil {
+			logger.WithError(err).Error("Invalid match time")
+			return nil, status.Error(codes.InvalidArgument, "Invalid played at time")
+		}
+		playedAt = t
+	}
+
+	userIDs := make([]string, 0, len(req.GetParticipants()))
+	for _, participant := range req.GetParticipants() {
+		userIDs = append(userIDs, participant.GetUserId())
+	}
+	var count int
+	if err := s.cfg.Database.Model(&pb.UserORM{}).Where("id IN (?)", userIDs).Count(&count).Error; err != nil {
+		logger.WithError(err).Error("Could not check participants")
+		return nil, status.Error(codes.Internal, "Could not record match")
+	}
+	if count != len(userIDs) {
+		logger.Error("Match references unknown users")
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+
+	// participants are applied in user id order so concurrent matches lock user_stats and user_ratings rows in the same order
+	order := make([]int, len(userIDs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return userIDs[order[i]] < userIDs[order[j]] })
+	participants := make([]*pb.MatchParticipant, len(order))
+	for k, i := range order {
+		participants[k] = req.GetParticipants()[i]
+	}
+
+	matchID := req.GetMatchId()
+	if matchID == "" {
+		matchID = uuid.NewV4().String()
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not record match")
+	}
+
+	res, err := txnDB.Exec(insertMatchQuery, matchID, req.GetMode(), req.GetDurationSeconds(), len(req.GetParticipants()), playedAt)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not insert match")
+		return nil, status.Error(codes.Internal, "Could not record match")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		txnDB.Rollback()
+		logger.Error("Match is already recorded")
+		return nil, status.Error(codes.AlreadyExists, "Match is already recorded")
+	}
+
+	rewards := make([]*pb.MatchReward, len(req.GetParticipants()))
+	for _, i := range order {
+		participant := req.GetParticipants()[i]
+		wins, top5 := placementStats(participant.GetPlacement())
+		res, err := txnDB.Exec(applyMatchStatsQuery, participant.GetUserId(), wins, top5, participant.GetKills())
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not update user stats")
+			return nil, status.Error(codes.Internal, "Could not record match")
+		}
+		if affected, err := res.RowsAffected(); err != nil || affected != 1 {
+			txnDB.Rollback()
+			logger.WithField("user_id", participant.GetUserId()).Error("Corrupted user - doesn't have 1 stats object")
+			return nil, status.Error(codes.Internal, "Profile is corrupted. Contact support.")
+		}
+
+		reward, err := s.matchReward(logger, txnDB, participant, playedAt)
+		if err != nil {
+			txnDB.Rollback()
+			return nil, err
+		}
+		if len(violations[i]) > 0 {
+			// a rollback can't take coins back, so flagged kills aren't paid for
+			reward.TotalCoins -= reward.GetKillCoins()
+			reward.KillCoins = 0
+			reward.Flagged = true
+		}
+		rewards[i] = reward
+
+		if _, err := txnDB.Exec(insertMatchParticipantQuery, matchID, participant.GetUserId(), participant.GetPlacement(), participant.GetKills(), reward.GetTotalCoins()); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not insert match participant")
+			return nil, status.Error(codes.Internal, "Could not record match")
+		}
+		if reward.GetTotalCoins() > 0 {
+			if _, err := txnDB.Exec(grantMatchRewardQuery, reward.GetTotalCoins(), participant.GetUserId()); err != nil {
+				txnDB.Rollback()
+				logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not grant match reward")
+				return nil, status.Error(codes.Internal, "Could not record match")
+			}
+		}
+		if _, err := txnDB.Exec(applySeasonStatsQuery, participant.GetUserId(), 1, wins, top5, participant.GetKills()); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not update season stats")
+			return nil, status.Error(codes.Internal, "Could not record match")
+		}
+		if len(violations[i]) > 0 {
+			logger.WithFields(logrus.Fields{"user_id": participant.GetUserId(), "violations": violations[i]}).Warn("Implausible match participant, flagging it for review")
+			_, err = txnDB.Exec(recordFlaggedStatUpdateQuery, participant.GetUserId(), 1, wins, top5, participant.GetKills(), playedAt,
+				strings.Join(violations[i], violationsSeparator))
+		} else {
+			_, err = txnDB.Exec(recordStatUpdateQuery, participant.GetUserId(), 1, wins, top5, participant.GetKills(), playedAt)
+		}
+		if err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not record stats update")
+			return nil, status.Error(codes.Internal, "Could not record match")
+		}
+		if _, err := applyAchievements(logger, txnDB, participant.GetUserId(), 1, wins, top5, participant.GetKills()); err != nil {
+			txnDB.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := applyMatchRatings(logger, txnDB, matchID, playedAt, participants); err != nil {
+		txnDB.Rollback()
+		return nil, err
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not record match")
+	}
+
+	return &pb.RecordMatchResponse{MatchId: matchID, Rewards: rewards}, nil
+}
+
+func (s *UsersStatsServer) ListMatchHistory(ctx context.Context, req *pb.ListMatchHistoryRequest) (*pb.ListMatchHistoryResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name": req.GetUsername(),
+	})
+	logger.Debug("List match history")
+
+	limit := req.GetPaging().GetLimit()
+	if limit == 0 {
+		limit = defaultMatchHistoryLimit
+	}
+	if limit > maxMatchHistoryLimit {
+		limit = maxMatchHistoryLimit
+	}
+	offset := req.GetPaging().GetOffset()
+
+	user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.cfg.Database.DB().Query(matchHistoryQuery, user.GetId(), limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch match history")
+		return nil, status.Error(codes.Internal, "Could not fetch match history")
+	}
+	defer rows.Close()
+
+	results := []*pb.MatchHistoryEntry{}
+	for rows.Next() {
+		var entry pb.MatchHistoryEntry
+		var playedAt time.Time
+		if err := rows.Scan(&entry.MatchId, &entry.Mode, &entry.DurationSeconds, &playedAt, &entry.Players, &entry.Placement, &entry.Kills, &entry.RewardCoins); err != nil {
+			logger.WithError(err).Error("Could not fetch match history")
+			return nil, status.Error(codes.Internal, "Could not fetch match history")
+		}
+		if entry.PlayedAt, err = ptypes.TimestampProto(playedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch match history")
+			return nil, status.Error(codes.Internal, "Could not fetch match history")
+		}
+		results = append(results, &entry)
+	}
+
+	page := &query.PageInfo{Size: int32(len(results))}
+	if int32(len(results)) == limit {
+		page.Offset = offset + limit
+	} else {
+		page.SetLastOffset()
+	}
+
+	return &pb.ListMatchHistoryResponse{Results: results, Page: page}, nil
+}
+
+func (s *UsersStatsServer) RebuildStats(ctx context.Context, req *pb.RebuildStatsRequest) (*pb.RebuildStatsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name": req.GetUsername(),
+	})
+	logger.Debug("Rebuild user stats")
+
+	userID := ""
+	if req.GetUsername() != "" {
+		user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetUsername())
+		if err != nil {
+			return nil, err
+		}
+		userID = user.GetId()
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not rebuild user stats")
+	}
+
+	res, err := txnDB.Exec(rebuildStatsQuery, userID)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not rebuild user stats")
+		return nil, status.Error(codes.Internal, "Could not rebuild user stats")
+	}
+	rebuilt, err := res.RowsAffected()
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not rebuild user stats")
+		return nil, status.Error(codes.Internal, "Could not rebuild user stats")
+	}
+
+	if _, err := txnDB.Exec(rebuildSeasonStatsQuery, userID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not rebuild season stats")
+		return nil, status.Error(codes.Internal, "Could not rebuild user stats")
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not rebuild user stats")
+	}
+
+	logger.WithField("rebuilt_users", rebuilt).Info("User stats rebuilt from the stats update log")
+
+	return &pb.RebuildStatsResponse{RebuiltUsers: int32(rebuilt)}, nil
+}
+
+func validateMatch(req *pb.RecordMatchRequest) error {
+	if req.GetMode() == "" {
+		return status.Error(codes.InvalidArgument, "Mode should be set")
+	}
+	if req.GetDurationSeconds() < 0 {
+		return status.Error(codes.InvalidArgument, "Duration can't be negative")
+	}
+	if len(req.GetParticipants()) == 0 {
+		return status.Error(codes.InvalidArgument, "Match should have participants")
+	}
+	seen := map[string]bool{}
+	for _, participant := range req.GetParticipants() {
+		if participant.GetPlacement() < 1 || participant.GetKills() < 0 {
+			return status.Error(codes.InvalidArgument, "Placement should start at 1 and kills can't be negative")
+		}
+		if seen[participant.GetUserId()] {
+			return status.Error(codes.InvalidArgument, "Participant is listed twice")
+		}
+		seen[participant.GetUserId()] = true
+	}
+	return nil
+}
+
+// participantStats returns the stats update a participant's match adds up to
+func participantStats(participant *pb.MatchParticipant) *pb.UpdateUserStatsRequest {
+	wins, top5 := placementStats(participant.GetPlacement())
+	return &pb.UpdateUserStatsRequest{
+		Username: participant.GetUserId(),
+		AddGames: 1,
+		AddWins:  wins,
+		AddTop5:  top5,
+		AddKills: participant.GetKills(),
+	}
+}
+
+// placementStats returns the wins and top 5 finishes a placement adds to the stats
+func placementStats(placement int32) (wins, top5 int32) {
+	if placement == 1 {
+		wins = 1
+	}
+	if placement <= top5Placement {
+		top5 = 1
+	}
+	return wins, top5
+}
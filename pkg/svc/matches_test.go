@@ -0,0 +1,355 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMatches(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+		Rewards: RewardsConfig{
+			Placement:     []int{100, 60},
+			PerKill:       5,
+			FirstWinOfDay: 50,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlCountUsers := `SELECT count(*) FROM "users" WHERE (id IN ($1,$2))`
+
+	match := &pb.RecordMatchRequest{
+		MatchId:         "match-id",
+		Mode:            "solo",
+		DurationSeconds: 1200,
+		Participants: []*pb.MatchParticipant{
+			{UserId: "user-a", Placement: 1, Kills: 7},
+			{UserId: "user-b", Placement: 9, Kills: 2},
+		},
+	}
+
+	// expectParticipant expects the stats, reward and achievements writes of one participant,
+	// winsToday is only queried for winners
+	expectParticipant := func(matchID, userID string, placement, kills, wins, top5 int32, winsToday int, reward int32) {
+		mock.ExpectExec(regexp.QuoteMeta(applyMatchStatsQuery)).WithArgs(userID, wins, top5, kills).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		if placement == 1 {
+			mock.ExpectQuery(regexp.QuoteMeta(winsBetweenQuery)).WithArgs(userID, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(winsToday))
+		}
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchParticipantQuery)).WithArgs(matchID, userID, placement, kills, reward).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(grantMatchRewardQuery)).WithArgs(reward, userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs(userID, 1, wins, top5, kills).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs(userID, 1, wins, top5, kills, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs(userID, 1, wins, top5, kills).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(nil))
+	}
+
+	t.Run("Record Match - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCountUsers)).WithArgs("user-a", "user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchQuery)).WithArgs("match-id", "solo", 1200, 2, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectParticipant("match-id", "user-a", 1, 7, 1, 1, 0, 185)
+		expectParticipant("match-id", "user-b", 9, 2, 0, 0, 0, 10)
+		mock.ExpectQuery(regexp.QuoteMeta(lockUserRatingQuery)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"rating", "deviation", "volatility", "games", "last_played_at"}))
+		mock.ExpectQuery(regexp.QuoteMeta(lockUserRatingQuery)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"rating", "deviation", "volatility", "games", "last_played_at"}))
+		rated := rateFreeForAll([]glickoRating{newGlickoRating(), newGlickoRating()}, []int32{1, 9})
+		mock.ExpectExec(regexp.QuoteMeta(saveUserRatingQuery)).WithArgs("user-a", rated[0].rating, rated[0].deviation, rated[0].volatility, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(insertRatingHistoryQuery)).WithArgs("user-a", "match-id", rated[0].rating, rated[0].deviation, rated[0].volatility, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(saveUserRatingQuery)).WithArgs("user-b", rated[1].rating, rated[1].deviation, rated[1].volatility, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(insertRatingHistoryQuery)).WithArgs("user-b", "match-id", rated[1].rating, rated[1].deviation, rated[1].volatility, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stClient.RecordMatch(ctx, match)
+		if err != nil {
+			t.Fatalf("error recording match: %v", err)
+		}
+		if res.GetMatchId() != "match-id" {
+			t.Fatalf("unexpected match id: %s", res.GetMatchId())
+		}
+		winner := res.GetRewards()[0]
+		if winner.GetPlacementCoins() != 100 || winner.GetKillCoins() != 35 || winner.GetFirstWinCoins() != 50 || winner.GetTotalCoins() != 185 {
+			t.Fatalf("unexpected winner reward: %v", winner)
+		}
+		if res.GetRewards()[1].GetPlacementCoins() != 0 || res.GetRewards()[1].GetTotalCoins() != 10 {
+			t.Fatalf("unexpected reward: %v", res.GetRewards()[1])
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - second win of the day", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "users" WHERE (id IN ($1))`)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchQuery)).WithArgs("match-2", "solo", 600, 1, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectParticipant("match-2", "user-a", 1, 3, 1, 1, 1, 115)
+		mock.ExpectCommit()
+
+		res, err := stClient.RecordMatch(ctx, &pb.RecordMatchRequest{
+			MatchId:         "match-2",
+			Mode:            "solo",
+			DurationSeconds: 600,
+			Participants:    []*pb.MatchParticipant{{UserId: "user-a", Placement: 1, Kills: 3}},
+		})
+		if err != nil {
+			t.Fatalf("error recording match: %v", err)
+		}
+		if res.GetRewards()[0].GetFirstWinCoins() != 0 || res.GetRewards()[0].GetTotalCoins() != 115 {
+			t.Fatalf("unexpected reward: %v", res.GetRewards()[0])
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - locks participants in user id order", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCountUsers)).WithArgs("user-b", "user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchQuery)).WithArgs("match-3", "solo", 900, 2, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectParticipant("match-3", "user-a", 1, 4, 1, 1, 0, 170)
+		expectParticipant("match-3", "user-b", 9, 1, 0, 0, 0, 5)
+		mock.ExpectQuery(regexp.QuoteMeta(lockUserRatingQuery)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"rating", "deviation", "volatility", "games", "last_played_at"}))
+		mock.ExpectQuery(regexp.QuoteMeta(lockUserRatingQuery)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"rating", "deviation", "volatility", "games", "last_played_at"}))
+		for _, userID := range []string{"user-a", "user-b"} {
+			mock.ExpectExec(regexp.QuoteMeta(saveUserRatingQuery)).WithArgs(userID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta(insertRatingHistoryQuery)).WithArgs(userID, "match-3", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		res, err := stClient.RecordMatch(ctx, &pb.RecordMatchRequest{
+			MatchId:         "match-3",
+			Mode:            "solo",
+			DurationSeconds: 900,
+			Participants: []*pb.MatchParticipant{
+				{UserId: "user-b", Placement: 9, Kills: 1},
+				{UserId: "user-a", Placement: 1, Kills: 4},
+			},
+		})
+		if err != nil {
+			t.Fatalf("error recording match: %v", err)
+		}
+		// rewards keep the order of the request
+		if res.GetRewards()[0].GetUserId() != "user-b" || res.GetRewards()[1].GetUserId() != "user-a" {
+			t.Fatalf("unexpected rewards: %v", res.GetRewards())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - already recorded", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCountUsers)).WithArgs("user-a", "user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchQuery)).WithArgs("match-id", "solo", 1200, 2, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := stClient.RecordMatch(ctx, match)
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - unknown participant", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCountUsers)).WithArgs("user-a", "user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		_, err := stClient.RecordMatch(ctx, match)
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - invalid", func(t *testing.T) {
+		requests := []*pb.RecordMatchRequest{
+			{Participants: match.GetParticipants()},
+			{Mode: "solo"},
+			{Mode: "solo", Participants: []*pb.MatchParticipant{{UserId: "user-a"}}},
+			{Mode: "solo", Participants: []*pb.MatchParticipant{{UserId: "user-a", Placement: 1}, {UserId: "user-a", Placement: 2}}},
+		}
+		for _, req := range requests {
+			_, err := stClient.RecordMatch(ctx, req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument for %v, got: %v", req, err)
+			}
+		}
+	})
+
+	implausible := &pb.RecordMatchRequest{
+		MatchId:         "match-3",
+		Mode:            "solo",
+		DurationSeconds: 600,
+		Participants:    []*pb.MatchParticipant{{UserId: "user-a", Placement: 2, Kills: 500}},
+	}
+
+	t.Run("Record Match - implausible", func(t *testing.T) {
+		stServer.cfg.Plausibility = PlausibilityConfig{MaxKillsPerGame: 40}
+		defer func() { stServer.cfg.Plausibility = PlausibilityConfig{} }()
+
+		_, err := stClient.RecordMatch(ctx, implausible)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Record Match - flagged", func(t *testing.T) {
+		stServer.cfg.Plausibility = PlausibilityConfig{MaxKillsPerGame: 40, Flag: true}
+		defer func() { stServer.cfg.Plausibility = PlausibilityConfig{} }()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "users" WHERE (id IN ($1))`)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchQuery)).WithArgs("match-3", "solo", 600, 1, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applyMatchStatsQuery)).WithArgs("user-a", 0, 1, 500).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// only the placement is paid
+		mock.ExpectExec(regexp.QuoteMeta(insertMatchParticipantQuery)).WithArgs("match-3", "user-a", 2, 500, 60).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(grantMatchRewardQuery)).WithArgs(60, "user-a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("user-a", 1, 0, 1, 500).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordFlaggedStatUpdateQuery)).
+			WithArgs("user-a", 1, 0, 1, 500, sqlmock.AnyArg(), "more than 40 kills per game").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("user-a", 1, 0, 1, 500).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectCommit()
+
+		res, err := stClient.RecordMatch(ctx, implausible)
+		if err != nil {
+			t.Fatalf("error recording match: %v", err)
+		}
+		reward := res.GetRewards()[0]
+		if !reward.GetFlagged() || reward.GetKillCoins() != 0 || reward.GetTotalCoins() != 60 {
+			t.Fatalf("unexpected reward: %v", reward)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List Match History", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-a", "alice"))
+		mock.ExpectQuery(regexp.QuoteMeta(matchHistoryQuery)).WithArgs("user-a", 1, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "mode", "duration_seconds", "played_at", "players", "placement", "kills", "reward_coins"}).
+				AddRow("match-id", "solo", 1200, time.Now(), 2, 1, 7, 185))
+
+		res, err := stClient.ListMatchHistory(ctx, &pb.ListMatchHistoryRequest{Username: "user-a", Paging: &query.Pagination{Limit: 1}})
+		if err != nil {
+			t.Fatalf("error listing match history: %v", err)
+		}
+		if len(res.GetResults()) != 1 || res.GetResults()[0].GetPlacement() != 1 || res.GetResults()[0].GetRewardCoins() != 185 || res.GetResults()[0].GetPlayedAt() == nil {
+			t.Fatalf("unexpected match history: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Rebuild Stats - all users", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(rebuildStatsQuery)).WithArgs("").
+			WillReturnResult(sqlmock.NewResult(0, 12))
+		mock.ExpectExec(regexp.QuoteMeta(rebuildSeasonStatsQuery)).WithArgs("").
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectCommit()
+
+		res, err := stClient.RebuildStats(ctx, &pb.RebuildStatsRequest{})
+		if err != nil {
+			t.Fatalf("error rebuilding stats: %v", err)
+		}
+		if res.GetRebuiltUsers() != 12 {
+			t.Fatalf("unexpected rebuilt users: %d", res.GetRebuiltUsers())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -2,8 +2,14 @@ package svc
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/images"
 	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"github.com/jinzhu/gorm"
 	uuid "github.com/satori/go.uuid"
@@ -12,8 +18,14 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// publishScheduledNewsQuery publishes scheduled news that are due, they count as published at their scheduled time
+const publishScheduledNewsQuery = "UPDATE news SET status = $1, published_at = publish_at WHERE status = $2 AND publish_at <= now()"
+
 type NewsServerConfig struct {
 	Database *gorm.DB
+	Locales  LocaleConfig
+	// Images holds uploaded images, without it image links aren't checked
+	Images images.BlobStore
 }
 
 type NewsServer struct {
@@ -36,6 +48,29 @@ func (s *NewsServer) Create(ctx context.Context, req *pb.CreateNewsRequest) (*pb
 	})
 	logger.Debug("Create News")
 
+	news := pb.NewsORM{
+		Id:          uuid.NewV4().String(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		ImageLink:   req.GetImageLink(),
+		Status:      int32(pb.NewsStatus_DRAFT),
+	}
+	if req.GetPublish() && req.GetPublishAt() != nil {
+		logger.Error("News is both published and scheduled")
+		return nil, status.Error(codes.InvalidArgument, "Either publish or publish at can be set")
+	}
+	if req.GetPublish() || req.GetPublishAt() != nil {
+		publishAt, err := newsPublishTime(logger, req.GetPublishAt())
+		if err != nil {
+			return nil, err
+		}
+		publishNews(&news, publishAt)
+	}
+
+	if err := checkImageExists(ctx, logger, s.cfg.Images, req.GetImageLink()); err != nil {
+		return nil, err
+	}
+
 	var existingNews pb.NewsORM
 	if err := s.cfg.Database.Where("title = ?", req.GetTitle()).First(&existingNews).Error; err == nil {
 		logger.Error("News with such title already exists")
@@ -45,13 +80,6 @@ func (s *NewsServer) Create(ctx context.Context, req *pb.CreateNewsRequest) (*pb
 		return nil, status.Error(codes.Internal, "Could not create news")
 	}
 
-	news := pb.NewsORM{
-		Id:          uuid.NewV4().String(),
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
-		ImageLink:   req.GetImageLink(),
-	}
-
 	if err := s.cfg.Database.Create(&news).Error; err != nil {
 		logger.WithError(err).Error("Could not create news")
 		return nil, status.Error(codes.Internal, "Could not create news")
@@ -85,27 +113,47 @@ func (s *NewsServer) Read(ctx context.Context, req *pb.ReadNewsRequest) (*pb.Rea
 		logger.WithError(err).Error("Could not read news")
 		return nil, status.Error(codes.Internal, "Could not read news")
 	}
+	if res.GetStatus() != pb.NewsStatus_PUBLISHED && !isNewsEditor(ctx) {
+		logger.Error("News is not published")
+		return nil, status.Error(codes.NotFound, "News not found")
+	}
 
 	return &pb.ReadNewsResponse{Result: res}, nil
 }
 
 func (s *NewsServer) List(ctx context.Context, req *pb.ListNewsRequest) (*pb.ListNewsResponse, error) {
-	logger := ctxlogrus.Extract(ctx)
+	locale := s.cfg.Locales.Resolve(ctx, req.GetLocale())
+	logger := ctxlogrus.Extract(ctx).WithField("locale", locale)
 	logger.Debug("List news")
 
-	res, err := pb.DefaultListNews(ctx, s.cfg.Database, req.GetFilter(), req.GetOrderBy(), req.GetPaging(), req.GetFields())
+	db := s.cfg.Database
+	if !isNewsEditor(ctx) {
+		db = db.Where("status = ?", int32(pb.NewsStatus_PUBLISHED))
+	}
+
+	res, err := pb.DefaultListNews(ctx, db, req.GetFilter(), req.GetOrderBy(), req.GetPaging(), req.GetFields())
 	if err != nil {
 		logger.WithError(err).Error("Could not list news")
 		return nil, status.Error(codes.Internal, "Could not list news")
 	}
 
-	return &pb.ListNewsResponse{Results: res}, nil
+	if s.cfg.Locales.IsTranslation(locale) && len(res) > 0 {
+		if err := s.translateNews(logger, locale, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListNewsResponse{Results: res, Locale: locale}, nil
 }
 
 func (s *NewsServer) Update(ctx context.Context, req *pb.UpdateNewsRequest) (*pb.UpdateNewsResponse, error) {
 	logger := ctxlogrus.Extract(ctx)
 	logger.Debug("Update news")
 
+	if err := checkImageExists(ctx, logger, s.cfg.Images, req.GetImageLink()); err != nil {
+		return nil, err
+	}
+
 	var existingNews pb.NewsORM
 	if err := s.cfg.Database.Where("title = ?", req.GetTitle()).First(&existingNews).Error; err == nil {
 		logger.Error("News with such title already exists")
@@ -141,3 +189,174 @@ func (s *NewsServer) Update(ctx context.Context, req *pb.UpdateNewsRequest) (*pb
 
 	return &pb.UpdateNewsResponse{}, nil
 }
+
+func (s *NewsServer) Delete(ctx context.Context, req *pb.DeleteNewsRequest) (*pb.DeleteNewsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id": req.GetId(),
+	})
+	logger.Debug("Delete news")
+
+	res := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&pb.NewsORM{})
+	if res.Error != nil {
+		logger.WithError(res.Error).Error("Could not delete news")
+		return nil, status.Error(codes.Internal, "Could not delete news")
+	}
+	if res.RowsAffected == 0 {
+		logger.Error("News not found")
+		return nil, status.Error(codes.NotFound, "News not found")
+	}
+
+	return &pb.DeleteNewsResponse{}, nil
+}
+
+// Publish makes a news visible to players, or schedules it when publish_at is in the future
+func (s *NewsServer) Publish(ctx context.Context, req *pb.PublishNewsRequest) (*pb.PublishNewsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id": req.GetId(),
+	})
+	logger.Debug("Publish news")
+
+	publishAt, err := newsPublishTime(logger, req.GetPublishAt())
+	if err != nil {
+		return nil, err
+	}
+
+	news, err := s.newsByID(logger, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if news.Status == int32(pb.NewsStatus_PUBLISHED) {
+		logger.Error("News is already published")
+		return nil, status.Error(codes.FailedPrecondition, "News is already published")
+	}
+
+	publishNews(news, publishAt)
+
+	result, err := s.saveNews(ctx, logger, news)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PublishNewsResponse{Result: result}, nil
+}
+
+// Unpublish hides a published or scheduled news from players, moving it back to drafts or to the archive
+func (s *NewsServer) Unpublish(ctx context.Context, req *pb.UnpublishNewsRequest) (*pb.UnpublishNewsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id":      req.GetId(),
+		"archive": req.GetArchive(),
+	})
+	logger.Debug("Unpublish news")
+
+	target := pb.NewsStatus_DRAFT
+	if req.GetArchive() {
+		target = pb.NewsStatus_ARCHIVED
+	}
+
+	news, err := s.newsByID(logger, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if news.Status == int32(target) {
+		logger.Error("News is already unpublished")
+		return nil, status.Errorf(codes.FailedPrecondition, "News is already %s", strings.ToLower(target.String()))
+	}
+
+	news.Status, news.PublishAt = int32(target), nil
+
+	result, err := s.saveNews(ctx, logger, news)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnpublishNewsResponse{Result: result}, nil
+}
+
+func (s *NewsServer) newsByID(logger *logrus.Entry, id string) (*pb.NewsORM, error) {
+	var news pb.NewsORM
+	if err := s.cfg.Database.Where("id = ?", id).First(&news).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("News not found")
+			return nil, status.Error(codes.NotFound, "News not found")
+		}
+		logger.WithError(err).Error("Could not fetch news")
+		return nil, status.Error(codes.Internal, "Could not fetch news")
+	}
+	return &news, nil
+}
+
+func (s *NewsServer) saveNews(ctx context.Context, logger *logrus.Entry, news *pb.NewsORM) (*pb.News, error) {
+	if err := s.cfg.Database.Save(news).Error; err != nil {
+		logger.WithError(err).Error("Could not update news")
+		return nil, status.Error(codes.Internal, "Could not update news")
+	}
+
+	result, err := news.ToPB(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Could not update news")
+		return nil, status.Error(codes.Internal, "Could not update news")
+	}
+	return &result, nil
+}
+
+// isNewsEditor tells if the caller has the high level access needed to see news in every status
+func isNewsEditor(ctx context.Context) bool {
+	claims, err := auth.GetAuthorizationData(ctx)
+	return err == nil && (claims.IsAdmin || claims.StandardClaims.Audience == "svc")
+}
+
+// newsPublishTime returns the time a news should be published at, or nil to publish it right away,
+// which is also the case for a publish_at that has already passed
+func newsPublishTime(logger *logrus.Entry, publishAt *timestamp.Timestamp) (*time.Time, error) {
+	if publishAt == nil {
+		return nil, nil
+	}
+	t, err := ptypes.Timestamp(publishAt)
+	if err != nil {
+		logger.WithError(err).Error("Invalid publish time")
+		return nil, status.Error(codes.InvalidArgument, "Invalid publish at time")
+	}
+	if !t.After(time.Now()) {
+		return nil, nil
+	}
+	return &t, nil
+}
+
+// publishNews publishes the news now when publishAt is nil and schedules it otherwise
+func publishNews(news *pb.NewsORM, publishAt *time.Time) {
+	if publishAt != nil {
+		news.Status, news.PublishAt = int32(pb.NewsStatus_SCHEDULED), publishAt
+		return
+	}
+	now := time.Now()
+	news.Status, news.PublishedAt = int32(pb.NewsStatus_PUBLISHED), &now
+}
+
+// PublishScheduledNews publishes the scheduled news that are due and returns how many were published
+func (s *NewsServer) PublishScheduledNews(ctx context.Context) (int64, error) {
+	res, err := s.cfg.Database.DB().ExecContext(ctx, publishScheduledNewsQuery, int32(pb.NewsStatus_PUBLISHED), int32(pb.NewsStatus_SCHEDULED))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RunNewsScheduler publishes due scheduled news every interval until ctx is done
+func RunNewsScheduler(ctx context.Context, s *NewsServer, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := s.PublishScheduledNews(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Could not publish scheduled news")
+				continue
+			}
+			if published > 0 {
+				logger.WithField("published", published).Info("Published scheduled news")
+			}
+		}
+	}
+}
@@ -7,12 +7,17 @@ import (
 	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/auth"
 	"github.com/amikhailau/users-service/pkg/pb"
 	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestNews(t *testing.T) {
@@ -61,8 +66,8 @@ func TestNews(t *testing.T) {
 	sqlSearchID2 := `SELECT * FROM "news" WHERE (id = $1) ORDER BY "news"."id" ASC LIMIT 1`
 	sqlSearchTitle := `SELECT * FROM "news" WHERE (title = $1) ORDER BY "news"."id" ASC LIMIT 1`
 	sqlBackSearch := `SELECT * FROM "news"  WHERE "news"."id" = $1 AND ((title = $2)) ORDER BY "news"."id" ASC LIMIT 1`
-	sqlCreateNews := `INSERT INTO "news" ("created_at","description","id","image_link","title") VALUES ($1,$2,$3,$4,$5) RETURNING "news"."id"`
-	sqlUpdateNews := `UPDATE "news" SET "created_at" = $1, "description" = $2, "image_link" = $3, "title" = $4  WHERE "news"."id" = $5`
+	sqlCreateNews := `INSERT INTO "news" ("created_at","description","id","image_link","publish_at","published_at","status","title") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "news"."id"`
+	sqlUpdateNews := `UPDATE "news" SET "created_at" = $1, "description" = $2, "image_link" = $3, "publish_at" = $4, "published_at" = $5, "status" = $6, "title" = $7  WHERE "news"."id" = $8`
 
 	t.Run("Create News - positive", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "title", "description", "image_link", "created_at"}).
@@ -71,7 +76,7 @@ func TestNews(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchTitle)).WithArgs(newNewsData.Title).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectBegin()
 		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateNews)).WithArgs(sqlmock.AnyArg(), newNewsData.Description, sqlmock.AnyArg(),
-			newNewsData.ImageLink, newNewsData.Title).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			newNewsData.ImageLink, nil, nil, int32(pb.NewsStatus_DRAFT), newNewsData.Title).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		mock.ExpectCommit()
 		mock.ExpectQuery(regexp.QuoteMeta(sqlBackSearch)).WithArgs(sqlmock.AnyArg(), newNewsData.Title).WillReturnRows(rows)
 
@@ -140,7 +145,7 @@ func TestNews(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID2)).WithArgs("some-id").WillReturnRows(rows)
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateNews)).WithArgs(sqlmock.AnyArg(), "description", "http://placeholder",
-			"new-title", "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
+			nil, nil, 0, "new-title", "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		_, err := newsClient.Update(ctx, &pb.UpdateNewsRequest{
@@ -193,4 +198,230 @@ func TestNews(t *testing.T) {
 		}
 
 	})
+
+	// claims are not verified by the server, so a player token only needs to parse
+	playerToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.GameClaims{
+		UserId:         "player-id",
+		StandardClaims: jwt.StandardClaims{Audience: "medieval", ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	}).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("Could not sign player token: %v", err)
+	}
+	playerCtx := metadata.NewOutgoingContext(ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger)),
+		metadata.New(map[string]string{"Authorization": "Bearer " + playerToken}))
+
+	newsColumns := []string{"id", "title", "description", "image_link", "created_at", "status", "publish_at", "published_at"}
+	sqlList := `SELECT * FROM "news" WHERE (status = $1)`
+	sqlListAll := `SELECT * FROM "news"`
+	sqlDeleteNews := `DELETE FROM "news"  WHERE (id = $1)`
+
+	t.Run("Create News - scheduled", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour)
+		publishAtProto, _ := ptypes.TimestampProto(publishAt)
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_SCHEDULED), publishAt, nil)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchTitle)).WithArgs("title").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateNews)).WithArgs(sqlmock.AnyArg(), "description", sqlmock.AnyArg(),
+			"http://placeholder", sqlmock.AnyArg(), nil, int32(pb.NewsStatus_SCHEDULED), "title").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlBackSearch)).WithArgs(sqlmock.AnyArg(), "title").WillReturnRows(rows)
+
+		res, err := newsClient.Create(ctx, &pb.CreateNewsRequest{Title: "title", Description: "description", ImageLink: "http://placeholder", PublishAt: publishAtProto})
+		if err != nil {
+			t.Fatalf("error creating news: %v", err)
+		}
+		if res.GetResult().GetStatus() != pb.NewsStatus_SCHEDULED {
+			t.Fatalf("expected a scheduled news, got: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create News - past publish at is published now", func(t *testing.T) {
+		publishAtProto, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_PUBLISHED), nil, time.Now())
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchTitle)).WithArgs("title").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateNews)).WithArgs(sqlmock.AnyArg(), "description", sqlmock.AnyArg(),
+			"http://placeholder", nil, sqlmock.AnyArg(), int32(pb.NewsStatus_PUBLISHED), "title").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlBackSearch)).WithArgs(sqlmock.AnyArg(), "title").WillReturnRows(rows)
+
+		res, err := newsClient.Create(ctx, &pb.CreateNewsRequest{Title: "title", Description: "description", ImageLink: "http://placeholder", PublishAt: publishAtProto})
+		if err != nil {
+			t.Fatalf("error creating news: %v", err)
+		}
+		if res.GetResult().GetStatus() != pb.NewsStatus_PUBLISHED {
+			t.Fatalf("expected a published news, got: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create News - published and scheduled", func(t *testing.T) {
+		_, err := newsClient.Create(ctx, &pb.CreateNewsRequest{Title: "title", Publish: true, PublishAt: ptypes.TimestampNow()})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("Read News - draft hidden from players", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_DRAFT), nil, nil)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+
+		_, err := newsClient.Read(playerCtx, &pb.ReadNewsRequest{Id: "some-id"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List News - players see published news", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_PUBLISHED), nil, time.Now())
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlList)).WithArgs(int32(pb.NewsStatus_PUBLISHED)).WillReturnRows(rows)
+
+		res, err := newsClient.List(playerCtx, &pb.ListNewsRequest{})
+		if err != nil {
+			t.Fatalf("error listing news: %v", err)
+		}
+		if len(res.GetResults()) != 1 {
+			t.Fatalf("unexpected news: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List News - editors see every status", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_DRAFT), nil, nil).
+			AddRow("other-id", "other", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_ARCHIVED), nil, time.Now())
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListAll)).WillReturnRows(rows)
+
+		res, err := newsClient.List(ctx, &pb.ListNewsRequest{})
+		if err != nil {
+			t.Fatalf("error listing news: %v", err)
+		}
+		if len(res.GetResults()) != 2 {
+			t.Fatalf("unexpected news: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Publish News - positive", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_DRAFT), nil, nil)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID2)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateNews)).WithArgs(sqlmock.AnyArg(), "description", "http://placeholder",
+			nil, sqlmock.AnyArg(), int32(pb.NewsStatus_PUBLISHED), "title", "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		res, err := newsClient.Publish(ctx, &pb.PublishNewsRequest{Id: "some-id"})
+		if err != nil {
+			t.Fatalf("error publishing news: %v", err)
+		}
+		if res.GetResult().GetStatus() != pb.NewsStatus_PUBLISHED || res.GetResult().GetPublishedAt() == nil {
+			t.Fatalf("expected a published news, got: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Publish News - already published", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_PUBLISHED), nil, time.Now())
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID2)).WithArgs("some-id").WillReturnRows(rows)
+
+		_, err := newsClient.Publish(ctx, &pb.PublishNewsRequest{Id: "some-id"})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Unpublish News - archive", func(t *testing.T) {
+		rows := sqlmock.NewRows(newsColumns).
+			AddRow("some-id", "title", "description", "http://placeholder", time.Now(), int32(pb.NewsStatus_PUBLISHED), nil, time.Now())
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID2)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateNews)).WithArgs(sqlmock.AnyArg(), "description", "http://placeholder",
+			nil, sqlmock.AnyArg(), int32(pb.NewsStatus_ARCHIVED), "title", "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		res, err := newsClient.Unpublish(ctx, &pb.UnpublishNewsRequest{Id: "some-id", Archive: true})
+		if err != nil {
+			t.Fatalf("error unpublishing news: %v", err)
+		}
+		if res.GetResult().GetStatus() != pb.NewsStatus_ARCHIVED {
+			t.Fatalf("expected an archived news, got: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Delete News - positive", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteNews)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if _, err := newsClient.Delete(ctx, &pb.DeleteNewsRequest{Id: "some-id"}); err != nil {
+			t.Fatalf("error deleting news: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Delete News - not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteNews)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		_, err := newsClient.Delete(ctx, &pb.DeleteNewsRequest{Id: "some-id"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Publish Scheduled News", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(publishScheduledNewsQuery)).WithArgs(int32(pb.NewsStatus_PUBLISHED), int32(pb.NewsStatus_SCHEDULED)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		published, err := newsServer.PublishScheduledNews(context.TODO())
+		if err != nil {
+			t.Fatalf("error publishing scheduled news: %v", err)
+		}
+		if published != 2 {
+			t.Fatalf("expected 2 published news, got %d", published)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
 }
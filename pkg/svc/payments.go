@@ -0,0 +1,374 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/payments"
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/jinzhu/gorm"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PaymentsServerConfig struct {
+	Database *gorm.DB
+	Provider payments.PaymentProvider
+}
+
+type PaymentsServer struct {
+	pb.PaymentsServer
+	cfg *PaymentsServerConfig
+}
+
+var _ pb.PaymentsServer = &PaymentsServer{}
+
+const (
+	maxWebhookPayloadSize = 1 << 20
+	paymentsLogComponent  = "payments-webhook"
+
+	insertPaymentQuery  = "INSERT INTO payment_transactions (provider, provider_transaction_id, user_id, gem_pack_id, gems, status) VALUES ($1, $2, $3, $4, $5, 'credited') ON CONFLICT (provider, provider_transaction_id) DO NOTHING"
+	reversePaymentQuery = "UPDATE payment_transactions SET status = $1 WHERE provider = $2 AND provider_transaction_id = $3 AND status = 'credited' RETURNING user_id, gems"
+	// a reversal of an unknown payment is recorded with no gems, so the purchase can't be credited when it arrives later
+	recordReversalQuery = "INSERT INTO payment_transactions (provider, provider_transaction_id, user_id, gem_pack_id, gems, status) " +
+		"VALUES ($1, $2, (SELECT id FROM users WHERE id = $3), NULLIF($4, ''), 0, $5) ON CONFLICT (provider, provider_transaction_id) DO NOTHING"
+	addGemsQuery      = "UPDATE users SET gems = gems + $1 WHERE id = $2"
+	clawBackGemsQuery = "UPDATE users SET gems = gems - $1 WHERE id = $2"
+
+	insertPurchaseIntentQuery = "INSERT INTO purchase_intents (provider, intent_id, user_id, gem_pack_id, gems, price_cents, currency) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	purchaseIntentQuery = "SELECT gem_pack_id, gems FROM purchase_intents WHERE provider = $1 AND intent_id = $2 AND user_id = $3"
+)
+
+func NewPaymentsServer(cfg *PaymentsServerConfig) (*PaymentsServer, error) {
+	return &PaymentsServer{
+		PaymentsServer: &pb.PaymentsDefaultServer{},
+		cfg:            cfg,
+	}, nil
+}
+
+func (s *PaymentsServer) CreateGemPack(ctx context.Context, req *pb.CreateGemPackRequest) (*pb.CreateGemPackResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("name", req.GetName())
+	logger.Debug("Create gem pack")
+
+	if req.GetName() == "" || req.GetGems() <= 0 || req.GetPriceCents() <= 0 || req.GetCurrency() == "" {
+		logger.Error("Gem pack validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Gem pack should have a name, currency, positive gems amount and price")
+	}
+
+	var existingPack pb.GemPackORM
+	if err := s.cfg.Database.Where("name = ?", req.GetName()).First(&existingPack).Error; err == nil {
+		logger.Error("Gem pack with such name already exists")
+		return nil, status.Error(codes.InvalidArgument, "Gem pack with such name already exists")
+	} else if err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not create gem pack")
+		return nil, status.Error(codes.Internal, "Could not create gem pack")
+	}
+
+	newPack := pb.GemPackORM{
+		Id:         uuid.NewV4().String(),
+		Name:       req.GetName(),
+		Gems:       req.GetGems(),
+		PriceCents: req.GetPriceCents(),
+		Currency:   req.GetCurrency(),
+	}
+
+	if err := s.cfg.Database.Create(&newPack).Error; err != nil {
+		logger.WithError(err).Error("Could not create gem pack")
+		return nil, status.Error(codes.Internal, "Could not create gem pack")
+	}
+
+	pbPack, err := newPack.ToPB(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Could not create gem pack")
+		return nil, status.Error(codes.Internal, "Could not create gem pack")
+	}
+
+	return &pb.CreateGemPackResponse{Result: &pbPack}, nil
+}
+
+func (s *PaymentsServer) DeleteGemPack(ctx context.Context, req *pb.DeleteGemPackRequest) (*pb.DeleteGemPackResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
+	logger.Debug("Delete gem pack")
+
+	var pack pb.GemPackORM
+	if err := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&pack).Error; err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not delete gem pack")
+		return nil, status.Error(codes.Internal, "Could not delete gem pack")
+	}
+
+	return &pb.DeleteGemPackResponse{}, nil
+}
+
+func (s *PaymentsServer) ListGemPacks(ctx context.Context, req *pb.ListGemPacksRequest) (*pb.ListGemPacksResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("List gem packs")
+
+	var packs []*pb.GemPackORM
+	if err := s.cfg.Database.Order("price_cents").Find(&packs).Error; err != nil {
+		logger.WithError(err).Error("Could not list gem packs")
+		return nil, status.Error(codes.Internal, "Could not list gem packs")
+	}
+
+	res := make([]*pb.GemPack, 0, len(packs))
+	for _, pack := range packs {
+		pbPack, err := pack.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not list gem packs")
+			return nil, status.Error(codes.Internal, "Could not list gem packs")
+		}
+		res = append(res, &pbPack)
+	}
+
+	return &pb.ListGemPacksResponse{Results: res}, nil
+}
+
+func (s *PaymentsServer) CreatePurchase(ctx context.Context, req *pb.CreatePurchaseRequest) (*pb.CreatePurchaseResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"pack_id": req.GetPackId(),
+	})
+	logger.Debug("Create purchase")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	var usr pb.UserORM
+	if err := s.cfg.Database.Where("id = ?", req.GetUserId()).First(&usr).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("User not found")
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		logger.WithError(err).Error("Could not find user")
+		return nil, status.Error(codes.Internal, "Could not find user")
+	}
+
+	pack, err := s.fetchGemPack(logger, req.GetPackId())
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := s.cfg.Provider.CreatePurchaseIntent(ctx, &payments.PurchaseIntent{
+		UserID:     usr.Id,
+		PackID:     pack.Id,
+		PriceCents: pack.PriceCents,
+		Currency:   pack.Currency,
+	})
+	if err != nil {
+		logger.WithError(err).Error("Could not create purchase intent")
+		return nil, status.Error(codes.Unavailable, "Could not create purchase")
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(insertPurchaseIntentQuery, s.cfg.Provider.Name(), intent.IntentID, usr.Id, pack.Id, pack.Gems,
+		pack.PriceCents, pack.Currency); err != nil {
+		logger.WithError(err).Error("Could not save purchase intent")
+		return nil, status.Error(codes.Internal, "Could not create purchase")
+	}
+
+	return &pb.CreatePurchaseResponse{
+		IntentId:     intent.IntentID,
+		ClientSecret: intent.ClientSecret,
+		PriceCents:   pack.PriceCents,
+		Currency:     pack.Currency,
+	}, nil
+}
+
+func (s *PaymentsServer) ValidateReceipt(ctx context.Context, req *pb.ValidateReceiptRequest) (*pb.ValidateReceiptResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("user_id", req.GetUserId())
+	logger.Debug("Validate receipt")
+
+	claims, _ := auth.GetAuthorizationData(ctx)
+	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
+		logger.Error("User can only use this endpoint for themselves")
+		return nil, status.Error(codes.Unauthenticated, "Not authorized for another user")
+	}
+
+	event, err := s.cfg.Provider.ValidateReceipt(ctx, req.GetReceipt())
+	if err != nil {
+		logger.WithError(err).Error("Receipt validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Invalid receipt")
+	}
+
+	if event.Type != payments.EventPurchase || event.UserID != req.GetUserId() {
+		logger.Error("Receipt does not belong to the user")
+		return nil, status.Error(codes.InvalidArgument, "Invalid receipt")
+	}
+
+	credited, err := s.applyEvent(logger, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateReceiptResponse{GemsCredited: credited}, nil
+}
+
+// ProcessWebhook verifies and applies a provider notification, repeated deliveries are ignored
+func (s *PaymentsServer) ProcessWebhook(ctx context.Context, payload []byte, signature string) error {
+	logger := ctxlogrus.Extract(ctx).WithField("provider", s.cfg.Provider.Name())
+	logger.Debug("Process payment webhook")
+
+	event, err := s.cfg.Provider.VerifyWebhook(payload, signature)
+	if err != nil {
+		logger.WithError(err).Error("Webhook verification failed")
+		return status.Error(codes.InvalidArgument, "Invalid webhook")
+	}
+
+	_, err = s.applyEvent(logger, event)
+	return err
+}
+
+// applyEvent credits or claws back gems for the event and returns the credited amount
+func (s *PaymentsServer) applyEvent(logger *logrus.Entry, event *payments.Event) (int32, error) {
+	logger = logger.WithFields(logrus.Fields{
+		"transaction_id": event.TransactionID,
+		"event_type":     event.Type,
+	})
+
+	switch event.Type {
+	case payments.EventPurchase:
+		return s.creditPurchase(logger, event)
+	case payments.EventRefund, payments.EventChargeback:
+		return 0, s.clawBack(logger, event)
+	default:
+		logger.Error("Unknown payment event type")
+		return 0, status.Error(codes.InvalidArgument, "Unknown payment event type")
+	}
+}
+
+func (s *PaymentsServer) creditPurchase(logger *logrus.Entry, event *payments.Event) (int32, error) {
+	pack, err := s.purchasedPack(logger, event)
+	if err != nil {
+		return 0, err
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return 0, status.Error(codes.Internal, "Could not credit purchase")
+	}
+
+	res, err := txnDB.Exec(insertPaymentQuery, s.cfg.Provider.Name(), event.TransactionID, event.UserID, pack.Id, pack.Gems)
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not record payment")
+		return 0, status.Error(codes.Internal, "Could not credit purchase")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		txnDB.Rollback()
+		logger.Debug("Payment has been already credited or reversed")
+		return 0, nil
+	}
+
+	if _, err := txnDB.Exec(addGemsQuery, pack.Gems, event.UserID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not credit gems")
+		return 0, status.Error(codes.Internal, "Could not credit purchase")
+	}
+
+	txnDB.Commit()
+
+	return pack.Gems, nil
+}
+
+// clawBack takes back the gems of a reversed payment, the balance is allowed to go
+// negative so that already spent gems are repaid by future purchases
+func (s *PaymentsServer) clawBack(logger *logrus.Entry, event *payments.Event) error {
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return status.Error(codes.Internal, "Could not reverse payment")
+	}
+
+	var userID string
+	var gems int32
+	err = txnDB.QueryRow(reversePaymentQuery, string(event.Type), s.cfg.Provider.Name(), event.TransactionID).Scan(&userID, &gems)
+	if err == sql.ErrNoRows {
+		if _, err := txnDB.Exec(recordReversalQuery, s.cfg.Provider.Name(), event.TransactionID, event.UserID, event.PackID, string(event.Type)); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not record reversal")
+			return status.Error(codes.Internal, "Could not reverse payment")
+		}
+		txnDB.Commit()
+		logger.Debug("Payment is unknown or has been already reversed")
+		return nil
+	}
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not reverse payment")
+		return status.Error(codes.Internal, "Could not reverse payment")
+	}
+
+	if _, err := txnDB.Exec(clawBackGemsQuery, gems, userID); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not claw back gems")
+		return status.Error(codes.Internal, "Could not reverse payment")
+	}
+
+	txnDB.Commit()
+
+	return nil
+}
+
+// purchasedPack returns the pack snapshotted when the purchase was started, events that don't match
+// a started purchase are rejected rather than credited with the pack as it is now
+func (s *PaymentsServer) purchasedPack(logger *logrus.Entry, event *payments.Event) (*pb.GemPackORM, error) {
+	pack := &pb.GemPackORM{}
+	err := s.cfg.Database.DB().QueryRow(purchaseIntentQuery, s.cfg.Provider.Name(), event.IntentID, event.UserID).Scan(&pack.Id, &pack.Gems)
+	if err == sql.ErrNoRows {
+		logger.Error("Purchase intent not found")
+		return nil, status.Error(codes.NotFound, "Purchase intent not found")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Could not find purchase intent")
+		return nil, status.Error(codes.Internal, "Could not credit purchase")
+	}
+	return pack, nil
+}
+
+func (s *PaymentsServer) fetchGemPack(logger *logrus.Entry, id string) (*pb.GemPackORM, error) {
+	var pack pb.GemPackORM
+	if err := s.cfg.Database.Where("id = ?", id).First(&pack).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Gem pack not found")
+			return nil, status.Error(codes.NotFound, "Gem pack not found")
+		}
+		logger.WithError(err).Error("Could not find gem pack")
+		return nil, status.Error(codes.Internal, "Could not find gem pack")
+	}
+	return &pack, nil
+}
+
+// NewPaymentsWebhookHandler exposes ProcessWebhook to the payment provider over plain HTTP,
+// the signature is checked against the raw body so it can't go through the gateway
+func NewPaymentsWebhookHandler(s *PaymentsServer, logger *logrus.Logger) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		payload, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, maxWebhookPayloadSize))
+		if err != nil {
+			writer.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		ctx := ctxlogrus.ToContext(request.Context(), logger.WithField("component", paymentsLogComponent))
+		if err := s.ProcessWebhook(ctx, payload, request.Header.Get(s.cfg.Provider.SignatureHeader())); err != nil {
+			writer.WriteHeader(runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		writer.WriteHeader(http.StatusOK)
+	})
+}
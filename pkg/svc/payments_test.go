@@ -0,0 +1,241 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/payments"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPayments(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	provider := payments.NewFakeProvider("secret")
+	paymentsServer, err := NewPaymentsServer(&PaymentsServerConfig{
+		Database: gdb,
+		Provider: provider,
+	})
+	if err != nil {
+		t.Fatalf("Could not create payments server: %v", err)
+	}
+	pb.RegisterPaymentsServer(server.GRPCServer, paymentsServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	paymentsClient := pb.NewPaymentsClient(conn)
+	webhook := NewPaymentsWebhookHandler(paymentsServer, logger)
+
+	sqlSearchName := `SELECT * FROM "gem_packs" WHERE (name = $1) ORDER BY "gem_packs"."id" ASC LIMIT 1`
+	sqlSearchID := `SELECT * FROM "gem_packs" WHERE (id = $1) ORDER BY "gem_packs"."id" ASC LIMIT 1`
+	sqlSearchIDUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlCreatePack := `INSERT INTO "gem_packs" ("currency","gems","id","name","price_cents") VALUES ($1,$2,$3,$4,$5) RETURNING "gem_packs"."id"`
+
+	packColumns := []string{"id", "name", "gems", "price_cents", "currency"}
+
+	sendWebhook := func(payload []byte, signature string) int {
+		request := httptest.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader(payload))
+		request.Header.Set(provider.SignatureHeader(), signature)
+		recorder := httptest.NewRecorder()
+		webhook.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	var intentID, transactionID string
+	var purchasePayload []byte
+	var purchaseSignature string
+
+	t.Run("Create Gem Pack - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchName)).WithArgs("Pile of gems").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreatePack)).WithArgs("USD", 100, sqlmock.AnyArg(), "Pile of gems", 499).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("pack-id"))
+		mock.ExpectCommit()
+
+		_, err := paymentsClient.CreateGemPack(ctx, &pb.CreateGemPackRequest{Name: "Pile of gems", Gems: 100, PriceCents: 499, Currency: "USD"})
+		if err != nil {
+			t.Fatalf("error creating gem pack: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Create Purchase - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-id", "player"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("pack-id").
+			WillReturnRows(sqlmock.NewRows(packColumns).AddRow("pack-id", "Pile of gems", 100, 499, "USD"))
+		mock.ExpectExec(regexp.QuoteMeta(insertPurchaseIntentQuery)).WithArgs("fake", sqlmock.AnyArg(), "user-id", "pack-id", 100, 499, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		res, err := paymentsClient.CreatePurchase(ctx, &pb.CreatePurchaseRequest{UserId: "user-id", PackId: "pack-id"})
+		if err != nil {
+			t.Fatalf("error creating purchase: %v", err)
+		}
+		if res.GetIntentId() == "" || res.GetPriceCents() != 499 {
+			t.Fatalf("unexpected purchase: %v", res)
+		}
+		intentID = res.GetIntentId()
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Payment Webhook - credits gems", func(t *testing.T) {
+		var receipt string
+		purchasePayload, purchaseSignature, receipt, err = provider.CompletePurchase(intentID)
+		if err != nil {
+			t.Fatalf("error completing purchase: %v", err)
+		}
+		event, _ := provider.ValidateReceipt(context.TODO(), receipt)
+		transactionID = event.TransactionID
+
+		// the gems come from the snapshot taken when the purchase was created
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseIntentQuery)).WithArgs("fake", intentID, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"gem_pack_id", "gems"}).AddRow("pack-id", 100))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertPaymentQuery)).WithArgs("fake", transactionID, "user-id", "pack-id", 100).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(addGemsQuery)).WithArgs(100, "user-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if code := sendWebhook(purchasePayload, purchaseSignature); code != http.StatusOK {
+			t.Fatalf("unexpected webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Payment Webhook - repeated delivery is ignored", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseIntentQuery)).WithArgs("fake", intentID, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"gem_pack_id", "gems"}).AddRow("pack-id", 100))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertPaymentQuery)).WithArgs("fake", transactionID, "user-id", "pack-id", 100).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		if code := sendWebhook(purchasePayload, purchaseSignature); code != http.StatusOK {
+			t.Fatalf("unexpected webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Payment Webhook - invalid signature", func(t *testing.T) {
+		if code := sendWebhook(purchasePayload, "deadbeef"); code != http.StatusBadRequest {
+			t.Fatalf("unexpected webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Payment Webhook - chargeback claws gems back", func(t *testing.T) {
+		payload, signature, err := provider.Chargeback(transactionID)
+		if err != nil {
+			t.Fatalf("error issuing chargeback: %v", err)
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(reversePaymentQuery)).WithArgs("chargeback", "fake", transactionID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "gems"}).AddRow("user-id", 100))
+		mock.ExpectExec(regexp.QuoteMeta(clawBackGemsQuery)).WithArgs(100, "user-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if code := sendWebhook(payload, signature); code != http.StatusOK {
+			t.Fatalf("unexpected webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+	t.Run("Payment Webhook - refund before its purchase", func(t *testing.T) {
+		intent, err := provider.CreatePurchaseIntent(context.TODO(), &payments.PurchaseIntent{UserID: "user-id", PackID: "pack-id"})
+		if err != nil {
+			t.Fatalf("error creating purchase intent: %v", err)
+		}
+		payload, signature, receipt, err := provider.CompletePurchase(intent.IntentID)
+		if err != nil {
+			t.Fatalf("error completing purchase: %v", err)
+		}
+		event, _ := provider.ValidateReceipt(context.TODO(), receipt)
+		refundPayload, refundSignature, err := provider.Refund(event.TransactionID)
+		if err != nil {
+			t.Fatalf("error issuing refund: %v", err)
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(reversePaymentQuery)).WithArgs("refund", "fake", event.TransactionID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "gems"}))
+		mock.ExpectExec(regexp.QuoteMeta(recordReversalQuery)).WithArgs("fake", event.TransactionID, "user-id", "pack-id", "refund").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseIntentQuery)).WithArgs("fake", intent.IntentID, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"gem_pack_id", "gems"}).AddRow("pack-id", 100))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(insertPaymentQuery)).WithArgs("fake", event.TransactionID, "user-id", "pack-id", 100).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		if code := sendWebhook(refundPayload, refundSignature); code != http.StatusOK {
+			t.Fatalf("unexpected refund webhook response code: %v", code)
+		}
+		if code := sendWebhook(payload, signature); code != http.StatusOK {
+			t.Fatalf("unexpected purchase webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Payment Webhook - unknown purchase intent", func(t *testing.T) {
+		intent, err := provider.CreatePurchaseIntent(context.TODO(), &payments.PurchaseIntent{UserID: "user-id", PackID: "pack-id"})
+		if err != nil {
+			t.Fatalf("error creating purchase intent: %v", err)
+		}
+		payload, signature, _, err := provider.CompletePurchase(intent.IntentID)
+		if err != nil {
+			t.Fatalf("error completing purchase: %v", err)
+		}
+
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseIntentQuery)).WithArgs("fake", intent.IntentID, "user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"gem_pack_id", "gems"}))
+
+		if code := sendWebhook(payload, signature); code != http.StatusNotFound {
+			t.Fatalf("unexpected webhook response code: %v", code)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
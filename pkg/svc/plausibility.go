@@ -0,0 +1,233 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flaggedStatusPending    = "pending"
+	flaggedStatusCleared    = "cleared"
+	flaggedStatusRolledBack = "rolled_back"
+
+	violationsSeparator = "; "
+
+	defaultFlaggedStatUpdatesLimit = 20
+	maxFlaggedStatUpdatesLimit     = 100
+
+	// recordFlaggedStatUpdateQuery logs the update like recordStatUpdateQuery and queues it for review
+	recordFlaggedStatUpdateQuery = "WITH logged AS (INSERT INTO stat_updates (user_id, games, wins, top5, kills, played_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6) RETURNING id) " +
+		"INSERT INTO flagged_stat_updates (stat_update_id, user_id, games, wins, top5, kills, violations) " +
+		"SELECT id, $1, $2, $3, $4, $5, $7 FROM logged"
+	listFlaggedStatUpdatesQuery = "SELECT id, user_id, games, wins, top5, kills, violations, status, created_at FROM flagged_stat_updates " +
+		"WHERE $1 OR status = 'pending' ORDER BY created_at, id LIMIT $2 OFFSET $3"
+	clearFlaggedStatUpdateQuery = "UPDATE flagged_stat_updates SET status = 'cleared', resolved_at = now() WHERE id = $1 AND status = 'pending'"
+	// resolving the row first locks it, so an update can't be rolled back twice
+	rollbackFlaggedStatUpdateQuery = "UPDATE flagged_stat_updates SET status = 'rolled_back', resolved_at = now() " +
+		"WHERE id = $1 AND status = 'pending' RETURNING user_id, stat_update_id, games, wins, top5, kills, created_at"
+	revertUserStatsQuery = "UPDATE user_stats SET games = games - $2, wins = wins - $3, top5 = top5 - $4, kills = kills - $5 " +
+		"WHERE user_id = $1 RETURNING games, wins, top5, kills"
+	// revertSeasonStatsQuery only touches the season the update was counted in while it's still running,
+	// archived standings are final
+	revertSeasonStatsQuery = "UPDATE season_stats ss SET games = ss.games - $2, wins = ss.wins - $3, top5 = ss.top5 - $4, kills = ss.kills - $5 " +
+		"FROM seasons s WHERE s.id = ss.season_id AND ss.user_id = $1 AND s.starts_at <= $6 AND s.ends_at > $6 AND s.archived_at IS NULL"
+	deleteStatUpdateQuery = "DELETE FROM stat_updates WHERE id = $1"
+)
+
+// PlausibilityConfig holds the sanity rules stats updates are checked against, a 0 maximum disables its rule.
+// Negative deltas and more wins or top 5 finishes than games always break the rules
+type PlausibilityConfig struct {
+	MaxGames int32
+	MaxKills int32
+	// MaxKillsPerGame is the most kills a single game of the update can account for
+	MaxKillsPerGame int32
+	// Flag accepts implausible updates and queues them for review instead of rejecting them
+	Flag bool
+}
+
+// violations lists the rules the update breaks
+func (c PlausibilityConfig) violations(req *pb.UpdateUserStatsRequest) []string {
+	violations := []string{}
+	deltas := []struct {
+		name  string
+		value int32
+	}{
+		{"games", req.GetAddGames()},
+		{"wins", req.GetAddWins()},
+		{"top5", req.GetAddTop5()},
+		{"kills", req.GetAddKills()},
+	}
+	for _, delta := range deltas {
+		if delta.value < 0 {
+			violations = append(violations, fmt.Sprintf("negative %s", delta.name))
+		}
+	}
+	if req.GetAddWins() > req.GetAddGames() {
+		violations = append(violations, "more wins than games")
+	}
+	if req.GetAddTop5() > req.GetAddGames() {
+		violations = append(violations, "more top5 than games")
+	}
+	if c.MaxGames > 0 && req.GetAddGames() > c.MaxGames {
+		violations = append(violations, fmt.Sprintf("more than %d games", c.MaxGames))
+	}
+	if c.MaxKills > 0 && req.GetAddKills() > c.MaxKills {
+		violations = append(violations, fmt.Sprintf("more than %d kills", c.MaxKills))
+	}
+	if c.MaxKillsPerGame > 0 && req.GetAddKills() > 0 && int64(req.GetAddKills()) > int64(req.GetAddGames())*int64(c.MaxKillsPerGame) {
+		violations = append(violations, fmt.Sprintf("more than %d kills per game", c.MaxKillsPerGame))
+	}
+	return violations
+}
+
+func (s *UsersStatsServer) ListFlaggedStatUpdates(ctx context.Context, req *pb.ListFlaggedStatUpdatesRequest) (*pb.ListFlaggedStatUpdatesResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"include_resolved": req.GetIncludeResolved(),
+	})
+	logger.Debug("List flagged stat updates")
+
+	limit := req.GetPaging().GetLimit()
+	if limit == 0 {
+		limit = defaultFlaggedStatUpdatesLimit
+	}
+	if limit > maxFlaggedStatUpdatesLimit {
+		limit = maxFlaggedStatUpdatesLimit
+	}
+	offset := req.GetPaging().GetOffset()
+
+	rows, err := s.cfg.Database.DB().Query(listFlaggedStatUpdatesQuery, req.GetIncludeResolved(), limit, offset)
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch flagged stat updates")
+		return nil, status.Error(codes.Internal, "Could not fetch flagged stat updates")
+	}
+	defer rows.Close()
+
+	results := []*pb.FlaggedStatUpdate{}
+	for rows.Next() {
+		var update pb.FlaggedStatUpdate
+		var violations string
+		var createdAt time.Time
+		if err := rows.Scan(&update.Id, &update.UserId, &update.AddGames, &update.AddWins, &update.AddTop5, &update.AddKills,
+			&violations, &update.Status, &createdAt); err != nil {
+			logger.WithError(err).Error("Could not fetch flagged stat updates")
+			return nil, status.Error(codes.Internal, "Could not fetch flagged stat updates")
+		}
+		update.Violations = strings.Split(violations, violationsSeparator)
+		if update.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+			logger.WithError(err).Error("Could not fetch flagged stat updates")
+			return nil, status.Error(codes.Internal, "Could not fetch flagged stat updates")
+		}
+		results = append(results, &update)
+	}
+
+	page := &query.PageInfo{Size: int32(len(results))}
+	if int32(len(results)) == limit {
+		page.Offset = offset + limit
+	} else {
+		page.SetLastOffset()
+	}
+
+	return &pb.ListFlaggedStatUpdatesResponse{Results: results, Page: page}, nil
+}
+
+// ClearFlaggedStatUpdate marks a flagged update as reviewed and legitimate
+func (s *UsersStatsServer) ClearFlaggedStatUpdate(ctx context.Context, req *pb.ClearFlaggedStatUpdateRequest) (*pb.ClearFlaggedStatUpdateResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id": req.GetId(),
+	})
+	logger.Debug("Clear flagged stat update")
+
+	res, err := s.cfg.Database.DB().Exec(clearFlaggedStatUpdateQuery, req.GetId())
+	if err != nil {
+		logger.WithError(err).Error("Could not clear flagged stat update")
+		return nil, status.Error(codes.Internal, "Could not clear flagged stat update")
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("Could not clear flagged stat update")
+		return nil, status.Error(codes.Internal, "Could not clear flagged stat update")
+	}
+	if affected == 0 {
+		logger.Error("Pending flagged stat update not found")
+		return nil, status.Error(codes.NotFound, "Pending flagged stat update not found")
+	}
+
+	return &pb.ClearFlaggedStatUpdateResponse{}, nil
+}
+
+// RollbackFlaggedStatUpdate subtracts a flagged update from the lifetime and running season stats and drops it
+// from the windowed stats, achievements the reverted stats no longer reach are revoked
+func (s *UsersStatsServer) RollbackFlaggedStatUpdate(ctx context.Context, req *pb.RollbackFlaggedStatUpdateRequest) (*pb.RollbackFlaggedStatUpdateResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id": req.GetId(),
+	})
+	logger.Debug("Rollback flagged stat update")
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+	}
+
+	var userID string
+	var statUpdateID sql.NullInt64
+	var games, wins, top5, kills int32
+	var createdAt time.Time
+	err = txnDB.QueryRow(rollbackFlaggedStatUpdateQuery, req.GetId()).Scan(&userID, &statUpdateID, &games, &wins, &top5, &kills, &createdAt)
+	if err == sql.ErrNoRows {
+		txnDB.Rollback()
+		logger.Error("Pending flagged stat update not found")
+		return nil, status.Error(codes.NotFound, "Pending flagged stat update not found")
+	}
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not resolve flagged stat update")
+		return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+	}
+
+	result := &pb.UserStats{}
+	if err := txnDB.QueryRow(revertUserStatsQuery, userID, games, wins, top5, kills).Scan(&result.Games, &result.Wins, &result.Top5, &result.Kills); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not revert user stats")
+		return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+	}
+
+	if _, err := txnDB.Exec(revertSeasonStatsQuery, userID, games, wins, top5, kills, createdAt); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not revert season stats")
+		return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+	}
+
+	if statUpdateID.Valid {
+		if _, err := txnDB.Exec(deleteStatUpdateQuery, statUpdateID.Int64); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not delete stats update")
+			return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+		}
+	}
+
+	revoked, err := revokeAchievements(logger, txnDB, userID, createdAt)
+	if err != nil {
+		txnDB.Rollback()
+		return nil, err
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not roll back flagged stat update")
+	}
+
+	return &pb.RollbackFlaggedStatUpdateResponse{Result: result, Revoked: revoked}, nil
+}
@@ -0,0 +1,225 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPlausibility(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+		Plausibility: PlausibilityConfig{
+			MaxGames:        10,
+			MaxKills:        200,
+			MaxKillsPerGame: 40,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlSearchID := `SELECT * FROM "user_stats"  WHERE ("user_id" = $1)`
+	sqlUpdate := `UPDATE "user_stats" SET "games" = $1, "kills" = $2, "top5" = $3, "user_id" = $4, "wins" = $5  WHERE "user_stats"."id" = $6`
+
+	flaggedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Violations", func(t *testing.T) {
+		cfg := PlausibilityConfig{MaxGames: 10, MaxKills: 200, MaxKillsPerGame: 40}
+		cases := []struct {
+			req        *pb.UpdateUserStatsRequest
+			violations int
+		}{
+			{&pb.UpdateUserStatsRequest{AddGames: 1, AddWins: 1, AddTop5: 1, AddKills: 12}, 0},
+			{&pb.UpdateUserStatsRequest{AddKills: -1}, 1},
+			{&pb.UpdateUserStatsRequest{AddGames: 1, AddWins: 2, AddTop5: 2}, 2},
+			{&pb.UpdateUserStatsRequest{AddGames: 11}, 1},
+			{&pb.UpdateUserStatsRequest{AddGames: 1, AddKills: 500}, 2},
+			{&pb.UpdateUserStatsRequest{AddKills: 1}, 1},
+		}
+		for _, c := range cases {
+			if violations := cfg.violations(c.req); len(violations) != c.violations {
+				t.Fatalf("expected %d violations for %v, got %v", c.violations, c.req, violations)
+			}
+		}
+		if violations := (PlausibilityConfig{}).violations(&pb.UpdateUserStatsRequest{AddGames: 1, AddKills: 500}); len(violations) != 0 {
+			t.Fatalf("expected disabled maximums, got %v", violations)
+		}
+	})
+
+	t.Run("Update stats - rejected", func(t *testing.T) {
+		_, err := stClient.UpdateStats(ctx, &pb.UpdateUserStatsRequest{Username: "some-id", AddGames: 1, AddKills: 500})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Update stats - flagged", func(t *testing.T) {
+		stServer.cfg.Plausibility.Flag = true
+		defer func() { stServer.cfg.Plausibility.Flag = false }()
+
+		rows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 0, 0, 'f')
+		statsRows := sqlmock.NewRows([]string{"id", "wins", "top5", "kills", "games"}).
+			AddRow(1, 10, 10, 100, 20)
+
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(statsRows)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs(21, 600, 10, nil, 10, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("some-id", 1, 0, 0, 500).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordFlaggedStatUpdateQuery)).
+			WithArgs("some-id", 1, 0, 0, 500, sqlmock.AnyArg(), "more than 200 kills; more than 40 kills per game").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("some-id", 1, 0, 0, 500).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("some-id").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectCommit()
+
+		res, err := stClient.UpdateStats(ctx, &pb.UpdateUserStatsRequest{Username: "some-id", AddGames: 1, AddKills: 500})
+		if err != nil {
+			t.Fatalf("error updating user stats: %v", err)
+		}
+		if !res.GetFlagged() {
+			t.Fatalf("expected the update to be flagged")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List flagged stat updates", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(listFlaggedStatUpdatesQuery)).WithArgs(false, defaultFlaggedStatUpdatesLimit, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "games", "wins", "top5", "kills", "violations", "status", "created_at"}).
+				AddRow(3, "some-id", 1, 0, 0, 500, "more than 200 kills; more than 40 kills per game", flaggedStatusPending, flaggedAt))
+
+		res, err := stClient.ListFlaggedStatUpdates(ctx, &pb.ListFlaggedStatUpdatesRequest{})
+		if err != nil {
+			t.Fatalf("error listing flagged stat updates: %v", err)
+		}
+		if len(res.GetResults()) != 1 || len(res.GetResults()[0].GetViolations()) != 2 || res.GetResults()[0].GetAddKills() != 500 {
+			t.Fatalf("unexpected flagged stat updates: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Clear flagged stat update", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(clearFlaggedStatUpdateQuery)).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		if _, err := stClient.ClearFlaggedStatUpdate(ctx, &pb.ClearFlaggedStatUpdateRequest{Id: 3}); err != nil {
+			t.Fatalf("error clearing flagged stat update: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Clear flagged stat update - already resolved", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(clearFlaggedStatUpdateQuery)).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := stClient.ClearFlaggedStatUpdate(ctx, &pb.ClearFlaggedStatUpdateRequest{Id: 3})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Rollback flagged stat update", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(rollbackFlaggedStatUpdateQuery)).WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "stat_update_id", "games", "wins", "top5", "kills", "created_at"}).
+				AddRow("some-id", 17, 1, 0, 0, 500, flaggedAt))
+		mock.ExpectQuery(regexp.QuoteMeta(revertUserStatsQuery)).WithArgs("some-id", 1, 0, 0, 500).
+			WillReturnRows(sqlmock.NewRows([]string{"games", "wins", "top5", "kills"}).AddRow(20, 10, 10, 100))
+		mock.ExpectExec(regexp.QuoteMeta(revertSeasonStatsQuery)).WithArgs("some-id", 1, 0, 0, 500, flaggedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(deleteStatUpdateQuery)).WithArgs(17).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(relockAchievementsQuery)).WithArgs("some-id", flaggedAt).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "metric", "scope", "threshold", "reward_coins", "reward_gems", "reward_item_id"}).
+				AddRow(2, "Slayer", "", "kills", "lifetime", 500, 50, 0, "badge-id"))
+		mock.ExpectExec(regexp.QuoteMeta(resetAchievementProgressQuery)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(revokeAchievementItemQuery)).WithArgs("some-id", "badge-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(revokeCurrenciesQuery)).WithArgs(50, 0, "some-id").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stClient.RollbackFlaggedStatUpdate(ctx, &pb.RollbackFlaggedStatUpdateRequest{Id: 4})
+		if err != nil {
+			t.Fatalf("error rolling back flagged stat update: %v", err)
+		}
+		if res.GetResult().GetKills() != 100 || res.GetResult().GetGames() != 20 {
+			t.Fatalf("unexpected stats: %v", res.GetResult())
+		}
+		if len(res.GetRevoked()) != 1 || res.GetRevoked()[0].GetName() != "Slayer" {
+			t.Fatalf("unexpected revoked achievements: %v", res.GetRevoked())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Rollback flagged stat update - already resolved", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(rollbackFlaggedStatUpdateQuery)).WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "stat_update_id", "games", "wins", "top5", "kills", "created_at"}))
+		mock.ExpectRollback()
+
+		_, err := stClient.RollbackFlaggedStatUpdate(ctx, &pb.RollbackFlaggedStatUpdateRequest{Id: 4})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	lockRetiredItemQuery = "SELECT retired FROM store_items WHERE id = $1 FOR UPDATE"
+	// when $4 is set owners get back what throwing the item away would give them, see throwAwayRefund,
+	// and everyone gets the flat compensation on top
+	compensateOwnersQuery = "UPDATE users u SET " +
+		"coins = u.coins + $2 + CASE WHEN $4 AND usi.source = 'purchase' AND usi.expires_at IS NULL " +
+		"THEN LEAST(usi.quantity::bigint * CASE WHEN si.on_sale THEN si.sale_coins_price ELSE si.coins_price END, 2147483647) ELSE 0 END, " +
+		"gems = u.gems + $3 + CASE WHEN $4 AND usi.source = 'purchase' AND usi.expires_at IS NULL " +
+		"THEN LEAST(usi.quantity::bigint * CASE WHEN si.on_sale THEN si.sale_gems_price ELSE si.gems_price END, 2147483647) ELSE 0 END " +
+		"FROM users_store_items usi JOIN store_items si ON si.id = usi.store_item_id " +
+		"WHERE usi.user_id = u.id AND usi.store_item_id = $1 AND (usi.expires_at IS NULL OR usi.expires_at > now())"
+	refundPendingGiftsQuery = "UPDATE users u SET coins = u.coins + g.coins, gems = u.gems + g.gems " +
+		"FROM (SELECT sender_id, sum(coins_price) AS coins, sum(gems_price) AS gems FROM gifts WHERE store_item_id = $1 AND status = 'pending' GROUP BY sender_id) g " +
+		"WHERE u.id = g.sender_id"
+	// deleting the item cascades to inventories, loadouts, gifts, storefronts and translations
+	purgeItemQuery = "DELETE FROM store_items WHERE id = $1"
+)
+
+// PurgeItem deletes a retired item for good, taking it away from everyone who owns it
+func (s *StoreItemsServer) PurgeItem(ctx context.Context, req *pb.PurgeItemRequest) (*pb.PurgeItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"id":                 req.GetId(),
+		"refund":             req.GetRefund(),
+		"compensation_coins": req.GetCompensationCoins(),
+		"compensation_gems":  req.GetCompensationGems(),
+	})
+	logger.Debug("Purge Item")
+
+	if req.GetCompensationCoins() < 0 || req.GetCompensationGems() < 0 {
+		logger.Error("Negative compensation")
+		return nil, status.Error(codes.InvalidArgument, "Compensation can't be negative")
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+
+	var retired bool
+	err = txnDB.QueryRow(lockRetiredItemQuery, req.GetId()).Scan(&retired)
+	if err == sql.ErrNoRows {
+		txnDB.Rollback()
+		logger.Error("Item not found")
+		return nil, status.Error(codes.NotFound, "Item not found")
+	}
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not find item")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+	if !retired {
+		txnDB.Rollback()
+		logger.Error("Item is not retired")
+		return nil, status.Error(codes.FailedPrecondition, "Only retired items can be purged")
+	}
+
+	owners, err := txnDB.Exec(compensateOwnersQuery, req.GetId(), req.GetCompensationCoins(), req.GetCompensationGems(), req.GetRefund())
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not compensate owners")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+	affectedOwners, err := owners.RowsAffected()
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not compensate owners")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+
+	senders, err := txnDB.Exec(refundPendingGiftsQuery, req.GetId())
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not refund pending gifts")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+	refundedSenders, err := senders.RowsAffected()
+	if err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not refund pending gifts")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+
+	if _, err := txnDB.Exec(purgeItemQuery, req.GetId()); err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not delete item")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+
+	if err := txnDB.Commit(); err != nil {
+		logger.WithError(err).Error("Could not commit transaction")
+		return nil, status.Error(codes.Internal, "Could not purge item")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"affected_owners":       affectedOwners,
+		"refunded_gift_senders": refundedSenders,
+	}).Info("Item purged")
+
+	return &pb.PurgeItemResponse{
+		AffectedOwners:      int32(affectedOwners),
+		RefundedGiftSenders: int32(refundedSenders),
+	}, nil
+}
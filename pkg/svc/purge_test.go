@@ -0,0 +1,145 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPurgeItem(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlSearchUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlSearchItem := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlListAll := `SELECT * FROM "store_items" ORDER BY "id"`
+
+	t.Run("Buy Item - retired", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-user-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "coins"}).AddRow("some-user-id", 500))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "coins_price", "retired"}).AddRow("some-item-id", 100, true))
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{UserId: "some-user-id", ItemId: "some-item-id"})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List Items - include retired", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListAll)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "retired"}).
+				AddRow("sword-id", "Sword", false).
+				AddRow("axe-id", "Axe", true))
+
+		res, err := stiClient.List(ctx, &pb.ListStoreItemsRequest{IncludeRetired: true})
+		if err != nil {
+			t.Fatalf("error listing items: %v", err)
+		}
+		if len(res.GetResults()) != 2 || !res.GetResults()[1].GetRetired() {
+			t.Fatalf("unexpected items: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Delete Item - not found", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(retireItemQuery)).WithArgs("some-item-id").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := stiClient.Delete(ctx, &pb.DeleteStoreItemRequest{Id: "some-item-id"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Purge Item - positive", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockRetiredItemQuery)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"retired"}).AddRow(true))
+		mock.ExpectExec(regexp.QuoteMeta(compensateOwnersQuery)).WithArgs("some-item-id", 50, 0, true).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(refundPendingGiftsQuery)).WithArgs("some-item-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purgeItemQuery)).WithArgs("some-item-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stiClient.PurgeItem(ctx, &pb.PurgeItemRequest{Id: "some-item-id", Refund: true, CompensationCoins: 50})
+		if err != nil {
+			t.Fatalf("error purging item: %v", err)
+		}
+		if res.GetAffectedOwners() != 3 || res.GetRefundedGiftSenders() != 1 {
+			t.Fatalf("unexpected purge result: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Purge Item - not retired", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockRetiredItemQuery)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"retired"}).AddRow(false))
+		mock.ExpectRollback()
+
+		_, err := stiClient.PurgeItem(ctx, &pb.PurgeItemRequest{Id: "some-item-id"})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Purge Item - negative compensation", func(t *testing.T) {
+		_, err := stiClient.PurgeItem(ctx, &pb.PurgeItemRequest{Id: "some-item-id", CompensationGems: -1})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+}
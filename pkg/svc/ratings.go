@@ -0,0 +1,335 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Glicko-2 parameters, see http://www.glicko.net/glicko/glicko2.pdf
+const (
+	defaultRating     = 1500.0
+	defaultDeviation  = 350.0
+	defaultVolatility = 0.06
+	// glickoScale converts ratings to the Glicko-2 scale and back
+	glickoScale = 173.7178
+	// glickoTau constrains how much the volatility changes over time
+	glickoTau     = 0.5
+	glickoEpsilon = 0.000001
+	// ratingPeriod is how long a player has to be inactive for the deviation to grow by one step
+	ratingPeriod = 24 * time.Hour
+
+	defaultRatingHistoryLimit = 10
+	maxRatingHistoryLimit     = 100
+	maxRatingsBatch           = 100
+
+	userRatingQuery     = "SELECT rating, deviation, volatility, games, last_played_at FROM user_ratings WHERE user_id = $1"
+	lockUserRatingQuery = userRatingQuery + " FOR UPDATE"
+	userRatingsRawQuery = "SELECT user_id, rating, deviation, volatility, games, last_played_at FROM user_ratings WHERE user_id IN (?)"
+	saveUserRatingQuery = "INSERT INTO user_ratings (user_id, rating, deviation, volatility, games, last_played_at) VALUES ($1, $2, $3, $4, 1, $5) " +
+		"ON CONFLICT (user_id) DO UPDATE SET rating = EXCLUDED.rating, deviation = EXCLUDED.deviation, volatility = EXCLUDED.volatility, " +
+		"games = user_ratings.games + 1, last_played_at = GREATEST(user_ratings.last_played_at, EXCLUDED.last_played_at)"
+	insertRatingHistoryQuery = "INSERT INTO rating_history (user_id, match_id, rating, deviation, volatility, played_at) VALUES ($1, $2, $3, $4, $5, $6)"
+	ratingHistoryQuery       = "SELECT match_id, rating, deviation, volatility, played_at FROM rating_history WHERE user_id = $1 " +
+		"ORDER BY played_at DESC, id DESC LIMIT $2"
+)
+
+type glickoRating struct {
+	rating     float64
+	deviation  float64
+	volatility float64
+}
+
+func newGlickoRating() glickoRating {
+	return glickoRating{rating: defaultRating, deviation: defaultDeviation, volatility: defaultVolatility}
+}
+
+// glickoOpponent is the outcome of a game against one opponent, score is 1 for a win, 0.5 for a draw and 0 for a loss
+type glickoOpponent struct {
+	rating glickoRating
+	score  float64
+}
+
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func glickoE(mu, muJ, phiJ float64) float64 {
+	return 1 / (1 + math.Exp(-glickoG(phiJ)*(mu-muJ)))
+}
+
+// update rates a player over one rating period
+func (r glickoRating) update(opponents []glickoOpponent) glickoRating {
+	if len(opponents) == 0 {
+		return r.decay(1)
+	}
+	mu := (r.rating - defaultRating) / glickoScale
+	phi := r.deviation / glickoScale
+
+	var vInv, sum float64
+	for _, opponent := range opponents {
+		muJ := (opponent.rating.rating - defaultRating) / glickoScale
+		phiJ := opponent.rating.deviation / glickoScale
+		g := glickoG(phiJ)
+		e := glickoE(mu, muJ, phiJ)
+		vInv += g * g * e * (1 - e)
+		sum += g * (opponent.score - e)
+	}
+	v := 1 / vInv
+	delta := v * sum
+
+	sigma := glickoVolatility(phi, v, delta, r.volatility)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phiNew := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	muNew := mu + phiNew*phiNew*sum
+
+	return glickoRating{
+		rating:     muNew*glickoScale + defaultRating,
+		deviation:  phiNew * glickoScale,
+		volatility: sigma,
+	}
+}
+
+// glickoVolatility finds the new volatility with the Illinois algorithm (step 5 of the paper)
+func glickoVolatility(phi, v, delta, sigma float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(glickoTau*glickoTau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*glickoTau) < 0 {
+			k++
+		}
+		B = a - k*glickoTau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > glickoEpsilon {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA = fA / 2
+		}
+		B, fB = C, fC
+	}
+
+	return math.Exp(A / 2)
+}
+
+// decay grows the deviation of a player that sat out the given number of rating periods,
+// it never goes past the deviation of a new player
+func (r glickoRating) decay(periods int) glickoRating {
+	if periods <= 0 {
+		return r
+	}
+	phi := r.deviation / glickoScale
+	r.deviation = math.Min(math.Sqrt(phi*phi+float64(periods)*r.volatility*r.volatility)*glickoScale, defaultDeviation)
+	return r
+}
+
+// decayUntil applies the inactivity decay between the last game and at
+func (r glickoRating) decayUntil(lastPlayedAt, at time.Time) glickoRating {
+	return r.decay(int(at.Sub(lastPlayedAt) / ratingPeriod))
+}
+
+// rateFreeForAll rates a free-for-all match as a single rating period in which every participant played
+// everyone else, beating the ones placed below, drawing the ones on the same placement and losing to the rest
+func rateFreeForAll(ratings []glickoRating, placements []int32) []glickoRating {
+	updated := make([]glickoRating, len(ratings))
+	for i := range ratings {
+		opponents := make([]glickoOpponent, 0, len(ratings)-1)
+		for j := range ratings {
+			if i == j {
+				continue
+			}
+			score := 0.0
+			if placements[i] < placements[j] {
+				score = 1
+			} else if placements[i] == placements[j] {
+				score = 0.5
+			}
+			opponents = append(opponents, glickoOpponent{rating: ratings[j], score: score})
+		}
+		updated[i] = ratings[i].update(opponents)
+	}
+	return updated
+}
+
+// applyMatchRatings updates the ratings of the match participants and records them in the rating history,
+// participants should be sorted by user id so their ratings are locked in a consistent order
+func applyMatchRatings(logger *logrus.Entry, txnDB *sql.Tx, matchID string, playedAt time.Time, participants []*pb.MatchParticipant) error {
+	if len(participants) < 2 {
+		return nil
+	}
+
+	ratings := make([]glickoRating, len(participants))
+	placements := make([]int32, len(participants))
+	for i, participant := range participants {
+		rating := newGlickoRating()
+		var games int32
+		var lastPlayedAt time.Time
+		err := txnDB.QueryRow(lockUserRatingQuery, participant.GetUserId()).
+			Scan(&rating.rating, &rating.deviation, &rating.volatility, &games, &lastPlayedAt)
+		if err != nil && err != sql.ErrNoRows {
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not fetch user rating")
+			return status.Error(codes.Internal, "Could not record match")
+		}
+		if err == nil {
+			rating = rating.decayUntil(lastPlayedAt, playedAt)
+		}
+		ratings[i] = rating
+		placements[i] = participant.GetPlacement()
+	}
+
+	for i, rating := range rateFreeForAll(ratings, placements) {
+		userID := participants[i].GetUserId()
+		if _, err := txnDB.Exec(saveUserRatingQuery, userID, rating.rating, rating.deviation, rating.volatility, playedAt); err != nil {
+			logger.WithError(err).WithField("user_id", userID).Error("Could not save user rating")
+			return status.Error(codes.Internal, "Could not record match")
+		}
+		if _, err := txnDB.Exec(insertRatingHistoryQuery, userID, matchID, rating.rating, rating.deviation, rating.volatility, playedAt); err != nil {
+			logger.WithError(err).WithField("user_id", userID).Error("Could not save rating history")
+			return status.Error(codes.Internal, "Could not record match")
+		}
+	}
+
+	return nil
+}
+
+func (s *UsersStatsServer) GetRating(ctx context.Context, req *pb.GetRatingRequest) (*pb.GetRatingResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name": req.GetUsername(),
+	})
+	logger.Debug("Get user rating")
+
+	limit := req.GetHistoryLimit()
+	if limit == 0 {
+		limit = defaultRatingHistoryLimit
+	}
+	if limit < 0 || limit > maxRatingHistoryLimit {
+		limit = maxRatingHistoryLimit
+	}
+
+	user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	rating := newGlickoRating()
+	result := &pb.Rating{UserId: user.GetId()}
+	var lastPlayedAt time.Time
+	err = s.cfg.Database.DB().QueryRow(userRatingQuery, user.GetId()).
+		Scan(&rating.rating, &rating.deviation, &rating.volatility, &result.Games, &lastPlayedAt)
+	if err != nil && err != sql.ErrNoRows {
+		logger.WithError(err).Error("Could not fetch user rating")
+		return nil, status.Error(codes.Internal, "Could not fetch user rating")
+	}
+	if err == nil {
+		if err := setRating(result, rating, lastPlayedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch user rating")
+			return nil, status.Error(codes.Internal, "Could not fetch user rating")
+		}
+	} else {
+		result.Rating, result.Deviation, result.Volatility = rating.rating, rating.deviation, rating.volatility
+	}
+
+	rows, err := s.cfg.Database.DB().Query(ratingHistoryQuery, user.GetId(), limit)
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch rating history")
+		return nil, status.Error(codes.Internal, "Could not fetch user rating")
+	}
+	defer rows.Close()
+
+	history := []*pb.RatingHistoryEntry{}
+	for rows.Next() {
+		var entry pb.RatingHistoryEntry
+		var playedAt time.Time
+		if err := rows.Scan(&entry.MatchId, &entry.Rating, &entry.Deviation, &entry.Volatility, &playedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch rating history")
+			return nil, status.Error(codes.Internal, "Could not fetch user rating")
+		}
+		if entry.PlayedAt, err = ptypes.TimestampProto(playedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch rating history")
+			return nil, status.Error(codes.Internal, "Could not fetch user rating")
+		}
+		history = append(history, &entry)
+	}
+
+	return &pb.GetRatingResponse{Result: result, History: history}, nil
+}
+
+func (s *UsersStatsServer) GetRatings(ctx context.Context, req *pb.GetRatingsRequest) (*pb.GetRatingsResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"users": len(req.GetUserIds()),
+	})
+	logger.Debug("Get user ratings")
+
+	if len(req.GetUserIds()) == 0 || len(req.GetUserIds()) > maxRatingsBatch {
+		logger.Error("Invalid ratings batch size")
+		return nil, status.Errorf(codes.InvalidArgument, "Between 1 and %d user ids should be requested", maxRatingsBatch)
+	}
+
+	rows, err := s.cfg.Database.Raw(userRatingsRawQuery, req.GetUserIds()).Rows()
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch user ratings")
+		return nil, status.Error(codes.Internal, "Could not fetch user ratings")
+	}
+	defer rows.Close()
+
+	found := map[string]*pb.Rating{}
+	for rows.Next() {
+		result := &pb.Rating{}
+		var rating glickoRating
+		var lastPlayedAt time.Time
+		if err := rows.Scan(&result.UserId, &rating.rating, &rating.deviation, &rating.volatility, &result.Games, &lastPlayedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch user ratings")
+			return nil, status.Error(codes.Internal, "Could not fetch user ratings")
+		}
+		if err := setRating(result, rating, lastPlayedAt); err != nil {
+			logger.WithError(err).Error("Could not fetch user ratings")
+			return nil, status.Error(codes.Internal, "Could not fetch user ratings")
+		}
+		found[result.GetUserId()] = result
+	}
+
+	results := make([]*pb.Rating, 0, len(req.GetUserIds()))
+	for _, userID := range req.GetUserIds() {
+		result, ok := found[userID]
+		if !ok {
+			result = &pb.Rating{UserId: userID, Rating: defaultRating, Deviation: defaultDeviation, Volatility: defaultVolatility}
+		}
+		results = append(results, result)
+	}
+
+	return &pb.GetRatingsResponse{Results: results}, nil
+}
+
+// setRating fills a stored rating in, with the deviation decayed for the time the player has been inactive
+func setRating(result *pb.Rating, rating glickoRating, lastPlayedAt time.Time) error {
+	rating = rating.decayUntil(lastPlayedAt, time.Now())
+	result.Rating, result.Deviation, result.Volatility = rating.rating, rating.deviation, rating.volatility
+	lastPlayed, err := ptypes.TimestampProto(lastPlayedAt)
+	if err != nil {
+		return err
+	}
+	result.LastPlayedAt = lastPlayed
+	return nil
+}
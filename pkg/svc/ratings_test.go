@@ -0,0 +1,178 @@
+package svc
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func assertRating(t *testing.T, got glickoRating, rating, deviation, volatility float64) {
+	t.Helper()
+	if math.Abs(got.rating-rating) > 0.01 || math.Abs(got.deviation-deviation) > 0.01 || math.Abs(got.volatility-volatility) > 0.00001 {
+		t.Fatalf("expected %.2f/%.2f/%.5f, got %.2f/%.2f/%.5f", rating, deviation, volatility, got.rating, got.deviation, got.volatility)
+	}
+}
+
+func TestGlicko(t *testing.T) {
+	t.Run("Update - reference example", func(t *testing.T) {
+		// the worked example from http://www.glicko.net/glicko/glicko2.pdf
+		player := glickoRating{rating: 1500, deviation: 200, volatility: 0.06}
+		got := player.update([]glickoOpponent{
+			{rating: glickoRating{rating: 1400, deviation: 30, volatility: 0.06}, score: 1},
+			{rating: glickoRating{rating: 1550, deviation: 100, volatility: 0.06}, score: 0},
+			{rating: glickoRating{rating: 1700, deviation: 300, volatility: 0.06}, score: 0},
+		})
+		assertRating(t, got, 1464.05, 151.52, 0.05999)
+	})
+
+	t.Run("Free for all - new players", func(t *testing.T) {
+		got := rateFreeForAll([]glickoRating{newGlickoRating(), newGlickoRating()}, []int32{1, 2})
+		assertRating(t, got[0], 1662.31, 290.32, 0.06)
+		assertRating(t, got[1], 1337.69, 290.32, 0.06)
+	})
+
+	t.Run("Free for all - shared placement", func(t *testing.T) {
+		got := rateFreeForAll([]glickoRating{newGlickoRating(), newGlickoRating(), newGlickoRating()}, []int32{2, 1, 2})
+		if got[0] != got[2] {
+			t.Fatalf("expected equal ratings for the same placement, got %v and %v", got[0], got[2])
+		}
+		if got[1].rating <= defaultRating || got[0].rating >= defaultRating {
+			t.Fatalf("unexpected ratings: %v", got)
+		}
+	})
+
+	t.Run("Decay", func(t *testing.T) {
+		player := glickoRating{rating: 1500, deviation: 200, volatility: 0.06}
+		assertRating(t, player.decay(1), 1500, 200.27, 0.06)
+		assertRating(t, player.decayUntil(time.Now().Add(-12*time.Hour), time.Now()), 1500, 200, 0.06)
+		assertRating(t, player.decay(100000), 1500, defaultDeviation, 0.06)
+	})
+}
+
+func TestRatings(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlUserRatings := `SELECT user_id, rating, deviation, volatility, games, last_played_at FROM user_ratings WHERE user_id IN ($1,$2)`
+	ratingColumns := []string{"rating", "deviation", "volatility", "games", "last_played_at"}
+
+	t.Run("Get Rating - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-a", "alice"))
+		mock.ExpectQuery(regexp.QuoteMeta(userRatingQuery)).WithArgs("user-a").
+			WillReturnRows(sqlmock.NewRows(ratingColumns).AddRow(1620.5, 120, 0.06, 14, time.Now().Add(-49*time.Hour)))
+		mock.ExpectQuery(regexp.QuoteMeta(ratingHistoryQuery)).WithArgs("user-a", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id", "rating", "deviation", "volatility", "played_at"}).
+				AddRow("match-b", 1620.5, 120, 0.06, time.Now()).
+				AddRow("match-a", 1600, 125, 0.06, time.Now()))
+
+		res, err := stClient.GetRating(ctx, &pb.GetRatingRequest{Username: "user-a", HistoryLimit: 2})
+		if err != nil {
+			t.Fatalf("error getting rating: %v", err)
+		}
+		decayed := glickoRating{rating: 1620.5, deviation: 120, volatility: 0.06}.decay(2)
+		if res.GetResult().GetRating() != 1620.5 || res.GetResult().GetDeviation() != decayed.deviation || res.GetResult().GetGames() != 14 {
+			t.Fatalf("unexpected rating: %v", res.GetResult())
+		}
+		if len(res.GetHistory()) != 2 || res.GetHistory()[0].GetMatchId() != "match-b" {
+			t.Fatalf("unexpected rating history: %v", res.GetHistory())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Rating - unrated player", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("user-b", "bob"))
+		mock.ExpectQuery(regexp.QuoteMeta(userRatingQuery)).WithArgs("user-b").
+			WillReturnRows(sqlmock.NewRows(ratingColumns))
+		mock.ExpectQuery(regexp.QuoteMeta(ratingHistoryQuery)).WithArgs("user-b", defaultRatingHistoryLimit).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id", "rating", "deviation", "volatility", "played_at"}))
+
+		res, err := stClient.GetRating(ctx, &pb.GetRatingRequest{Username: "user-b"})
+		if err != nil {
+			t.Fatalf("error getting rating: %v", err)
+		}
+		if res.GetResult().GetRating() != defaultRating || res.GetResult().GetDeviation() != defaultDeviation || len(res.GetHistory()) != 0 {
+			t.Fatalf("unexpected rating: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Ratings - lobby", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlUserRatings)).WithArgs("user-b", "user-a").
+			WillReturnRows(sqlmock.NewRows(append([]string{"user_id"}, ratingColumns...)).
+				AddRow("user-a", 1620.5, 120, 0.06, 14, time.Now()))
+
+		res, err := stClient.GetRatings(ctx, &pb.GetRatingsRequest{UserIds: []string{"user-b", "user-a"}})
+		if err != nil {
+			t.Fatalf("error getting ratings: %v", err)
+		}
+		if len(res.GetResults()) != 2 || res.GetResults()[0].GetRating() != defaultRating || res.GetResults()[1].GetRating() != 1620.5 {
+			t.Fatalf("unexpected ratings: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Ratings - empty batch", func(t *testing.T) {
+		_, err := stClient.GetRatings(ctx, &pb.GetRatingsRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+}
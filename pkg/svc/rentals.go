@@ -0,0 +1,127 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// a timed grant only extends an existing rental, a grant without expiry makes it permanent
+	grantTimedItemQuery = "INSERT INTO users_store_items (user_id, store_item_id, source, expires_at) VALUES ($1, $2, 'grant', $3) " +
+		"ON CONFLICT (user_id, store_item_id) DO UPDATE SET expires_at = EXCLUDED.expires_at " +
+		"WHERE users_store_items.expires_at IS NOT NULL AND (EXCLUDED.expires_at IS NULL OR EXCLUDED.expires_at > users_store_items.expires_at)"
+	// removing the row also unequips the item
+	sweepExpiredItemsQuery = "DELETE FROM users_store_items WHERE expires_at <= now()"
+)
+
+func (s *StoreItemsServer) GrantItem(ctx context.Context, req *pb.GrantItemRequest) (*pb.GrantItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id": req.GetUserId(),
+		"item_id": req.GetItemId(),
+	})
+	logger.Debug("Granting item")
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		t, err := ptypes.Timestamp(req.GetExpiresAt())
+		if err != nil || !t.After(time.Now()) {
+			logger.Error("Invalid expiration time")
+			return nil, status.Error(codes.InvalidArgument, "Expiration time must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	var usr pb.UserORM
+	if err := s.cfg.Database.Where("id = ?", req.GetUserId()).First(&usr).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("User not found")
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		logger.WithError(err).Error("Could not find user")
+		return nil, status.Error(codes.Internal, "Could not find user")
+	}
+
+	var item pb.StoreItemORM
+	if err := s.cfg.Database.Where("id = ?", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Item not found")
+			return nil, status.Error(codes.NotFound, "Item not found")
+		}
+		logger.WithError(err).Error("Could not find item")
+		return nil, status.Error(codes.Internal, "Could not find item")
+	}
+
+	if item.Consumable {
+		logger.Error("Consumables can't be granted with expiry")
+		return nil, status.Error(codes.FailedPrecondition, "Consumable items can't be granted")
+	}
+
+	res, err := s.cfg.Database.DB().Exec(grantTimedItemQuery, usr.Id, item.Id, expiresAt)
+	if err != nil {
+		logger.WithError(err).Error("Could not grant item")
+		return nil, status.Error(codes.Internal, "Could not grant item")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		logger.Error("Item is already owned")
+		return nil, status.Error(codes.AlreadyExists, "Item is already owned")
+	}
+
+	return &pb.GrantItemResponse{}, nil
+}
+
+// SweepExpiredItems removes expired rentals from all inventories and returns how many were removed
+func (s *StoreItemsServer) SweepExpiredItems(ctx context.Context) (int64, error) {
+	res, err := s.cfg.Database.DB().ExecContext(ctx, sweepExpiredItemsQuery)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RunExpiredItemsSweeper sweeps expired rentals every interval until ctx is done
+func RunExpiredItemsSweeper(ctx context.Context, s *StoreItemsServer, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := s.SweepExpiredItems(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Could not sweep expired items")
+				continue
+			}
+			if removed > 0 {
+				logger.WithField("removed", removed).Info("Swept expired items")
+			}
+		}
+	}
+}
+
+// scanUserItem reads a row of userItemsQuery or equippedUserItemsQuery
+func scanUserItem(rows *sql.Rows, now time.Time) (*pb.UserItemInfo, error) {
+	var item pb.UserItemInfo
+	var expiresAt sql.NullTime
+	if err := rows.Scan(&item.ItemId, &item.Equipped, &item.Quantity, &expiresAt); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		var err error
+		if item.ExpiresAt, err = ptypes.TimestampProto(expiresAt.Time); err != nil {
+			return nil, err
+		}
+		item.RemainingSeconds = int64(expiresAt.Time.Sub(now) / time.Second)
+	}
+	return &item, nil
+}
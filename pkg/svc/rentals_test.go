@@ -0,0 +1,180 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRentals(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+
+	sqlSearchItem := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlSearchUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+	sqlUpdateUser := `UPDATE "users" SET "coins" = $1, "email" = $2, "gems" = $3, "name" = $4, "password" = $5  WHERE "users"."id" = $6`
+
+	userColumns := []string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}
+	itemColumns := []string{"id", "name", "type", "coins_price", "gems_price", "consumable", "rental_days", "rental_coins_price", "rental_gems_price"}
+
+	t.Run("BuyByUser - rent", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("some-id", "someemail@email.com", "some-hash", time.Now(), time.Now(), "some-name", 1000, 100, false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("some-item-id", "Sword", 1, 500, 0, false, 7, 50, 0))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(950, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(rentItemQuery)).WithArgs("some-id", "some-item-id", 7).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{UserId: "some-id", ItemId: "some-item-id", Rent: true})
+		if err != nil {
+			t.Fatalf("error renting item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("BuyByUser - rent not available", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("some-id", "someemail@email.com", "some-hash", time.Now(), time.Now(), "some-name", 1000, 100, false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("some-item-id", "Sword", 1, 500, 0, false, 0, 0, 0))
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{UserId: "some-id", ItemId: "some-item-id", Rent: true})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Grant Item - timed", func(t *testing.T) {
+		expiresAt, _ := ptypes.TimestampProto(time.Now().Add(24 * time.Hour))
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("some-id", "someemail@email.com", "some-hash", time.Now(), time.Now(), "some-name", 0, 0, false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("some-item-id", "Sword", 1, 500, 0, false, 0, 0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(grantTimedItemQuery)).WithArgs("some-id", "some-item-id", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		_, err := stiClient.GrantItem(ctx, &pb.GrantItemRequest{UserId: "some-id", ItemId: "some-item-id", ExpiresAt: expiresAt})
+		if err != nil {
+			t.Fatalf("error granting item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Grant Item - already owned", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("some-id", "someemail@email.com", "some-hash", time.Now(), time.Now(), "some-name", 0, 0, false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows(itemColumns).AddRow("some-item-id", "Sword", 1, 500, 0, false, 0, 0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(grantTimedItemQuery)).WithArgs("some-id", "some-item-id", nil).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := stiClient.GrantItem(ctx, &pb.GrantItemRequest{UserId: "some-id", ItemId: "some-item-id"})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Grant Item - expiry in the past", func(t *testing.T) {
+		expiresAt, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+
+		_, err := stiClient.GrantItem(ctx, &pb.GrantItemRequest{UserId: "some-id", ItemId: "some-item-id", ExpiresAt: expiresAt})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("Get User Items - remaining time", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchUser)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows(userColumns).AddRow("some-id", "someemail@email.com", "some-hash", time.Now(), time.Now(), "some-name", 0, 0, false))
+		mock.ExpectQuery(regexp.QuoteMeta(userItemsQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"store_item_id", "equipped", "quantity", "expires_at"}).
+				AddRow("some-item-id", true, 1, time.Now().Add(2*time.Hour)).
+				AddRow("other-item-id", false, 1, nil))
+
+		res, err := stiClient.GetUserItemsIds(ctx, &pb.GetUserItemsIdsRequest{UserId: "some-id"})
+		if err != nil {
+			t.Fatalf("error getting user items: %v", err)
+		}
+		if len(res.GetItems()) != 2 {
+			t.Fatalf("expected 2 items, got %d", len(res.GetItems()))
+		}
+		if res.GetItems()[0].GetExpiresAt() == nil || res.GetItems()[0].GetRemainingSeconds() <= 3600 {
+			t.Fatalf("expected rental to expire in 2 hours, got %v", res.GetItems()[0])
+		}
+		if res.GetItems()[1].GetExpiresAt() != nil || res.GetItems()[1].GetRemainingSeconds() != 0 {
+			t.Fatalf("expected permanent item, got %v", res.GetItems()[1])
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Sweep Expired Items", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(sweepExpiredItemsQuery)).WillReturnResult(sqlmock.NewResult(0, 4))
+
+		removed, err := stiServer.SweepExpiredItems(context.Background())
+		if err != nil {
+			t.Fatalf("error sweeping expired items: %v", err)
+		}
+		if removed != 4 {
+			t.Fatalf("expected 4 removed items, got %d", removed)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package svc
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	winsBetweenQuery = "SELECT count(*) FROM match_participants mp JOIN matches m ON m.id = mp.match_id " +
+		"WHERE mp.user_id = $1 AND mp.placement = 1 AND m.played_at >= $2 AND m.played_at < $3"
+	grantMatchRewardQuery = "UPDATE users SET coins = coins + $1 WHERE id = $2"
+)
+
+// RewardsConfig is the coin reward table recorded matches are paid out with
+type RewardsConfig struct {
+	// Placement holds the coins for placement 1, 2 and so on, placements past its end get nothing
+	Placement []int
+	PerKill   int
+	// FirstWinOfDay is paid on top for the first win of a UTC day
+	FirstWinOfDay int
+}
+
+// reward computes the reward breakdown of a participant
+func (c RewardsConfig) reward(participant *pb.MatchParticipant, firstWin bool) *pb.MatchReward {
+	reward := &pb.MatchReward{UserId: participant.GetUserId()}
+	if placement := int(participant.GetPlacement()); placement <= len(c.Placement) {
+		reward.PlacementCoins = int32(c.Placement[placement-1])
+	}
+	reward.KillCoins = participant.GetKills() * int32(c.PerKill)
+	if firstWin {
+		reward.FirstWinCoins = int32(c.FirstWinOfDay)
+	}
+	reward.TotalCoins = reward.PlacementCoins + reward.KillCoins + reward.FirstWinCoins
+	return reward
+}
+
+// matchReward computes the reward of a participant whose stats row is already locked by the match
+// transaction, so two matches won at once can't both get the first win bonus
+func (s *UsersStatsServer) matchReward(logger *logrus.Entry, txnDB *sql.Tx, participant *pb.MatchParticipant, playedAt time.Time) (*pb.MatchReward, error) {
+	firstWin := false
+	if participant.GetPlacement() == 1 && s.cfg.Rewards.FirstWinOfDay > 0 {
+		day := startOfDay(playedAt)
+		var wins int
+		if err := txnDB.QueryRow(winsBetweenQuery, participant.GetUserId(), day, day.AddDate(0, 0, 1)).Scan(&wins); err != nil {
+			logger.WithError(err).WithField("user_id", participant.GetUserId()).Error("Could not check wins of the day")
+			return nil, status.Error(codes.Internal, "Could not record match")
+		}
+		firstWin = wins == 0
+	}
+	return s.cfg.Rewards.reward(participant, firstWin), nil
+}
@@ -0,0 +1,225 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	insertSeasonQuery       = "INSERT INTO seasons (name, starts_at, ends_at) VALUES ($1, $2, $3) RETURNING id"
+	overlappingSeasonsQuery = "SELECT count(*) FROM seasons WHERE starts_at < $2 AND ends_at > $1"
+	listSeasonsQuery        = "SELECT id, name, starts_at, ends_at, archived_at IS NOT NULL FROM seasons ORDER BY starts_at"
+	seasonArchivedQuery     = "SELECT archived_at IS NOT NULL FROM seasons WHERE id = $1"
+	// %s is season_stats or season_standings
+	seasonStatsQuery = "SELECT games, wins, top5, kills FROM %s WHERE season_id = $1 AND user_id = $2"
+	// the active season is locked for share so a rollover waits for the writes in flight,
+	// nothing is written when no season is running
+	applySeasonStatsQuery = "WITH season AS (SELECT id FROM seasons WHERE starts_at <= now() AND ends_at > now() AND archived_at IS NULL FOR SHARE) " +
+		"INSERT INTO season_stats (season_id, user_id, games, wins, top5, kills) SELECT id, $1::varchar, $2::int, $3::int, $4::int, $5::int FROM season " +
+		"ON CONFLICT (season_id, user_id) DO UPDATE SET games = season_stats.games + EXCLUDED.games, wins = season_stats.wins + EXCLUDED.wins, " +
+		"top5 = season_stats.top5 + EXCLUDED.top5, kills = season_stats.kills + EXCLUDED.kills"
+	endedSeasonsQuery          = "SELECT id FROM seasons WHERE ends_at <= now() AND archived_at IS NULL ORDER BY id"
+	lockEndedSeasonQuery       = "SELECT id FROM seasons WHERE id = $1 AND archived_at IS NULL FOR UPDATE"
+	freezeSeasonStandingsQuery = "INSERT INTO season_standings (season_id, user_id, games, wins, top5, kills) " +
+		"SELECT season_id, user_id, games, wins, top5, kills FROM season_stats WHERE season_id = $1"
+	clearSeasonStatsQuery = "DELETE FROM season_stats WHERE season_id = $1"
+	archiveSeasonQuery    = "UPDATE seasons SET archived_at = now() WHERE id = $1"
+)
+
+func (s *UsersStatsServer) CreateSeason(ctx context.Context, req *pb.CreateSeasonRequest) (*pb.CreateSeasonResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"name": req.GetName(),
+	})
+	logger.Debug("Create season")
+
+	if req.GetName() == "" {
+		logger.Error("Empty season name")
+		return nil, status.Error(codes.InvalidArgument, "Name should be set")
+	}
+	startsAt, err := ptypes.Timestamp(req.GetStartsAt())
+	if err != nil {
+		logger.WithError(err).Error("Invalid season start")
+		return nil, status.Error(codes.InvalidArgument, "Start time should be set")
+	}
+	endsAt, err := ptypes.Timestamp(req.GetEndsAt())
+	if err != nil || !endsAt.After(startsAt) {
+		logger.WithError(err).Error("Invalid season end")
+		return nil, status.Error(codes.InvalidArgument, "End time should be after the start time")
+	}
+
+	var overlapping int
+	if err := s.cfg.Database.DB().QueryRow(overlappingSeasonsQuery, startsAt, endsAt).Scan(&overlapping); err != nil {
+		logger.WithError(err).Error("Could not check overlapping seasons")
+		return nil, status.Error(codes.Internal, "Could not create season")
+	}
+	if overlapping > 0 {
+		logger.Error("Season overlaps another one")
+		return nil, status.Error(codes.FailedPrecondition, "Season overlaps another season")
+	}
+
+	season := &pb.Season{Name: req.GetName(), StartsAt: req.GetStartsAt(), EndsAt: req.GetEndsAt()}
+	if err := s.cfg.Database.DB().QueryRow(insertSeasonQuery, req.GetName(), startsAt, endsAt).Scan(&season.Id); err != nil {
+		logger.WithError(err).Error("Could not create season")
+		return nil, status.Error(codes.Internal, "Could not create season")
+	}
+
+	return &pb.CreateSeasonResponse{Result: season}, nil
+}
+
+func (s *UsersStatsServer) ListSeasons(ctx context.Context, req *pb.ListSeasonsRequest) (*pb.ListSeasonsResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("List seasons")
+
+	rows, err := s.cfg.Database.DB().Query(listSeasonsQuery)
+	if err != nil {
+		logger.WithError(err).Error("Could not list seasons")
+		return nil, status.Error(codes.Internal, "Could not list seasons")
+	}
+	defer rows.Close()
+
+	results := []*pb.Season{}
+	for rows.Next() {
+		var season pb.Season
+		var startsAt, endsAt time.Time
+		if err := rows.Scan(&season.Id, &season.Name, &startsAt, &endsAt, &season.Archived); err != nil {
+			logger.WithError(err).Error("Could not list seasons")
+			return nil, status.Error(codes.Internal, "Could not list seasons")
+		}
+		if season.StartsAt, err = ptypes.TimestampProto(startsAt); err != nil {
+			logger.WithError(err).Error("Could not list seasons")
+			return nil, status.Error(codes.Internal, "Could not list seasons")
+		}
+		if season.EndsAt, err = ptypes.TimestampProto(endsAt); err != nil {
+			logger.WithError(err).Error("Could not list seasons")
+			return nil, status.Error(codes.Internal, "Could not list seasons")
+		}
+		results = append(results, &season)
+	}
+
+	return &pb.ListSeasonsResponse{Results: results}, nil
+}
+
+// ArchiveEndedSeasons freezes the standings of seasons that are over and returns how many were archived
+func (s *UsersStatsServer) ArchiveEndedSeasons(ctx context.Context) (int, error) {
+	rows, err := s.cfg.Database.DB().QueryContext(ctx, endedSeasonsQuery)
+	if err != nil {
+		return 0, err
+	}
+	var ended []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ended = append(ended, id)
+	}
+	rows.Close()
+
+	archived := 0
+	for _, id := range ended {
+		ok, err := s.archiveSeason(ctx, id)
+		if err != nil {
+			return archived, err
+		}
+		if ok {
+			archived++
+		}
+	}
+	return archived, nil
+}
+
+// archiveSeason moves the season stats into the standings, false means another replica got there first
+func (s *UsersStatsServer) archiveSeason(ctx context.Context, id int32) (bool, error) {
+	txnDB, err := s.cfg.Database.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	var locked int32
+	err = txnDB.QueryRow(lockEndedSeasonQuery, id).Scan(&locked)
+	if err == sql.ErrNoRows {
+		txnDB.Rollback()
+		return false, nil
+	}
+	if err != nil {
+		txnDB.Rollback()
+		return false, err
+	}
+
+	for _, query := range []string{freezeSeasonStandingsQuery, clearSeasonStatsQuery, archiveSeasonQuery} {
+		if _, err := txnDB.Exec(query, id); err != nil {
+			txnDB.Rollback()
+			return false, err
+		}
+	}
+
+	return true, txnDB.Commit()
+}
+
+// RunSeasonRollover archives ended seasons every interval until ctx is done
+func RunSeasonRollover(ctx context.Context, s *UsersStatsServer, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			archived, err := s.ArchiveEndedSeasons(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Could not archive ended seasons")
+				continue
+			}
+			if archived > 0 {
+				logger.WithField("archived", archived).Info("Archived ended seasons")
+			}
+		}
+	}
+}
+
+// seasonStatsTable returns the table holding the stats of the season, season_standings once it's archived
+func (s *UsersStatsServer) seasonStatsTable(logger *logrus.Entry, seasonID int32) (string, error) {
+	var archived bool
+	err := s.cfg.Database.DB().QueryRow(seasonArchivedQuery, seasonID).Scan(&archived)
+	if err == sql.ErrNoRows {
+		logger.Error("Season not found")
+		return "", status.Error(codes.NotFound, "Season not found")
+	}
+	if err != nil {
+		logger.WithError(err).Error("Could not find season")
+		return "", status.Error(codes.Internal, "Could not find season")
+	}
+	if archived {
+		return "season_standings", nil
+	}
+	return "season_stats", nil
+}
+
+// getSeasonStats returns the stats of the user in the season, users who didn't play in it get zeros
+func (s *UsersStatsServer) getSeasonStats(logger *logrus.Entry, userID string, seasonID int32) (*pb.UserStats, error) {
+	table, err := s.seasonStatsTable(logger, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &pb.UserStats{}
+	err = s.cfg.Database.DB().QueryRow(fmt.Sprintf(seasonStatsQuery, table), seasonID, userID).
+		Scan(&stats.Games, &stats.Wins, &stats.Top5, &stats.Kills)
+	if err != nil && err != sql.ErrNoRows {
+		logger.WithError(err).Error("Could not fetch season stats")
+		return nil, status.Error(codes.Internal, "Could not fetch user stats")
+	}
+
+	return stats, nil
+}
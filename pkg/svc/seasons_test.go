@@ -0,0 +1,205 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSeasons(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	usrServer, err := NewUsersServer(&UsersServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users server: %v", err)
+	}
+	pb.RegisterUsersServer(server.GRPCServer, usrServer)
+
+	stServer, err := NewUsersStatsServer(&UsersStatsServerConfig{
+		Database:    gdb,
+		UsersServer: usrServer,
+	})
+	if err != nil {
+		t.Fatalf("Could not create users stats server: %v", err)
+	}
+	pb.RegisterUsersStatsServer(server.GRPCServer, stServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stClient := pb.NewUsersStatsClient(conn)
+
+	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 3, 0)
+	startProto, _ := ptypes.TimestampProto(start)
+	endProto, _ := ptypes.TimestampProto(end)
+
+	t.Run("Create Season - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(overlappingSeasonsQuery)).WithArgs(start, end).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta(insertSeasonQuery)).WithArgs("Season 1", start, end).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		res, err := stClient.CreateSeason(ctx, &pb.CreateSeasonRequest{Name: "Season 1", StartsAt: startProto, EndsAt: endProto})
+		if err != nil {
+			t.Fatalf("error creating season: %v", err)
+		}
+		if res.GetResult().GetId() != 1 {
+			t.Fatalf("unexpected season: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create Season - overlapping", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(overlappingSeasonsQuery)).WithArgs(start, end).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		_, err := stClient.CreateSeason(ctx, &pb.CreateSeasonRequest{Name: "Season 1b", StartsAt: startProto, EndsAt: endProto})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Create Season - ends before start", func(t *testing.T) {
+		_, err := stClient.CreateSeason(ctx, &pb.CreateSeasonRequest{Name: "Season 0", StartsAt: endProto, EndsAt: startProto})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("List Seasons", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(listSeasonsQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "starts_at", "ends_at", "archived"}).
+				AddRow(1, "Season 1", start, end, true))
+
+		res, err := stClient.ListSeasons(ctx, &pb.ListSeasonsRequest{})
+		if err != nil {
+			t.Fatalf("error listing seasons: %v", err)
+		}
+		if len(res.GetResults()) != 1 || !res.GetResults()[0].GetArchived() || res.GetResults()[0].GetEndsAt().GetSeconds() != end.Unix() {
+			t.Fatalf("unexpected seasons: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Stats - archived season", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-id", "some-name"))
+		mock.ExpectQuery(regexp.QuoteMeta(seasonArchivedQuery)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(true))
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(seasonStatsQuery, "season_standings"))).WithArgs(1, "some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"games", "wins", "top5", "kills"}).AddRow(30, 4, 12, 80))
+
+		res, err := stClient.GetStats(ctx, &pb.ReadUserStatsRequest{Username: "some-id", SeasonId: 1})
+		if err != nil {
+			t.Fatalf("error reading season stats: %v", err)
+		}
+		if res.GetResult().GetGames() != 30 || res.GetResult().GetKills() != 80 {
+			t.Fatalf("unexpected season stats: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Stats - unknown season", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-id", "some-name"))
+		mock.ExpectQuery(regexp.QuoteMeta(seasonArchivedQuery)).WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"archived"}))
+
+		_, err := stClient.GetStats(ctx, &pb.ReadUserStatsRequest{Username: "some-id", SeasonId: 7})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get Leaderboard - running season", func(t *testing.T) {
+		sqlSeasonWins := fmt.Sprintf(leaderboardQuery, "wins", "DESC", "<", leaderboardSource("season_stats", true))
+		mock.ExpectQuery(regexp.QuoteMeta(seasonArchivedQuery)).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"archived"}).AddRow(false))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSeasonWins)).WithArgs("", 0, defaultLeaderboardLimit, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"rank", "user_id", "name", "value"}).AddRow(1, "some-id", "some-name", 3))
+
+		res, err := stClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{SeasonId: 2})
+		if err != nil {
+			t.Fatalf("error getting leaderboard: %v", err)
+		}
+		if len(res.GetEntries()) != 1 || res.GetEntries()[0].GetValue() != 3 {
+			t.Fatalf("unexpected leaderboard: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Archive Ended Seasons", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(endedSeasonsQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockEndedSeasonQuery)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(regexp.QuoteMeta(freezeSeasonStandingsQuery)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 40))
+		mock.ExpectExec(regexp.QuoteMeta(clearSeasonStatsQuery)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 40))
+		mock.ExpectExec(regexp.QuoteMeta(archiveSeasonQuery)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockEndedSeasonQuery)).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		archived, err := stServer.ArchiveEndedSeasons(context.TODO())
+		if err != nil {
+			t.Fatalf("error archiving seasons: %v", err)
+		}
+		if archived != 1 {
+			t.Fatalf("expected 1 archived season, got %d", archived)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
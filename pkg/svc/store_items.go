@@ -2,10 +2,13 @@ package svc
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"strings"
+	"math"
+	"time"
 
 	"github.com/amikhailau/users-service/pkg/auth"
+	"github.com/amikhailau/users-service/pkg/images"
 	"github.com/amikhailau/users-service/pkg/pb"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"github.com/jinzhu/gorm"
@@ -17,6 +20,12 @@ import (
 
 type StoreItemsServerConfig struct {
 	Database *gorm.DB
+	// GiftsPerDay limits how many gifts a user can send per UTC day, 0 means no limit
+	GiftsPerDay int
+	// Images holds uploaded images, without it image ids aren't checked
+	Images images.BlobStore
+	// Locales decides the language List returns items in
+	Locales LocaleConfig
 }
 
 type StoreItemsServer struct {
@@ -27,11 +36,29 @@ type StoreItemsServer struct {
 var _ pb.StoreItemsServer = &StoreItemsServer{}
 
 const (
+	itemRetiredErrorMsg         = "Item is retired"
+	consumableItemOwnedErrorMsg = "Consumable can't change while players own the item"
+
+	retireItemQuery        = "UPDATE store_items SET retired = 't' WHERE id = $1"
 	deequipQuery           = "UPDATE users_store_items SET equipped = 'f' WHERE user_id = $1 AND store_item_id = $2"
-	equipQuery             = "UPDATE users_store_items SET equipped = 't' WHERE user_id = $1 AND store_item_id = $2"
-	userItemsQuery         = "SELECT store_item_id, equipped FROM users_store_items WHERE user_id = $1"
-	equippedUserItemsQuery = "SELECT store_item_id, equipped FROM users_store_items WHERE user_id = $1 AND equipped = 't'"
-	findEquippedQuery      = "SELECT si.id FROM store_items si JOIN users_store_items usi ON usi.store_item_id = si.id WHERE si.type = $1 AND usi.user_id = $2 AND usi.equipped = $3"
+	equipQuery             = "UPDATE users_store_items SET equipped = 't' WHERE user_id = $1 AND store_item_id = $2 AND (expires_at IS NULL OR expires_at > now())"
+	userItemsQuery         = "SELECT store_item_id, equipped, quantity, expires_at FROM users_store_items WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > now())"
+	equippedUserItemsQuery = "SELECT store_item_id, equipped, quantity, expires_at FROM users_store_items WHERE user_id = $1 AND equipped = 't' AND (expires_at IS NULL OR expires_at > now())"
+	findEquippedQuery      = "SELECT si.id FROM store_items si JOIN users_store_items usi ON usi.store_item_id = si.id JOIN item_types it ON it.id = si.type WHERE it.slot = $1 AND usi.user_id = $2 AND usi.equipped = $3 AND (usi.expires_at IS NULL OR usi.expires_at > now())"
+	addToStackQuery        = "UPDATE users_store_items SET quantity = quantity + $1 WHERE user_id = $2 AND store_item_id = $3 AND ($4 = 0 OR quantity + $1 <= $4)"
+	// buying an item that is only rented makes it permanent
+	buyItemQuery = "INSERT INTO users_store_items (user_id, store_item_id) VALUES ($1, $2) " +
+		"ON CONFLICT (user_id, store_item_id) DO UPDATE SET expires_at = NULL, source = 'purchase' WHERE users_store_items.expires_at IS NOT NULL"
+	// renting an item that is already rented extends the rental
+	rentItemQuery = "INSERT INTO users_store_items (user_id, store_item_id, source, expires_at) VALUES ($1, $2, 'rental', now() + make_interval(days => $3)) " +
+		"ON CONFLICT (user_id, store_item_id) DO UPDATE SET expires_at = GREATEST(users_store_items.expires_at, now()) + make_interval(days => $3) WHERE users_store_items.expires_at IS NOT NULL"
+	insertStackQuery      = "INSERT INTO users_store_items (user_id, store_item_id, quantity) VALUES ($1, $2, $3)"
+	consumeQuery          = "UPDATE users_store_items SET quantity = quantity - $1 WHERE user_id = $2 AND store_item_id = $3 AND quantity >= $1 RETURNING quantity"
+	deleteEmptyStackQuery = "DELETE FROM users_store_items WHERE user_id = $1 AND store_item_id = $2 AND quantity = 0"
+	lockOwnedItemQuery    = "SELECT id, source, quantity, expires_at FROM users_store_items WHERE user_id = $1 AND store_item_id = $2 FOR UPDATE"
+	deleteOwnedItemQuery  = "DELETE FROM users_store_items WHERE id = $1"
+	countItemOwnersQuery  = "SELECT count(*) FROM users_store_items WHERE store_item_id = $1"
+	ownedItemsRawQuery    = "SELECT DISTINCT store_item_id FROM users_store_items WHERE store_item_id IN (?)"
 )
 
 func NewStoreItemsServer(cfg *StoreItemsServerConfig) (*StoreItemsServer, error) {
@@ -48,21 +75,60 @@ func (s *StoreItemsServer) Create(ctx context.Context, req *pb.CreateStoreItemRe
 	})
 	logger.Debug("Create Item")
 
+	if req.GetMaxStack() < 0 {
+		logger.Error("Negative max stack")
+		return nil, status.Error(codes.InvalidArgument, "Max stack can't be negative")
+	}
+
+	if req.GetRentalDays() < 0 || (req.GetRentalDays() > 0 && req.GetConsumable()) {
+		logger.Error("Rental validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Rental days can't be negative and consumables can't be rented")
+	}
+
+	if _, err := s.findItemType(logger, req.GetType()); err != nil {
+		return nil, err
+	}
+
+	if err := checkImageExists(ctx, logger, s.cfg.Images, req.GetImageId()); err != nil {
+		return nil, err
+	}
+
 	if err := s.checkIfItemExists(logger, req.GetName(), req.GetImageId(), req.GetType()); err != nil {
 		return nil, err
 	}
 
+	id := uuid.NewV4().String()
+	sku := req.GetSku()
+	if sku == "" {
+		sku = id
+	} else {
+		var existingItem pb.StoreItemORM
+		if err := s.cfg.Database.Where("sku = ?", sku).First(&existingItem).Error; err == nil {
+			logger.Error("Item with such SKU already exists")
+			return nil, status.Error(codes.InvalidArgument, "Item with such SKU already exists")
+		} else if err != gorm.ErrRecordNotFound {
+			logger.WithError(err).Error("Could not create new item")
+			return nil, status.Error(codes.Internal, "Could not create new item")
+		}
+	}
+
 	newItem := pb.StoreItemORM{
-		Id:             uuid.NewV4().String(),
-		Name:           req.GetName(),
-		Description:    req.GetDescription(),
-		Type:           req.GetType(),
-		CoinsPrice:     req.GetCoinsPrice(),
-		GemsPrice:      req.GetGemsPrice(),
-		ImageId:        req.GetImageId(),
-		OnSale:         req.GetOnSale(),
-		SaleCoinsPrice: req.GetSaleCoinsPrice(),
-		SaleGemsPrice:  req.GetSaleGemsPrice(),
+		Id:               id,
+		Sku:              sku,
+		Name:             req.GetName(),
+		Description:      req.GetDescription(),
+		Type:             req.GetType(),
+		CoinsPrice:       req.GetCoinsPrice(),
+		GemsPrice:        req.GetGemsPrice(),
+		ImageId:          req.GetImageId(),
+		OnSale:           req.GetOnSale(),
+		SaleCoinsPrice:   req.GetSaleCoinsPrice(),
+		SaleGemsPrice:    req.GetSaleGemsPrice(),
+		Consumable:       req.GetConsumable(),
+		MaxStack:         req.GetMaxStack(),
+		RentalDays:       req.GetRentalDays(),
+		RentalCoinsPrice: req.GetRentalCoinsPrice(),
+		RentalGemsPrice:  req.GetRentalGemsPrice(),
 	}
 
 	if err := s.cfg.Database.Create(&newItem).Error; err != nil {
@@ -113,8 +179,38 @@ func (s *StoreItemsServer) Update(ctx context.Context, req *pb.UpdateStoreItemRe
 		return nil, err
 	}
 
+	if imageID := req.GetPayload().GetImageId(); imageID != "" && imageID != resp.GetResult().GetImageId() {
+		if err := checkImageExists(ctx, logger, s.cfg.Images, imageID); err != nil {
+			return nil, err
+		}
+	}
+
+	consumable := resp.GetResult().GetConsumable()
+	if req.GetFields() != nil {
+		for _, path := range req.GetFields().GetPaths() {
+			if path == "consumable" {
+				consumable = req.GetPayload().GetConsumable()
+			}
+		}
+	} else if req.GetPayload().GetConsumable() {
+		consumable = true
+	}
+	if consumable != resp.GetResult().GetConsumable() {
+		if err := s.checkItemNotOwned(logger, resp.GetResult().GetId()); err != nil {
+			return nil, err
+		}
+	}
+
 	var gormReq *pb.UpdateStoreItemRequest
 	if req.GetFields() != nil {
+		for _, path := range req.GetFields().GetPaths() {
+			if path != "type" {
+				continue
+			}
+			if _, err := s.findItemType(logger, req.GetPayload().GetType()); err != nil {
+				return nil, err
+			}
+		}
 		gormReq = req
 	} else {
 		item := resp.GetResult()
@@ -138,6 +234,17 @@ func (s *StoreItemsServer) Update(ctx context.Context, req *pb.UpdateStoreItemRe
 			item.SaleCoinsPrice = req.GetPayload().GetSaleCoinsPrice()
 			item.SaleGemsPrice = req.GetPayload().GetSaleGemsPrice()
 		}
+		if req.GetPayload().GetConsumable() {
+			item.Consumable = true
+		}
+		if req.GetPayload().GetMaxStack() != 0 {
+			item.MaxStack = req.GetPayload().GetMaxStack()
+		}
+		if req.GetPayload().GetRentalDays() != 0 {
+			item.RentalDays = req.GetPayload().GetRentalDays()
+			item.RentalCoinsPrice = req.GetPayload().GetRentalCoinsPrice()
+			item.RentalGemsPrice = req.GetPayload().GetRentalGemsPrice()
+		}
 		gormReq = &pb.UpdateStoreItemRequest{Payload: item}
 	}
 
@@ -153,39 +260,74 @@ func (s *StoreItemsServer) Update(ctx context.Context, req *pb.UpdateStoreItemRe
 	return res, nil
 }
 
+// Delete retires the item: it disappears from the store, but players who own it keep it.
+// PurgeItem removes retired items for good.
 func (s *StoreItemsServer) Delete(ctx context.Context, req *pb.DeleteStoreItemRequest) (*pb.DeleteStoreItemResponse, error) {
 	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
 	logger.Debug("Delete Item")
 
-	var item pb.StoreItemORM
-	if err := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&item).Error; err != nil && err != gorm.ErrRecordNotFound {
+	res, err := s.cfg.Database.DB().Exec(retireItemQuery, req.GetId())
+	if err != nil {
 		logger.WithError(err).Error("Could not delete item")
 		return nil, status.Error(codes.Internal, "Could not delete item")
 	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		logger.Error("Item not found")
+		return nil, status.Error(codes.NotFound, "Item not found")
+	}
 
 	return &pb.DeleteStoreItemResponse{}, nil
 }
 
 func (s *StoreItemsServer) List(ctx context.Context, req *pb.ListStoreItemsRequest) (*pb.ListStoreItemsResponse, error) {
-	logger := ctxlogrus.Extract(ctx)
+	locale := s.cfg.Locales.Resolve(ctx, req.GetLocale())
+	logger := ctxlogrus.Extract(ctx).WithField("locale", locale)
 	logger.Debug("List Items")
 
-	res, err := pb.DefaultListStoreItem(ctx, s.cfg.Database, req.GetFilter(), req.GetOrderBy(), req.GetPaging(), req.GetFields())
+	db := s.cfg.Database
+	if req.GetIncludeRetired() {
+		claims, _ := auth.GetAuthorizationData(ctx)
+		if !claims.IsAdmin && claims.StandardClaims.Audience != "svc" {
+			logger.Error("Only admins can list retired items")
+			return nil, status.Error(codes.Unauthenticated, "Not authorized to list retired items")
+		}
+	} else {
+		db = db.Where("NOT retired")
+	}
+
+	res, err := pb.DefaultListStoreItem(ctx, db, req.GetFilter(), req.GetOrderBy(), req.GetPaging(), req.GetFields())
 	if err != nil {
 		logger.WithError(err).Error("Could not list items")
 		return nil, status.Error(codes.Internal, "Could not list items")
 	}
 
-	return &pb.ListStoreItemsResponse{Results: res}, nil
+	if s.cfg.Locales.IsTranslation(locale) && len(res) > 0 {
+		if err := s.translateItems(logger, locale, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListStoreItemsResponse{Results: res, Locale: locale}, nil
 }
 
 func (s *StoreItemsServer) BuyByUser(ctx context.Context, req *pb.BuyByUserRequest) (*pb.BuyByUserResponse, error) {
 	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
-		"user_id": req.GetUserId(),
-		"item_id": req.GetItemId(),
+		"user_id":  req.GetUserId(),
+		"item_id":  req.GetItemId(),
+		"quantity": req.GetQuantity(),
+		"rent":     req.GetRent(),
 	})
 	logger.Debug("Buying item")
 
+	quantity := req.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		logger.Error("Negative quantity")
+		return nil, status.Error(codes.InvalidArgument, "Quantity can't be negative")
+	}
+
 	claims, _ := auth.GetAuthorizationData(ctx)
 	if !claims.IsAdmin && claims.UserId != req.GetUserId() {
 		logger.Error("User can only use this endpoint for themselves")
@@ -213,32 +355,31 @@ func (s *StoreItemsServer) BuyByUser(ctx context.Context, req *pb.BuyByUserReque
 		return nil, status.Error(codes.Internal, "Could not find item")
 	}
 
-	if item.OnSale {
-		if usr.Gems < item.SaleGemsPrice {
-			logger.Error("Not enough gems")
-			return nil, status.Error(codes.InvalidArgument, "Not enough gems")
-		}
-
-		if usr.Coins < item.SaleCoinsPrice {
-			logger.Error("Not enough coins")
-			return nil, status.Error(codes.InvalidArgument, "Not enough coins")
-		}
+	if item.Retired {
+		logger.Error(itemRetiredErrorMsg)
+		return nil, status.Error(codes.FailedPrecondition, itemRetiredErrorMsg)
+	}
 
-		usr.Gems -= item.SaleGemsPrice
-		usr.Coins -= item.SaleCoinsPrice
-	} else {
-		if usr.Gems < item.GemsPrice {
-			logger.Error("Not enough gems")
-			return nil, status.Error(codes.InvalidArgument, "Not enough gems")
-		}
+	if !item.Consumable && quantity != 1 {
+		logger.Error("Only consumables can be bought in bulk")
+		return nil, status.Error(codes.InvalidArgument, "Only consumable items can be bought in quantity")
+	}
+	if item.MaxStack > 0 && quantity > item.MaxStack {
+		logger.Error("Quantity exceeds max stack")
+		return nil, status.Error(codes.InvalidArgument, "Quantity exceeds max stack")
+	}
 
-		if usr.Coins < item.CoinsPrice {
-			logger.Error("Not enough coins")
-			return nil, status.Error(codes.InvalidArgument, "Not enough coins")
+	coinsPrice, gemsPrice := itemPrice(&item)
+	if req.GetRent() {
+		if item.RentalDays <= 0 {
+			logger.Error("Item can't be rented")
+			return nil, status.Error(codes.FailedPrecondition, "Item can't be rented")
 		}
+		coinsPrice, gemsPrice = item.RentalCoinsPrice, item.RentalGemsPrice
+	}
 
-		usr.Gems -= item.GemsPrice
-		usr.Coins -= item.CoinsPrice
+	if err := chargeUser(logger, &usr, coinsPrice, gemsPrice, quantity); err != nil {
+		return nil, err
 	}
 
 	txnDB := s.cfg.Database.Begin()
@@ -249,10 +390,26 @@ func (s *StoreItemsServer) BuyByUser(ctx context.Context, req *pb.BuyByUserReque
 		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
 	}
 
-	if err := txnDB.Model(&usr).Association("Items").Append(&item).Error; err != nil {
-		txnDB.Rollback()
-		logger.WithError(err).Error("Could not proceed with the operation")
-		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
+	if item.Consumable {
+		if err := s.addToStack(logger, txnDB, usr.Id, &item, quantity); err != nil {
+			txnDB.Rollback()
+			return nil, err
+		}
+	} else {
+		res := txnDB.Exec(buyItemQuery, usr.Id, item.Id)
+		if req.GetRent() {
+			res = txnDB.Exec(rentItemQuery, usr.Id, item.Id, item.RentalDays)
+		}
+		if res.Error != nil {
+			txnDB.Rollback()
+			logger.WithError(res.Error).Error("Could not proceed with the operation")
+			return nil, status.Error(codes.Internal, "Could not proceed with the operation")
+		}
+		if res.RowsAffected == 0 {
+			txnDB.Rollback()
+			logger.Error("Item is already owned")
+			return nil, status.Error(codes.AlreadyExists, "Item is already owned")
+		}
 	}
 
 	txnDB.Commit()
@@ -260,6 +417,62 @@ func (s *StoreItemsServer) BuyByUser(ctx context.Context, req *pb.BuyByUserReque
 	return &pb.BuyByUserResponse{}, nil
 }
 
+func (s *StoreItemsServer) ConsumeItem(ctx context.Context, req *pb.ConsumeItemRequest) (*pb.ConsumeItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"user_id":  req.GetUserId(),
+		"item_id":  req.GetItemId(),
+		"quantity": req.GetQuantity(),
+	})
+	logger.Debug("Consuming item")
+
+	quantity := req.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		logger.Error("Negative quantity")
+		return nil, status.Error(codes.InvalidArgument, "Quantity can't be negative")
+	}
+
+	item, err := s.Read(ctx, &pb.ReadStoreItemRequest{Id: req.GetItemId()})
+	if err != nil {
+		return nil, err
+	}
+	if !item.GetResult().GetConsumable() {
+		logger.Error("Item is not consumable")
+		return nil, status.Error(codes.FailedPrecondition, "Item is not consumable")
+	}
+
+	txnDB, err := s.cfg.Database.DB().Begin()
+	if err != nil {
+		logger.WithError(err).Error("Could not start transaction")
+		return nil, status.Error(codes.Internal, "Could not consume item")
+	}
+
+	var remaining int32
+	if err := txnDB.QueryRow(consumeQuery, quantity, req.GetUserId(), req.GetItemId()).Scan(&remaining); err != nil {
+		txnDB.Rollback()
+		if err == sql.ErrNoRows {
+			logger.Error("Not enough items")
+			return nil, status.Error(codes.FailedPrecondition, "Not enough items")
+		}
+		logger.WithError(err).Error("Could not consume item")
+		return nil, status.Error(codes.Internal, "Could not consume item")
+	}
+
+	if remaining == 0 {
+		if _, err := txnDB.Exec(deleteEmptyStackQuery, req.GetUserId(), req.GetItemId()); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not remove empty stack")
+			return nil, status.Error(codes.Internal, "Could not consume item")
+		}
+	}
+
+	txnDB.Commit()
+
+	return &pb.ConsumeItemResponse{Remaining: remaining}, nil
+}
+
 func (s *StoreItemsServer) ThrowAwayByUser(ctx context.Context, req *pb.ThrowAwayByUserRequest) (*pb.ThrowAwayByUserResponse, error) {
 	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
 		"user_id": req.GetUserId(),
@@ -288,29 +501,40 @@ func (s *StoreItemsServer) ThrowAwayByUser(ctx context.Context, req *pb.ThrowAwa
 		return nil, status.Error(codes.Internal, "Could not find item")
 	}
 
-	if item.OnSale {
-		usr.Gems += item.SaleGemsPrice
-		usr.Coins += item.SaleCoinsPrice
-	} else {
-		usr.Gems += item.GemsPrice
-		usr.Coins += item.CoinsPrice
-	}
-
 	txnDB := s.cfg.Database.Begin()
 
-	if err := txnDB.Save(&usr).Error; err != nil {
+	var ownedID int32
+	var source string
+	var quantity int32
+	var expiresAt *time.Time
+	if err := txnDB.CommonDB().QueryRow(lockOwnedItemQuery, usr.Id, item.Id).Scan(&ownedID, &source, &quantity, &expiresAt); err != nil {
 		txnDB.Rollback()
+		if err == sql.ErrNoRows {
+			logger.Error("User doesn't own the item")
+			return nil, status.Error(codes.NotFound, "User doesn't own the item")
+		}
 		logger.WithError(err).Error("Could not proceed with the operation")
 		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
 	}
 
-	if err := txnDB.Model(&usr).Association("Items").Delete(&item).Error; err != nil {
+	if _, err := txnDB.CommonDB().Exec(deleteOwnedItemQuery, ownedID); err != nil {
 		txnDB.Rollback()
 		logger.WithError(err).Error("Could not proceed with the operation")
 		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
 	}
 
-	txnDB.Commit()
+	if coinsRefund, gemsRefund := throwAwayRefund(&item, source, quantity, expiresAt); coinsRefund > 0 || gemsRefund > 0 {
+		if _, err := txnDB.CommonDB().Exec(refundCurrenciesQuery, coinsRefund, gemsRefund, usr.Id); err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not proceed with the operation")
+			return nil, status.Error(codes.Internal, "Could not proceed with the operation")
+		}
+	}
+
+	if err := txnDB.Commit().Error; err != nil {
+		logger.WithError(err).Error("Could not proceed with the operation")
+		return nil, status.Error(codes.Internal, "Could not proceed with the operation")
+	}
 
 	return &pb.ThrowAwayByUserResponse{}, nil
 }
@@ -341,14 +565,14 @@ func (s *StoreItemsServer) GetUserItemsIds(ctx context.Context, req *pb.GetUserI
 		logger.WithError(err).Error("Could not fetch user items")
 		return nil, status.Error(codes.Internal, "Could not fetch user items")
 	}
+	now := time.Now()
 	for rows.Next() {
-		item := pb.UserItemInfo{}
-		err := rows.Scan(&item.ItemId, &item.Equipped)
+		item, err := scanUserItem(rows, now)
 		if err != nil {
 			logger.WithError(err).Error("Could not fetch user items")
 			return nil, status.Error(codes.Internal, "Could not fetch user items")
 		}
-		items = append(items, &item)
+		items = append(items, item)
 	}
 
 	return &pb.GetUserItemsIdsResponse{Items: items}, nil
@@ -380,14 +604,14 @@ func (s *StoreItemsServer) GetEquippedUserItemsIds(ctx context.Context, req *pb.
 		logger.WithError(err).Error("Could not fetch user items")
 		return nil, status.Error(codes.Internal, "Could not fetch user items")
 	}
+	now := time.Now()
 	for rows.Next() {
-		item := pb.UserItemInfo{}
-		err := rows.Scan(&item.ItemId, &item.Equipped)
+		item, err := scanUserItem(rows, now)
 		if err != nil {
 			logger.WithError(err).Error("Could not fetch user items")
 			return nil, status.Error(codes.Internal, "Could not fetch user items")
 		}
-		items = append(items, &item)
+		items = append(items, item)
 	}
 
 	return &pb.GetEquippedUserItemsIdsResponse{Items: items}, nil
@@ -411,23 +635,44 @@ func (s *StoreItemsServer) EquipByUser(ctx context.Context, req *pb.EquipByUserR
 		return nil, err
 	}
 
-	var itemEquippedId string
-	found := true
-	if err := s.cfg.Database.DB().QueryRow(findEquippedQuery, item.GetResult().GetType(), req.GetUserId(), true).Scan(&itemEquippedId); err != nil {
-		if !strings.Contains(err.Error(), "no rows") {
-			logger.WithError(err).Error("Could not fetch equipped item")
+	itemType, err := s.findItemType(logger, item.GetResult().GetType())
+	if err != nil {
+		return nil, err
+	}
+	if itemType.Slot == "" {
+		logger.Error("Item type has no equipment slot")
+		return nil, status.Error(codes.FailedPrecondition, "Items of this type can't be equipped")
+	}
+
+	rows, err := s.cfg.Database.DB().Query(findEquippedQuery, itemType.Slot, req.GetUserId(), true)
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch equipped items")
+		return nil, status.Error(codes.Internal, "Could not equip item")
+	}
+	defer rows.Close()
+
+	equippedIds := []string{}
+	for rows.Next() {
+		var itemEquippedId string
+		if err := rows.Scan(&itemEquippedId); err != nil {
+			logger.WithError(err).Error("Could not fetch equipped items")
 			return nil, status.Error(codes.Internal, "Could not equip item")
 		}
-		found = false
+		if itemEquippedId == item.GetResult().GetId() {
+			logger.Debug("Item has been already equipped, deequipping it")
+			if _, err := s.cfg.Database.DB().Exec(deequipQuery, req.GetUserId(), itemEquippedId); err != nil {
+				logger.WithError(err).Error("Could not deequip item")
+				return nil, status.Error(codes.Internal, "Could not deequip item")
+			}
+			return &pb.EquipByUserResponse{}, nil
+		}
+		equippedIds = append(equippedIds, itemEquippedId)
 	}
 
-	if found && itemEquippedId == item.GetResult().GetId() {
-		logger.Debug("Item has been already equipped, deequipping it")
-		if _, err := s.cfg.Database.DB().Exec(deequipQuery, req.GetUserId(), itemEquippedId); err != nil {
-			logger.WithError(err).Error("Could not deequip item")
-			return nil, status.Error(codes.Internal, "Could not deequip item")
-		}
-		return &pb.EquipByUserResponse{}, nil
+	// a full single item slot is swapped, bigger slots have to be freed by the player
+	if int32(len(equippedIds)) >= itemType.SlotCapacity && itemType.SlotCapacity > 1 {
+		logger.Error("Equipment slot is full")
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Slot %s is full", itemType.Slot))
 	}
 
 	txnDB, err := s.cfg.Database.DB().Begin()
@@ -436,11 +681,13 @@ func (s *StoreItemsServer) EquipByUser(ctx context.Context, req *pb.EquipByUserR
 		return nil, status.Error(codes.Internal, "Could not equip item")
 	}
 
-	if found {
-		if _, err := txnDB.Exec(deequipQuery, req.GetUserId(), itemEquippedId); err != nil {
-			txnDB.Rollback()
-			logger.WithError(err).Error("Could not deequip item")
-			return nil, status.Error(codes.Internal, "Could not equip item")
+	if int32(len(equippedIds)) >= itemType.SlotCapacity {
+		for _, itemEquippedId := range equippedIds {
+			if _, err := txnDB.Exec(deequipQuery, req.GetUserId(), itemEquippedId); err != nil {
+				txnDB.Rollback()
+				logger.WithError(err).Error("Could not deequip item")
+				return nil, status.Error(codes.Internal, "Could not equip item")
+			}
 		}
 	}
 
@@ -476,3 +723,106 @@ func (s *StoreItemsServer) checkIfItemExists(logger *logrus.Entry, name, image_i
 
 	return nil
 }
+
+// checkItemNotOwned refuses consumable changes of owned items, stacks and single copies would no longer match the item
+func (s *StoreItemsServer) checkItemNotOwned(logger *logrus.Entry, itemID string) error {
+	var owners int
+	if err := s.cfg.Database.DB().QueryRow(countItemOwnersQuery, itemID).Scan(&owners); err != nil {
+		logger.WithError(err).Error("Could not check item owners")
+		return status.Error(codes.Internal, "Could not update item")
+	}
+	if owners > 0 {
+		logger.Error(consumableItemOwnedErrorMsg)
+		return status.Error(codes.FailedPrecondition, consumableItemOwnedErrorMsg)
+	}
+	return nil
+}
+
+// imageExists reports whether imageID was uploaded, see uploadedImageExists
+func (s *StoreItemsServer) imageExists(ctx context.Context, imageID string) (bool, error) {
+	return uploadedImageExists(ctx, s.cfg.Images, imageID)
+}
+
+// throwAwayRefund is what throwing away quantity owned items gives back. Only bought items are refunded, at their
+// current price; rentals are never refunded since the price they were paid isn't kept, grants were free and
+// refunding gifts would let the sender's payment reach the recipient as currency, around the daily gift limit
+func throwAwayRefund(item *pb.StoreItemORM, source string, quantity int32, expiresAt *time.Time) (int32, int32) {
+	if source != "purchase" || expiresAt != nil {
+		return 0, 0
+	}
+	coinsPrice, gemsPrice := itemPrice(item)
+	return cappedTotal(coinsPrice, quantity), cappedTotal(gemsPrice, quantity)
+}
+
+// cappedTotal returns price*quantity, capped to fit into int32
+func cappedTotal(price, quantity int32) int32 {
+	total := int64(price) * int64(quantity)
+	if total > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(total)
+}
+
+// itemPrice returns the coins and gems price the item is currently sold for
+func itemPrice(item *pb.StoreItemORM) (int32, int32) {
+	if item.OnSale {
+		return item.SaleCoinsPrice, item.SaleGemsPrice
+	}
+	return item.CoinsPrice, item.GemsPrice
+}
+
+// chargeUser deducts the price of quantity items from the user balance without saving it
+func chargeUser(logger *logrus.Entry, usr *pb.UserORM, coinsPrice, gemsPrice, quantity int32) error {
+	if quantity > 1 {
+		totalCoins, totalGems := int64(coinsPrice)*int64(quantity), int64(gemsPrice)*int64(quantity)
+		if totalCoins > math.MaxInt32 || totalGems > math.MaxInt32 {
+			logger.Error("Total price overflows")
+			return status.Error(codes.InvalidArgument, "Quantity is too big")
+		}
+		coinsPrice, gemsPrice = int32(totalCoins), int32(totalGems)
+	}
+
+	if usr.Gems < gemsPrice {
+		logger.Error("Not enough gems")
+		return status.Error(codes.InvalidArgument, "Not enough gems")
+	}
+
+	if usr.Coins < coinsPrice {
+		logger.Error("Not enough coins")
+		return status.Error(codes.InvalidArgument, "Not enough coins")
+	}
+
+	usr.Gems -= gemsPrice
+	usr.Coins -= coinsPrice
+
+	return nil
+}
+
+// addToStack grows the user stack of a consumable, creating it on the first purchase
+func (s *StoreItemsServer) addToStack(logger *logrus.Entry, txnDB *gorm.DB, userID string, item *pb.StoreItemORM, quantity int32) error {
+	res := txnDB.Exec(addToStackQuery, quantity, userID, item.Id, item.MaxStack)
+	if res.Error != nil {
+		logger.WithError(res.Error).Error("Could not add items to stack")
+		return status.Error(codes.Internal, "Could not proceed with the operation")
+	}
+	if res.RowsAffected > 0 {
+		return nil
+	}
+
+	var owned int
+	if err := txnDB.Raw(ownsItemQuery, userID, item.Id).Row().Scan(&owned); err != nil {
+		logger.WithError(err).Error("Could not check owned items")
+		return status.Error(codes.Internal, "Could not proceed with the operation")
+	}
+	if owned > 0 {
+		logger.Error("Stack limit reached")
+		return status.Error(codes.FailedPrecondition, "Stack limit reached")
+	}
+
+	if err := txnDB.Exec(insertStackQuery, userID, item.Id, quantity).Error; err != nil {
+		logger.WithError(err).Error("Could not create stack")
+		return status.Error(codes.Internal, "Could not proceed with the operation")
+	}
+
+	return nil
+}
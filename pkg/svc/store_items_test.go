@@ -4,10 +4,13 @@ import (
 	"context"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/amikhailau/users-service/pkg/pb"
 	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	testutils "github.com/amikhailau/users-service/pkg/testing"
@@ -53,14 +56,14 @@ func TestStoreItems(t *testing.T) {
 	sqlSearchIDEdited2 := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
 	sqlSearchIDUser := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
 	sqlSearchNameType := `SELECT * FROM "store_items" WHERE (name = $1 AND type = $2) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlSearchItemType := `SELECT * FROM "item_types" WHERE (id = $1) ORDER BY "item_types"."id" ASC LIMIT 1`
 	sqlSearchImageID := `SELECT * FROM "store_items" WHERE (image_id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
-	sqlList := `SELECT * FROM "store_items" ORDER BY "id"`
-	sqlListOrdered := `SELECT * FROM "store_items" ORDER BY store_items.name,"id"`
-	sqlUpdateItem := `UPDATE "store_items" SET "coins_price" = $1, "description" = $2, "gems_price" = $3, "image_id" = $4, "name" = $5, "on_sale" = $6, "sale_coins_price" = $7, "sale_gems_price" = $8, "type" = $9  WHERE "store_items"."id" = $10`
-	sqlCreateItem := `INSERT INTO "store_items" ("coins_price","description","gems_price","id","image_id","name","on_sale","sale_coins_price","sale_gems_price","type") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "store_items"."id"`
-	sqlDeleteItem := `DELETE FROM "store_items"  WHERE (id = $1)`
+	sqlList := `SELECT * FROM "store_items" WHERE (NOT retired) ORDER BY "id"`
+	sqlListOrdered := `SELECT * FROM "store_items" WHERE (NOT retired) ORDER BY store_items.name,"id"`
+	sqlUpdateItem := `UPDATE "store_items" SET "coins_price" = $1, "consumable" = $2, "description" = $3, "gems_price" = $4, "image_id" = $5, "max_stack" = $6, "name" = $7, "on_sale" = $8, "rental_coins_price" = $9, "rental_days" = $10, "rental_gems_price" = $11, "retired" = $12, "sale_coins_price" = $13, "sale_gems_price" = $14, "sku" = $15, "type" = $16  WHERE "store_items"."id" = $17`
+	sqlCreateItem := `INSERT INTO "store_items" ("coins_price","consumable","description","gems_price","id","image_id","max_stack","name","on_sale","rental_coins_price","rental_days","rental_gems_price","retired","sale_coins_price","sale_gems_price","sku","type") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17) RETURNING "store_items"."id"`
 	sqlUpdateUser := `UPDATE "users" SET "coins" = $1, "email" = $2, "gems" = $3, "name" = $4, "password" = $5  WHERE "users"."id" = $6`
-	sqlBuyItem := "^INSERT INTO .*"
+	itemTypeColumns := []string{"id", "name", "display_name", "slot", "slot_capacity"}
 	sqlThrowAwayItem := "^DELETE FROM .*"
 	userSqlSearchID := `SELECT * FROM "users" WHERE (id = $1) ORDER BY "users"."id" ASC LIMIT 1`
 
@@ -89,12 +92,15 @@ func TestStoreItems(t *testing.T) {
 
 	t.Run("Create Item - positive", func(t *testing.T) {
 
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(newItemData.Type).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(newItemData.Type, "skin", "Skin", "skin", 1))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchNameType)).WithArgs(newItemData.Name, newItemData.Type).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchImageID)).WithArgs(newItemData.ImageId).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectBegin()
-		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateItem)).WithArgs(newItemData.CoinsPrice, newItemData.Description,
-			newItemData.GemsPrice, sqlmock.AnyArg(), newItemData.ImageId, newItemData.Name, newItemData.OnSale, newItemData.SaleCoinsPrice,
-			newItemData.SaleGemsPrice, newItemData.Type).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateItem)).WithArgs(newItemData.CoinsPrice, newItemData.Consumable, newItemData.Description,
+			newItemData.GemsPrice, sqlmock.AnyArg(), newItemData.ImageId, newItemData.MaxStack, newItemData.Name, newItemData.OnSale,
+			newItemData.RentalCoinsPrice, newItemData.RentalDays, newItemData.RentalGemsPrice, false, newItemData.SaleCoinsPrice,
+			newItemData.SaleGemsPrice, sqlmock.AnyArg(), newItemData.Type).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		mock.ExpectCommit()
 
 		_, err := stiClient.Create(ctx, newItemData)
@@ -113,6 +119,8 @@ func TestStoreItems(t *testing.T) {
 			"on_sale", "sale_coins_price", "sale_gems_price", "type", "created_at", "updated_at"}).
 			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
 
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(newItemData.Type).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(newItemData.Type, "skin", "Skin", "skin", 1))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchNameType)).WithArgs(newItemData.Name, newItemData.Type).WillReturnRows(rows)
 
 		_, err := stiClient.Create(ctx, newItemData)
@@ -131,6 +139,8 @@ func TestStoreItems(t *testing.T) {
 			"on_sale", "sale_coins_price", "sale_gems_price", "type", "created_at", "updated_at"}).
 			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
 
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(newItemData.Type).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(newItemData.Type, "skin", "Skin", "skin", 1))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchNameType)).WithArgs(newItemData.Name, newItemData.Type).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchImageID)).WithArgs(newItemData.ImageId).WillReturnRows(rows)
 
@@ -144,6 +154,19 @@ func TestStoreItems(t *testing.T) {
 
 	})
 
+	t.Run("Create Item - unknown type", func(t *testing.T) {
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(newItemData.Type).WillReturnRows(sqlmock.NewRows(nil))
+
+		_, err := stiClient.Create(ctx, newItemData)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
 	t.Run("Read Item - found", func(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
@@ -182,9 +205,7 @@ func TestStoreItems(t *testing.T) {
 
 	t.Run("Delete Item", func(t *testing.T) {
 
-		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(sqlDeleteItem)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectCommit()
+		mock.ExpectExec(regexp.QuoteMeta(retireItemQuery)).WithArgs("some-id").WillReturnResult(sqlmock.NewResult(1, 1))
 
 		_, err := stiClient.Delete(ctx, &pb.DeleteStoreItemRequest{
 			Id: "some-id",
@@ -209,10 +230,10 @@ func TestStoreItems(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchImageID)).WithArgs(updateItemData.Payload.ImageId).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited)).WithArgs(updateItemData.Payload.Id).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateItem)).WithArgs(updateItemData.Payload.CoinsPrice, updateItemData.Payload.Description,
-			updateItemData.Payload.GemsPrice, updateItemData.Payload.ImageId,
-			updateItemData.Payload.Name, updateItemData.Payload.OnSale, updateItemData.Payload.SaleCoinsPrice,
-			updateItemData.Payload.SaleGemsPrice, updateItemData.Payload.Type, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateItem)).WithArgs(updateItemData.Payload.CoinsPrice, false, updateItemData.Payload.Description,
+			updateItemData.Payload.GemsPrice, updateItemData.Payload.ImageId, 0,
+			updateItemData.Payload.Name, updateItemData.Payload.OnSale, 0, 0, 0, false, updateItemData.Payload.SaleCoinsPrice,
+			updateItemData.Payload.SaleGemsPrice, "", updateItemData.Payload.Type, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		_, err := stiClient.Update(ctx, updateItemData)
@@ -225,6 +246,26 @@ func TestStoreItems(t *testing.T) {
 
 	})
 
+	t.Run("Update Item - consumable of an owned item", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
+			"on_sale", "sale_coins_price", "sale_gems_price", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchNameType)).WithArgs("", 0).WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchImageID)).WithArgs("").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectQuery(regexp.QuoteMeta(countItemOwnersQuery)).WithArgs("some-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		_, err := stiClient.Update(ctx, &pb.UpdateStoreItemRequest{Payload: &pb.StoreItem{Id: "some-id", Consumable: true}})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
 	t.Run("Update Item - same name and id", func(t *testing.T) {
 
 		otherRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
@@ -323,7 +364,7 @@ func TestStoreItems(t *testing.T) {
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(900, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(sqlBuyItem).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(buyItemQuery)).WithArgs("some-id", "some-item-id").WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
@@ -351,7 +392,7 @@ func TestStoreItems(t *testing.T) {
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(950, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(sqlBuyItem).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(buyItemQuery)).WithArgs("some-id", "some-item-id").WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
@@ -366,7 +407,7 @@ func TestStoreItems(t *testing.T) {
 		}
 	})
 
-	t.Run("ThrowAwayByUser - positive", func(t *testing.T) {
+	t.Run("BuyByUser - already owned", func(t *testing.T) {
 		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
 			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
 
@@ -376,9 +417,37 @@ func TestStoreItems(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
 		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(1100, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(900, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(sqlThrowAwayItem).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(buyItemQuery)).WithArgs("some-id", "some-item-id").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
+			UserId: "some-id",
+			ItemId: "some-item-id",
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("ThrowAwayByUser - positive", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockOwnedItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "source", "quantity", "expires_at"}).AddRow(7, "purchase", 1, nil))
+		mock.ExpectExec(sqlThrowAwayItem).WithArgs(7).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(refundCurrenciesQuery)).WithArgs(100, 0, "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		_, err := stiClient.ThrowAwayByUser(ctx, &pb.ThrowAwayByUserRequest{
@@ -393,6 +462,95 @@ func TestStoreItems(t *testing.T) {
 		}
 	})
 
+	t.Run("ThrowAwayByUser - stack is refunded per item", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "consumable", "created_at", "updated_at"}).
+			AddRow(100, "desc", 5, "some-item-id", "some-im-id", "some-name", 1, true, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockOwnedItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "source", "quantity", "expires_at"}).AddRow(7, "purchase", 3, nil))
+		mock.ExpectExec(sqlThrowAwayItem).WithArgs(7).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(refundCurrenciesQuery)).WithArgs(300, 15, "some-id").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if _, err := stiClient.ThrowAwayByUser(ctx, &pb.ThrowAwayByUserRequest{UserId: "some-id", ItemId: "some-item-id"}); err != nil {
+			t.Fatalf("error throwing away item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("ThrowAwayByUser - rental is not refunded", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockOwnedItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "source", "quantity", "expires_at"}).AddRow(7, "rental", 1, time.Now().Add(-time.Hour)))
+		mock.ExpectExec(sqlThrowAwayItem).WithArgs(7).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if _, err := stiClient.ThrowAwayByUser(ctx, &pb.ThrowAwayByUserRequest{UserId: "some-id", ItemId: "some-item-id"}); err != nil {
+			t.Fatalf("error throwing away item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("ThrowAwayByUser - gift is not refunded", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockOwnedItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "source", "quantity", "expires_at"}).AddRow(7, "gift", 1, nil))
+		mock.ExpectExec(sqlThrowAwayItem).WithArgs(7).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if _, err := stiClient.ThrowAwayByUser(ctx, &pb.ThrowAwayByUserRequest{UserId: "some-id", ItemId: "some-item-id"}); err != nil {
+			t.Fatalf("error throwing away item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("ThrowAwayByUser - not owned", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockOwnedItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "source", "quantity", "expires_at"}))
+		mock.ExpectRollback()
+
+		_, err := stiClient.ThrowAwayByUser(ctx, &pb.ThrowAwayByUserRequest{UserId: "some-id", ItemId: "some-item-id"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
 	t.Run("Get User Items - found", func(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
@@ -442,7 +600,9 @@ func TestStoreItems(t *testing.T) {
 		nextRows := sqlmock.NewRows([]string{"id"}).AddRow("some-other-id")
 
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
-		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs(1, "some-id", true).WillReturnRows(nextRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(1, "skin", "Skin", "skin", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs("skin", "some-id", true).WillReturnRows(nextRows)
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(deequipQuery)).WithArgs("some-id", "some-other-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
@@ -470,7 +630,9 @@ func TestStoreItems(t *testing.T) {
 			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
 
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
-		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs(1, "some-id", true).WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(1, "skin", "Skin", "skin", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs("skin", "some-id", true).WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(equipQuery)).WithArgs("some-id", "some-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
@@ -498,7 +660,9 @@ func TestStoreItems(t *testing.T) {
 		nextRows := sqlmock.NewRows([]string{"id"}).AddRow("some-id")
 
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
-		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs(1, "some-id", true).WillReturnRows(nextRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(1, "skin", "Skin", "skin", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs("skin", "some-id", true).WillReturnRows(nextRows)
 		mock.ExpectExec(regexp.QuoteMeta(deequipQuery)).WithArgs("some-id", "some-id").
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -515,4 +679,195 @@ func TestStoreItems(t *testing.T) {
 
 	})
 
+	t.Run("Equip by User - slot is full", func(t *testing.T) {
+
+		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
+			"on_sale", "sale_coins_price", "sale_gems_price", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 2, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		nextRows := sqlmock.NewRows([]string{"id"}).AddRow("some-other-id").AddRow("some-third-id")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(2, "emote", "Emote", "emotes", 2))
+		mock.ExpectQuery(regexp.QuoteMeta(findEquippedQuery)).WithArgs("emotes", "some-id", true).WillReturnRows(nextRows)
+
+		_, err := stiClient.EquipByUser(ctx, &pb.EquipByUserRequest{
+			UserId: "some-id",
+			ItemId: "some-id",
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+
+	})
+
+	t.Run("Equip by User - type without slot", func(t *testing.T) {
+
+		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
+			"on_sale", "sale_coins_price", "sale_gems_price", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-id", "some-im-id", "some-name", false, 0, 0, 3, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemType)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).AddRow(3, "potion", "Potion", "", 1))
+
+		_, err := stiClient.EquipByUser(ctx, &pb.EquipByUserRequest{
+			UserId: "some-id",
+			ItemId: "some-id",
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+
+	})
+
+	t.Run("BuyByUser - consumable stack", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type",
+			"created_at", "updated_at", "consumable", "max_stack"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57", true, 10)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(700, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(addToStackQuery)).WithArgs(3, "some-id", "some-item-id", 10).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(regexp.QuoteMeta(insertStackQuery)).WithArgs("some-id", "some-item-id", 3).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
+			UserId:   "some-id",
+			ItemId:   "some-item-id",
+			Quantity: 3,
+		})
+		if err != nil {
+			t.Fatalf("error buying item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("BuyByUser - consumable stack limit", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type",
+			"created_at", "updated_at", "consumable", "max_stack"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57", true, 10)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(sqlUpdateUser)).WithArgs(700, "someemail@email.com", 100, "some-name", "some-hash", "some-id").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(addToStackQuery)).WithArgs(3, "some-id", "some-item-id", 10).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(ownsItemQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
+			UserId:   "some-id",
+			ItemId:   "some-item-id",
+			Quantity: 3,
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("BuyByUser - quantity of non-consumable", func(t *testing.T) {
+		uRows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 1000, 100, 'f')
+
+		iRows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name", "type", "created_at", "updated_at"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDUser)).WithArgs("some-id").WillReturnRows(uRows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchIDEdited2)).WithArgs("some-item-id").WillReturnRows(iRows)
+
+		_, err := stiClient.BuyByUser(ctx, &pb.BuyByUserRequest{
+			UserId:   "some-id",
+			ItemId:   "some-item-id",
+			Quantity: 2,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Consume Item - positive", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
+			"type", "created_at", "updated_at", "consumable", "max_stack"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57", true, 10)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-item-id").WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(consumeQuery)).WithArgs(2, "some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(0))
+		mock.ExpectExec(regexp.QuoteMeta(deleteEmptyStackQuery)).WithArgs("some-id", "some-item-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := stiClient.ConsumeItem(ctx, &pb.ConsumeItemRequest{
+			UserId:   "some-id",
+			ItemId:   "some-item-id",
+			Quantity: 2,
+		})
+		if err != nil {
+			t.Fatalf("error consuming item: %v", err)
+		}
+		if res.GetRemaining() != 0 {
+			t.Fatalf("unexpected remaining quantity: %v", res.GetRemaining())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Consume Item - not enough", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"coins_price", "description", "gems_price", "id", "image_id", "name",
+			"type", "created_at", "updated_at", "consumable", "max_stack"}).
+			AddRow(100, "desc", 0, "some-item-id", "some-im-id", "some-name", 1, "2020-01-01 01:05:57", "2020-01-01 01:05:57", true, 10)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-item-id").WillReturnRows(rows)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(consumeQuery)).WithArgs(5, "some-id", "some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}))
+		mock.ExpectRollback()
+
+		_, err := stiClient.ConsumeItem(ctx, &pb.ConsumeItemRequest{
+			UserId:   "some-id",
+			ItemId:   "some-item-id",
+			Quantity: 5,
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
 }
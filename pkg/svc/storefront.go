@@ -0,0 +1,488 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type StorefrontServerConfig struct {
+	Database *gorm.DB
+	// ResetHour is the UTC hour at which the storefront rotates to the next day
+	ResetHour int
+}
+
+type StorefrontServer struct {
+	pb.StorefrontServer
+	cfg *StorefrontServerConfig
+}
+
+var _ pb.StorefrontServer = &StorefrontServer{}
+
+const (
+	storefrontDayLayout             = "2006-01-02"
+	storefrontSlotNameTakenErrorMsg = "Storefront slot with such name already exists"
+
+	storefrontPinsQuery      = "SELECT slot_id, store_item_id FROM storefront_pins WHERE day = $1 ORDER BY id"
+	insertStorefrontPinQuery = "INSERT INTO storefront_pins (slot_id, day, store_item_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+	deleteStorefrontPinQuery = "DELETE FROM storefront_pins WHERE slot_id = $1 AND day = $2 AND store_item_id = $3"
+)
+
+func NewStorefrontServer(cfg *StorefrontServerConfig) (*StorefrontServer, error) {
+	if cfg.ResetHour < 0 || cfg.ResetHour > 23 {
+		return nil, fmt.Errorf("storefront reset hour should be from 0 to 23, got %d", cfg.ResetHour)
+	}
+	return &StorefrontServer{
+		StorefrontServer: &pb.StorefrontDefaultServer{},
+		cfg:              cfg,
+	}, nil
+}
+
+func (s *StorefrontServer) GetStorefront(ctx context.Context, req *pb.GetStorefrontRequest) (*pb.GetStorefrontResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("Get storefront")
+
+	now := time.Now().UTC()
+	day := s.rotationDay(now)
+
+	slots, err := s.buildRotation(ctx, logger, day)
+	if err != nil {
+		return nil, err
+	}
+
+	nextReset := day.Add(24*time.Hour + time.Duration(s.cfg.ResetHour)*time.Hour)
+	nextResetPb, err := ptypes.TimestampProto(nextReset)
+	if err != nil {
+		logger.WithError(err).Error("Failed to convert unix time to proto timestamp")
+		return nil, status.Error(codes.Internal, "Could not fetch storefront")
+	}
+
+	return &pb.GetStorefrontResponse{
+		Day:               day.Format(storefrontDayLayout),
+		Slots:             slots,
+		NextResetAt:       nextResetPb,
+		SecondsUntilReset: int64(nextReset.Sub(now) / time.Second),
+	}, nil
+}
+
+func (s *StorefrontServer) PreviewStorefront(ctx context.Context, req *pb.PreviewStorefrontRequest) (*pb.PreviewStorefrontResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("day", req.GetDay())
+	logger.Debug("Preview storefront")
+
+	day, err := time.Parse(storefrontDayLayout, req.GetDay())
+	if err != nil {
+		logger.WithError(err).Error("Invalid day provided")
+		return nil, status.Error(codes.InvalidArgument, "Day should be in YYYY-MM-DD format")
+	}
+
+	slots, err := s.buildRotation(ctx, logger, day)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PreviewStorefrontResponse{Day: day.Format(storefrontDayLayout), Slots: slots}, nil
+}
+
+func (s *StorefrontServer) CreateStorefrontSlot(ctx context.Context, req *pb.CreateStorefrontSlotRequest) (*pb.CreateStorefrontSlotResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("name", req.GetName())
+	logger.Debug("Create storefront slot")
+
+	if req.GetName() == "" || req.GetItemsCount() <= 0 {
+		logger.Error("Slot validation failed")
+		return nil, status.Error(codes.InvalidArgument, "Slot should have a name and a positive items count")
+	}
+
+	var existingSlot pb.StorefrontSlotORM
+	if err := s.cfg.Database.Where("name = ?", req.GetName()).First(&existingSlot).Error; err == nil {
+		logger.Error(storefrontSlotNameTakenErrorMsg)
+		return nil, status.Error(codes.AlreadyExists, storefrontSlotNameTakenErrorMsg)
+	} else if err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not create storefront slot")
+		return nil, status.Error(codes.Internal, "Could not create storefront slot")
+	}
+
+	pool, err := s.toPoolEntries(logger, req.GetPool())
+	if err != nil {
+		return nil, err
+	}
+
+	newSlot := pb.StorefrontSlotORM{
+		Name:       req.GetName(),
+		ItemsCount: req.GetItemsCount(),
+		Pool:       pool,
+	}
+
+	if err := s.cfg.Database.Create(&newSlot).Error; err != nil {
+		logger.WithError(err).Error("Could not create storefront slot")
+		return nil, status.Error(codes.Internal, "Could not create storefront slot")
+	}
+
+	pbSlot, err := newSlot.ToPB(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Could not create storefront slot")
+		return nil, status.Error(codes.Internal, "Could not create storefront slot")
+	}
+
+	return &pb.CreateStorefrontSlotResponse{Result: &pbSlot}, nil
+}
+
+func (s *StorefrontServer) UpdateStorefrontSlot(ctx context.Context, req *pb.UpdateStorefrontSlotRequest) (*pb.UpdateStorefrontSlotResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
+	logger.Debug("Update storefront slot")
+
+	var slot pb.StorefrontSlotORM
+	if err := s.cfg.Database.Where("id = ?", req.GetId()).First(&slot).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Storefront slot not found")
+			return nil, status.Error(codes.NotFound, "Storefront slot not found")
+		}
+		logger.WithError(err).Error("Could not update storefront slot")
+		return nil, status.Error(codes.Internal, "Could not update storefront slot")
+	}
+
+	if req.GetName() != "" && req.GetName() != slot.Name {
+		var existingSlot pb.StorefrontSlotORM
+		if err := s.cfg.Database.Where("name = ? AND id <> ?", req.GetName(), slot.Id).First(&existingSlot).Error; err == nil {
+			logger.Error(storefrontSlotNameTakenErrorMsg)
+			return nil, status.Error(codes.AlreadyExists, storefrontSlotNameTakenErrorMsg)
+		} else if err != gorm.ErrRecordNotFound {
+			logger.WithError(err).Error("Could not update storefront slot")
+			return nil, status.Error(codes.Internal, "Could not update storefront slot")
+		}
+		slot.Name = req.GetName()
+	}
+	if req.GetItemsCount() > 0 {
+		slot.ItemsCount = req.GetItemsCount()
+	}
+
+	txnDB := s.cfg.Database.Begin()
+
+	if len(req.GetPool()) > 0 {
+		pool, err := s.toPoolEntries(logger, req.GetPool())
+		if err != nil {
+			txnDB.Rollback()
+			return nil, err
+		}
+		if err := txnDB.Where("storefront_slot_id = ?", slot.Id).Delete(&pb.StorefrontPoolEntryORM{}).Error; err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not update storefront slot pool")
+			return nil, status.Error(codes.Internal, "Could not update storefront slot")
+		}
+		slot.Pool = pool
+	}
+
+	if err := txnDB.Save(&slot).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not update storefront slot")
+		return nil, status.Error(codes.Internal, "Could not update storefront slot")
+	}
+
+	txnDB.Commit()
+
+	return &pb.UpdateStorefrontSlotResponse{}, nil
+}
+
+func (s *StorefrontServer) DeleteStorefrontSlot(ctx context.Context, req *pb.DeleteStorefrontSlotRequest) (*pb.DeleteStorefrontSlotResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithField("id", req.GetId())
+	logger.Debug("Delete storefront slot")
+
+	var slot pb.StorefrontSlotORM
+	if err := s.cfg.Database.Where("id = ?", req.GetId()).Delete(&slot).Error; err != nil && err != gorm.ErrRecordNotFound {
+		logger.WithError(err).Error("Could not delete storefront slot")
+		return nil, status.Error(codes.Internal, "Could not delete storefront slot")
+	}
+
+	return &pb.DeleteStorefrontSlotResponse{}, nil
+}
+
+func (s *StorefrontServer) ListStorefrontSlots(ctx context.Context, req *pb.ListStorefrontSlotsRequest) (*pb.ListStorefrontSlotsResponse, error) {
+	logger := ctxlogrus.Extract(ctx)
+	logger.Debug("List storefront slots")
+
+	slots, err := s.fetchSlots(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.StorefrontSlot, 0, len(slots))
+	for _, slot := range slots {
+		pbSlot, err := slot.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not list storefront slots")
+			return nil, status.Error(codes.Internal, "Could not list storefront slots")
+		}
+		res = append(res, &pbSlot)
+	}
+
+	return &pb.ListStorefrontSlotsResponse{Results: res}, nil
+}
+
+func (s *StorefrontServer) PinStorefrontItem(ctx context.Context, req *pb.PinStorefrontItemRequest) (*pb.PinStorefrontItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"slot_id": req.GetSlotId(),
+		"day":     req.GetDay(),
+		"item_id": req.GetItemId(),
+	})
+	logger.Debug("Pin storefront item")
+
+	day, err := time.Parse(storefrontDayLayout, req.GetDay())
+	if err != nil {
+		logger.WithError(err).Error("Invalid day provided")
+		return nil, status.Error(codes.InvalidArgument, "Day should be in YYYY-MM-DD format")
+	}
+
+	var slot pb.StorefrontSlotORM
+	if err := s.cfg.Database.Where("id = ?", req.GetSlotId()).First(&slot).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Storefront slot not found")
+			return nil, status.Error(codes.NotFound, "Storefront slot not found")
+		}
+		logger.WithError(err).Error("Could not find storefront slot")
+		return nil, status.Error(codes.Internal, "Could not find storefront slot")
+	}
+
+	var item pb.StoreItemORM
+	if err := s.cfg.Database.Where("id = ?", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Item not found")
+			return nil, status.Error(codes.NotFound, "Item not found")
+		}
+		logger.WithError(err).Error("Could not find item")
+		return nil, status.Error(codes.Internal, "Could not find item")
+	}
+
+	if item.Retired {
+		logger.Error(itemRetiredErrorMsg)
+		return nil, status.Error(codes.FailedPrecondition, itemRetiredErrorMsg)
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(insertStorefrontPinQuery, slot.Id, day.Format(storefrontDayLayout), item.Id); err != nil {
+		logger.WithError(err).Error("Could not pin item")
+		return nil, status.Error(codes.Internal, "Could not pin item")
+	}
+
+	return &pb.PinStorefrontItemResponse{}, nil
+}
+
+func (s *StorefrontServer) UnpinStorefrontItem(ctx context.Context, req *pb.UnpinStorefrontItemRequest) (*pb.UnpinStorefrontItemResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"slot_id": req.GetSlotId(),
+		"day":     req.GetDay(),
+		"item_id": req.GetItemId(),
+	})
+	logger.Debug("Unpin storefront item")
+
+	day, err := time.Parse(storefrontDayLayout, req.GetDay())
+	if err != nil {
+		logger.WithError(err).Error("Invalid day provided")
+		return nil, status.Error(codes.InvalidArgument, "Day should be in YYYY-MM-DD format")
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(deleteStorefrontPinQuery, req.GetSlotId(), day.Format(storefrontDayLayout), req.GetItemId()); err != nil {
+		logger.WithError(err).Error("Could not unpin item")
+		return nil, status.Error(codes.Internal, "Could not unpin item")
+	}
+
+	return &pb.UnpinStorefrontItemResponse{}, nil
+}
+
+// rotationDay returns the storefront day the given moment belongs to
+func (s *StorefrontServer) rotationDay(now time.Time) time.Time {
+	shifted := now.UTC().Add(-time.Duration(s.cfg.ResetHour) * time.Hour)
+	return time.Date(shifted.Year(), shifted.Month(), shifted.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (s *StorefrontServer) buildRotation(ctx context.Context, logger *logrus.Entry, day time.Time) ([]*pb.StorefrontRotationSlot, error) {
+	slots, err := s.fetchSlots(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pins := map[int32][]string{}
+	rows, err := s.cfg.Database.DB().Query(storefrontPinsQuery, day.Format(storefrontDayLayout))
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch storefront pins")
+		return nil, status.Error(codes.Internal, "Could not fetch storefront")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var slotID int32
+		var itemID string
+		if err := rows.Scan(&slotID, &itemID); err != nil {
+			logger.WithError(err).Error("Could not fetch storefront pins")
+			return nil, status.Error(codes.Internal, "Could not fetch storefront")
+		}
+		pins[slotID] = append(pins[slotID], itemID)
+	}
+
+	candidateIDs := []string{}
+	seen := map[string]bool{}
+	for _, slot := range slots {
+		for _, entry := range slot.Pool {
+			if !seen[entry.ItemId] {
+				candidateIDs = append(candidateIDs, entry.ItemId)
+				seen[entry.ItemId] = true
+			}
+		}
+		for _, id := range pins[slot.Id] {
+			if !seen[id] {
+				candidateIDs = append(candidateIDs, id)
+				seen[id] = true
+			}
+		}
+	}
+
+	items := map[string]*pb.StoreItem{}
+	if len(candidateIDs) > 0 {
+		var ormItems []*pb.StoreItemORM
+		if err := s.cfg.Database.Where("id IN (?)", candidateIDs).Find(&ormItems).Error; err != nil {
+			logger.WithError(err).Error("Could not fetch storefront items")
+			return nil, status.Error(codes.Internal, "Could not fetch storefront")
+		}
+		for _, ormItem := range ormItems {
+			// retired items stay in pools and pins but are no longer offered
+			if ormItem.Retired {
+				continue
+			}
+			pbItem, err := ormItem.ToPB(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Could not fetch storefront items")
+				return nil, status.Error(codes.Internal, "Could not fetch storefront")
+			}
+			items[pbItem.Id] = &pbItem
+		}
+	}
+
+	res := make([]*pb.StorefrontRotationSlot, 0, len(slots))
+	for _, slot := range slots {
+		// items that aren't offered are left out before the pick, so they don't take the place of others
+		offered := *slot
+		offered.Pool = make([]*pb.StorefrontPoolEntryORM, 0, len(slot.Pool))
+		for _, entry := range slot.Pool {
+			if _, ok := items[entry.ItemId]; ok {
+				offered.Pool = append(offered.Pool, entry)
+			}
+		}
+		pinned := make([]string, 0, len(pins[slot.Id]))
+		for _, id := range pins[slot.Id] {
+			if _, ok := items[id]; ok {
+				pinned = append(pinned, id)
+			}
+		}
+
+		rotationSlot := &pb.StorefrontRotationSlot{SlotId: slot.Id, Name: slot.Name, Items: []*pb.StoreItem{}}
+		for _, id := range pickStorefrontItems(day, &offered, pinned) {
+			rotationSlot.Items = append(rotationSlot.Items, items[id])
+		}
+		res = append(res, rotationSlot)
+	}
+
+	return res, nil
+}
+
+func (s *StorefrontServer) fetchSlots(logger *logrus.Entry) ([]*pb.StorefrontSlotORM, error) {
+	var slots []*pb.StorefrontSlotORM
+	if err := s.cfg.Database.Preload("Pool").Order("id").Find(&slots).Error; err != nil {
+		logger.WithError(err).Error("Could not fetch storefront slots")
+		return nil, status.Error(codes.Internal, "Could not fetch storefront slots")
+	}
+	return slots, nil
+}
+
+func (s *StorefrontServer) toPoolEntries(logger *logrus.Entry, entries []*pb.StorefrontPoolEntry) ([]*pb.StorefrontPoolEntryORM, error) {
+	pool := make([]*pb.StorefrontPoolEntryORM, 0, len(entries))
+	ids := make([]string, 0, len(entries))
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		if entry.GetWeight() < 0 {
+			logger.Error("Negative pool weight")
+			return nil, status.Error(codes.InvalidArgument, "Pool weights can't be negative")
+		}
+		if seen[entry.GetItemId()] {
+			logger.Error("Duplicate pool item")
+			return nil, status.Error(codes.InvalidArgument, "Pool can't contain the same item twice")
+		}
+		seen[entry.GetItemId()] = true
+		weight := entry.GetWeight()
+		if weight == 0 {
+			weight = 1
+		}
+		pool = append(pool, &pb.StorefrontPoolEntryORM{ItemId: entry.GetItemId(), Weight: weight})
+		ids = append(ids, entry.GetItemId())
+	}
+
+	if len(ids) == 0 {
+		return pool, nil
+	}
+
+	var count int
+	if err := s.cfg.Database.Model(&pb.StoreItemORM{}).Where("id IN (?)", ids).Count(&count).Error; err != nil {
+		logger.WithError(err).Error("Could not check pool items")
+		return nil, status.Error(codes.Internal, "Could not check pool items")
+	}
+	if count != len(ids) {
+		logger.Error("Pool references unknown items")
+		return nil, status.Error(codes.NotFound, "Item not found")
+	}
+
+	return pool, nil
+}
+
+// pickStorefrontItems selects the slot items for the given day. Pinned items go
+// first, the rest is drawn from the weighted pool with a generator seeded by the
+// day and slot name, so every replica comes up with the same rotation.
+func pickStorefrontItems(day time.Time, slot *pb.StorefrontSlotORM, pinned []string) []string {
+	count := int(slot.ItemsCount)
+	picked := make([]string, 0, count)
+	taken := map[string]bool{}
+	for _, id := range pinned {
+		if len(picked) == count {
+			break
+		}
+		if !taken[id] {
+			picked = append(picked, id)
+			taken[id] = true
+		}
+	}
+
+	pool := make([]*pb.StorefrontPoolEntryORM, 0, len(slot.Pool))
+	for _, entry := range slot.Pool {
+		if !taken[entry.ItemId] && entry.Weight > 0 {
+			pool = append(pool, entry)
+		}
+	}
+	sort.Slice(pool, func(i, j int) bool { return pool[i].ItemId < pool[j].ItemId })
+
+	hash := fnv.New64a()
+	hash.Write([]byte(day.Format(storefrontDayLayout) + "/" + slot.Name))
+	rnd := rand.New(rand.NewSource(int64(hash.Sum64())))
+
+	for len(picked) < count && len(pool) > 0 {
+		var total int64
+		for _, entry := range pool {
+			total += int64(entry.Weight)
+		}
+		target := rnd.Int63n(total)
+		for i, entry := range pool {
+			if target < int64(entry.Weight) {
+				picked = append(picked, entry.ItemId)
+				pool = append(pool[:i], pool[i+1:]...)
+				break
+			}
+			target -= int64(entry.Weight)
+		}
+	}
+
+	return picked
+}
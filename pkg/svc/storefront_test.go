@@ -0,0 +1,206 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestStorefront(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	storefrontServer, err := NewStorefrontServer(&StorefrontServerConfig{
+		Database: gdb,
+	})
+	if err != nil {
+		t.Fatalf("Could not create storefront server: %v", err)
+	}
+	pb.RegisterStorefrontServer(server.GRPCServer, storefrontServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	storefrontClient := pb.NewStorefrontClient(conn)
+
+	sqlFetchSlots := `SELECT * FROM "storefront_slots" ORDER BY "id"`
+	sqlFetchPool := `SELECT * FROM "storefront_pool_entries" WHERE ("storefront_slot_id" IN ($1))`
+	sqlFetchItems := `SELECT * FROM "store_items" WHERE (id IN ($1,$2))`
+	sqlSearchSlotName := `SELECT * FROM "storefront_slots" WHERE (name = $1) ORDER BY "storefront_slots"."id" ASC LIMIT 1`
+	sqlSearchSlotID := `SELECT * FROM "storefront_slots" WHERE (id = $1) ORDER BY "storefront_slots"."id" ASC LIMIT 1`
+	sqlSearchOtherSlotName := `SELECT * FROM "storefront_slots" WHERE (name = $1 AND id <> $2) ORDER BY "storefront_slots"."id" ASC LIMIT 1`
+	sqlSearchItemID := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlCountItems := `SELECT count(*) FROM "store_items" WHERE (id IN ($1))`
+	sqlCreateSlot := `INSERT INTO "storefront_slots" ("items_count","name") VALUES ($1,$2) RETURNING "storefront_slots"."id"`
+	sqlCreatePoolEntry := `INSERT INTO "storefront_pool_entries" ("item_id","storefront_slot_id","weight") VALUES ($1,$2,$3) RETURNING "storefront_pool_entries"."id"`
+
+	t.Run("Get Storefront - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchSlots)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "items_count"}).AddRow(1, "daily", 2))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchPool)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "weight", "storefront_slot_id"}).
+				AddRow(1, "item-1", 1, 1).AddRow(2, "item-2", 5, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(storefrontPinsQuery)).WithArgs(sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id", "store_item_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchItems)).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "coins_price"}).
+				AddRow("item-1", "Sword", 10).AddRow("item-2", "Shield", 20))
+
+		res, err := storefrontClient.GetStorefront(ctx, &pb.GetStorefrontRequest{})
+		if err != nil {
+			t.Fatalf("error fetching storefront: %v", err)
+		}
+		if len(res.GetSlots()) != 1 || len(res.GetSlots()[0].GetItems()) != 2 {
+			t.Fatalf("unexpected storefront: %v", res.GetSlots())
+		}
+		if res.GetSecondsUntilReset() <= 0 || res.GetSecondsUntilReset() > 24*60*60 {
+			t.Fatalf("unexpected seconds until reset: %v", res.GetSecondsUntilReset())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Get Storefront - retired items are skipped", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchSlots)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "items_count"}).AddRow(1, "daily", 1))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchPool)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "weight", "storefront_slot_id"}).
+				AddRow(1, "item-1", 100, 1).AddRow(2, "item-2", 1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(storefrontPinsQuery)).WithArgs(sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id", "store_item_id"}))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlFetchItems)).WithArgs("item-1", "item-2").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "coins_price", "retired"}).
+				AddRow("item-1", "Sword", 10, true).AddRow("item-2", "Shield", 20, false))
+
+		res, err := storefrontClient.GetStorefront(ctx, &pb.GetStorefrontRequest{})
+		if err != nil {
+			t.Fatalf("error fetching storefront: %v", err)
+		}
+		if len(res.GetSlots()) != 1 || len(res.GetSlots()[0].GetItems()) != 1 || res.GetSlots()[0].GetItems()[0].GetId() != "item-2" {
+			t.Fatalf("unexpected storefront: %v", res.GetSlots())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Preview Storefront - invalid day", func(t *testing.T) {
+		_, err := storefrontClient.PreviewStorefront(ctx, &pb.PreviewStorefrontRequest{Day: "tomorrow"})
+		if err == nil {
+			t.Fatal("expected error for invalid day")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Create Storefront Slot - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchSlotName)).WithArgs("weekly").WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCountItems)).WithArgs("item-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreateSlot)).WithArgs(1, "weekly").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlCreatePoolEntry)).WithArgs("item-1", 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectCommit()
+
+		res, err := storefrontClient.CreateStorefrontSlot(ctx, &pb.CreateStorefrontSlotRequest{
+			Name:       "weekly",
+			ItemsCount: 1,
+			Pool:       []*pb.StorefrontPoolEntry{{ItemId: "item-1"}},
+		})
+		if err != nil {
+			t.Fatalf("error creating storefront slot: %v", err)
+		}
+		if res.GetResult().GetId() != 2 {
+			t.Fatalf("unexpected slot id: %v", res.GetResult().GetId())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Update Storefront Slot - name taken", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchSlotID)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "items_count"}).AddRow(1, "daily", 2))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchOtherSlotName)).WithArgs("weekly", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "items_count"}).AddRow(2, "weekly", 1))
+
+		_, err := storefrontClient.UpdateStorefrontSlot(ctx, &pb.UpdateStorefrontSlotRequest{Id: 1, Name: "weekly"})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("New Storefront Server - invalid reset hour", func(t *testing.T) {
+		if _, err := NewStorefrontServer(&StorefrontServerConfig{Database: gdb, ResetHour: 24}); err == nil {
+			t.Fatal("expected an error for reset hour 24")
+		}
+	})
+
+	t.Run("Pin Storefront Item - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchSlotID)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "items_count"}).AddRow(1, "daily", 2))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItemID)).WithArgs("item-1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("item-1", "Sword"))
+		mock.ExpectExec(regexp.QuoteMeta(insertStorefrontPinQuery)).WithArgs(1, "2021-05-01", "item-1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		_, err := storefrontClient.PinStorefrontItem(ctx, &pb.PinStorefrontItemRequest{SlotId: 1, Day: "2021-05-01", ItemId: "item-1"})
+		if err != nil {
+			t.Fatalf("error pinning storefront item: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	t.Run("Pick Storefront Items - deterministic", func(t *testing.T) {
+		slot := &pb.StorefrontSlotORM{Name: "daily", ItemsCount: 3, Pool: []*pb.StorefrontPoolEntryORM{
+			{ItemId: "a", Weight: 1}, {ItemId: "b", Weight: 3}, {ItemId: "c", Weight: 2}, {ItemId: "d", Weight: 1},
+		}}
+		day := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		first := pickStorefrontItems(day, slot, []string{"d"})
+		second := pickStorefrontItems(day, slot, []string{"d"})
+		if len(first) != 3 || first[0] != "d" {
+			t.Fatalf("unexpected pick: %v", first)
+		}
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("picks differ for the same day: %v vs %v", first, second)
+			}
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/amikhailau/users-service/pkg/pb"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	translationNotFoundErrorMsg = "Translation not found"
+	unsupportedLocaleErrorMsg   = "Locale is not supported or is the default one"
+
+	upsertItemTranslationQuery = "INSERT INTO store_item_translations (store_item_id, locale, name, description) VALUES ($1, $2, $3, $4) " +
+		"ON CONFLICT (store_item_id, locale) DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description"
+	deleteItemTranslationQuery = "DELETE FROM store_item_translations WHERE store_item_id = $1 AND locale = $2"
+	itemTranslationsRawQuery   = "SELECT store_item_id, name, description FROM store_item_translations WHERE locale = ? AND store_item_id IN (?)"
+
+	upsertNewsTranslationQuery = "INSERT INTO news_translations (news_id, locale, title, description) VALUES ($1, $2, $3, $4) " +
+		"ON CONFLICT (news_id, locale) DO UPDATE SET title = EXCLUDED.title, description = EXCLUDED.description"
+	deleteNewsTranslationQuery = "DELETE FROM news_translations WHERE news_id = $1 AND locale = $2"
+	newsTranslationsRawQuery   = "SELECT news_id, title, description FROM news_translations WHERE locale = ? AND news_id IN (?)"
+)
+
+func (s *StoreItemsServer) SetItemTranslation(ctx context.Context, req *pb.SetItemTranslationRequest) (*pb.SetItemTranslationResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"item_id": req.GetItemId(),
+		"locale":  req.GetLocale(),
+	})
+	logger.Debug("Set Item Translation")
+
+	if !s.cfg.Locales.IsTranslation(req.GetLocale()) {
+		logger.Error("Unsupported locale")
+		return nil, status.Error(codes.InvalidArgument, unsupportedLocaleErrorMsg)
+	}
+	if req.GetName() == "" {
+		logger.Error("Empty translated name")
+		return nil, status.Error(codes.InvalidArgument, "Name should be set")
+	}
+
+	var item pb.StoreItemORM
+	if err := s.cfg.Database.Where("id = ?", req.GetItemId()).First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("Item not found")
+			return nil, status.Error(codes.NotFound, "Item not found")
+		}
+		logger.WithError(err).Error("Could not find item")
+		return nil, status.Error(codes.Internal, "Could not find item")
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(upsertItemTranslationQuery, item.Id, req.GetLocale(), req.GetName(), req.GetDescription()); err != nil {
+		logger.WithError(err).Error("Could not set item translation")
+		return nil, status.Error(codes.Internal, "Could not set item translation")
+	}
+
+	return &pb.SetItemTranslationResponse{Result: &pb.StoreItemTranslation{
+		ItemId:      item.Id,
+		Locale:      req.GetLocale(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}}, nil
+}
+
+func (s *StoreItemsServer) DeleteItemTranslation(ctx context.Context, req *pb.DeleteItemTranslationRequest) (*pb.DeleteItemTranslationResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"item_id": req.GetItemId(),
+		"locale":  req.GetLocale(),
+	})
+	logger.Debug("Delete Item Translation")
+
+	res, err := s.cfg.Database.DB().Exec(deleteItemTranslationQuery, req.GetItemId(), req.GetLocale())
+	if err != nil {
+		logger.WithError(err).Error("Could not delete item translation")
+		return nil, status.Error(codes.Internal, "Could not delete item translation")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		logger.Error(translationNotFoundErrorMsg)
+		return nil, status.Error(codes.NotFound, translationNotFoundErrorMsg)
+	}
+
+	return &pb.DeleteItemTranslationResponse{}, nil
+}
+
+// translateItems replaces names and descriptions of items that have a translation to locale
+func (s *StoreItemsServer) translateItems(logger *logrus.Entry, locale string, items []*pb.StoreItem) error {
+	byID := map[string]*pb.StoreItem{}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		byID[item.GetId()] = item
+		ids = append(ids, item.GetId())
+	}
+
+	rows, err := s.cfg.Database.Raw(itemTranslationsRawQuery, locale, ids).Rows()
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch item translations")
+		return status.Error(codes.Internal, "Could not fetch item translations")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, name, description string
+		if err := rows.Scan(&id, &name, &description); err != nil {
+			logger.WithError(err).Error("Could not fetch item translations")
+			return status.Error(codes.Internal, "Could not fetch item translations")
+		}
+		if item, ok := byID[id]; ok {
+			item.Name = name
+			if description != "" {
+				item.Description = description
+			}
+		}
+	}
+	return nil
+}
+
+func (s *NewsServer) SetNewsTranslation(ctx context.Context, req *pb.SetNewsTranslationRequest) (*pb.SetNewsTranslationResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"news_id": req.GetNewsId(),
+		"locale":  req.GetLocale(),
+	})
+	logger.Debug("Set News Translation")
+
+	if !s.cfg.Locales.IsTranslation(req.GetLocale()) {
+		logger.Error("Unsupported locale")
+		return nil, status.Error(codes.InvalidArgument, unsupportedLocaleErrorMsg)
+	}
+	if req.GetTitle() == "" {
+		logger.Error("Empty translated title")
+		return nil, status.Error(codes.InvalidArgument, "Title should be set")
+	}
+
+	var news pb.NewsORM
+	if err := s.cfg.Database.Where("id = ?", req.GetNewsId()).First(&news).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Error("News not found")
+			return nil, status.Error(codes.NotFound, "News not found")
+		}
+		logger.WithError(err).Error("Could not find news")
+		return nil, status.Error(codes.Internal, "Could not find news")
+	}
+
+	if _, err := s.cfg.Database.DB().Exec(upsertNewsTranslationQuery, news.Id, req.GetLocale(), req.GetTitle(), req.GetDescription()); err != nil {
+		logger.WithError(err).Error("Could not set news translation")
+		return nil, status.Error(codes.Internal, "Could not set news translation")
+	}
+
+	return &pb.SetNewsTranslationResponse{Result: &pb.NewsTranslation{
+		NewsId:      news.Id,
+		Locale:      req.GetLocale(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	}}, nil
+}
+
+func (s *NewsServer) DeleteNewsTranslation(ctx context.Context, req *pb.DeleteNewsTranslationRequest) (*pb.DeleteNewsTranslationResponse, error) {
+	logger := ctxlogrus.Extract(ctx).WithFields(logrus.Fields{
+		"news_id": req.GetNewsId(),
+		"locale":  req.GetLocale(),
+	})
+	logger.Debug("Delete News Translation")
+
+	res, err := s.cfg.Database.DB().Exec(deleteNewsTranslationQuery, req.GetNewsId(), req.GetLocale())
+	if err != nil {
+		logger.WithError(err).Error("Could not delete news translation")
+		return nil, status.Error(codes.Internal, "Could not delete news translation")
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		logger.Error(translationNotFoundErrorMsg)
+		return nil, status.Error(codes.NotFound, translationNotFoundErrorMsg)
+	}
+
+	return &pb.DeleteNewsTranslationResponse{}, nil
+}
+
+// translateNews replaces titles and descriptions of news that have a translation to locale
+func (s *NewsServer) translateNews(logger *logrus.Entry, locale string, news []*pb.News) error {
+	byID := map[string]*pb.News{}
+	ids := make([]string, 0, len(news))
+	for _, n := range news {
+		byID[n.GetId()] = n
+		ids = append(ids, n.GetId())
+	}
+
+	rows, err := s.cfg.Database.Raw(newsTranslationsRawQuery, locale, ids).Rows()
+	if err != nil {
+		logger.WithError(err).Error("Could not fetch news translations")
+		return status.Error(codes.Internal, "Could not fetch news translations")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, title, description string
+		if err := rows.Scan(&id, &title, &description); err != nil {
+			logger.WithError(err).Error("Could not fetch news translations")
+			return status.Error(codes.Internal, "Could not fetch news translations")
+		}
+		if n, ok := byID[id]; ok {
+			n.Title = title
+			if description != "" {
+				n.Description = description
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package svc
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/amikhailau/users-service/pkg/pb"
+	testutils "github.com/amikhailau/users-service/pkg/testing"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslations(t *testing.T) {
+	logger := logrus.New()
+	ctx := ctxlogrus.ToContext(context.TODO(), logrus.NewEntry(logger))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer " + token}))
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Could not create mock db: %v", err)
+	}
+	gdb, err := gorm.Open("postgres", db)
+	if err != nil {
+		t.Fatalf("Could not create mock gorm db: %v", err)
+	}
+
+	server := testutils.NewTestServer(gdb, logger)
+
+	locales := LocaleConfig{Default: "en", Supported: []string{"en", "de", "pt-BR"}}
+
+	stiServer, err := NewStoreItemsServer(&StoreItemsServerConfig{
+		Database: gdb,
+		Locales:  locales,
+	})
+	if err != nil {
+		t.Fatalf("Could not create store items server: %v", err)
+	}
+	pb.RegisterStoreItemsServer(server.GRPCServer, stiServer)
+
+	newsServer, err := NewNewsServer(&NewsServerConfig{
+		Database: gdb,
+		Locales:  locales,
+	})
+	if err != nil {
+		t.Fatalf("Could not create news server: %v", err)
+	}
+	pb.RegisterNewsServiceServer(server.GRPCServer, newsServer)
+
+	conn, err := server.Serve(ctx, ":0")
+	if err != nil {
+		t.Fatalf("Could not start test server: %v", err)
+	}
+	defer server.Close()
+
+	stiClient := pb.NewStoreItemsClient(conn)
+	newsClient := pb.NewNewsServiceClient(conn)
+
+	sqlSearchItem := `SELECT * FROM "store_items" WHERE (id = $1) ORDER BY "store_items"."id" ASC LIMIT 1`
+	sqlListItems := `SELECT * FROM "store_items" WHERE (NOT retired) ORDER BY "id"`
+	sqlItemTranslations := `SELECT store_item_id, name, description FROM store_item_translations WHERE locale = $1 AND store_item_id IN ($2,$3)`
+	sqlListNews := `SELECT * FROM "news" ORDER BY "id"`
+	sqlNewsTranslations := `SELECT news_id, title, description FROM news_translations WHERE locale = $1 AND news_id IN ($2)`
+
+	t.Run("Resolve Locale", func(t *testing.T) {
+		header := func(value string) context.Context {
+			return metadata.NewIncomingContext(context.TODO(), metadata.Pairs("grpcgateway-accept-language", value))
+		}
+		cases := []struct {
+			ctx       context.Context
+			requested string
+			expected  string
+		}{
+			{context.TODO(), "", "en"},
+			{context.TODO(), "de", "de"},
+			{context.TODO(), "pt_br", "pt-BR"},
+			{context.TODO(), "fr", "en"},
+			{header("fr-CH, de-AT;q=0.8, en;q=0.5"), "", "de"},
+			{header("en;q=0.4, pt-BR"), "", "pt-BR"},
+			{header("de"), "pt-BR", "pt-BR"},
+			{header("fr, *;q=0.1"), "", "en"},
+		}
+		for _, c := range cases {
+			if locale := locales.Resolve(c.ctx, c.requested); locale != c.expected {
+				t.Fatalf("expected %s for %q, got %s", c.expected, c.requested, locale)
+			}
+		}
+	})
+
+	t.Run("Set Item Translation - positive", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchItem)).WithArgs("some-item-id").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("some-item-id", "Sword"))
+		mock.ExpectExec(regexp.QuoteMeta(upsertItemTranslationQuery)).WithArgs("some-item-id", "de", "Schwert", "").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		res, err := stiClient.SetItemTranslation(ctx, &pb.SetItemTranslationRequest{ItemId: "some-item-id", Locale: "de", Name: "Schwert"})
+		if err != nil {
+			t.Fatalf("error setting item translation: %v", err)
+		}
+		if res.GetResult().GetName() != "Schwert" {
+			t.Fatalf("unexpected translation: %v", res.GetResult())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Set Item Translation - default locale", func(t *testing.T) {
+		_, err := stiClient.SetItemTranslation(ctx, &pb.SetItemTranslationRequest{ItemId: "some-item-id", Locale: "en", Name: "Sword"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Run("Delete Item Translation - not found", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(deleteItemTranslationQuery)).WithArgs("some-item-id", "de").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := stiClient.DeleteItemTranslation(ctx, &pb.DeleteItemTranslationRequest{ItemId: "some-item-id", Locale: "de"})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("expected NotFound, got: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List Items - translated", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListItems)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).
+				AddRow("sword-id", "Sword", "Sharp").
+				AddRow("shield-id", "Shield", "Sturdy"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlItemTranslations)).WithArgs("de", "sword-id", "shield-id").
+			WillReturnRows(sqlmock.NewRows([]string{"store_item_id", "name", "description"}).AddRow("sword-id", "Schwert", ""))
+
+		headerCtx := metadata.AppendToOutgoingContext(ctx, "accept-language", "de-DE,de;q=0.9")
+		res, err := stiClient.List(headerCtx, &pb.ListStoreItemsRequest{})
+		if err != nil {
+			t.Fatalf("error listing items: %v", err)
+		}
+		if res.GetLocale() != "de" {
+			t.Fatalf("expected de locale, got %s", res.GetLocale())
+		}
+		sword, shield := res.GetResults()[0], res.GetResults()[1]
+		if sword.GetName() != "Schwert" || sword.GetDescription() != "Sharp" || shield.GetName() != "Shield" {
+			t.Fatalf("unexpected translated items: %v", res.GetResults())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List News - translated", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListNews)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description"}).AddRow("news-id", "Patch notes", "New maps"))
+		mock.ExpectQuery(regexp.QuoteMeta(sqlNewsTranslations)).WithArgs("pt-BR", "news-id").
+			WillReturnRows(sqlmock.NewRows([]string{"news_id", "title", "description"}).AddRow("news-id", "Notas da versão", "Novos mapas"))
+
+		res, err := newsClient.List(ctx, &pb.ListNewsRequest{Locale: "pt-BR"})
+		if err != nil {
+			t.Fatalf("error listing news: %v", err)
+		}
+		if res.GetLocale() != "pt-BR" || res.GetResults()[0].GetTitle() != "Notas da versão" {
+			t.Fatalf("unexpected translated news: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("List News - default locale", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(sqlListNews)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description"}).AddRow("news-id", "Patch notes", "New maps"))
+
+		res, err := newsClient.List(ctx, &pb.ListNewsRequest{})
+		if err != nil {
+			t.Fatalf("error listing news: %v", err)
+		}
+		if res.GetLocale() != "en" || res.GetResults()[0].GetTitle() != "Patch notes" {
+			t.Fatalf("unexpected news: %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+}
@@ -184,14 +184,16 @@ func (s *UsersServer) List(ctx context.Context, req *pb.ListUsersRequest) (*pb.L
 	logger := ctxlogrus.Extract(ctx)
 	logger.Debug("List users")
 
-	if req.GetOrderBy() != nil {
-		orderBy := strings.ToLower(req.GetOrderBy().GoString())
-
-		if strings.Contains(orderBy, "kills") || strings.Contains(orderBy, "top5") ||
-			strings.Contains(orderBy, "wins") || strings.Contains(orderBy, "games") {
-			resQuery := fetchUsersByStatsQuery + "ORDER BY " + "us." + strings.Split(orderBy, ",")[0] + " LIMIT 100"
-
-			fmt.Println(resQuery)
+	// Deprecated: ordering by stats is kept for old clients, GetLeaderboard replaces it
+	if criterias := req.GetOrderBy().GetCriterias(); len(criterias) > 0 {
+		if column, ok := statsOrderColumn(criterias[0].GetTag()); ok {
+			logger.Warn("Listing users ordered by stats is deprecated, use GetLeaderboard")
+
+			direction := "ASC"
+			if criterias[0].IsDesc() {
+				direction = "DESC"
+			}
+			resQuery := fetchUsersByStatsQuery + "ORDER BY us." + column + " " + direction + " LIMIT 100"
 
 			users := []*pb.User{}
 			rows, err := s.cfg.Database.DB().Query(resQuery)
@@ -199,6 +201,7 @@ func (s *UsersServer) List(ctx context.Context, req *pb.ListUsersRequest) (*pb.L
 				logger.WithError(err).Error("Could not fetch users")
 				return nil, status.Error(codes.Internal, "Could not fetch users")
 			}
+			defer rows.Close()
 			for rows.Next() {
 				user := pb.User{Stats: &pb.UserStats{}}
 				err := rows.Scan(&user.Name, &user.Stats.Games, &user.Stats.Wins, &user.Stats.Top5, &user.Stats.Kills)
@@ -388,3 +391,14 @@ func verifyPassword(s string) (eightOrMore, number, upper bool) {
 	eightOrMore = letters >= 8
 	return
 }
+
+// statsOrderColumn maps a sorting tag to a whitelisted user_stats column
+func statsOrderColumn(tag string) (string, bool) {
+	tag = strings.ToLower(tag)
+	for _, column := range leaderboardColumns {
+		if column == tag {
+			return column, true
+		}
+	}
+	return "", false
+}
@@ -2,6 +2,8 @@ package svc
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/amikhailau/users-service/pkg/pb"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
@@ -11,23 +13,45 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	maxStatsWindowMatches = 500
+
+	recordStatUpdateQuery = "INSERT INTO stat_updates (user_id, games, wins, top5, kills, played_at) VALUES ($1, $2, $3, $4, $5, $6)"
+	statsSinceQuery       = "SELECT COALESCE(sum(games), 0), COALESCE(sum(wins), 0), COALESCE(sum(top5), 0), COALESCE(sum(kills), 0) " +
+		"FROM stat_updates WHERE user_id = $1 AND played_at >= $2"
+	// the window only reads the last $2 rows of the partial index on updates that added games
+	lastMatchesStatsQuery = "SELECT COALESCE(sum(games), 0), COALESCE(sum(wins), 0), COALESCE(sum(top5), 0), COALESCE(sum(kills), 0) FROM (" +
+		"SELECT games, wins, top5, kills FROM stat_updates WHERE user_id = $1 AND games > 0 ORDER BY played_at DESC LIMIT $2) last"
+)
+
 type UsersStatsServerConfig struct {
 	Database    *gorm.DB
 	UsersServer *UsersServer
+	Rewards     RewardsConfig
+	// Plausibility holds the rules stats updates and recorded matches are checked against
+	Plausibility PlausibilityConfig
+	// LeaderboardStaleness is how old cached leaderboards can get, and so how far behind stats writes
+	// they can lag, 0 disables the cache
+	LeaderboardStaleness time.Duration
 }
 
 type UsersStatsServer struct {
 	pb.UsersStatsServer
-	cfg *UsersStatsServerConfig
+	cfg         *UsersStatsServerConfig
+	leaderboard *leaderboardCache
 }
 
 var _ pb.UsersStatsServer = &UsersStatsServer{}
 
 func NewUsersStatsServer(cfg *UsersStatsServerConfig) (*UsersStatsServer, error) {
-	return &UsersStatsServer{
+	s := &UsersStatsServer{
 		UsersStatsServer: &pb.UsersStatsDefaultServer{},
 		cfg:              cfg,
-	}, nil
+	}
+	if cfg.LeaderboardStaleness > 0 {
+		s.leaderboard = newLeaderboardCache(cfg.LeaderboardStaleness)
+	}
+	return s, nil
 }
 
 func (s *UsersStatsServer) GetStats(ctx context.Context, req *pb.ReadUserStatsRequest) (*pb.ReadUserStatsResponse, error) {
@@ -36,18 +60,59 @@ func (s *UsersStatsServer) GetStats(ctx context.Context, req *pb.ReadUserStatsRe
 	})
 	logger.Debug("Read user stats")
 
-	stats, err := s.getDBStats(ctx, logger, req.GetUsername())
-	if err != nil {
-		return nil, err
+	if req.GetLastMatches() < 0 || req.GetLastMatches() > maxStatsWindowMatches {
+		logger.Error("Invalid last matches window")
+		return nil, status.Errorf(codes.InvalidArgument, "Last matches should be between 0 and %d", maxStatsWindowMatches)
 	}
 
-	pbStats, err := stats.ToPB(ctx)
-	if err != nil {
-		logger.WithError(err).Error("Could not fetch user stats")
-		return nil, status.Error(codes.Internal, "Could not fetch user stats")
+	var user *pb.User
+	var result *pb.UserStats
+	if req.GetSeasonId() != 0 {
+		var err error
+		user, err = s.cfg.UsersServer.findUserByProvidedID(ctx, logger, req.GetUsername())
+		if err != nil {
+			return nil, err
+		}
+		result, err = s.getSeasonStats(logger.WithField("season_id", req.GetSeasonId()), user.GetId(), req.GetSeasonId())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var stats *pb.UserStatsORM
+		var err error
+		user, stats, err = s.getDBStats(ctx, logger, req.GetUsername())
+		if err != nil {
+			return nil, err
+		}
+		pbStats, err := stats.ToPB(ctx)
+		if err != nil {
+			logger.WithError(err).Error("Could not fetch user stats")
+			return nil, status.Error(codes.Internal, "Could not fetch user stats")
+		}
+		result = &pbStats
 	}
 
-	return &pb.ReadUserStatsResponse{Result: &pbStats}, nil
+	res := &pb.ReadUserStatsResponse{Result: result, Derived: deriveStats(result)}
+
+	var err error
+	now := time.Now()
+	if req.GetLastWeek() {
+		if res.LastWeek, err = s.windowedStats(logger, statsSinceQuery, user.GetId(), now.AddDate(0, 0, -7)); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetLastMonth() {
+		if res.LastMonth, err = s.windowedStats(logger, statsSinceQuery, user.GetId(), now.AddDate(0, 0, -30)); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetLastMatches() > 0 {
+		if res.LastMatches, err = s.windowedStats(logger, lastMatchesStatsQuery, user.GetId(), req.GetLastMatches()); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
 
 func (s *UsersStatsServer) UpdateStats(ctx context.Context, req *pb.UpdateUserStatsRequest) (*pb.UpdateUserStatsResponse, error) {
@@ -56,7 +121,17 @@ func (s *UsersStatsServer) UpdateStats(ctx context.Context, req *pb.UpdateUserSt
 	})
 	logger.Debug("Update user stats")
 
-	stats, err := s.getDBStats(ctx, logger, req.GetUsername())
+	violations := s.cfg.Plausibility.violations(req)
+	if len(violations) > 0 {
+		logger = logger.WithField("violations", violations)
+		if !s.cfg.Plausibility.Flag {
+			logger.Error("Implausible stats update")
+			return nil, status.Errorf(codes.InvalidArgument, "Implausible stats update: %s", strings.Join(violations, violationsSeparator))
+		}
+		logger.Warn("Implausible stats update, flagging it for review")
+	}
+
+	user, stats, err := s.getDBStats(ctx, logger, req.GetUsername())
 	if err != nil {
 		return nil, err
 	}
@@ -66,18 +141,51 @@ func (s *UsersStatsServer) UpdateStats(ctx context.Context, req *pb.UpdateUserSt
 	stats.Top5 += req.GetAddTop5()
 	stats.Wins += req.GetAddWins()
 
-	if err := s.cfg.Database.Save(stats).Error; err != nil {
+	txnDB := s.cfg.Database.Begin()
+
+	if err := txnDB.Save(stats).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not update user stats")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	if err := txnDB.Exec(applySeasonStatsQuery, user.GetId(), req.GetAddGames(), req.GetAddWins(), req.GetAddTop5(), req.GetAddKills()).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not update season stats")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	if len(violations) > 0 {
+		if err := txnDB.Exec(recordFlaggedStatUpdateQuery, user.GetId(), req.GetAddGames(), req.GetAddWins(), req.GetAddTop5(), req.GetAddKills(), time.Now(),
+			strings.Join(violations, violationsSeparator)).Error; err != nil {
+			txnDB.Rollback()
+			logger.WithError(err).Error("Could not record flagged stats update")
+			return nil, status.Error(codes.Internal, "Could not update user stats")
+		}
+	} else if err := txnDB.Exec(recordStatUpdateQuery, user.GetId(), req.GetAddGames(), req.GetAddWins(), req.GetAddTop5(), req.GetAddKills(), time.Now()).Error; err != nil {
+		txnDB.Rollback()
+		logger.WithError(err).Error("Could not record stats update")
+		return nil, status.Error(codes.Internal, "Could not update user stats")
+	}
+
+	unlocked, err := applyAchievements(logger, txnDB.CommonDB(), user.GetId(), req.GetAddGames(), req.GetAddWins(), req.GetAddTop5(), req.GetAddKills())
+	if err != nil {
+		txnDB.Rollback()
+		return nil, err
+	}
+
+	if err := txnDB.Commit().Error; err != nil {
 		logger.WithError(err).Error("Could not update user stats")
 		return nil, status.Error(codes.Internal, "Could not update user stats")
 	}
 
-	return &pb.UpdateUserStatsResponse{}, nil
+	return &pb.UpdateUserStatsResponse{Unlocked: unlocked, Flagged: len(violations) > 0}, nil
 }
 
-func (s *UsersStatsServer) getDBStats(ctx context.Context, logger *logrus.Entry, username string) (*pb.UserStatsORM, error) {
+func (s *UsersStatsServer) getDBStats(ctx context.Context, logger *logrus.Entry, username string) (*pb.User, *pb.UserStatsORM, error) {
 	user, err := s.cfg.UsersServer.findUserByProvidedID(ctx, logger, username)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	usr := pb.UserORM{
@@ -86,13 +194,41 @@ func (s *UsersStatsServer) getDBStats(ctx context.Context, logger *logrus.Entry,
 	var stats []*pb.UserStatsORM
 	if err := s.cfg.Database.Model(&usr).Association("Stats").Find(&stats).Error; err != nil {
 		logger.WithError(err).Error("Could not fetch user stats")
-		return nil, status.Error(codes.Internal, "Could not fetch user stats")
+		return nil, nil, status.Error(codes.Internal, "Could not fetch user stats")
 	}
 
 	if len(stats) != 1 {
 		logger.Error("Corrupted user - doesn't have 1 stats object")
-		return nil, status.Error(codes.Internal, "Profile is corrupted. Contact support.")
+		return nil, nil, status.Error(codes.Internal, "Profile is corrupted. Contact support.")
+	}
+
+	return user, stats[0], nil
+}
+
+func (s *UsersStatsServer) windowedStats(logger *logrus.Entry, query string, userID string, bound interface{}) (*pb.WindowedStats, error) {
+	totals := &pb.UserStats{}
+	if err := s.cfg.Database.DB().QueryRow(query, userID, bound).Scan(&totals.Games, &totals.Wins, &totals.Top5, &totals.Kills); err != nil {
+		logger.WithError(err).Error("Could not fetch windowed stats")
+		return nil, status.Error(codes.Internal, "Could not fetch user stats")
 	}
+	return &pb.WindowedStats{Totals: totals, Derived: deriveStats(totals)}, nil
+}
 
-	return stats[0], nil
+// deriveStats computes the ratios clients show next to the counters, ratios of players without games are 0
+// and a player who won every game has the kills as K/D
+func deriveStats(stats *pb.UserStats) *pb.DerivedStats {
+	derived := &pb.DerivedStats{}
+	if stats.GetGames() == 0 {
+		return derived
+	}
+	games := float64(stats.GetGames())
+	deaths := stats.GetGames() - stats.GetWins()
+	if deaths < 1 {
+		deaths = 1
+	}
+	derived.KdRatio = float64(stats.GetKills()) / float64(deaths)
+	derived.WinRate = float64(stats.GetWins()) / games
+	derived.Top5Rate = float64(stats.GetTop5()) / games
+	derived.AvgKills = float64(stats.GetKills()) / games
+	return derived
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestUsersStats(t *testing.T) {
@@ -79,6 +81,53 @@ func TestUsersStats(t *testing.T) {
 		}
 	})
 
+	t.Run("Get stats - derived and windows", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
+			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 0, 0, 'f')
+		statsRows := sqlmock.NewRows([]string{"id", "wins", "top5", "kills", "games"}).
+			AddRow(1, 10, 10, 100, 20)
+		windowColumns := []string{"games", "wins", "top5", "kills"}
+
+		mock.ExpectQuery(regexp.QuoteMeta(userSqlSearchID)).WithArgs("some-id").WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(statsRows)
+		mock.ExpectQuery(regexp.QuoteMeta(statsSinceQuery)).WithArgs("some-id", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(windowColumns).AddRow(0, 0, 0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(lastMatchesStatsQuery)).WithArgs("some-id", 5).
+			WillReturnRows(sqlmock.NewRows(windowColumns).AddRow(5, 5, 5, 12))
+
+		res, err := stClient.GetStats(ctx, &pb.ReadUserStatsRequest{
+			Username:    "some-id",
+			LastMonth:   true,
+			LastMatches: 5,
+		})
+		if err != nil {
+			t.Fatalf("error reading user stats: %v", err)
+		}
+		derived := res.GetDerived()
+		if derived.GetKdRatio() != 10 || derived.GetWinRate() != 0.5 || derived.GetTop5Rate() != 0.5 || derived.GetAvgKills() != 5 {
+			t.Fatalf("unexpected derived stats: %v", derived)
+		}
+		if res.GetLastWeek() != nil || res.GetLastMonth().GetDerived().GetWinRate() != 0 {
+			t.Fatalf("unexpected time windows: %v", res)
+		}
+		if res.GetLastMatches().GetTotals().GetKills() != 12 || res.GetLastMatches().GetDerived().GetKdRatio() != 12 {
+			t.Fatalf("unexpected last matches window: %v", res.GetLastMatches())
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("mock shows different data: %v", err)
+		}
+	})
+
+	t.Run("Get stats - too many last matches", func(t *testing.T) {
+		_, err := stClient.GetStats(ctx, &pb.ReadUserStatsRequest{
+			Username:    "some-id",
+			LastMatches: maxStatsWindowMatches + 1,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
 	t.Run("Update stats - positive", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "email", "password", "created_at", "updated_at", "name", "coins", "gems", "is_admin"}).
 			AddRow("some-id", "someemail@email.com", "some-hash", "2020-01-01 01:05:57", "2020-01-01 01:05:57", "some-name", 0, 0, 'f')
@@ -89,6 +138,10 @@ func TestUsersStats(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(sqlSearchID)).WithArgs("some-id").WillReturnRows(statsRows)
 		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(sqlUpdate)).WithArgs(21, 102, 10, nil, 10, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(applySeasonStatsQuery)).WithArgs("some-id", 1, 0, 0, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(recordStatUpdateQuery)).WithArgs("some-id", 1, 0, 0, 2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(achievementProgressQuery)).WithArgs("some-id", 1, 0, 0, 2).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectQuery(regexp.QuoteMeta(unlockAchievementsQuery)).WithArgs("some-id").WillReturnRows(sqlmock.NewRows(nil))
 		mock.ExpectCommit()
 
 		_, err := stClient.UpdateStats(ctx, &pb.UpdateUserStatsRequest{